@@ -1,6 +1,68 @@
 import { Coin, CoinSDKType } from "../../cosmos/base/v1beta1/coin";
 import * as _m0 from "protobufjs/minimal";
 import { Long, DeepPartial } from "../../helpers";
+/** BridgeEventStatus is the status of a bridge event. */
+
+export enum BridgeEventStatus {
+  /** BRIDGE_EVENT_STATUS_UNRECOGNIZED - Event has not been recognized by the queried node nor acknowledged. */
+  BRIDGE_EVENT_STATUS_UNRECOGNIZED = 0,
+
+  /** BRIDGE_EVENT_STATUS_RECOGNIZED - Event has been recognized by the queried node but not yet acknowledged. */
+  BRIDGE_EVENT_STATUS_RECOGNIZED = 1,
+
+  /** BRIDGE_EVENT_STATUS_ACKNOWLEDGED - Event has been acknowledged in-consensus. */
+  BRIDGE_EVENT_STATUS_ACKNOWLEDGED = 2,
+  UNRECOGNIZED = -1,
+}
+/** BridgeEventStatus is the status of a bridge event. */
+
+export enum BridgeEventStatusSDKType {
+  /** BRIDGE_EVENT_STATUS_UNRECOGNIZED - Event has not been recognized by the queried node nor acknowledged. */
+  BRIDGE_EVENT_STATUS_UNRECOGNIZED = 0,
+
+  /** BRIDGE_EVENT_STATUS_RECOGNIZED - Event has been recognized by the queried node but not yet acknowledged. */
+  BRIDGE_EVENT_STATUS_RECOGNIZED = 1,
+
+  /** BRIDGE_EVENT_STATUS_ACKNOWLEDGED - Event has been acknowledged in-consensus. */
+  BRIDGE_EVENT_STATUS_ACKNOWLEDGED = 2,
+  UNRECOGNIZED = -1,
+}
+export function bridgeEventStatusFromJSON(object: any): BridgeEventStatus {
+  switch (object) {
+    case 0:
+    case "BRIDGE_EVENT_STATUS_UNRECOGNIZED":
+      return BridgeEventStatus.BRIDGE_EVENT_STATUS_UNRECOGNIZED;
+
+    case 1:
+    case "BRIDGE_EVENT_STATUS_RECOGNIZED":
+      return BridgeEventStatus.BRIDGE_EVENT_STATUS_RECOGNIZED;
+
+    case 2:
+    case "BRIDGE_EVENT_STATUS_ACKNOWLEDGED":
+      return BridgeEventStatus.BRIDGE_EVENT_STATUS_ACKNOWLEDGED;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BridgeEventStatus.UNRECOGNIZED;
+  }
+}
+export function bridgeEventStatusToJSON(object: BridgeEventStatus): string {
+  switch (object) {
+    case BridgeEventStatus.BRIDGE_EVENT_STATUS_UNRECOGNIZED:
+      return "BRIDGE_EVENT_STATUS_UNRECOGNIZED";
+
+    case BridgeEventStatus.BRIDGE_EVENT_STATUS_RECOGNIZED:
+      return "BRIDGE_EVENT_STATUS_RECOGNIZED";
+
+    case BridgeEventStatus.BRIDGE_EVENT_STATUS_ACKNOWLEDGED:
+      return "BRIDGE_EVENT_STATUS_ACKNOWLEDGED";
+
+    case BridgeEventStatus.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /** BridgeEvent is a recognized event from the Ethereum blockchain. */
 
 export interface BridgeEvent {
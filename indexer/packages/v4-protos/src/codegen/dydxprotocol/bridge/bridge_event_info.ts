@@ -30,6 +30,36 @@ export interface BridgeEventInfoSDKType {
 
   eth_block_height: Long;
 }
+/**
+ * HourlyAcknowledgedAmount stores the total amount of bridge events
+ * acknowledged in an hour.
+ */
+
+export interface HourlyAcknowledgedAmount {
+  /**
+   * The hour (in hours since unix epoch) of block time at which bridge events
+   * were acknowledged.
+   */
+  hour: number;
+  /** The total amount of bridge events acknowledged in above hour. */
+
+  amount: Uint8Array;
+}
+/**
+ * HourlyAcknowledgedAmount stores the total amount of bridge events
+ * acknowledged in an hour.
+ */
+
+export interface HourlyAcknowledgedAmountSDKType {
+  /**
+   * The hour (in hours since unix epoch) of block time at which bridge events
+   * were acknowledged.
+   */
+  hour: number;
+  /** The total amount of bridge events acknowledged in above hour. */
+
+  amount: Uint8Array;
+}
 
 function createBaseBridgeEventInfo(): BridgeEventInfo {
   return {
@@ -84,4 +114,59 @@ export const BridgeEventInfo = {
     return message;
   }
 
+};
+
+function createBaseHourlyAcknowledgedAmount(): HourlyAcknowledgedAmount {
+  return {
+    hour: 0,
+    amount: new Uint8Array()
+  };
+}
+
+export const HourlyAcknowledgedAmount = {
+  encode(message: HourlyAcknowledgedAmount, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.hour !== 0) {
+      writer.uint32(8).uint32(message.hour);
+    }
+
+    if (message.amount.length !== 0) {
+      writer.uint32(18).bytes(message.amount);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): HourlyAcknowledgedAmount {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHourlyAcknowledgedAmount();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.hour = reader.uint32();
+          break;
+
+        case 2:
+          message.amount = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<HourlyAcknowledgedAmount>): HourlyAcknowledgedAmount {
+    const message = createBaseHourlyAcknowledgedAmount();
+    message.hour = object.hour ?? 0;
+    message.amount = object.amount ?? new Uint8Array();
+    return message;
+  }
+
 };
\ No newline at end of file
@@ -104,6 +104,20 @@ export interface SafetyParams {
    */
 
   delayBlocks: number;
+  /**
+   * The maximum total amount of bridge events that can be acknowledged in a
+   * rolling 24 hour window (by block time, at hourly granularity). Zero means
+   * no cap.
+   */
+
+  dailyAcknowledgedAmountCap: Uint8Array;
+  /**
+   * The number of blocks after a bridge event is recognized (by a node's
+   * bridge daemon) until it can be acknowledged, during which a bad bridge
+   * can be detected. Zero means no delay.
+   */
+
+  acknowledgementDelayBlocks: number;
 }
 /** SafetyParams stores safety parameters for the module. */
 
@@ -116,6 +130,20 @@ export interface SafetyParamsSDKType {
    */
 
   delay_blocks: number;
+  /**
+   * The maximum total amount of bridge events that can be acknowledged in a
+   * rolling 24 hour window (by block time, at hourly granularity). Zero means
+   * no cap.
+   */
+
+  daily_acknowledged_amount_cap: Uint8Array;
+  /**
+   * The number of blocks after a bridge event is recognized (by a node's
+   * bridge daemon) until it can be acknowledged, during which a bad bridge
+   * can be detected. Zero means no delay.
+   */
+
+  acknowledgement_delay_blocks: number;
 }
 
 function createBaseEventParams(): EventParams {
@@ -261,7 +289,9 @@ export const ProposeParams = {
 function createBaseSafetyParams(): SafetyParams {
   return {
     isDisabled: false,
-    delayBlocks: 0
+    delayBlocks: 0,
+    dailyAcknowledgedAmountCap: new Uint8Array(),
+    acknowledgementDelayBlocks: 0
   };
 }
 
@@ -275,6 +305,14 @@ export const SafetyParams = {
       writer.uint32(16).uint32(message.delayBlocks);
     }
 
+    if (message.dailyAcknowledgedAmountCap.length !== 0) {
+      writer.uint32(26).bytes(message.dailyAcknowledgedAmountCap);
+    }
+
+    if (message.acknowledgementDelayBlocks !== 0) {
+      writer.uint32(32).uint32(message.acknowledgementDelayBlocks);
+    }
+
     return writer;
   },
 
@@ -295,6 +333,14 @@ export const SafetyParams = {
           message.delayBlocks = reader.uint32();
           break;
 
+        case 3:
+          message.dailyAcknowledgedAmountCap = reader.bytes();
+          break;
+
+        case 4:
+          message.acknowledgementDelayBlocks = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -308,6 +354,8 @@ export const SafetyParams = {
     const message = createBaseSafetyParams();
     message.isDisabled = object.isDisabled ?? false;
     message.delayBlocks = object.delayBlocks ?? 0;
+    message.dailyAcknowledgedAmountCap = object.dailyAcknowledgedAmountCap ?? new Uint8Array();
+    message.acknowledgementDelayBlocks = object.acknowledgementDelayBlocks ?? 0;
     return message;
   }
 
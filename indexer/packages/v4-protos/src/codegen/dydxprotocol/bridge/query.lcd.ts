@@ -1,5 +1,5 @@
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryEventParamsRequest, QueryEventParamsResponseSDKType, QueryProposeParamsRequest, QueryProposeParamsResponseSDKType, QuerySafetyParamsRequest, QuerySafetyParamsResponseSDKType, QueryAcknowledgedEventInfoRequest, QueryAcknowledgedEventInfoResponseSDKType, QueryRecognizedEventInfoRequest, QueryRecognizedEventInfoResponseSDKType, QueryDelayedCompleteBridgeMessagesRequest, QueryDelayedCompleteBridgeMessagesResponseSDKType } from "./query";
+import { QueryEventParamsRequest, QueryEventParamsResponseSDKType, QueryProposeParamsRequest, QueryProposeParamsResponseSDKType, QuerySafetyParamsRequest, QuerySafetyParamsResponseSDKType, QueryAcknowledgedEventInfoRequest, QueryAcknowledgedEventInfoResponseSDKType, QueryRecognizedEventInfoRequest, QueryRecognizedEventInfoResponseSDKType, QueryBridgeEventRequest, QueryBridgeEventResponseSDKType, QueryDelayedCompleteBridgeMessagesRequest, QueryDelayedCompleteBridgeMessagesResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -14,6 +14,7 @@ export class LCDQueryClient {
     this.safetyParams = this.safetyParams.bind(this);
     this.acknowledgedEventInfo = this.acknowledgedEventInfo.bind(this);
     this.recognizedEventInfo = this.recognizedEventInfo.bind(this);
+    this.bridgeEvent = this.bridgeEvent.bind(this);
     this.delayedCompleteBridgeMessages = this.delayedCompleteBridgeMessages.bind(this);
   }
   /* Queries the EventParams. */
@@ -55,6 +56,14 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/v4/bridge/recognized_event_info`;
     return await this.req.get<QueryRecognizedEventInfoResponseSDKType>(endpoint);
   }
+  /* Queries a bridge event by ID and its status. Since recognized events are
+   from memory, the response may be different between nodes. */
+
+
+  async bridgeEvent(params: QueryBridgeEventRequest): Promise<QueryBridgeEventResponseSDKType> {
+    const endpoint = `dydxprotocol/v4/bridge/bridge_event/${params.id}`;
+    return await this.req.get<QueryBridgeEventResponseSDKType>(endpoint);
+  }
   /* Queries all `MsgCompleteBridge` messages that are delayed (not yet
    executed) and corresponding block heights at which they will execute. */
 
@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryEventParamsRequest, QueryEventParamsResponse, QueryProposeParamsRequest, QueryProposeParamsResponse, QuerySafetyParamsRequest, QuerySafetyParamsResponse, QueryAcknowledgedEventInfoRequest, QueryAcknowledgedEventInfoResponse, QueryRecognizedEventInfoRequest, QueryRecognizedEventInfoResponse, QueryDelayedCompleteBridgeMessagesRequest, QueryDelayedCompleteBridgeMessagesResponse } from "./query";
+import { QueryEventParamsRequest, QueryEventParamsResponse, QueryProposeParamsRequest, QueryProposeParamsResponse, QuerySafetyParamsRequest, QuerySafetyParamsResponse, QueryAcknowledgedEventInfoRequest, QueryAcknowledgedEventInfoResponse, QueryRecognizedEventInfoRequest, QueryRecognizedEventInfoResponse, QueryBridgeEventRequest, QueryBridgeEventResponse, QueryDelayedCompleteBridgeMessagesRequest, QueryDelayedCompleteBridgeMessagesResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -27,6 +27,12 @@ export interface Query {
    */
 
   recognizedEventInfo(request?: QueryRecognizedEventInfoRequest): Promise<QueryRecognizedEventInfoResponse>;
+  /**
+   * Queries a bridge event by ID and its status. Since recognized events are
+   * from memory, the response may be different between nodes.
+   */
+
+  bridgeEvent(request: QueryBridgeEventRequest): Promise<QueryBridgeEventResponse>;
   /**
    * Queries all `MsgCompleteBridge` messages that are delayed (not yet
    * executed) and corresponding block heights at which they will execute.
@@ -44,6 +50,7 @@ export class QueryClientImpl implements Query {
     this.safetyParams = this.safetyParams.bind(this);
     this.acknowledgedEventInfo = this.acknowledgedEventInfo.bind(this);
     this.recognizedEventInfo = this.recognizedEventInfo.bind(this);
+    this.bridgeEvent = this.bridgeEvent.bind(this);
     this.delayedCompleteBridgeMessages = this.delayedCompleteBridgeMessages.bind(this);
   }
 
@@ -77,6 +84,12 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QueryRecognizedEventInfoResponse.decode(new _m0.Reader(data)));
   }
 
+  bridgeEvent(request: QueryBridgeEventRequest): Promise<QueryBridgeEventResponse> {
+    const data = QueryBridgeEventRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.bridge.Query", "BridgeEvent", data);
+    return promise.then(data => QueryBridgeEventResponse.decode(new _m0.Reader(data)));
+  }
+
   delayedCompleteBridgeMessages(request: QueryDelayedCompleteBridgeMessagesRequest): Promise<QueryDelayedCompleteBridgeMessagesResponse> {
     const data = QueryDelayedCompleteBridgeMessagesRequest.encode(request).finish();
     const promise = this.rpc.request("dydxprotocol.bridge.Query", "DelayedCompleteBridgeMessages", data);
@@ -108,6 +121,10 @@ export const createRpcQueryExtension = (base: QueryClient) => {
       return queryService.recognizedEventInfo(request);
     },
 
+    bridgeEvent(request: QueryBridgeEventRequest): Promise<QueryBridgeEventResponse> {
+      return queryService.bridgeEvent(request);
+    },
+
     delayedCompleteBridgeMessages(request: QueryDelayedCompleteBridgeMessagesRequest): Promise<QueryDelayedCompleteBridgeMessagesResponse> {
       return queryService.delayedCompleteBridgeMessages(request);
     }
@@ -1,6 +1,7 @@
 import { EventParams, EventParamsSDKType, ProposeParams, ProposeParamsSDKType, SafetyParams, SafetyParamsSDKType } from "./params";
 import { BridgeEventInfo, BridgeEventInfoSDKType } from "./bridge_event_info";
 import { MsgCompleteBridge, MsgCompleteBridgeSDKType } from "./tx";
+import { BridgeEvent, BridgeEventSDKType, BridgeEventStatus, BridgeEventStatusSDKType } from "./bridge_event";
 import * as _m0 from "protobufjs/minimal";
 import { DeepPartial } from "../../helpers";
 /** QueryEventParamsRequest is a request type for the EventParams RPC method. */
@@ -113,6 +114,38 @@ export interface QueryRecognizedEventInfoResponse {
 export interface QueryRecognizedEventInfoResponseSDKType {
   info?: BridgeEventInfoSDKType;
 }
+/** QueryBridgeEventRequest is a request type for the BridgeEvent RPC method. */
+
+export interface QueryBridgeEventRequest {
+  /** QueryBridgeEventRequest is a request type for the BridgeEvent RPC method. */
+  id: number;
+}
+/** QueryBridgeEventRequest is a request type for the BridgeEvent RPC method. */
+
+export interface QueryBridgeEventRequestSDKType {
+  /** QueryBridgeEventRequest is a request type for the BridgeEvent RPC method. */
+  id: number;
+}
+/** QueryBridgeEventResponse is a response type for the BridgeEvent RPC method. */
+
+export interface QueryBridgeEventResponse {
+  /**
+   * The bridge event, which is empty if the queried node doesn't have the
+   * event in memory.
+   */
+  event?: BridgeEvent;
+  status: BridgeEventStatus;
+}
+/** QueryBridgeEventResponse is a response type for the BridgeEvent RPC method. */
+
+export interface QueryBridgeEventResponseSDKType {
+  /**
+   * The bridge event, which is empty if the queried node doesn't have the
+   * event in memory.
+   */
+  event?: BridgeEventSDKType;
+  status: BridgeEventStatusSDKType;
+}
 /**
  * QueryDelayedCompleteBridgeMessagesRequest is a request type for the
  * DelayedCompleteBridgeMessages RPC method.
@@ -569,6 +602,106 @@ export const QueryRecognizedEventInfoResponse = {
 
 };
 
+function createBaseQueryBridgeEventRequest(): QueryBridgeEventRequest {
+  return {
+    id: 0
+  };
+}
+
+export const QueryBridgeEventRequest = {
+  encode(message: QueryBridgeEventRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryBridgeEventRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryBridgeEventRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryBridgeEventRequest>): QueryBridgeEventRequest {
+    const message = createBaseQueryBridgeEventRequest();
+    message.id = object.id ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryBridgeEventResponse(): QueryBridgeEventResponse {
+  return {
+    event: undefined,
+    status: 0
+  };
+}
+
+export const QueryBridgeEventResponse = {
+  encode(message: QueryBridgeEventResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.event !== undefined) {
+      BridgeEvent.encode(message.event, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryBridgeEventResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryBridgeEventResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.event = BridgeEvent.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.status = (reader.int32() as any);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryBridgeEventResponse>): QueryBridgeEventResponse {
+    const message = createBaseQueryBridgeEventResponse();
+    message.event = object.event !== undefined && object.event !== null ? BridgeEvent.fromPartial(object.event) : undefined;
+    message.status = object.status ?? 0;
+    return message;
+  }
+
+};
+
 function createBaseQueryDelayedCompleteBridgeMessagesRequest(): QueryDelayedCompleteBridgeMessagesRequest {
   return {
     address: ""
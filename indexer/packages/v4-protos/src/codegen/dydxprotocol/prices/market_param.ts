@@ -39,6 +39,12 @@ export interface MarketParam {
    */
 
   exchangeConfigJson: string;
+  /**
+   * The expected interval (in seconds) between consecutive price updates of
+   * this market. Zero means the market has no expected update cadence.
+   */
+
+  updateIntervalSeconds: number;
 }
 /**
  * MarketParam represents the x/prices configuration for markets, including
@@ -79,6 +85,12 @@ export interface MarketParamSDKType {
    */
 
   exchange_config_json: string;
+  /**
+   * The expected interval (in seconds) between consecutive price updates of
+   * this market. Zero means the market has no expected update cadence.
+   */
+
+  update_interval_seconds: number;
 }
 
 function createBaseMarketParam(): MarketParam {
@@ -88,7 +100,8 @@ function createBaseMarketParam(): MarketParam {
     exponent: 0,
     minExchanges: 0,
     minPriceChangePpm: 0,
-    exchangeConfigJson: ""
+    exchangeConfigJson: "",
+    updateIntervalSeconds: 0
   };
 }
 
@@ -118,6 +131,10 @@ export const MarketParam = {
       writer.uint32(50).string(message.exchangeConfigJson);
     }
 
+    if (message.updateIntervalSeconds !== 0) {
+      writer.uint32(56).uint32(message.updateIntervalSeconds);
+    }
+
     return writer;
   },
 
@@ -154,6 +171,10 @@ export const MarketParam = {
           message.exchangeConfigJson = reader.string();
           break;
 
+        case 7:
+          message.updateIntervalSeconds = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -171,6 +192,7 @@ export const MarketParam = {
     message.minExchanges = object.minExchanges ?? 0;
     message.minPriceChangePpm = object.minPriceChangePpm ?? 0;
     message.exchangeConfigJson = object.exchangeConfigJson ?? "";
+    message.updateIntervalSeconds = object.updateIntervalSeconds ?? 0;
     return message;
   }
 
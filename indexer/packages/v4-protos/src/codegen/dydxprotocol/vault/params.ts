@@ -1,5 +1,262 @@
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { Long, DeepPartial } from "../../helpers";
+/**
+ * SizeProfile determines how order size is distributed across a vault's
+ * layers.
+ */
+
+export enum SizeProfile {
+  /** SIZE_PROFILE_FLAT - All layers have the same size. */
+  SIZE_PROFILE_FLAT = 0,
+
+  /** SIZE_PROFILE_FRONT_LOADED - Inner layers have more size than outer layers. */
+  SIZE_PROFILE_FRONT_LOADED = 1,
+
+  /** SIZE_PROFILE_BACK_LOADED - Outer layers have more size than inner layers. */
+  SIZE_PROFILE_BACK_LOADED = 2,
+  UNRECOGNIZED = -1,
+}
+/**
+ * SizeProfile determines how order size is distributed across a vault's
+ * layers.
+ */
+
+export enum SizeProfileSDKType {
+  /** SIZE_PROFILE_FLAT - All layers have the same size. */
+  SIZE_PROFILE_FLAT = 0,
+
+  /** SIZE_PROFILE_FRONT_LOADED - Inner layers have more size than outer layers. */
+  SIZE_PROFILE_FRONT_LOADED = 1,
+
+  /** SIZE_PROFILE_BACK_LOADED - Outer layers have more size than inner layers. */
+  SIZE_PROFILE_BACK_LOADED = 2,
+  UNRECOGNIZED = -1,
+}
+export function sizeProfileFromJSON(object: any): SizeProfile {
+  switch (object) {
+    case 0:
+    case "SIZE_PROFILE_FLAT":
+      return SizeProfile.SIZE_PROFILE_FLAT;
+
+    case 1:
+    case "SIZE_PROFILE_FRONT_LOADED":
+      return SizeProfile.SIZE_PROFILE_FRONT_LOADED;
+
+    case 2:
+    case "SIZE_PROFILE_BACK_LOADED":
+      return SizeProfile.SIZE_PROFILE_BACK_LOADED;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return SizeProfile.UNRECOGNIZED;
+  }
+}
+export function sizeProfileToJSON(object: SizeProfile): string {
+  switch (object) {
+    case SizeProfile.SIZE_PROFILE_FLAT:
+      return "SIZE_PROFILE_FLAT";
+
+    case SizeProfile.SIZE_PROFILE_FRONT_LOADED:
+      return "SIZE_PROFILE_FRONT_LOADED";
+
+    case SizeProfile.SIZE_PROFILE_BACK_LOADED:
+      return "SIZE_PROFILE_BACK_LOADED";
+
+    case SizeProfile.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+/**
+ * PlacementPriority determines the order in which a vault places orders of
+ * its layers, which matters when `max_vault_orders_per_block` caps the number
+ * of orders that a vault places.
+ */
+
+export enum PlacementPriority {
+  /** PLACEMENT_PRIORITY_INNER_FIRST - Inner layers are placed before outer layers. */
+  PLACEMENT_PRIORITY_INNER_FIRST = 0,
+
+  /** PLACEMENT_PRIORITY_OUTER_FIRST - Outer layers are placed before inner layers. */
+  PLACEMENT_PRIORITY_OUTER_FIRST = 1,
+  UNRECOGNIZED = -1,
+}
+/**
+ * PlacementPriority determines the order in which a vault places orders of
+ * its layers, which matters when `max_vault_orders_per_block` caps the number
+ * of orders that a vault places.
+ */
+
+export enum PlacementPrioritySDKType {
+  /** PLACEMENT_PRIORITY_INNER_FIRST - Inner layers are placed before outer layers. */
+  PLACEMENT_PRIORITY_INNER_FIRST = 0,
+
+  /** PLACEMENT_PRIORITY_OUTER_FIRST - Outer layers are placed before inner layers. */
+  PLACEMENT_PRIORITY_OUTER_FIRST = 1,
+  UNRECOGNIZED = -1,
+}
+export function placementPriorityFromJSON(object: any): PlacementPriority {
+  switch (object) {
+    case 0:
+    case "PLACEMENT_PRIORITY_INNER_FIRST":
+      return PlacementPriority.PLACEMENT_PRIORITY_INNER_FIRST;
+
+    case 1:
+    case "PLACEMENT_PRIORITY_OUTER_FIRST":
+      return PlacementPriority.PLACEMENT_PRIORITY_OUTER_FIRST;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PlacementPriority.UNRECOGNIZED;
+  }
+}
+export function placementPriorityToJSON(object: PlacementPriority): string {
+  switch (object) {
+    case PlacementPriority.PLACEMENT_PRIORITY_INNER_FIRST:
+      return "PLACEMENT_PRIORITY_INNER_FIRST";
+
+    case PlacementPriority.PLACEMENT_PRIORITY_OUTER_FIRST:
+      return "PLACEMENT_PRIORITY_OUTER_FIRST";
+
+    case PlacementPriority.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+/**
+ * ActivationThresholdMode determines how the quote quantums that a vault with
+ * no perpetual positions must have to activate are computed.
+ */
+
+export enum ActivationThresholdMode {
+  /** ACTIVATION_THRESHOLD_MODE_STATIC - The threshold is `activation_threshold_quote_quantums`. */
+  ACTIVATION_THRESHOLD_MODE_STATIC = 0,
+
+  /**
+   * ACTIVATION_THRESHOLD_MODE_DYNAMIC - The threshold is a vault's minimum order notional times `layers`, i.e.
+   * the quote quantums needed to fund a full book. Minimum order notional is
+   * the notional of the smallest order that a vault can place at oracle price.
+   */
+  ACTIVATION_THRESHOLD_MODE_DYNAMIC = 1,
+  UNRECOGNIZED = -1,
+}
+/**
+ * ActivationThresholdMode determines how the quote quantums that a vault with
+ * no perpetual positions must have to activate are computed.
+ */
+
+export enum ActivationThresholdModeSDKType {
+  /** ACTIVATION_THRESHOLD_MODE_STATIC - The threshold is `activation_threshold_quote_quantums`. */
+  ACTIVATION_THRESHOLD_MODE_STATIC = 0,
+
+  /**
+   * ACTIVATION_THRESHOLD_MODE_DYNAMIC - The threshold is a vault's minimum order notional times `layers`, i.e.
+   * the quote quantums needed to fund a full book. Minimum order notional is
+   * the notional of the smallest order that a vault can place at oracle price.
+   */
+  ACTIVATION_THRESHOLD_MODE_DYNAMIC = 1,
+  UNRECOGNIZED = -1,
+}
+export function activationThresholdModeFromJSON(object: any): ActivationThresholdMode {
+  switch (object) {
+    case 0:
+    case "ACTIVATION_THRESHOLD_MODE_STATIC":
+      return ActivationThresholdMode.ACTIVATION_THRESHOLD_MODE_STATIC;
+
+    case 1:
+    case "ACTIVATION_THRESHOLD_MODE_DYNAMIC":
+      return ActivationThresholdMode.ACTIVATION_THRESHOLD_MODE_DYNAMIC;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ActivationThresholdMode.UNRECOGNIZED;
+  }
+}
+export function activationThresholdModeToJSON(object: ActivationThresholdMode): string {
+  switch (object) {
+    case ActivationThresholdMode.ACTIVATION_THRESHOLD_MODE_STATIC:
+      return "ACTIVATION_THRESHOLD_MODE_STATIC";
+
+    case ActivationThresholdMode.ACTIVATION_THRESHOLD_MODE_DYNAMIC:
+      return "ACTIVATION_THRESHOLD_MODE_DYNAMIC";
+
+    case ActivationThresholdMode.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+/**
+ * RefreshStrategy determines how a vault replaces its resting orders with new
+ * orders when refreshing its orders.
+ */
+
+export enum RefreshStrategy {
+  /**
+   * REFRESH_STRATEGY_REPLACE - Each new order replaces the resting order of the same side and layer, for
+   * which a single long-term order replacement indexer event is sent.
+   */
+  REFRESH_STRATEGY_REPLACE = 0,
+
+  /**
+   * REFRESH_STRATEGY_CANCEL_THEN_PLACE - Resting orders are cancelled before new orders are placed, for which an
+   * order removal indexer event is sent for each cancelled order followed by
+   * an order placement indexer event for each placed order.
+   */
+  REFRESH_STRATEGY_CANCEL_THEN_PLACE = 1,
+  UNRECOGNIZED = -1,
+}
+/**
+ * RefreshStrategy determines how a vault replaces its resting orders with new
+ * orders when refreshing its orders.
+ */
+
+export enum RefreshStrategySDKType {
+  /**
+   * REFRESH_STRATEGY_REPLACE - Each new order replaces the resting order of the same side and layer, for
+   * which a single long-term order replacement indexer event is sent.
+   */
+  REFRESH_STRATEGY_REPLACE = 0,
+
+  /**
+   * REFRESH_STRATEGY_CANCEL_THEN_PLACE - Resting orders are cancelled before new orders are placed, for which an
+   * order removal indexer event is sent for each cancelled order followed by
+   * an order placement indexer event for each placed order.
+   */
+  REFRESH_STRATEGY_CANCEL_THEN_PLACE = 1,
+  UNRECOGNIZED = -1,
+}
+export function refreshStrategyFromJSON(object: any): RefreshStrategy {
+  switch (object) {
+    case 0:
+    case "REFRESH_STRATEGY_REPLACE":
+      return RefreshStrategy.REFRESH_STRATEGY_REPLACE;
+
+    case 1:
+    case "REFRESH_STRATEGY_CANCEL_THEN_PLACE":
+      return RefreshStrategy.REFRESH_STRATEGY_CANCEL_THEN_PLACE;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return RefreshStrategy.UNRECOGNIZED;
+  }
+}
+export function refreshStrategyToJSON(object: RefreshStrategy): string {
+  switch (object) {
+    case RefreshStrategy.REFRESH_STRATEGY_REPLACE:
+      return "REFRESH_STRATEGY_REPLACE";
+
+    case RefreshStrategy.REFRESH_STRATEGY_CANCEL_THEN_PLACE:
+      return "REFRESH_STRATEGY_CANCEL_THEN_PLACE";
+
+    case RefreshStrategy.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /** Params stores `x/vault` parameters. */
 
 export interface Params {
@@ -31,10 +288,298 @@ export interface Params {
    * The number of quote quantums in quote asset that a vault with no perpetual
    * positions must have to activate, i.e. if a vault has no perpetual positions
    * and has strictly less than this amount of quote asset, it will not
-   * activate.
+   * activate. Whether a vault with exactly this amount activates depends on
+   * `activation_inclusive`. Only used if `activation_threshold_mode` is static.
    */
 
   activationThresholdQuoteQuantums: Uint8Array;
+  /**
+   * The minimum amount of equity (in quote quantums) that a vault must have
+   * for each layer of orders it places. If a vault's equity can't fund all
+   * `layers`, it places fewer layers (inner layers first) but always at least
+   * one. A value of zero disables this reduction.
+   */
+
+  minEquityPerLayerQuoteQuantums: Uint8Array;
+  /**
+   * Whether a vault skews its orders based on its inventory. If false, a
+   * vault's bids and asks are symmetric around the oracle price regardless of
+   * its inventory.
+   */
+
+  skewEnabled: boolean;
+  /**
+   * The minimum number of blocks between two consecutive order refreshes of a
+   * vault. Since client IDs of vault orders alternate between even and odd
+   * blocks, a refresh only happens on a block of different parity from the
+   * vault's last refresh, so this must be zero or odd. A value of zero or one
+   * refreshes every block.
+   */
+
+  minRefreshIntervalBlocks: number;
+  /**
+   * The minimum size (in base quantums) of an order that a vault places. If
+   * order size is positive but rounds down to zero, it is rounded up to this
+   * size (and then up to a multiple of step base quantums) instead, in which
+   * case a vault places only as many layers as its equity can fund. A value of
+   * zero disables this rounding up.
+   */
+
+  minLotBaseQuantums: Long;
+  /**
+   * The spread multiplier (in ppm) of each layer. If non-empty, the i-th
+   * layer is placed `spread * spread_multiplier_ppm_by_layer[i] / 1_000_000`
+   * away from the oracle price (before skew) instead of `spread * (i+1)`.
+   * Layers beyond the length of this list use its last multiplier. Length of
+   * this list must not exceed `layers`.
+   */
+
+  spreadMultiplierPpmByLayer: number[];
+  /**
+   * The maximum change (in base quantums) in a vault's inventory if all orders
+   * on one side that increase the vault's exposure or flip its position were
+   * filled in a block. Sizes of such orders are scaled down proportionally to
+   * respect this limit. A value of zero disables this limit.
+   */
+
+  maxPositionDeltaPerBlockBaseQuantums: Long;
+  /**
+   * The maximum age (in seconds) of a vault order, after which the order is
+   * cancelled regardless of whether the vault refreshes its orders. A value of
+   * zero disables this limit.
+   */
+
+  hardMaxOrderAgeSeconds: number;
+  /**
+   * The minimum number of ticks that a vault's orders must be away from oracle
+   * price on each side, i.e. bids are at most `oracle - n * tick` and asks are
+   * at least `oracle + n * tick`. A value of zero disables this floor.
+   */
+
+  minTicksFromOraclePerSide: number;
+  /**
+   * How strongly (in ppm) order sizes shrink as volatility of a vault's market
+   * rises. Order sizes are scaled by
+   * `1 / (1 + order_size_vol_scale_ppm * volatility_ppm / 1_000_000^2)`, where
+   * `volatility_ppm` is the EWMA of absolute per-block oracle price returns of
+   * the market. A value of zero disables this scaling.
+   */
+
+  orderSizeVolScalePpm: number;
+  /**
+   * Whether to move orders of some vaults one tick away from oracle price, as
+   * deterministically derived from each vault's ID, so that vaults with
+   * identical params don't all quote at the same price levels.
+   */
+
+  subticksJitterEnabled: boolean;
+  /**
+   * How order size is distributed across layers. Size of each layer is order
+   * size weighted by the profile such that the average weight across layers
+   * is one.
+   */
+
+  sizeProfile: SizeProfile;
+  /**
+   * Whether spread is at least the round-trip fee of vaults, i.e. sum of taker
+   * and maker fee ppm of a vault's fee tier, so that quotes are profitable
+   * after fees.
+   */
+
+  includeFeeFloor: boolean;
+  /**
+   * The absolute inventory (in base quantums) beyond which sizes of orders on
+   * the side that increases a vault's exposure are scaled down by
+   * `soft_inventory_band_base_quantums / |inventory|`, i.e. the further
+   * inventory is beyond the band the smaller the orders (halved at twice the
+   * band), to slow down accumulation before hitting hard limits. Scaled sizes
+   * are at least step size so that the side isn't suppressed. A value of zero
+   * disables this band.
+   */
+
+  softInventoryBandBaseQuantums: Long;
+  /**
+   * Address of the vault operator, who can update a whitelisted subset of
+   * params within `operator_param_bounds` between gov proposals. Empty if
+   * there is no operator.
+   */
+
+  operator: string;
+  /** The minimum number of blocks between two param updates by the operator. */
+
+  operatorUpdateIntervalBlocks: number;
+  /** The bounds within which the operator can update params. */
+
+  operatorParamBounds?: OperatorParamBounds;
+  /**
+   * Whether a vault with no perpetual positions and exactly
+   * `activation_threshold_quote_quantums` of quote asset activates. If true,
+   * a vault activates at the threshold (`>=`). If false, a vault must have
+   * strictly more than the threshold (`>`) to activate.
+   */
+
+  activationInclusive: boolean;
+  /**
+   * Fraction (in parts-per-million) of a vault order's size that a single fill
+   * must exceed to requote the vault within the same block, bypassing
+   * `min_refresh_interval_blocks`. Zero disables requoting on fills.
+   */
+
+  requoteFillThresholdPctPpm: number;
+  /**
+   * The maximum number of orders that all vaults place in a block, which bounds
+   * block size. Once this is reached, the last refreshed vault only places some
+   * of its orders in order of `placement_priority`, and it and remaining vaults
+   * are deferred to later blocks in round-robin order. Zero means no limit.
+   */
+
+  maxVaultOrdersPerBlock: number;
+  /**
+   * The number of blocks before a vault's resting orders expire within which
+   * the vault replaces them, bypassing `min_refresh_interval_blocks`. Block
+   * duration is estimated as the duration of the last block. Since refreshes
+   * only happen on blocks of different parity from the last refresh, this
+   * should be at least two. Zero disables early renewal.
+   */
+
+  renewBufferBlocks: number;
+  /**
+   * Minimum lifetime (in seconds) of vault orders. Good-til-block-time of a vault
+   * order is raised to at least current block time plus this, such that orders
+   * don't expire within the block of placement under clock skew. Must be at most
+   * the clob's stateful order time window. Zero disables the floor.
+   */
+
+  minOrderLifetimeSeconds: number;
+  /**
+   * The absolute inventory (in base quantums) within which a vault's inventory
+   * is treated as zero when computing skew, such that small inventory
+   * fluctuations near flat don't reprice quotes. Beyond the band, skew is
+   * computed from inventory measured from the edge of the band. Doesn't apply
+   * to vaults with index constituents. A value of zero disables this band.
+   */
+
+  inventoryDeadBandBaseQuantums: Long;
+  /**
+   * Whether to round order subticks to a multiple of subticks per tick towards
+   * oracle price (asks down and bids up) instead of away from it, as long as
+   * orders stay on their side of oracle price, so that quotes are slightly
+   * tighter.
+   */
+
+  roundToMid: boolean;
+  /**
+   * Whether to net exposure of vaults that quote at the same price market. If
+   * true, a vault whose position is fully offset by opposing positions of other
+   * such vaults doesn't place orders that reduce its position, as those orders
+   * would increase net exposure of the vaults.
+   */
+
+  crossVaultNetting: boolean;
+  /**
+   * Maximum deviation (in ppm) of an order's subticks from oracle price, beyond
+   * which a warning is logged and telemetry is emitted, as such an order likely
+   * indicates a misconfiguration. 0 means disabled.
+   */
+
+  maxSubticksDeviationPpm: number;
+  /**
+   * Whether orders whose subticks deviate from oracle price by more than
+   * `max_subticks_deviation_ppm` are not placed.
+   */
+
+  strictSubticksDeviation: boolean;
+  /** The order in which a vault places orders of its layers. */
+
+  placementPriority: PlacementPriority;
+  /** How the activation threshold of a vault is computed. */
+
+  activationThresholdMode: ActivationThresholdMode;
+  /**
+   * The factor (in ppm) by which a vault widens its spread as its inventory
+   * grows, i.e. `spread = spread * (1 + |leverage| * inventory_spread_scale)`.
+   * Unlike skew, which shifts orders of both sides in the same direction, this
+   * widens the spread on both sides so that an exposed vault charges more for
+   * liquidity. A value of zero disables this scaling.
+   */
+
+  inventorySpreadScalePpm: number;
+  /**
+   * Whether a vault skips refreshing its orders if orders to place are the
+   * same as its resting orders, i.e. have the same side, size, and subticks at
+   * each side and layer, and none of its resting orders expires within
+   * `max(renew_buffer_blocks, 1)` blocks, which saves cancelling and placing
+   * the same orders.
+   */
+
+  skipUnchangedOrders: boolean;
+  /**
+   * The duration (in seconds) of an epoch over which time-weighted share
+   * balance of each owner is tracked, where epoch `e` starts at unix time
+   * `e * owner_share_epoch_seconds`. Changing this value invalidates tracked
+   * weights of past epochs. A value of zero disables tracking.
+   */
+
+  ownerShareEpochSeconds: number;
+  /**
+   * The number of consecutive blocks for which an active vault places zero
+   * orders when refreshing its orders before a vault_watchdog event is
+   * emitted, which is emitted again at each such refresh with an escalating
+   * level until the vault places orders again. A value of zero disables the
+   * watchdog.
+   */
+
+  watchdogBlocks: number;
+  /** How a vault replaces its resting orders when refreshing its orders. */
+
+  refreshStrategy: RefreshStrategy;
+  /**
+   * The maximum magnitude (in ppm) of skew of each layer, i.e. skew is clamped
+   * to `[-max_skew_ppm, max_skew_ppm]`, which bounds how far a highly
+   * leveraged vault shifts its quotes. A value of zero means skew is unbounded.
+   */
+
+  maxSkewPpm: number;
+  /**
+   * Whether a vault keeps its resting orders of outer layers (all layers but
+   * layer 0) that are the same as the orders it would place, instead of
+   * replacing them, which reduces churn of a vault's book when prices haven't
+   * moved. A kept order is still replaced once it's due for renewal.
+   */
+
+  skipUnchangedOuterLayers: boolean;
+  /**
+   * Client metadata that is set on all vault orders, which allows fills of
+   * vault orders to be attributed to a version of the vault strategy.
+   */
+
+  clientMetadata: number;
+  /**
+   * Whether pending funding of a vault is settled into its subaccount before
+   * shares of a deposit are computed. Vault equity already accounts for pending
+   * funding, so share math is the same either way, but settling first persists
+   * funding owed to or by the vault before its shares change.
+   */
+
+  settleFundingBeforeShareMath: boolean;
+  /**
+   * Minimum number of blocks between a vault's last refresh and a refresh of
+   * its orders triggered by the vault operator (via `MsgRefreshVaultOrders`),
+   * which limits how often the operator can refresh a vault's orders. This is a
+   * single interval that applies to every vault. A value of zero means operator
+   * refreshes are only limited to blocks with a different parity from the block
+   * of last refresh.
+   */
+
+  operatorRefreshIntervalBlocks: number;
+  /**
+   * Number of update intervals of a vault's price market after which vault
+   * orders expire, such that orders don't outlive a few oracle updates. If zero
+   * or if the market has no `update_interval_seconds`, orders expire after
+   * `order_expiration_seconds` instead.
+   */
+
+  expirationOracleTicks: number;
 }
 /** Params stores `x/vault` parameters. */
 
@@ -67,10 +612,326 @@ export interface ParamsSDKType {
    * The number of quote quantums in quote asset that a vault with no perpetual
    * positions must have to activate, i.e. if a vault has no perpetual positions
    * and has strictly less than this amount of quote asset, it will not
-   * activate.
+   * activate. Whether a vault with exactly this amount activates depends on
+   * `activation_inclusive`. Only used if `activation_threshold_mode` is static.
    */
 
   activation_threshold_quote_quantums: Uint8Array;
+  /**
+   * The minimum amount of equity (in quote quantums) that a vault must have
+   * for each layer of orders it places. If a vault's equity can't fund all
+   * `layers`, it places fewer layers (inner layers first) but always at least
+   * one. A value of zero disables this reduction.
+   */
+
+  min_equity_per_layer_quote_quantums: Uint8Array;
+  /**
+   * Whether a vault skews its orders based on its inventory. If false, a
+   * vault's bids and asks are symmetric around the oracle price regardless of
+   * its inventory.
+   */
+
+  skew_enabled: boolean;
+  /**
+   * The minimum number of blocks between two consecutive order refreshes of a
+   * vault. Since client IDs of vault orders alternate between even and odd
+   * blocks, a refresh only happens on a block of different parity from the
+   * vault's last refresh, so this must be zero or odd. A value of zero or one
+   * refreshes every block.
+   */
+
+  min_refresh_interval_blocks: number;
+  /**
+   * The minimum size (in base quantums) of an order that a vault places. If
+   * order size is positive but rounds down to zero, it is rounded up to this
+   * size (and then up to a multiple of step base quantums) instead, in which
+   * case a vault places only as many layers as its equity can fund. A value of
+   * zero disables this rounding up.
+   */
+
+  min_lot_base_quantums: Long;
+  /**
+   * The spread multiplier (in ppm) of each layer. If non-empty, the i-th
+   * layer is placed `spread * spread_multiplier_ppm_by_layer[i] / 1_000_000`
+   * away from the oracle price (before skew) instead of `spread * (i+1)`.
+   * Layers beyond the length of this list use its last multiplier. Length of
+   * this list must not exceed `layers`.
+   */
+
+  spread_multiplier_ppm_by_layer: number[];
+  /**
+   * The maximum change (in base quantums) in a vault's inventory if all orders
+   * on one side that increase the vault's exposure or flip its position were
+   * filled in a block. Sizes of such orders are scaled down proportionally to
+   * respect this limit. A value of zero disables this limit.
+   */
+
+  max_position_delta_per_block_base_quantums: Long;
+  /**
+   * The maximum age (in seconds) of a vault order, after which the order is
+   * cancelled regardless of whether the vault refreshes its orders. A value of
+   * zero disables this limit.
+   */
+
+  hard_max_order_age_seconds: number;
+  /**
+   * The minimum number of ticks that a vault's orders must be away from oracle
+   * price on each side, i.e. bids are at most `oracle - n * tick` and asks are
+   * at least `oracle + n * tick`. A value of zero disables this floor.
+   */
+
+  min_ticks_from_oracle_per_side: number;
+  /**
+   * How strongly (in ppm) order sizes shrink as volatility of a vault's market
+   * rises. Order sizes are scaled by
+   * `1 / (1 + order_size_vol_scale_ppm * volatility_ppm / 1_000_000^2)`, where
+   * `volatility_ppm` is the EWMA of absolute per-block oracle price returns of
+   * the market. A value of zero disables this scaling.
+   */
+
+  order_size_vol_scale_ppm: number;
+  /**
+   * Whether to move orders of some vaults one tick away from oracle price, as
+   * deterministically derived from each vault's ID, so that vaults with
+   * identical params don't all quote at the same price levels.
+   */
+
+  subticks_jitter_enabled: boolean;
+  /**
+   * How order size is distributed across layers. Size of each layer is order
+   * size weighted by the profile such that the average weight across layers
+   * is one.
+   */
+
+  size_profile: SizeProfileSDKType;
+  /**
+   * Whether spread is at least the round-trip fee of vaults, i.e. sum of taker
+   * and maker fee ppm of a vault's fee tier, so that quotes are profitable
+   * after fees.
+   */
+
+  include_fee_floor: boolean;
+  /**
+   * The absolute inventory (in base quantums) beyond which sizes of orders on
+   * the side that increases a vault's exposure are scaled down by
+   * `soft_inventory_band_base_quantums / |inventory|`, i.e. the further
+   * inventory is beyond the band the smaller the orders (halved at twice the
+   * band), to slow down accumulation before hitting hard limits. Scaled sizes
+   * are at least step size so that the side isn't suppressed. A value of zero
+   * disables this band.
+   */
+
+  soft_inventory_band_base_quantums: Long;
+  /**
+   * Address of the vault operator, who can update a whitelisted subset of
+   * params within `operator_param_bounds` between gov proposals. Empty if
+   * there is no operator.
+   */
+
+  operator: string;
+  /** The minimum number of blocks between two param updates by the operator. */
+
+  operator_update_interval_blocks: number;
+  /** The bounds within which the operator can update params. */
+
+  operator_param_bounds?: OperatorParamBoundsSDKType;
+  /**
+   * Whether a vault with no perpetual positions and exactly
+   * `activation_threshold_quote_quantums` of quote asset activates. If true,
+   * a vault activates at the threshold (`>=`). If false, a vault must have
+   * strictly more than the threshold (`>`) to activate.
+   */
+
+  activation_inclusive: boolean;
+  /**
+   * Fraction (in parts-per-million) of a vault order's size that a single fill
+   * must exceed to requote the vault within the same block, bypassing
+   * `min_refresh_interval_blocks`. Zero disables requoting on fills.
+   */
+
+  requote_fill_threshold_pct_ppm: number;
+  /**
+   * The maximum number of orders that all vaults place in a block, which bounds
+   * block size. Once this is reached, the last refreshed vault only places some
+   * of its orders in order of `placement_priority`, and it and remaining vaults
+   * are deferred to later blocks in round-robin order. Zero means no limit.
+   */
+
+  max_vault_orders_per_block: number;
+  /**
+   * The number of blocks before a vault's resting orders expire within which
+   * the vault replaces them, bypassing `min_refresh_interval_blocks`. Block
+   * duration is estimated as the duration of the last block. Since refreshes
+   * only happen on blocks of different parity from the last refresh, this
+   * should be at least two. Zero disables early renewal.
+   */
+
+  renew_buffer_blocks: number;
+  /**
+   * Minimum lifetime (in seconds) of vault orders. Good-til-block-time of a vault
+   * order is raised to at least current block time plus this, such that orders
+   * don't expire within the block of placement under clock skew. Must be at most
+   * the clob's stateful order time window. Zero disables the floor.
+   */
+
+  min_order_lifetime_seconds: number;
+  /**
+   * The absolute inventory (in base quantums) within which a vault's inventory
+   * is treated as zero when computing skew, such that small inventory
+   * fluctuations near flat don't reprice quotes. Beyond the band, skew is
+   * computed from inventory measured from the edge of the band. Doesn't apply
+   * to vaults with index constituents. A value of zero disables this band.
+   */
+
+  inventory_dead_band_base_quantums: Long;
+  /**
+   * Whether to round order subticks to a multiple of subticks per tick towards
+   * oracle price (asks down and bids up) instead of away from it, as long as
+   * orders stay on their side of oracle price, so that quotes are slightly
+   * tighter.
+   */
+
+  round_to_mid: boolean;
+  /**
+   * Whether to net exposure of vaults that quote at the same price market. If
+   * true, a vault whose position is fully offset by opposing positions of other
+   * such vaults doesn't place orders that reduce its position, as those orders
+   * would increase net exposure of the vaults.
+   */
+
+  cross_vault_netting: boolean;
+  /**
+   * Maximum deviation (in ppm) of an order's subticks from oracle price, beyond
+   * which a warning is logged and telemetry is emitted, as such an order likely
+   * indicates a misconfiguration. 0 means disabled.
+   */
+
+  max_subticks_deviation_ppm: number;
+  /**
+   * Whether orders whose subticks deviate from oracle price by more than
+   * `max_subticks_deviation_ppm` are not placed.
+   */
+
+  strict_subticks_deviation: boolean;
+  /** The order in which a vault places orders of its layers. */
+
+  placement_priority: PlacementPrioritySDKType;
+  /** How the activation threshold of a vault is computed. */
+
+  activation_threshold_mode: ActivationThresholdModeSDKType;
+  /**
+   * The factor (in ppm) by which a vault widens its spread as its inventory
+   * grows, i.e. `spread = spread * (1 + |leverage| * inventory_spread_scale)`.
+   * Unlike skew, which shifts orders of both sides in the same direction, this
+   * widens the spread on both sides so that an exposed vault charges more for
+   * liquidity. A value of zero disables this scaling.
+   */
+
+  inventory_spread_scale_ppm: number;
+  /**
+   * Whether a vault skips refreshing its orders if orders to place are the
+   * same as its resting orders, i.e. have the same side, size, and subticks at
+   * each side and layer, and none of its resting orders expires within
+   * `max(renew_buffer_blocks, 1)` blocks, which saves cancelling and placing
+   * the same orders.
+   */
+
+  skip_unchanged_orders: boolean;
+  /**
+   * The duration (in seconds) of an epoch over which time-weighted share
+   * balance of each owner is tracked, where epoch `e` starts at unix time
+   * `e * owner_share_epoch_seconds`. Changing this value invalidates tracked
+   * weights of past epochs. A value of zero disables tracking.
+   */
+
+  owner_share_epoch_seconds: number;
+  /**
+   * The number of consecutive blocks for which an active vault places zero
+   * orders when refreshing its orders before a vault_watchdog event is
+   * emitted, which is emitted again at each such refresh with an escalating
+   * level until the vault places orders again. A value of zero disables the
+   * watchdog.
+   */
+
+  watchdog_blocks: number;
+  /** How a vault replaces its resting orders when refreshing its orders. */
+
+  refresh_strategy: RefreshStrategySDKType;
+  /**
+   * The maximum magnitude (in ppm) of skew of each layer, i.e. skew is clamped
+   * to `[-max_skew_ppm, max_skew_ppm]`, which bounds how far a highly
+   * leveraged vault shifts its quotes. A value of zero means skew is unbounded.
+   */
+
+  max_skew_ppm: number;
+  /**
+   * Whether a vault keeps its resting orders of outer layers (all layers but
+   * layer 0) that are the same as the orders it would place, instead of
+   * replacing them, which reduces churn of a vault's book when prices haven't
+   * moved. A kept order is still replaced once it's due for renewal.
+   */
+
+  skip_unchanged_outer_layers: boolean;
+  /**
+   * Client metadata that is set on all vault orders, which allows fills of
+   * vault orders to be attributed to a version of the vault strategy.
+   */
+
+  client_metadata: number;
+  /**
+   * Whether pending funding of a vault is settled into its subaccount before
+   * shares of a deposit are computed. Vault equity already accounts for pending
+   * funding, so share math is the same either way, but settling first persists
+   * funding owed to or by the vault before its shares change.
+   */
+
+  settle_funding_before_share_math: boolean;
+  /**
+   * Minimum number of blocks between a vault's last refresh and a refresh of
+   * its orders triggered by the vault operator (via `MsgRefreshVaultOrders`),
+   * which limits how often the operator can refresh a vault's orders. This is a
+   * single interval that applies to every vault. A value of zero means operator
+   * refreshes are only limited to blocks with a different parity from the block
+   * of last refresh.
+   */
+
+  operator_refresh_interval_blocks: number;
+  /**
+   * Number of update intervals of a vault's price market after which vault
+   * orders expire, such that orders don't outlive a few oracle updates. If zero
+   * or if the market has no `update_interval_seconds`, orders expire after
+   * `order_expiration_seconds` instead.
+   */
+
+  expiration_oracle_ticks: number;
+}
+/**
+ * OperatorParamBounds are the inclusive bounds within which the vault operator
+ * can update params.
+ */
+
+export interface OperatorParamBounds {
+  /** Minimum and maximum `spread_min_ppm` that the operator can set. */
+  spreadMinPpmMin: number;
+  spreadMinPpmMax: number;
+  /** Minimum and maximum `order_size_pct_ppm` that the operator can set. */
+
+  orderSizePctPpmMin: number;
+  orderSizePctPpmMax: number;
+}
+/**
+ * OperatorParamBounds are the inclusive bounds within which the vault operator
+ * can update params.
+ */
+
+export interface OperatorParamBoundsSDKType {
+  /** Minimum and maximum `spread_min_ppm` that the operator can set. */
+  spread_min_ppm_min: number;
+  spread_min_ppm_max: number;
+  /** Minimum and maximum `order_size_pct_ppm` that the operator can set. */
+
+  order_size_pct_ppm_min: number;
+  order_size_pct_ppm_max: number;
 }
 
 function createBaseParams(): Params {
@@ -81,7 +942,46 @@ function createBaseParams(): Params {
     skewFactorPpm: 0,
     orderSizePctPpm: 0,
     orderExpirationSeconds: 0,
-    activationThresholdQuoteQuantums: new Uint8Array()
+    activationThresholdQuoteQuantums: new Uint8Array(),
+    minEquityPerLayerQuoteQuantums: new Uint8Array(),
+    skewEnabled: false,
+    minRefreshIntervalBlocks: 0,
+    minLotBaseQuantums: Long.UZERO,
+    spreadMultiplierPpmByLayer: [],
+    maxPositionDeltaPerBlockBaseQuantums: Long.UZERO,
+    hardMaxOrderAgeSeconds: 0,
+    minTicksFromOraclePerSide: 0,
+    orderSizeVolScalePpm: 0,
+    subticksJitterEnabled: false,
+    sizeProfile: 0,
+    includeFeeFloor: false,
+    softInventoryBandBaseQuantums: Long.UZERO,
+    operator: "",
+    operatorUpdateIntervalBlocks: 0,
+    operatorParamBounds: undefined,
+    activationInclusive: false,
+    requoteFillThresholdPctPpm: 0,
+    maxVaultOrdersPerBlock: 0,
+    renewBufferBlocks: 0,
+    minOrderLifetimeSeconds: 0,
+    inventoryDeadBandBaseQuantums: Long.UZERO,
+    roundToMid: false,
+    crossVaultNetting: false,
+    maxSubticksDeviationPpm: 0,
+    strictSubticksDeviation: false,
+    placementPriority: 0,
+    activationThresholdMode: 0,
+    inventorySpreadScalePpm: 0,
+    skipUnchangedOrders: false,
+    ownerShareEpochSeconds: 0,
+    watchdogBlocks: 0,
+    refreshStrategy: 0,
+    maxSkewPpm: 0,
+    skipUnchangedOuterLayers: false,
+    clientMetadata: 0,
+    settleFundingBeforeShareMath: false,
+    operatorRefreshIntervalBlocks: 0,
+    expirationOracleTicks: 0
   };
 }
 
@@ -115,6 +1015,166 @@ export const Params = {
       writer.uint32(58).bytes(message.activationThresholdQuoteQuantums);
     }
 
+    if (message.minEquityPerLayerQuoteQuantums.length !== 0) {
+      writer.uint32(66).bytes(message.minEquityPerLayerQuoteQuantums);
+    }
+
+    if (message.skewEnabled === true) {
+      writer.uint32(72).bool(message.skewEnabled);
+    }
+
+    if (message.minRefreshIntervalBlocks !== 0) {
+      writer.uint32(88).uint32(message.minRefreshIntervalBlocks);
+    }
+
+    if (!message.minLotBaseQuantums.isZero()) {
+      writer.uint32(96).uint64(message.minLotBaseQuantums);
+    }
+
+    writer.uint32(106).fork();
+
+    for (const v of message.spreadMultiplierPpmByLayer) {
+      writer.uint32(v);
+    }
+
+    writer.ldelim();
+
+    if (!message.maxPositionDeltaPerBlockBaseQuantums.isZero()) {
+      writer.uint32(112).uint64(message.maxPositionDeltaPerBlockBaseQuantums);
+    }
+
+    if (message.hardMaxOrderAgeSeconds !== 0) {
+      writer.uint32(120).uint32(message.hardMaxOrderAgeSeconds);
+    }
+
+    if (message.minTicksFromOraclePerSide !== 0) {
+      writer.uint32(128).uint32(message.minTicksFromOraclePerSide);
+    }
+
+    if (message.orderSizeVolScalePpm !== 0) {
+      writer.uint32(136).uint32(message.orderSizeVolScalePpm);
+    }
+
+    if (message.subticksJitterEnabled === true) {
+      writer.uint32(144).bool(message.subticksJitterEnabled);
+    }
+
+    if (message.sizeProfile !== 0) {
+      writer.uint32(152).int32(message.sizeProfile);
+    }
+
+    if (message.includeFeeFloor === true) {
+      writer.uint32(160).bool(message.includeFeeFloor);
+    }
+
+    if (!message.softInventoryBandBaseQuantums.isZero()) {
+      writer.uint32(168).uint64(message.softInventoryBandBaseQuantums);
+    }
+
+    if (message.operator !== "") {
+      writer.uint32(178).string(message.operator);
+    }
+
+    if (message.operatorUpdateIntervalBlocks !== 0) {
+      writer.uint32(184).uint32(message.operatorUpdateIntervalBlocks);
+    }
+
+    if (message.operatorParamBounds !== undefined) {
+      OperatorParamBounds.encode(message.operatorParamBounds, writer.uint32(194).fork()).ldelim();
+    }
+
+    if (message.activationInclusive === true) {
+      writer.uint32(200).bool(message.activationInclusive);
+    }
+
+    if (message.requoteFillThresholdPctPpm !== 0) {
+      writer.uint32(208).uint32(message.requoteFillThresholdPctPpm);
+    }
+
+    if (message.maxVaultOrdersPerBlock !== 0) {
+      writer.uint32(216).uint32(message.maxVaultOrdersPerBlock);
+    }
+
+    if (message.renewBufferBlocks !== 0) {
+      writer.uint32(224).uint32(message.renewBufferBlocks);
+    }
+
+    if (message.minOrderLifetimeSeconds !== 0) {
+      writer.uint32(232).uint32(message.minOrderLifetimeSeconds);
+    }
+
+    if (!message.inventoryDeadBandBaseQuantums.isZero()) {
+      writer.uint32(240).uint64(message.inventoryDeadBandBaseQuantums);
+    }
+
+    if (message.roundToMid === true) {
+      writer.uint32(248).bool(message.roundToMid);
+    }
+
+    if (message.crossVaultNetting === true) {
+      writer.uint32(256).bool(message.crossVaultNetting);
+    }
+
+    if (message.maxSubticksDeviationPpm !== 0) {
+      writer.uint32(264).uint32(message.maxSubticksDeviationPpm);
+    }
+
+    if (message.strictSubticksDeviation === true) {
+      writer.uint32(272).bool(message.strictSubticksDeviation);
+    }
+
+    if (message.placementPriority !== 0) {
+      writer.uint32(280).int32(message.placementPriority);
+    }
+
+    if (message.activationThresholdMode !== 0) {
+      writer.uint32(288).int32(message.activationThresholdMode);
+    }
+
+    if (message.inventorySpreadScalePpm !== 0) {
+      writer.uint32(296).uint32(message.inventorySpreadScalePpm);
+    }
+
+    if (message.skipUnchangedOrders === true) {
+      writer.uint32(304).bool(message.skipUnchangedOrders);
+    }
+
+    if (message.ownerShareEpochSeconds !== 0) {
+      writer.uint32(312).uint32(message.ownerShareEpochSeconds);
+    }
+
+    if (message.watchdogBlocks !== 0) {
+      writer.uint32(320).uint32(message.watchdogBlocks);
+    }
+
+    if (message.refreshStrategy !== 0) {
+      writer.uint32(328).int32(message.refreshStrategy);
+    }
+
+    if (message.maxSkewPpm !== 0) {
+      writer.uint32(336).uint32(message.maxSkewPpm);
+    }
+
+    if (message.skipUnchangedOuterLayers === true) {
+      writer.uint32(344).bool(message.skipUnchangedOuterLayers);
+    }
+
+    if (message.clientMetadata !== 0) {
+      writer.uint32(352).uint32(message.clientMetadata);
+    }
+
+    if (message.settleFundingBeforeShareMath === true) {
+      writer.uint32(360).bool(message.settleFundingBeforeShareMath);
+    }
+
+    if (message.operatorRefreshIntervalBlocks !== 0) {
+      writer.uint32(368).uint32(message.operatorRefreshIntervalBlocks);
+    }
+
+    if (message.expirationOracleTicks !== 0) {
+      writer.uint32(376).uint32(message.expirationOracleTicks);
+    }
+
     return writer;
   },
 
@@ -155,6 +1215,171 @@ export const Params = {
           message.activationThresholdQuoteQuantums = reader.bytes();
           break;
 
+        case 8:
+          message.minEquityPerLayerQuoteQuantums = reader.bytes();
+          break;
+
+        case 9:
+          message.skewEnabled = reader.bool();
+          break;
+
+        case 11:
+          message.minRefreshIntervalBlocks = reader.uint32();
+          break;
+
+        case 12:
+          message.minLotBaseQuantums = (reader.uint64() as Long);
+          break;
+
+        case 13:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+
+            while (reader.pos < end2) {
+              message.spreadMultiplierPpmByLayer.push(reader.uint32());
+            }
+          } else {
+            message.spreadMultiplierPpmByLayer.push(reader.uint32());
+          }
+
+          break;
+
+        case 14:
+          message.maxPositionDeltaPerBlockBaseQuantums = (reader.uint64() as Long);
+          break;
+
+        case 15:
+          message.hardMaxOrderAgeSeconds = reader.uint32();
+          break;
+
+        case 16:
+          message.minTicksFromOraclePerSide = reader.uint32();
+          break;
+
+        case 17:
+          message.orderSizeVolScalePpm = reader.uint32();
+          break;
+
+        case 18:
+          message.subticksJitterEnabled = reader.bool();
+          break;
+
+        case 19:
+          message.sizeProfile = (reader.int32() as any);
+          break;
+
+        case 20:
+          message.includeFeeFloor = reader.bool();
+          break;
+
+        case 21:
+          message.softInventoryBandBaseQuantums = (reader.uint64() as Long);
+          break;
+
+        case 22:
+          message.operator = reader.string();
+          break;
+
+        case 23:
+          message.operatorUpdateIntervalBlocks = reader.uint32();
+          break;
+
+        case 24:
+          message.operatorParamBounds = OperatorParamBounds.decode(reader, reader.uint32());
+          break;
+
+        case 25:
+          message.activationInclusive = reader.bool();
+          break;
+
+        case 26:
+          message.requoteFillThresholdPctPpm = reader.uint32();
+          break;
+
+        case 27:
+          message.maxVaultOrdersPerBlock = reader.uint32();
+          break;
+
+        case 28:
+          message.renewBufferBlocks = reader.uint32();
+          break;
+
+        case 29:
+          message.minOrderLifetimeSeconds = reader.uint32();
+          break;
+
+        case 30:
+          message.inventoryDeadBandBaseQuantums = (reader.uint64() as Long);
+          break;
+
+        case 31:
+          message.roundToMid = reader.bool();
+          break;
+
+        case 32:
+          message.crossVaultNetting = reader.bool();
+          break;
+
+        case 33:
+          message.maxSubticksDeviationPpm = reader.uint32();
+          break;
+
+        case 34:
+          message.strictSubticksDeviation = reader.bool();
+          break;
+
+        case 35:
+          message.placementPriority = (reader.int32() as any);
+          break;
+
+        case 36:
+          message.activationThresholdMode = (reader.int32() as any);
+          break;
+
+        case 37:
+          message.inventorySpreadScalePpm = reader.uint32();
+          break;
+
+        case 38:
+          message.skipUnchangedOrders = reader.bool();
+          break;
+
+        case 39:
+          message.ownerShareEpochSeconds = reader.uint32();
+          break;
+
+        case 40:
+          message.watchdogBlocks = reader.uint32();
+          break;
+
+        case 41:
+          message.refreshStrategy = (reader.int32() as any);
+          break;
+
+        case 42:
+          message.maxSkewPpm = reader.uint32();
+          break;
+
+        case 43:
+          message.skipUnchangedOuterLayers = reader.bool();
+          break;
+
+        case 44:
+          message.clientMetadata = reader.uint32();
+          break;
+
+        case 45:
+          message.settleFundingBeforeShareMath = reader.bool();
+          break;
+
+        case 46:
+          message.operatorRefreshIntervalBlocks = reader.uint32();
+          break;
+
+        case 47:
+          message.expirationOracleTicks = reader.uint32();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -173,6 +1398,120 @@ export const Params = {
     message.orderSizePctPpm = object.orderSizePctPpm ?? 0;
     message.orderExpirationSeconds = object.orderExpirationSeconds ?? 0;
     message.activationThresholdQuoteQuantums = object.activationThresholdQuoteQuantums ?? new Uint8Array();
+    message.minEquityPerLayerQuoteQuantums = object.minEquityPerLayerQuoteQuantums ?? new Uint8Array();
+    message.skewEnabled = object.skewEnabled ?? false;
+    message.minRefreshIntervalBlocks = object.minRefreshIntervalBlocks ?? 0;
+    message.minLotBaseQuantums = object.minLotBaseQuantums !== undefined && object.minLotBaseQuantums !== null ? Long.fromValue(object.minLotBaseQuantums) : Long.UZERO;
+    message.spreadMultiplierPpmByLayer = object.spreadMultiplierPpmByLayer?.map(e => e) || [];
+    message.maxPositionDeltaPerBlockBaseQuantums = object.maxPositionDeltaPerBlockBaseQuantums !== undefined && object.maxPositionDeltaPerBlockBaseQuantums !== null ? Long.fromValue(object.maxPositionDeltaPerBlockBaseQuantums) : Long.UZERO;
+    message.hardMaxOrderAgeSeconds = object.hardMaxOrderAgeSeconds ?? 0;
+    message.minTicksFromOraclePerSide = object.minTicksFromOraclePerSide ?? 0;
+    message.orderSizeVolScalePpm = object.orderSizeVolScalePpm ?? 0;
+    message.subticksJitterEnabled = object.subticksJitterEnabled ?? false;
+    message.sizeProfile = object.sizeProfile ?? 0;
+    message.includeFeeFloor = object.includeFeeFloor ?? false;
+    message.softInventoryBandBaseQuantums = object.softInventoryBandBaseQuantums !== undefined && object.softInventoryBandBaseQuantums !== null ? Long.fromValue(object.softInventoryBandBaseQuantums) : Long.UZERO;
+    message.operator = object.operator ?? "";
+    message.operatorUpdateIntervalBlocks = object.operatorUpdateIntervalBlocks ?? 0;
+    message.operatorParamBounds = object.operatorParamBounds !== undefined && object.operatorParamBounds !== null ? OperatorParamBounds.fromPartial(object.operatorParamBounds) : undefined;
+    message.activationInclusive = object.activationInclusive ?? false;
+    message.requoteFillThresholdPctPpm = object.requoteFillThresholdPctPpm ?? 0;
+    message.maxVaultOrdersPerBlock = object.maxVaultOrdersPerBlock ?? 0;
+    message.renewBufferBlocks = object.renewBufferBlocks ?? 0;
+    message.minOrderLifetimeSeconds = object.minOrderLifetimeSeconds ?? 0;
+    message.inventoryDeadBandBaseQuantums = object.inventoryDeadBandBaseQuantums !== undefined && object.inventoryDeadBandBaseQuantums !== null ? Long.fromValue(object.inventoryDeadBandBaseQuantums) : Long.UZERO;
+    message.roundToMid = object.roundToMid ?? false;
+    message.crossVaultNetting = object.crossVaultNetting ?? false;
+    message.maxSubticksDeviationPpm = object.maxSubticksDeviationPpm ?? 0;
+    message.strictSubticksDeviation = object.strictSubticksDeviation ?? false;
+    message.placementPriority = object.placementPriority ?? 0;
+    message.activationThresholdMode = object.activationThresholdMode ?? 0;
+    message.inventorySpreadScalePpm = object.inventorySpreadScalePpm ?? 0;
+    message.skipUnchangedOrders = object.skipUnchangedOrders ?? false;
+    message.ownerShareEpochSeconds = object.ownerShareEpochSeconds ?? 0;
+    message.watchdogBlocks = object.watchdogBlocks ?? 0;
+    message.refreshStrategy = object.refreshStrategy ?? 0;
+    message.maxSkewPpm = object.maxSkewPpm ?? 0;
+    message.skipUnchangedOuterLayers = object.skipUnchangedOuterLayers ?? false;
+    message.clientMetadata = object.clientMetadata ?? 0;
+    message.settleFundingBeforeShareMath = object.settleFundingBeforeShareMath ?? false;
+    message.operatorRefreshIntervalBlocks = object.operatorRefreshIntervalBlocks ?? 0;
+    message.expirationOracleTicks = object.expirationOracleTicks ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseOperatorParamBounds(): OperatorParamBounds {
+  return {
+    spreadMinPpmMin: 0,
+    spreadMinPpmMax: 0,
+    orderSizePctPpmMin: 0,
+    orderSizePctPpmMax: 0
+  };
+}
+
+export const OperatorParamBounds = {
+  encode(message: OperatorParamBounds, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.spreadMinPpmMin !== 0) {
+      writer.uint32(8).uint32(message.spreadMinPpmMin);
+    }
+
+    if (message.spreadMinPpmMax !== 0) {
+      writer.uint32(16).uint32(message.spreadMinPpmMax);
+    }
+
+    if (message.orderSizePctPpmMin !== 0) {
+      writer.uint32(24).uint32(message.orderSizePctPpmMin);
+    }
+
+    if (message.orderSizePctPpmMax !== 0) {
+      writer.uint32(32).uint32(message.orderSizePctPpmMax);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperatorParamBounds {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperatorParamBounds();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.spreadMinPpmMin = reader.uint32();
+          break;
+
+        case 2:
+          message.spreadMinPpmMax = reader.uint32();
+          break;
+
+        case 3:
+          message.orderSizePctPpmMin = reader.uint32();
+          break;
+
+        case 4:
+          message.orderSizePctPpmMax = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<OperatorParamBounds>): OperatorParamBounds {
+    const message = createBaseOperatorParamBounds();
+    message.spreadMinPpmMin = object.spreadMinPpmMin ?? 0;
+    message.spreadMinPpmMax = object.spreadMinPpmMax ?? 0;
+    message.orderSizePctPpmMin = object.orderSizePctPpmMin ?? 0;
+    message.orderSizePctPpmMax = object.orderSizePctPpmMax ?? 0;
     return message;
   }
 
@@ -1,6 +1,6 @@
 import { setPaginationParams } from "../../helpers";
 import { LCDClient } from "@osmonauts/lcd";
-import { QueryParamsRequest, QueryParamsResponseSDKType, QueryVaultRequest, QueryVaultResponseSDKType, QueryAllVaultsRequest, QueryAllVaultsResponseSDKType, QueryOwnerSharesRequest, QueryOwnerSharesResponseSDKType } from "./query";
+import { QueryParamsRequest, QueryParamsResponseSDKType, QueryVaultRequest, QueryVaultResponseSDKType, QueryAllVaultsRequest, QueryAllVaultsResponseSDKType, QueryOwnerSharesRequest, QueryOwnerSharesResponseSDKType, QueryVaultQuotingStatusRequest, QueryVaultQuotingStatusResponseSDKType, QueryQuoteDepositRequest, QueryQuoteDepositResponseSDKType, QueryVaultQuoteCurveRequest, QueryVaultQuoteCurveResponseSDKType, QueryVaultQuotedNotionalRequest, QueryVaultQuotedNotionalResponseSDKType, QueryDecodeVaultClientIdRequest, QueryDecodeVaultClientIdResponseSDKType, QueryVaultFillStatsRequest, QueryVaultFillStatsResponseSDKType, QueryVaultMarginRequest, QueryVaultMarginResponseSDKType, QueryVaultActivityLogRequest, QueryVaultActivityLogResponseSDKType, QueryVaultRefreshHistoryRequest, QueryVaultRefreshHistoryResponseSDKType, QueryVaultValueAtRiskRequest, QueryVaultValueAtRiskResponseSDKType, QueryOwnerUnrealizedPnlRequest, QueryOwnerUnrealizedPnlResponseSDKType, QueryTotalVaultTvlRequest, QueryTotalVaultTvlResponseSDKType, QueryTotalVaultInventoryRequest, QueryTotalVaultInventoryResponseSDKType, QueryExplainVaultOrderRequest, QueryExplainVaultOrderResponseSDKType, QueryOrphanedVaultOrdersRequest, QueryOrphanedVaultOrdersResponseSDKType, QueryVaultMakerEdgeRequest, QueryVaultMakerEdgeResponseSDKType, QueryCurrentBlockClientIdParityRequest, QueryCurrentBlockClientIdParityResponseSDKType, QueryVaultLastQuotePriceRequest, QueryVaultLastQuotePriceResponseSDKType, QueryMarketVolatilityRequest, QueryMarketVolatilityResponseSDKType } from "./query";
 export class LCDQueryClient {
   req: LCDClient;
 
@@ -14,6 +14,25 @@ export class LCDQueryClient {
     this.vault = this.vault.bind(this);
     this.allVaults = this.allVaults.bind(this);
     this.ownerShares = this.ownerShares.bind(this);
+    this.vaultQuotingStatus = this.vaultQuotingStatus.bind(this);
+    this.quoteDeposit = this.quoteDeposit.bind(this);
+    this.vaultQuoteCurve = this.vaultQuoteCurve.bind(this);
+    this.vaultQuotedNotional = this.vaultQuotedNotional.bind(this);
+    this.decodeVaultClientId = this.decodeVaultClientId.bind(this);
+    this.vaultFillStats = this.vaultFillStats.bind(this);
+    this.vaultMargin = this.vaultMargin.bind(this);
+    this.vaultActivityLog = this.vaultActivityLog.bind(this);
+    this.vaultRefreshHistory = this.vaultRefreshHistory.bind(this);
+    this.vaultValueAtRisk = this.vaultValueAtRisk.bind(this);
+    this.ownerUnrealizedPnl = this.ownerUnrealizedPnl.bind(this);
+    this.totalVaultTvl = this.totalVaultTvl.bind(this);
+    this.totalVaultInventory = this.totalVaultInventory.bind(this);
+    this.explainVaultOrder = this.explainVaultOrder.bind(this);
+    this.orphanedVaultOrders = this.orphanedVaultOrders.bind(this);
+    this.vaultMakerEdge = this.vaultMakerEdge.bind(this);
+    this.currentBlockClientIdParity = this.currentBlockClientIdParity.bind(this);
+    this.vaultLastQuotePrice = this.vaultLastQuotePrice.bind(this);
+    this.marketVolatility = this.marketVolatility.bind(this);
   }
   /* Queries the Params. */
 
@@ -61,5 +80,162 @@ export class LCDQueryClient {
     const endpoint = `dydxprotocol/vault/owner_shares/${params.type}/${params.number}`;
     return await this.req.get<QueryOwnerSharesResponseSDKType>(endpoint, options);
   }
+  /* Queries whether a vault is quoting and if not, why. */
+
+
+  async vaultQuotingStatus(params: QueryVaultQuotingStatusRequest): Promise<QueryVaultQuotingStatusResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/quoting_status/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultQuotingStatusResponseSDKType>(endpoint);
+  }
+  /* Queries shares that a deposit to a vault would mint. */
+
+
+  async quoteDeposit(params: QueryQuoteDepositRequest): Promise<QueryQuoteDepositResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.quoteQuantums !== "undefined") {
+      options.params.quote_quantums = params.quoteQuantums;
+    }
+
+    const endpoint = `dydxprotocol/vault/quote_deposit/${params.type}/${params.number}`;
+    return await this.req.get<QueryQuoteDepositResponseSDKType>(endpoint, options);
+  }
+  /* Queries the quote curve of a vault, i.e. price and size of each of its
+   orders. */
+
+
+  async vaultQuoteCurve(params: QueryVaultQuoteCurveRequest): Promise<QueryVaultQuoteCurveResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/quote_curve/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultQuoteCurveResponseSDKType>(endpoint);
+  }
+  /* Queries the notional value that a vault quotes on each side. */
+
+
+  async vaultQuotedNotional(params: QueryVaultQuotedNotionalRequest): Promise<QueryVaultQuotedNotionalResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/quoted_notional/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultQuotedNotionalResponseSDKType>(endpoint);
+  }
+  /* Decodes the client ID of a vault order into its side, block parity, and
+   layer. */
+
+
+  async decodeVaultClientId(params: QueryDecodeVaultClientIdRequest): Promise<QueryDecodeVaultClientIdResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/decode_client_id/${params.clientId}`;
+    return await this.req.get<QueryDecodeVaultClientIdResponseSDKType>(endpoint);
+  }
+  /* Queries the fill statistics of a vault. */
+
+
+  async vaultFillStats(params: QueryVaultFillStatsRequest): Promise<QueryVaultFillStatsResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/fill_stats/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultFillStatsResponseSDKType>(endpoint);
+  }
+  /* Queries the margin requirements and free collateral of a vault. */
+
+
+  async vaultMargin(params: QueryVaultMarginRequest): Promise<QueryVaultMarginResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/margin/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultMarginResponseSDKType>(endpoint);
+  }
+  /* Queries the activity log of a vault, i.e. its params changes and status
+   changes in the order they happened. */
+
+
+  async vaultActivityLog(params: QueryVaultActivityLogRequest): Promise<QueryVaultActivityLogResponseSDKType> {
+    const options: any = {
+      params: {}
+    };
+
+    if (typeof params?.pagination !== "undefined") {
+      setPaginationParams(options, params.pagination);
+    }
+
+    const endpoint = `dydxprotocol/vault/activity_log/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultActivityLogResponseSDKType>(endpoint, options);
+  }
+  /* Queries the most recent order refreshes of a vault, oldest first. */
+
+
+  async vaultRefreshHistory(params: QueryVaultRefreshHistoryRequest): Promise<QueryVaultRefreshHistoryResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/refresh_history/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultRefreshHistoryResponseSDKType>(endpoint);
+  }
+  /* Queries the one-block value at risk of a vault's position at a confidence. */
+
+
+  async vaultValueAtRisk(params: QueryVaultValueAtRiskRequest): Promise<QueryVaultValueAtRiskResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/value_at_risk/${params.type}/${params.number}/${params.confidencePpm}`;
+    return await this.req.get<QueryVaultValueAtRiskResponseSDKType>(endpoint);
+  }
+  /* Queries unrealized PnL of an owner's shares in a vault. */
+
+
+  async ownerUnrealizedPnl(params: QueryOwnerUnrealizedPnlRequest): Promise<QueryOwnerUnrealizedPnlResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/owner_unrealized_pnl/${params.type}/${params.number}/${params.owner}`;
+    return await this.req.get<QueryOwnerUnrealizedPnlResponseSDKType>(endpoint);
+  }
+  /* Queries total value locked across all vaults. */
+
+
+  async totalVaultTvl(_params: QueryTotalVaultTvlRequest = {}): Promise<QueryTotalVaultTvlResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/total_tvl`;
+    return await this.req.get<QueryTotalVaultTvlResponseSDKType>(endpoint);
+  }
+  /* Queries net inventory of all vaults in the perpetual of a clob pair. */
+
+
+  async totalVaultInventory(params: QueryTotalVaultInventoryRequest): Promise<QueryTotalVaultInventoryResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/total_inventory/${params.clobPairId}`;
+    return await this.req.get<QueryTotalVaultInventoryResponseSDKType>(endpoint);
+  }
+  /* Queries every intermediate value of the quoting computation of one order
+   of a vault. */
+
+
+  async explainVaultOrder(params: QueryExplainVaultOrderRequest): Promise<QueryExplainVaultOrderResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/explain_order/${params.type}/${params.number}/${params.side}/${params.layer}`;
+    return await this.req.get<QueryExplainVaultOrderResponseSDKType>(endpoint);
+  }
+  /* Queries resting orders of a vault that don't correspond to any order that
+   the vault currently generates. */
+
+
+  async orphanedVaultOrders(params: QueryOrphanedVaultOrdersRequest): Promise<QueryOrphanedVaultOrdersResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/orphaned_orders/${params.type}/${params.number}`;
+    return await this.req.get<QueryOrphanedVaultOrdersResponseSDKType>(endpoint);
+  }
+  /* Queries the estimated maker edge of a vault, i.e. its effective spread
+   minus estimated adverse selection. */
+
+
+  async vaultMakerEdge(params: QueryVaultMakerEdgeRequest): Promise<QueryVaultMakerEdgeResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/maker_edge/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultMakerEdgeResponseSDKType>(endpoint);
+  }
+  /* Queries the block height parity that client IDs of vault orders placed in
+   the current block encode. */
+
+
+  async currentBlockClientIdParity(_params: QueryCurrentBlockClientIdParityRequest = {}): Promise<QueryCurrentBlockClientIdParityResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/client_id_parity`;
+    return await this.req.get<QueryCurrentBlockClientIdParityResponseSDKType>(endpoint);
+  }
+  /* Queries the oracle price that a vault's orders were based on at its last
+   refresh along with the live oracle price of the same market. */
+
+
+  async vaultLastQuotePrice(params: QueryVaultLastQuotePriceRequest): Promise<QueryVaultLastQuotePriceResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/last_quote_price/${params.type}/${params.number}`;
+    return await this.req.get<QueryVaultLastQuotePriceResponseSDKType>(endpoint);
+  }
+  /* Queries realized volatility of a market's oracle price. */
+
+
+  async marketVolatility(params: QueryMarketVolatilityRequest): Promise<QueryMarketVolatilityResponseSDKType> {
+    const endpoint = `dydxprotocol/vault/market_volatility/${params.marketId}`;
+    return await this.req.get<QueryMarketVolatilityResponseSDKType>(endpoint);
+  }
 
 }
\ No newline at end of file
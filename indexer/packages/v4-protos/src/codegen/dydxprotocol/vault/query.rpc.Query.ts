@@ -1,7 +1,7 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
 import { QueryClient, createProtobufRpcClient } from "@cosmjs/stargate";
-import { QueryParamsRequest, QueryParamsResponse, QueryVaultRequest, QueryVaultResponse, QueryAllVaultsRequest, QueryAllVaultsResponse, QueryOwnerSharesRequest, QueryOwnerSharesResponse } from "./query";
+import { QueryParamsRequest, QueryParamsResponse, QueryVaultRequest, QueryVaultResponse, QueryAllVaultsRequest, QueryAllVaultsResponse, QueryOwnerSharesRequest, QueryOwnerSharesResponse, QueryVaultQuotingStatusRequest, QueryVaultQuotingStatusResponse, QueryQuoteDepositRequest, QueryQuoteDepositResponse, QueryVaultQuoteCurveRequest, QueryVaultQuoteCurveResponse, QueryVaultQuotedNotionalRequest, QueryVaultQuotedNotionalResponse, QueryDecodeVaultClientIdRequest, QueryDecodeVaultClientIdResponse, QueryVaultFillStatsRequest, QueryVaultFillStatsResponse, QueryVaultMarginRequest, QueryVaultMarginResponse, QueryVaultActivityLogRequest, QueryVaultActivityLogResponse, QueryVaultRefreshHistoryRequest, QueryVaultRefreshHistoryResponse, QueryVaultValueAtRiskRequest, QueryVaultValueAtRiskResponse, QueryOwnerUnrealizedPnlRequest, QueryOwnerUnrealizedPnlResponse, QueryTotalVaultTvlRequest, QueryTotalVaultTvlResponse, QueryTotalVaultInventoryRequest, QueryTotalVaultInventoryResponse, QueryExplainVaultOrderRequest, QueryExplainVaultOrderResponse, QueryOrphanedVaultOrdersRequest, QueryOrphanedVaultOrdersResponse, QueryVaultMakerEdgeRequest, QueryVaultMakerEdgeResponse, QueryCurrentBlockClientIdParityRequest, QueryCurrentBlockClientIdParityResponse, QueryVaultLastQuotePriceRequest, QueryVaultLastQuotePriceResponse, QueryMarketVolatilityRequest, QueryMarketVolatilityResponse } from "./query";
 /** Query defines the gRPC querier service. */
 
 export interface Query {
@@ -16,6 +16,87 @@ export interface Query {
   /** Queries owner shares of a vault. */
 
   ownerShares(request: QueryOwnerSharesRequest): Promise<QueryOwnerSharesResponse>;
+  /** Queries whether a vault is quoting and if not, why. */
+
+  vaultQuotingStatus(request: QueryVaultQuotingStatusRequest): Promise<QueryVaultQuotingStatusResponse>;
+  /** Queries shares that a deposit to a vault would mint. */
+
+  quoteDeposit(request: QueryQuoteDepositRequest): Promise<QueryQuoteDepositResponse>;
+  /**
+   * Queries the quote curve of a vault, i.e. price and size of each of its
+   * orders.
+   */
+
+  vaultQuoteCurve(request: QueryVaultQuoteCurveRequest): Promise<QueryVaultQuoteCurveResponse>;
+  /** Queries the notional value that a vault quotes on each side. */
+
+  vaultQuotedNotional(request: QueryVaultQuotedNotionalRequest): Promise<QueryVaultQuotedNotionalResponse>;
+  /**
+   * Decodes the client ID of a vault order into its side, block parity, and
+   * layer.
+   */
+
+  decodeVaultClientId(request: QueryDecodeVaultClientIdRequest): Promise<QueryDecodeVaultClientIdResponse>;
+  /** Queries the fill statistics of a vault. */
+
+  vaultFillStats(request: QueryVaultFillStatsRequest): Promise<QueryVaultFillStatsResponse>;
+  /** Queries the margin requirements and free collateral of a vault. */
+
+  vaultMargin(request: QueryVaultMarginRequest): Promise<QueryVaultMarginResponse>;
+  /**
+   * Queries the activity log of a vault, i.e. its params changes and status
+   * changes in the order they happened.
+   */
+
+  vaultActivityLog(request: QueryVaultActivityLogRequest): Promise<QueryVaultActivityLogResponse>;
+  /** Queries the most recent order refreshes of a vault, oldest first. */
+
+  vaultRefreshHistory(request: QueryVaultRefreshHistoryRequest): Promise<QueryVaultRefreshHistoryResponse>;
+  /** Queries the one-block value at risk of a vault's position at a confidence. */
+
+  vaultValueAtRisk(request: QueryVaultValueAtRiskRequest): Promise<QueryVaultValueAtRiskResponse>;
+  /** Queries unrealized PnL of an owner's shares in a vault. */
+
+  ownerUnrealizedPnl(request: QueryOwnerUnrealizedPnlRequest): Promise<QueryOwnerUnrealizedPnlResponse>;
+  /** Queries total value locked across all vaults. */
+
+  totalVaultTvl(request?: QueryTotalVaultTvlRequest): Promise<QueryTotalVaultTvlResponse>;
+  /** Queries net inventory of all vaults in the perpetual of a clob pair. */
+
+  totalVaultInventory(request: QueryTotalVaultInventoryRequest): Promise<QueryTotalVaultInventoryResponse>;
+  /**
+   * Queries every intermediate value of the quoting computation of one order
+   * of a vault.
+   */
+
+  explainVaultOrder(request: QueryExplainVaultOrderRequest): Promise<QueryExplainVaultOrderResponse>;
+  /**
+   * Queries resting orders of a vault that don't correspond to any order that
+   * the vault currently generates.
+   */
+
+  orphanedVaultOrders(request: QueryOrphanedVaultOrdersRequest): Promise<QueryOrphanedVaultOrdersResponse>;
+  /**
+   * Queries the estimated maker edge of a vault, i.e. its effective spread
+   * minus estimated adverse selection.
+   */
+
+  vaultMakerEdge(request: QueryVaultMakerEdgeRequest): Promise<QueryVaultMakerEdgeResponse>;
+  /**
+   * Queries the block height parity that client IDs of vault orders placed in
+   * the current block encode.
+   */
+
+  currentBlockClientIdParity(request?: QueryCurrentBlockClientIdParityRequest): Promise<QueryCurrentBlockClientIdParityResponse>;
+  /**
+   * Queries the oracle price that a vault's orders were based on at its last
+   * refresh along with the live oracle price of the same market.
+   */
+
+  vaultLastQuotePrice(request: QueryVaultLastQuotePriceRequest): Promise<QueryVaultLastQuotePriceResponse>;
+  /** Queries realized volatility of a market's oracle price. */
+
+  marketVolatility(request: QueryMarketVolatilityRequest): Promise<QueryMarketVolatilityResponse>;
 }
 export class QueryClientImpl implements Query {
   private readonly rpc: Rpc;
@@ -26,6 +107,25 @@ export class QueryClientImpl implements Query {
     this.vault = this.vault.bind(this);
     this.allVaults = this.allVaults.bind(this);
     this.ownerShares = this.ownerShares.bind(this);
+    this.vaultQuotingStatus = this.vaultQuotingStatus.bind(this);
+    this.quoteDeposit = this.quoteDeposit.bind(this);
+    this.vaultQuoteCurve = this.vaultQuoteCurve.bind(this);
+    this.vaultQuotedNotional = this.vaultQuotedNotional.bind(this);
+    this.decodeVaultClientId = this.decodeVaultClientId.bind(this);
+    this.vaultFillStats = this.vaultFillStats.bind(this);
+    this.vaultMargin = this.vaultMargin.bind(this);
+    this.vaultActivityLog = this.vaultActivityLog.bind(this);
+    this.vaultRefreshHistory = this.vaultRefreshHistory.bind(this);
+    this.vaultValueAtRisk = this.vaultValueAtRisk.bind(this);
+    this.ownerUnrealizedPnl = this.ownerUnrealizedPnl.bind(this);
+    this.totalVaultTvl = this.totalVaultTvl.bind(this);
+    this.totalVaultInventory = this.totalVaultInventory.bind(this);
+    this.explainVaultOrder = this.explainVaultOrder.bind(this);
+    this.orphanedVaultOrders = this.orphanedVaultOrders.bind(this);
+    this.vaultMakerEdge = this.vaultMakerEdge.bind(this);
+    this.currentBlockClientIdParity = this.currentBlockClientIdParity.bind(this);
+    this.vaultLastQuotePrice = this.vaultLastQuotePrice.bind(this);
+    this.marketVolatility = this.marketVolatility.bind(this);
   }
 
   params(request: QueryParamsRequest = {}): Promise<QueryParamsResponse> {
@@ -54,6 +154,120 @@ export class QueryClientImpl implements Query {
     return promise.then(data => QueryOwnerSharesResponse.decode(new _m0.Reader(data)));
   }
 
+  vaultQuotingStatus(request: QueryVaultQuotingStatusRequest): Promise<QueryVaultQuotingStatusResponse> {
+    const data = QueryVaultQuotingStatusRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultQuotingStatus", data);
+    return promise.then(data => QueryVaultQuotingStatusResponse.decode(new _m0.Reader(data)));
+  }
+
+  quoteDeposit(request: QueryQuoteDepositRequest): Promise<QueryQuoteDepositResponse> {
+    const data = QueryQuoteDepositRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "QuoteDeposit", data);
+    return promise.then(data => QueryQuoteDepositResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultQuoteCurve(request: QueryVaultQuoteCurveRequest): Promise<QueryVaultQuoteCurveResponse> {
+    const data = QueryVaultQuoteCurveRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultQuoteCurve", data);
+    return promise.then(data => QueryVaultQuoteCurveResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultQuotedNotional(request: QueryVaultQuotedNotionalRequest): Promise<QueryVaultQuotedNotionalResponse> {
+    const data = QueryVaultQuotedNotionalRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultQuotedNotional", data);
+    return promise.then(data => QueryVaultQuotedNotionalResponse.decode(new _m0.Reader(data)));
+  }
+
+  decodeVaultClientId(request: QueryDecodeVaultClientIdRequest): Promise<QueryDecodeVaultClientIdResponse> {
+    const data = QueryDecodeVaultClientIdRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "DecodeVaultClientId", data);
+    return promise.then(data => QueryDecodeVaultClientIdResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultFillStats(request: QueryVaultFillStatsRequest): Promise<QueryVaultFillStatsResponse> {
+    const data = QueryVaultFillStatsRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultFillStats", data);
+    return promise.then(data => QueryVaultFillStatsResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultMargin(request: QueryVaultMarginRequest): Promise<QueryVaultMarginResponse> {
+    const data = QueryVaultMarginRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultMargin", data);
+    return promise.then(data => QueryVaultMarginResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultActivityLog(request: QueryVaultActivityLogRequest): Promise<QueryVaultActivityLogResponse> {
+    const data = QueryVaultActivityLogRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultActivityLog", data);
+    return promise.then(data => QueryVaultActivityLogResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultRefreshHistory(request: QueryVaultRefreshHistoryRequest): Promise<QueryVaultRefreshHistoryResponse> {
+    const data = QueryVaultRefreshHistoryRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultRefreshHistory", data);
+    return promise.then(data => QueryVaultRefreshHistoryResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultValueAtRisk(request: QueryVaultValueAtRiskRequest): Promise<QueryVaultValueAtRiskResponse> {
+    const data = QueryVaultValueAtRiskRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultValueAtRisk", data);
+    return promise.then(data => QueryVaultValueAtRiskResponse.decode(new _m0.Reader(data)));
+  }
+
+  ownerUnrealizedPnl(request: QueryOwnerUnrealizedPnlRequest): Promise<QueryOwnerUnrealizedPnlResponse> {
+    const data = QueryOwnerUnrealizedPnlRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "OwnerUnrealizedPnl", data);
+    return promise.then(data => QueryOwnerUnrealizedPnlResponse.decode(new _m0.Reader(data)));
+  }
+
+  totalVaultTvl(request: QueryTotalVaultTvlRequest = {}): Promise<QueryTotalVaultTvlResponse> {
+    const data = QueryTotalVaultTvlRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "TotalVaultTvl", data);
+    return promise.then(data => QueryTotalVaultTvlResponse.decode(new _m0.Reader(data)));
+  }
+
+  totalVaultInventory(request: QueryTotalVaultInventoryRequest): Promise<QueryTotalVaultInventoryResponse> {
+    const data = QueryTotalVaultInventoryRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "TotalVaultInventory", data);
+    return promise.then(data => QueryTotalVaultInventoryResponse.decode(new _m0.Reader(data)));
+  }
+
+  explainVaultOrder(request: QueryExplainVaultOrderRequest): Promise<QueryExplainVaultOrderResponse> {
+    const data = QueryExplainVaultOrderRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "ExplainVaultOrder", data);
+    return promise.then(data => QueryExplainVaultOrderResponse.decode(new _m0.Reader(data)));
+  }
+
+  orphanedVaultOrders(request: QueryOrphanedVaultOrdersRequest): Promise<QueryOrphanedVaultOrdersResponse> {
+    const data = QueryOrphanedVaultOrdersRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "OrphanedVaultOrders", data);
+    return promise.then(data => QueryOrphanedVaultOrdersResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultMakerEdge(request: QueryVaultMakerEdgeRequest): Promise<QueryVaultMakerEdgeResponse> {
+    const data = QueryVaultMakerEdgeRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultMakerEdge", data);
+    return promise.then(data => QueryVaultMakerEdgeResponse.decode(new _m0.Reader(data)));
+  }
+
+  currentBlockClientIdParity(request: QueryCurrentBlockClientIdParityRequest = {}): Promise<QueryCurrentBlockClientIdParityResponse> {
+    const data = QueryCurrentBlockClientIdParityRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "CurrentBlockClientIdParity", data);
+    return promise.then(data => QueryCurrentBlockClientIdParityResponse.decode(new _m0.Reader(data)));
+  }
+
+  vaultLastQuotePrice(request: QueryVaultLastQuotePriceRequest): Promise<QueryVaultLastQuotePriceResponse> {
+    const data = QueryVaultLastQuotePriceRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "VaultLastQuotePrice", data);
+    return promise.then(data => QueryVaultLastQuotePriceResponse.decode(new _m0.Reader(data)));
+  }
+
+  marketVolatility(request: QueryMarketVolatilityRequest): Promise<QueryMarketVolatilityResponse> {
+    const data = QueryMarketVolatilityRequest.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Query", "MarketVolatility", data);
+    return promise.then(data => QueryMarketVolatilityResponse.decode(new _m0.Reader(data)));
+  }
+
 }
 export const createRpcQueryExtension = (base: QueryClient) => {
   const rpc = createProtobufRpcClient(base);
@@ -73,6 +287,82 @@ export const createRpcQueryExtension = (base: QueryClient) => {
 
     ownerShares(request: QueryOwnerSharesRequest): Promise<QueryOwnerSharesResponse> {
       return queryService.ownerShares(request);
+    },
+
+    vaultQuotingStatus(request: QueryVaultQuotingStatusRequest): Promise<QueryVaultQuotingStatusResponse> {
+      return queryService.vaultQuotingStatus(request);
+    },
+
+    quoteDeposit(request: QueryQuoteDepositRequest): Promise<QueryQuoteDepositResponse> {
+      return queryService.quoteDeposit(request);
+    },
+
+    vaultQuoteCurve(request: QueryVaultQuoteCurveRequest): Promise<QueryVaultQuoteCurveResponse> {
+      return queryService.vaultQuoteCurve(request);
+    },
+
+    vaultQuotedNotional(request: QueryVaultQuotedNotionalRequest): Promise<QueryVaultQuotedNotionalResponse> {
+      return queryService.vaultQuotedNotional(request);
+    },
+
+    decodeVaultClientId(request: QueryDecodeVaultClientIdRequest): Promise<QueryDecodeVaultClientIdResponse> {
+      return queryService.decodeVaultClientId(request);
+    },
+
+    vaultFillStats(request: QueryVaultFillStatsRequest): Promise<QueryVaultFillStatsResponse> {
+      return queryService.vaultFillStats(request);
+    },
+
+    vaultMargin(request: QueryVaultMarginRequest): Promise<QueryVaultMarginResponse> {
+      return queryService.vaultMargin(request);
+    },
+
+    vaultActivityLog(request: QueryVaultActivityLogRequest): Promise<QueryVaultActivityLogResponse> {
+      return queryService.vaultActivityLog(request);
+    },
+
+    vaultRefreshHistory(request: QueryVaultRefreshHistoryRequest): Promise<QueryVaultRefreshHistoryResponse> {
+      return queryService.vaultRefreshHistory(request);
+    },
+
+    vaultValueAtRisk(request: QueryVaultValueAtRiskRequest): Promise<QueryVaultValueAtRiskResponse> {
+      return queryService.vaultValueAtRisk(request);
+    },
+
+    ownerUnrealizedPnl(request: QueryOwnerUnrealizedPnlRequest): Promise<QueryOwnerUnrealizedPnlResponse> {
+      return queryService.ownerUnrealizedPnl(request);
+    },
+
+    totalVaultTvl(request?: QueryTotalVaultTvlRequest): Promise<QueryTotalVaultTvlResponse> {
+      return queryService.totalVaultTvl(request);
+    },
+
+    totalVaultInventory(request: QueryTotalVaultInventoryRequest): Promise<QueryTotalVaultInventoryResponse> {
+      return queryService.totalVaultInventory(request);
+    },
+
+    explainVaultOrder(request: QueryExplainVaultOrderRequest): Promise<QueryExplainVaultOrderResponse> {
+      return queryService.explainVaultOrder(request);
+    },
+
+    orphanedVaultOrders(request: QueryOrphanedVaultOrdersRequest): Promise<QueryOrphanedVaultOrdersResponse> {
+      return queryService.orphanedVaultOrders(request);
+    },
+
+    vaultMakerEdge(request: QueryVaultMakerEdgeRequest): Promise<QueryVaultMakerEdgeResponse> {
+      return queryService.vaultMakerEdge(request);
+    },
+
+    currentBlockClientIdParity(request?: QueryCurrentBlockClientIdParityRequest): Promise<QueryCurrentBlockClientIdParityResponse> {
+      return queryService.currentBlockClientIdParity(request);
+    },
+
+    vaultLastQuotePrice(request: QueryVaultLastQuotePriceRequest): Promise<QueryVaultLastQuotePriceResponse> {
+      return queryService.vaultLastQuotePrice(request);
+    },
+
+    marketVolatility(request: QueryMarketVolatilityRequest): Promise<QueryMarketVolatilityResponse> {
+      return queryService.marketVolatility(request);
     }
 
   };
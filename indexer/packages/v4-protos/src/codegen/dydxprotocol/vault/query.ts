@@ -1,9 +1,10 @@
-import { VaultType, VaultTypeSDKType, VaultId, VaultIdSDKType, NumShares, NumSharesSDKType, OwnerShare, OwnerShareSDKType } from "./vault";
+import { VaultType, VaultTypeSDKType, VaultId, VaultIdSDKType, NumShares, NumSharesSDKType, OwnerShare, OwnerShareSDKType, VaultFillStats, VaultFillStatsSDKType, VaultActivity, VaultActivitySDKType, VaultRefresh, VaultRefreshSDKType, MarketVolatility, MarketVolatilitySDKType } from "./vault";
 import { PageRequest, PageRequestSDKType, PageResponse, PageResponseSDKType } from "../../cosmos/base/query/v1beta1/pagination";
 import { Params, ParamsSDKType } from "./params";
 import { SubaccountId, SubaccountIdSDKType } from "../subaccounts/subaccount";
+import { Order_Side, Order_SideSDKType, Order, OrderSDKType } from "../clob/order";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { DeepPartial, Long } from "../../helpers";
 /** QueryParamsRequest is a request type for the Params RPC method. */
 
 export interface QueryParamsRequest {}
@@ -40,6 +41,7 @@ export interface QueryVaultResponse {
   equity: Uint8Array;
   inventory: Uint8Array;
   totalShares?: NumShares;
+  label: string;
 }
 /** QueryVaultResponse is a response type for the Vault RPC method. */
 
@@ -49,6 +51,7 @@ export interface QueryVaultResponseSDKType {
   equity: Uint8Array;
   inventory: Uint8Array;
   total_shares?: NumSharesSDKType;
+  label: string;
 }
 /** QueryAllVaultsRequest is a request type for the AllVaults RPC method. */
 
@@ -98,6 +101,956 @@ export interface QueryOwnerSharesResponseSDKType {
   owner_shares: OwnerShareSDKType[];
   pagination?: PageResponseSDKType;
 }
+/**
+ * QueryVaultQuotingStatusRequest is a request type for the VaultQuotingStatus
+ * RPC method.
+ */
+
+export interface QueryVaultQuotingStatusRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultQuotingStatusRequest is a request type for the VaultQuotingStatus
+ * RPC method.
+ */
+
+export interface QueryVaultQuotingStatusRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultQuotingStatusResponse is a response type for the
+ * VaultQuotingStatus RPC method.
+ */
+
+export interface QueryVaultQuotingStatusResponse {
+  /** Whether the vault places orders. */
+  quoting: boolean;
+  /** Reason why the vault doesn't place orders. Empty if the vault is quoting. */
+
+  reason: string;
+}
+/**
+ * QueryVaultQuotingStatusResponse is a response type for the
+ * VaultQuotingStatus RPC method.
+ */
+
+export interface QueryVaultQuotingStatusResponseSDKType {
+  /** Whether the vault places orders. */
+  quoting: boolean;
+  /** Reason why the vault doesn't place orders. Empty if the vault is quoting. */
+
+  reason: string;
+}
+/** QueryQuoteDepositRequest is a request type for the QuoteDeposit RPC method. */
+
+export interface QueryQuoteDepositRequest {
+  type: VaultType;
+  number: number;
+  /** Amount of quote quantums to deposit. */
+
+  quoteQuantums: Long;
+}
+/** QueryQuoteDepositRequest is a request type for the QuoteDeposit RPC method. */
+
+export interface QueryQuoteDepositRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+  /** Amount of quote quantums to deposit. */
+
+  quote_quantums: Long;
+}
+/**
+ * QueryQuoteDepositResponse is a response type for the QuoteDeposit RPC
+ * method.
+ */
+
+export interface QueryQuoteDepositResponse {
+  /** Number of shares that the deposit would mint. */
+  sharesOut?: NumShares;
+  /**
+   * Price of one share in quote quantums, in parts per million, i.e.
+   * `equity * 1_000_000 / total_shares`, or 1_000_000 if the vault has no
+   * shares yet.
+   */
+
+  sharePricePpm: Uint8Array;
+}
+/**
+ * QueryQuoteDepositResponse is a response type for the QuoteDeposit RPC
+ * method.
+ */
+
+export interface QueryQuoteDepositResponseSDKType {
+  /** Number of shares that the deposit would mint. */
+  shares_out?: NumSharesSDKType;
+  /**
+   * Price of one share in quote quantums, in parts per million, i.e.
+   * `equity * 1_000_000 / total_shares`, or 1_000_000 if the vault has no
+   * shares yet.
+   */
+
+  share_price_ppm: Uint8Array;
+}
+/**
+ * QueryVaultQuoteCurveRequest is a request type for the VaultQuoteCurve RPC
+ * method.
+ */
+
+export interface QueryVaultQuoteCurveRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultQuoteCurveRequest is a request type for the VaultQuoteCurve RPC
+ * method.
+ */
+
+export interface QueryVaultQuoteCurveRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultQuoteCurveResponse is a response type for the VaultQuoteCurve RPC
+ * method.
+ */
+
+export interface QueryVaultQuoteCurveResponse {
+  /**
+   * Points of the quote curve, one for each order that the vault would place,
+   * in the same order as the vault's orders (ask then bid at each layer).
+   */
+  points: QuoteCurvePoint[];
+  /** Oracle price in subticks (rounded down) for reference. */
+
+  oracleSubticks: Long;
+}
+/**
+ * QueryVaultQuoteCurveResponse is a response type for the VaultQuoteCurve RPC
+ * method.
+ */
+
+export interface QueryVaultQuoteCurveResponseSDKType {
+  /**
+   * Points of the quote curve, one for each order that the vault would place,
+   * in the same order as the vault's orders (ask then bid at each layer).
+   */
+  points: QuoteCurvePointSDKType[];
+  /** Oracle price in subticks (rounded down) for reference. */
+
+  oracle_subticks: Long;
+}
+/** QuoteCurvePoint is a point on a vault's quote curve. */
+
+export interface QuoteCurvePoint {
+  /** Side of the order. */
+  side: Order_Side;
+  /** Price of the order in subticks. */
+
+  subticks: Long;
+  /** Size of the order in base quantums. */
+
+  quantums: Long;
+  /** Layer of the order, starting from 0 for the innermost layer. */
+
+  layer: number;
+}
+/** QuoteCurvePoint is a point on a vault's quote curve. */
+
+export interface QuoteCurvePointSDKType {
+  /** Side of the order. */
+  side: Order_SideSDKType;
+  /** Price of the order in subticks. */
+
+  subticks: Long;
+  /** Size of the order in base quantums. */
+
+  quantums: Long;
+  /** Layer of the order, starting from 0 for the innermost layer. */
+
+  layer: number;
+}
+/**
+ * QueryVaultQuotedNotionalRequest is a request type for the VaultQuotedNotional
+ * RPC method.
+ */
+
+export interface QueryVaultQuotedNotionalRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultQuotedNotionalRequest is a request type for the VaultQuotedNotional
+ * RPC method.
+ */
+
+export interface QueryVaultQuotedNotionalRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultQuotedNotionalResponse is a response type for the
+ * VaultQuotedNotional RPC method.
+ */
+
+export interface QueryVaultQuotedNotionalResponse {
+  /** Total notional (in quote quantums) of the vault's bids. */
+  bidNotional: Uint8Array;
+  /** Total notional (in quote quantums) of the vault's asks. */
+
+  askNotional: Uint8Array;
+}
+/**
+ * QueryVaultQuotedNotionalResponse is a response type for the
+ * VaultQuotedNotional RPC method.
+ */
+
+export interface QueryVaultQuotedNotionalResponseSDKType {
+  /** Total notional (in quote quantums) of the vault's bids. */
+  bid_notional: Uint8Array;
+  /** Total notional (in quote quantums) of the vault's asks. */
+
+  ask_notional: Uint8Array;
+}
+/**
+ * QueryDecodeVaultClientIdRequest is a request type for the DecodeVaultClientId
+ * RPC method.
+ */
+
+export interface QueryDecodeVaultClientIdRequest {
+  /**
+   * QueryDecodeVaultClientIdRequest is a request type for the DecodeVaultClientId
+   * RPC method.
+   */
+  clientId: number;
+}
+/**
+ * QueryDecodeVaultClientIdRequest is a request type for the DecodeVaultClientId
+ * RPC method.
+ */
+
+export interface QueryDecodeVaultClientIdRequestSDKType {
+  /**
+   * QueryDecodeVaultClientIdRequest is a request type for the DecodeVaultClientId
+   * RPC method.
+   */
+  client_id: number;
+}
+/**
+ * QueryDecodeVaultClientIdResponse is a response type for the
+ * DecodeVaultClientId RPC method.
+ */
+
+export interface QueryDecodeVaultClientIdResponse {
+  /** Side of the order. */
+  side: Order_Side;
+  /** Parity of the block height at which the order was placed. */
+
+  blockParity: number;
+  /** Layer of the order. */
+
+  layer: number;
+}
+/**
+ * QueryDecodeVaultClientIdResponse is a response type for the
+ * DecodeVaultClientId RPC method.
+ */
+
+export interface QueryDecodeVaultClientIdResponseSDKType {
+  /** Side of the order. */
+  side: Order_SideSDKType;
+  /** Parity of the block height at which the order was placed. */
+
+  block_parity: number;
+  /** Layer of the order. */
+
+  layer: number;
+}
+/**
+ * QueryVaultFillStatsRequest is a request type for the VaultFillStats RPC
+ * method.
+ */
+
+export interface QueryVaultFillStatsRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultFillStatsRequest is a request type for the VaultFillStats RPC
+ * method.
+ */
+
+export interface QueryVaultFillStatsRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultFillStatsResponse is a response type for the VaultFillStats RPC
+ * method.
+ */
+
+export interface QueryVaultFillStatsResponse {
+  stats?: VaultFillStats;
+}
+/**
+ * QueryVaultFillStatsResponse is a response type for the VaultFillStats RPC
+ * method.
+ */
+
+export interface QueryVaultFillStatsResponseSDKType {
+  stats?: VaultFillStatsSDKType;
+}
+/** QueryVaultMarginRequest is a request type for the VaultMargin RPC method. */
+
+export interface QueryVaultMarginRequest {
+  type: VaultType;
+  number: number;
+}
+/** QueryVaultMarginRequest is a request type for the VaultMargin RPC method. */
+
+export interface QueryVaultMarginRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/** QueryVaultMarginResponse is a response type for the VaultMargin RPC method. */
+
+export interface QueryVaultMarginResponse {
+  /** Initial margin requirement (in quote quantums) of the vault. */
+  initialMarginQuoteQuantums: Uint8Array;
+  /** Maintenance margin requirement (in quote quantums) of the vault. */
+
+  maintenanceMarginQuoteQuantums: Uint8Array;
+  /**
+   * Free collateral (in quote quantums) of the vault, i.e. net collateral minus
+   * initial margin requirement.
+   */
+
+  freeCollateralQuoteQuantums: Uint8Array;
+}
+/** QueryVaultMarginResponse is a response type for the VaultMargin RPC method. */
+
+export interface QueryVaultMarginResponseSDKType {
+  /** Initial margin requirement (in quote quantums) of the vault. */
+  initial_margin_quote_quantums: Uint8Array;
+  /** Maintenance margin requirement (in quote quantums) of the vault. */
+
+  maintenance_margin_quote_quantums: Uint8Array;
+  /**
+   * Free collateral (in quote quantums) of the vault, i.e. net collateral minus
+   * initial margin requirement.
+   */
+
+  free_collateral_quote_quantums: Uint8Array;
+}
+/**
+ * QueryVaultActivityLogRequest is a request type for the VaultActivityLog RPC
+ * method.
+ */
+
+export interface QueryVaultActivityLogRequest {
+  type: VaultType;
+  number: number;
+  pagination?: PageRequest;
+}
+/**
+ * QueryVaultActivityLogRequest is a request type for the VaultActivityLog RPC
+ * method.
+ */
+
+export interface QueryVaultActivityLogRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+  pagination?: PageRequestSDKType;
+}
+/**
+ * QueryVaultActivityLogResponse is a response type for the VaultActivityLog RPC
+ * method.
+ */
+
+export interface QueryVaultActivityLogResponse {
+  activities: VaultActivity[];
+  pagination?: PageResponse;
+}
+/**
+ * QueryVaultActivityLogResponse is a response type for the VaultActivityLog RPC
+ * method.
+ */
+
+export interface QueryVaultActivityLogResponseSDKType {
+  activities: VaultActivitySDKType[];
+  pagination?: PageResponseSDKType;
+}
+/**
+ * QueryVaultRefreshHistoryRequest is a request type for the VaultRefreshHistory
+ * RPC method.
+ */
+
+export interface QueryVaultRefreshHistoryRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultRefreshHistoryRequest is a request type for the VaultRefreshHistory
+ * RPC method.
+ */
+
+export interface QueryVaultRefreshHistoryRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultRefreshHistoryResponse is a response type for the
+ * VaultRefreshHistory RPC method.
+ */
+
+export interface QueryVaultRefreshHistoryResponse {
+  refreshes: VaultRefresh[];
+}
+/**
+ * QueryVaultRefreshHistoryResponse is a response type for the
+ * VaultRefreshHistory RPC method.
+ */
+
+export interface QueryVaultRefreshHistoryResponseSDKType {
+  refreshes: VaultRefreshSDKType[];
+}
+/**
+ * QueryVaultValueAtRiskRequest is a request type for the VaultValueAtRisk RPC
+ * method.
+ */
+
+export interface QueryVaultValueAtRiskRequest {
+  type: VaultType;
+  number: number;
+  /** Confidence (in ppm) of the value at risk. */
+
+  confidencePpm: number;
+}
+/**
+ * QueryVaultValueAtRiskRequest is a request type for the VaultValueAtRisk RPC
+ * method.
+ */
+
+export interface QueryVaultValueAtRiskRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+  /** Confidence (in ppm) of the value at risk. */
+
+  confidence_ppm: number;
+}
+/**
+ * QueryVaultValueAtRiskResponse is a response type for the VaultValueAtRisk RPC
+ * method.
+ */
+
+export interface QueryVaultValueAtRiskResponse {
+  /** Value at risk (in quote quantums) of the vault's position. */
+  valueAtRiskQuoteQuantums: Uint8Array;
+}
+/**
+ * QueryVaultValueAtRiskResponse is a response type for the VaultValueAtRisk RPC
+ * method.
+ */
+
+export interface QueryVaultValueAtRiskResponseSDKType {
+  /** Value at risk (in quote quantums) of the vault's position. */
+  value_at_risk_quote_quantums: Uint8Array;
+}
+/**
+ * QueryOwnerUnrealizedPnlRequest is a request type for the OwnerUnrealizedPnl
+ * RPC method.
+ */
+
+export interface QueryOwnerUnrealizedPnlRequest {
+  type: VaultType;
+  number: number;
+  owner: string;
+}
+/**
+ * QueryOwnerUnrealizedPnlRequest is a request type for the OwnerUnrealizedPnl
+ * RPC method.
+ */
+
+export interface QueryOwnerUnrealizedPnlRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+  owner: string;
+}
+/**
+ * QueryOwnerUnrealizedPnlResponse is a response type for the OwnerUnrealizedPnl
+ * RPC method.
+ */
+
+export interface QueryOwnerUnrealizedPnlResponse {
+  /**
+   * Unrealized PnL (in quote quantums) of the owner's shares, i.e. current value
+   * of the shares minus their cost basis.
+   */
+  unrealizedPnlQuoteQuantums: Uint8Array;
+  /** Cost basis (in quote quantums) of the owner's shares. */
+
+  costBasisQuoteQuantums: Uint8Array;
+}
+/**
+ * QueryOwnerUnrealizedPnlResponse is a response type for the OwnerUnrealizedPnl
+ * RPC method.
+ */
+
+export interface QueryOwnerUnrealizedPnlResponseSDKType {
+  /**
+   * Unrealized PnL (in quote quantums) of the owner's shares, i.e. current value
+   * of the shares minus their cost basis.
+   */
+  unrealized_pnl_quote_quantums: Uint8Array;
+  /** Cost basis (in quote quantums) of the owner's shares. */
+
+  cost_basis_quote_quantums: Uint8Array;
+}
+/** QueryTotalVaultTvlRequest is a request type for the TotalVaultTvl RPC method. */
+
+export interface QueryTotalVaultTvlRequest {}
+/** QueryTotalVaultTvlRequest is a request type for the TotalVaultTvl RPC method. */
+
+export interface QueryTotalVaultTvlRequestSDKType {}
+/**
+ * QueryTotalVaultTvlResponse is a response type for the TotalVaultTvl RPC
+ * method.
+ */
+
+export interface QueryTotalVaultTvlResponse {
+  /** Sum of equity (in quote quantums) of all vaults. */
+  totalEquityQuoteQuantums: Uint8Array;
+  /** Number of vaults. */
+
+  vaultCount: number;
+}
+/**
+ * QueryTotalVaultTvlResponse is a response type for the TotalVaultTvl RPC
+ * method.
+ */
+
+export interface QueryTotalVaultTvlResponseSDKType {
+  /** Sum of equity (in quote quantums) of all vaults. */
+  total_equity_quote_quantums: Uint8Array;
+  /** Number of vaults. */
+
+  vault_count: number;
+}
+/**
+ * QueryTotalVaultInventoryRequest is a request type for the TotalVaultInventory
+ * RPC method.
+ */
+
+export interface QueryTotalVaultInventoryRequest {
+  /**
+   * QueryTotalVaultInventoryRequest is a request type for the TotalVaultInventory
+   * RPC method.
+   */
+  clobPairId: number;
+}
+/**
+ * QueryTotalVaultInventoryRequest is a request type for the TotalVaultInventory
+ * RPC method.
+ */
+
+export interface QueryTotalVaultInventoryRequestSDKType {
+  /**
+   * QueryTotalVaultInventoryRequest is a request type for the TotalVaultInventory
+   * RPC method.
+   */
+  clob_pair_id: number;
+}
+/**
+ * QueryTotalVaultInventoryResponse is a response type for the
+ * TotalVaultInventory RPC method.
+ */
+
+export interface QueryTotalVaultInventoryResponse {
+  /**
+   * Sum of signed positions (in base quantums) of all vaults in the perpetual
+   * of the clob pair.
+   */
+  inventoryBaseQuantums: Uint8Array;
+}
+/**
+ * QueryTotalVaultInventoryResponse is a response type for the
+ * TotalVaultInventory RPC method.
+ */
+
+export interface QueryTotalVaultInventoryResponseSDKType {
+  /**
+   * Sum of signed positions (in base quantums) of all vaults in the perpetual
+   * of the clob pair.
+   */
+  inventory_base_quantums: Uint8Array;
+}
+/**
+ * QueryExplainVaultOrderRequest is a request type for the ExplainVaultOrder RPC
+ * method.
+ */
+
+export interface QueryExplainVaultOrderRequest {
+  type: VaultType;
+  number: number;
+  /** Side of the order. */
+
+  side: Order_Side;
+  /** Layer of the order, starting from 0 for the innermost layer. */
+
+  layer: number;
+}
+/**
+ * QueryExplainVaultOrderRequest is a request type for the ExplainVaultOrder RPC
+ * method.
+ */
+
+export interface QueryExplainVaultOrderRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+  /** Side of the order. */
+
+  side: Order_SideSDKType;
+  /** Layer of the order, starting from 0 for the innermost layer. */
+
+  layer: number;
+}
+/**
+ * QueryExplainVaultOrderResponse is a response type for the ExplainVaultOrder
+ * RPC method.
+ */
+
+export interface QueryExplainVaultOrderResponse {
+  explanation?: VaultOrderExplanation;
+}
+/**
+ * QueryExplainVaultOrderResponse is a response type for the ExplainVaultOrder
+ * RPC method.
+ */
+
+export interface QueryExplainVaultOrderResponseSDKType {
+  explanation?: VaultOrderExplanationSDKType;
+}
+/**
+ * VaultOrderExplanation contains intermediate values of the quoting computation
+ * of a vault order.
+ */
+
+export interface VaultOrderExplanation {
+  /** Side of the order. */
+  side: Order_Side;
+  /** Layer of the order, starting from 0 for the innermost layer. */
+
+  layer: number;
+  /**
+   * Spread (in ppm) of the order's layer, i.e. `spread * (layer + 1)` or
+   * `spread * spread_multiplier` if spread multipliers are set.
+   */
+
+  spreadPpm: Long;
+  /**
+   * Leverage (in ppm) of the order's layer, i.e. `leverage - layer *
+   * order_size_pct` for asks and `leverage + layer * order_size_pct` for bids.
+   */
+
+  leveragePpm: Uint8Array;
+  /**
+   * Skew (in ppm) of the order's layer, i.e. `-leverage * spread *
+   * skew_factor`.
+   */
+
+  skewPpm: Uint8Array;
+  /**
+   * Price in subticks of `oracle_price * (1 + skew +/- spread)` (+ for asks
+   * and - for bids), rounded up for asks and down for bids.
+   */
+
+  rawSubticks: Uint8Array;
+  /**
+   * Raw price in subticks bounded to be at least (for asks) or at most (for
+   * bids) oracle price and `min_ticks_from_oracle_per_side` ticks away from it.
+   */
+
+  boundedSubticks: Uint8Array;
+  /**
+   * Price of the order in subticks, i.e. bounded price rounded to a multiple
+   * of subticks per tick (up for asks and down for bids) after jitter,
+   * clamping, and de-duplication of price levels across layers.
+   */
+
+  roundedSubticks: Long;
+  /** Size of the order in base quantums. */
+
+  sizeBaseQuantums: Long;
+}
+/**
+ * VaultOrderExplanation contains intermediate values of the quoting computation
+ * of a vault order.
+ */
+
+export interface VaultOrderExplanationSDKType {
+  /** Side of the order. */
+  side: Order_SideSDKType;
+  /** Layer of the order, starting from 0 for the innermost layer. */
+
+  layer: number;
+  /**
+   * Spread (in ppm) of the order's layer, i.e. `spread * (layer + 1)` or
+   * `spread * spread_multiplier` if spread multipliers are set.
+   */
+
+  spread_ppm: Long;
+  /**
+   * Leverage (in ppm) of the order's layer, i.e. `leverage - layer *
+   * order_size_pct` for asks and `leverage + layer * order_size_pct` for bids.
+   */
+
+  leverage_ppm: Uint8Array;
+  /**
+   * Skew (in ppm) of the order's layer, i.e. `-leverage * spread *
+   * skew_factor`.
+   */
+
+  skew_ppm: Uint8Array;
+  /**
+   * Price in subticks of `oracle_price * (1 + skew +/- spread)` (+ for asks
+   * and - for bids), rounded up for asks and down for bids.
+   */
+
+  raw_subticks: Uint8Array;
+  /**
+   * Raw price in subticks bounded to be at least (for asks) or at most (for
+   * bids) oracle price and `min_ticks_from_oracle_per_side` ticks away from it.
+   */
+
+  bounded_subticks: Uint8Array;
+  /**
+   * Price of the order in subticks, i.e. bounded price rounded to a multiple
+   * of subticks per tick (up for asks and down for bids) after jitter,
+   * clamping, and de-duplication of price levels across layers.
+   */
+
+  rounded_subticks: Long;
+  /** Size of the order in base quantums. */
+
+  size_base_quantums: Long;
+}
+/**
+ * QueryOrphanedVaultOrdersRequest is a request type for the OrphanedVaultOrders
+ * RPC method.
+ */
+
+export interface QueryOrphanedVaultOrdersRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryOrphanedVaultOrdersRequest is a request type for the OrphanedVaultOrders
+ * RPC method.
+ */
+
+export interface QueryOrphanedVaultOrdersRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryOrphanedVaultOrdersResponse is a response type for the
+ * OrphanedVaultOrders RPC method.
+ */
+
+export interface QueryOrphanedVaultOrdersResponse {
+  /** Resting orders of the vault that are orphaned. */
+  orders: Order[];
+}
+/**
+ * QueryOrphanedVaultOrdersResponse is a response type for the
+ * OrphanedVaultOrders RPC method.
+ */
+
+export interface QueryOrphanedVaultOrdersResponseSDKType {
+  /** Resting orders of the vault that are orphaned. */
+  orders: OrderSDKType[];
+}
+/**
+ * QueryVaultMakerEdgeRequest is a request type for the VaultMakerEdge RPC
+ * method.
+ */
+
+export interface QueryVaultMakerEdgeRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultMakerEdgeRequest is a request type for the VaultMakerEdge RPC
+ * method.
+ */
+
+export interface QueryVaultMakerEdgeRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultMakerEdgeResponse is a response type for the VaultMakerEdge RPC
+ * method.
+ */
+
+export interface QueryVaultMakerEdgeResponse {
+  /**
+   * Maker edge (in ppm) of the vault, which is negative if estimated adverse
+   * selection exceeds effective spread.
+   */
+  makerEdgePpm: Long;
+}
+/**
+ * QueryVaultMakerEdgeResponse is a response type for the VaultMakerEdge RPC
+ * method.
+ */
+
+export interface QueryVaultMakerEdgeResponseSDKType {
+  /**
+   * Maker edge (in ppm) of the vault, which is negative if estimated adverse
+   * selection exceeds effective spread.
+   */
+  maker_edge_ppm: Long;
+}
+/**
+ * QueryCurrentBlockClientIdParityRequest is a request type for the
+ * CurrentBlockClientIdParity RPC method.
+ */
+
+export interface QueryCurrentBlockClientIdParityRequest {}
+/**
+ * QueryCurrentBlockClientIdParityRequest is a request type for the
+ * CurrentBlockClientIdParity RPC method.
+ */
+
+export interface QueryCurrentBlockClientIdParityRequestSDKType {}
+/**
+ * QueryCurrentBlockClientIdParityResponse is a response type for the
+ * CurrentBlockClientIdParity RPC method.
+ */
+
+export interface QueryCurrentBlockClientIdParityResponse {
+  /** Parity of the current block height, i.e. 0 if even and 1 if odd. */
+  blockParity: number;
+}
+/**
+ * QueryCurrentBlockClientIdParityResponse is a response type for the
+ * CurrentBlockClientIdParity RPC method.
+ */
+
+export interface QueryCurrentBlockClientIdParityResponseSDKType {
+  /** Parity of the current block height, i.e. 0 if even and 1 if odd. */
+  block_parity: number;
+}
+/**
+ * QueryVaultLastQuotePriceRequest is a request type for the
+ * VaultLastQuotePrice RPC method.
+ */
+
+export interface QueryVaultLastQuotePriceRequest {
+  type: VaultType;
+  number: number;
+}
+/**
+ * QueryVaultLastQuotePriceRequest is a request type for the
+ * VaultLastQuotePrice RPC method.
+ */
+
+export interface QueryVaultLastQuotePriceRequestSDKType {
+  type: VaultTypeSDKType;
+  number: number;
+}
+/**
+ * QueryVaultLastQuotePriceResponse is a response type for the
+ * VaultLastQuotePrice RPC method.
+ */
+
+export interface QueryVaultLastQuotePriceResponse {
+  /** Market whose price the vault quoted at. */
+  marketId: number;
+  /** Exponent of the market's prices. */
+
+  exponent: number;
+  /** Price that the vault's orders were based on at its last refresh. */
+
+  lastQuotePrice: Long;
+  /** Current oracle price of the market. */
+
+  oraclePrice: Long;
+}
+/**
+ * QueryVaultLastQuotePriceResponse is a response type for the
+ * VaultLastQuotePrice RPC method.
+ */
+
+export interface QueryVaultLastQuotePriceResponseSDKType {
+  /** Market whose price the vault quoted at. */
+  market_id: number;
+  /** Exponent of the market's prices. */
+
+  exponent: number;
+  /** Price that the vault's orders were based on at its last refresh. */
+
+  last_quote_price: Long;
+  /** Current oracle price of the market. */
+
+  oracle_price: Long;
+}
+/**
+ * QueryMarketVolatilityRequest is a request type for the MarketVolatility RPC
+ * method.
+ */
+
+export interface QueryMarketVolatilityRequest {
+  /**
+   * QueryMarketVolatilityRequest is a request type for the MarketVolatility RPC
+   * method.
+   */
+  marketId: number;
+}
+/**
+ * QueryMarketVolatilityRequest is a request type for the MarketVolatility RPC
+ * method.
+ */
+
+export interface QueryMarketVolatilityRequestSDKType {
+  /**
+   * QueryMarketVolatilityRequest is a request type for the MarketVolatility RPC
+   * method.
+   */
+  market_id: number;
+}
+/**
+ * QueryMarketVolatilityResponse is a response type for the MarketVolatility RPC
+ * method.
+ */
+
+export interface QueryMarketVolatilityResponse {
+  /** Realized volatility of the market. */
+  volatility?: MarketVolatility;
+  /**
+   * Whether no per-block return of the market's oracle price has been observed
+   * yet, in which case volatility is zero.
+   */
+
+  insufficientData: boolean;
+}
+/**
+ * QueryMarketVolatilityResponse is a response type for the MarketVolatility RPC
+ * method.
+ */
+
+export interface QueryMarketVolatilityResponseSDKType {
+  /** Realized volatility of the market. */
+  volatility?: MarketVolatilitySDKType;
+  /**
+   * Whether no per-block return of the market's oracle price has been observed
+   * yet, in which case volatility is zero.
+   */
+
+  insufficient_data: boolean;
+}
 
 function createBaseQueryParamsRequest(): QueryParamsRequest {
   return {};
@@ -239,7 +1192,8 @@ function createBaseQueryVaultResponse(): QueryVaultResponse {
     subaccountId: undefined,
     equity: new Uint8Array(),
     inventory: new Uint8Array(),
-    totalShares: undefined
+    totalShares: undefined,
+    label: ""
   };
 }
 
@@ -265,6 +1219,10 @@ export const QueryVaultResponse = {
       NumShares.encode(message.totalShares, writer.uint32(42).fork()).ldelim();
     }
 
+    if (message.label !== "") {
+      writer.uint32(50).string(message.label);
+    }
+
     return writer;
   },
 
@@ -297,6 +1255,10 @@ export const QueryVaultResponse = {
           message.totalShares = NumShares.decode(reader, reader.uint32());
           break;
 
+        case 6:
+          message.label = reader.string();
+          break;
+
         default:
           reader.skipType(tag & 7);
           break;
@@ -313,6 +1275,7 @@ export const QueryVaultResponse = {
     message.equity = object.equity ?? new Uint8Array();
     message.inventory = object.inventory ?? new Uint8Array();
     message.totalShares = object.totalShares !== undefined && object.totalShares !== null ? NumShares.fromPartial(object.totalShares) : undefined;
+    message.label = object.label ?? "";
     return message;
   }
 
@@ -536,4 +1499,2242 @@ export const QueryOwnerSharesResponse = {
     return message;
   }
 
+};
+
+function createBaseQueryVaultQuotingStatusRequest(): QueryVaultQuotingStatusRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultQuotingStatusRequest = {
+  encode(message: QueryVaultQuotingStatusRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultQuotingStatusRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultQuotingStatusRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultQuotingStatusRequest>): QueryVaultQuotingStatusRequest {
+    const message = createBaseQueryVaultQuotingStatusRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultQuotingStatusResponse(): QueryVaultQuotingStatusResponse {
+  return {
+    quoting: false,
+    reason: ""
+  };
+}
+
+export const QueryVaultQuotingStatusResponse = {
+  encode(message: QueryVaultQuotingStatusResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.quoting === true) {
+      writer.uint32(8).bool(message.quoting);
+    }
+
+    if (message.reason !== "") {
+      writer.uint32(18).string(message.reason);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultQuotingStatusResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultQuotingStatusResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.quoting = reader.bool();
+          break;
+
+        case 2:
+          message.reason = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultQuotingStatusResponse>): QueryVaultQuotingStatusResponse {
+    const message = createBaseQueryVaultQuotingStatusResponse();
+    message.quoting = object.quoting ?? false;
+    message.reason = object.reason ?? "";
+    return message;
+  }
+
+};
+
+function createBaseQueryQuoteDepositRequest(): QueryQuoteDepositRequest {
+  return {
+    type: 0,
+    number: 0,
+    quoteQuantums: Long.UZERO
+  };
+}
+
+export const QueryQuoteDepositRequest = {
+  encode(message: QueryQuoteDepositRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (!message.quoteQuantums.isZero()) {
+      writer.uint32(24).uint64(message.quoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryQuoteDepositRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryQuoteDepositRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.quoteQuantums = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryQuoteDepositRequest>): QueryQuoteDepositRequest {
+    const message = createBaseQueryQuoteDepositRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    message.quoteQuantums = object.quoteQuantums !== undefined && object.quoteQuantums !== null ? Long.fromValue(object.quoteQuantums) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryQuoteDepositResponse(): QueryQuoteDepositResponse {
+  return {
+    sharesOut: undefined,
+    sharePricePpm: new Uint8Array()
+  };
+}
+
+export const QueryQuoteDepositResponse = {
+  encode(message: QueryQuoteDepositResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.sharesOut !== undefined) {
+      NumShares.encode(message.sharesOut, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.sharePricePpm.length !== 0) {
+      writer.uint32(18).bytes(message.sharePricePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryQuoteDepositResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryQuoteDepositResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.sharesOut = NumShares.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.sharePricePpm = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryQuoteDepositResponse>): QueryQuoteDepositResponse {
+    const message = createBaseQueryQuoteDepositResponse();
+    message.sharesOut = object.sharesOut !== undefined && object.sharesOut !== null ? NumShares.fromPartial(object.sharesOut) : undefined;
+    message.sharePricePpm = object.sharePricePpm ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultQuoteCurveRequest(): QueryVaultQuoteCurveRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultQuoteCurveRequest = {
+  encode(message: QueryVaultQuoteCurveRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultQuoteCurveRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultQuoteCurveRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultQuoteCurveRequest>): QueryVaultQuoteCurveRequest {
+    const message = createBaseQueryVaultQuoteCurveRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultQuoteCurveResponse(): QueryVaultQuoteCurveResponse {
+  return {
+    points: [],
+    oracleSubticks: Long.UZERO
+  };
+}
+
+export const QueryVaultQuoteCurveResponse = {
+  encode(message: QueryVaultQuoteCurveResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.points) {
+      QuoteCurvePoint.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (!message.oracleSubticks.isZero()) {
+      writer.uint32(16).uint64(message.oracleSubticks);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultQuoteCurveResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultQuoteCurveResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.points.push(QuoteCurvePoint.decode(reader, reader.uint32()));
+          break;
+
+        case 2:
+          message.oracleSubticks = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultQuoteCurveResponse>): QueryVaultQuoteCurveResponse {
+    const message = createBaseQueryVaultQuoteCurveResponse();
+    message.points = object.points?.map(e => QuoteCurvePoint.fromPartial(e)) || [];
+    message.oracleSubticks = object.oracleSubticks !== undefined && object.oracleSubticks !== null ? Long.fromValue(object.oracleSubticks) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQuoteCurvePoint(): QuoteCurvePoint {
+  return {
+    side: 0,
+    subticks: Long.UZERO,
+    quantums: Long.UZERO,
+    layer: 0
+  };
+}
+
+export const QuoteCurvePoint = {
+  encode(message: QuoteCurvePoint, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.side !== 0) {
+      writer.uint32(8).int32(message.side);
+    }
+
+    if (!message.subticks.isZero()) {
+      writer.uint32(16).uint64(message.subticks);
+    }
+
+    if (!message.quantums.isZero()) {
+      writer.uint32(24).uint64(message.quantums);
+    }
+
+    if (message.layer !== 0) {
+      writer.uint32(32).uint32(message.layer);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuoteCurvePoint {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuoteCurvePoint();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.side = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.subticks = (reader.uint64() as Long);
+          break;
+
+        case 3:
+          message.quantums = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.layer = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QuoteCurvePoint>): QuoteCurvePoint {
+    const message = createBaseQuoteCurvePoint();
+    message.side = object.side ?? 0;
+    message.subticks = object.subticks !== undefined && object.subticks !== null ? Long.fromValue(object.subticks) : Long.UZERO;
+    message.quantums = object.quantums !== undefined && object.quantums !== null ? Long.fromValue(object.quantums) : Long.UZERO;
+    message.layer = object.layer ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultQuotedNotionalRequest(): QueryVaultQuotedNotionalRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultQuotedNotionalRequest = {
+  encode(message: QueryVaultQuotedNotionalRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultQuotedNotionalRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultQuotedNotionalRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultQuotedNotionalRequest>): QueryVaultQuotedNotionalRequest {
+    const message = createBaseQueryVaultQuotedNotionalRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultQuotedNotionalResponse(): QueryVaultQuotedNotionalResponse {
+  return {
+    bidNotional: new Uint8Array(),
+    askNotional: new Uint8Array()
+  };
+}
+
+export const QueryVaultQuotedNotionalResponse = {
+  encode(message: QueryVaultQuotedNotionalResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.bidNotional.length !== 0) {
+      writer.uint32(10).bytes(message.bidNotional);
+    }
+
+    if (message.askNotional.length !== 0) {
+      writer.uint32(18).bytes(message.askNotional);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultQuotedNotionalResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultQuotedNotionalResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.bidNotional = reader.bytes();
+          break;
+
+        case 2:
+          message.askNotional = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultQuotedNotionalResponse>): QueryVaultQuotedNotionalResponse {
+    const message = createBaseQueryVaultQuotedNotionalResponse();
+    message.bidNotional = object.bidNotional ?? new Uint8Array();
+    message.askNotional = object.askNotional ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryDecodeVaultClientIdRequest(): QueryDecodeVaultClientIdRequest {
+  return {
+    clientId: 0
+  };
+}
+
+export const QueryDecodeVaultClientIdRequest = {
+  encode(message: QueryDecodeVaultClientIdRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clientId !== 0) {
+      writer.uint32(8).uint32(message.clientId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryDecodeVaultClientIdRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryDecodeVaultClientIdRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clientId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryDecodeVaultClientIdRequest>): QueryDecodeVaultClientIdRequest {
+    const message = createBaseQueryDecodeVaultClientIdRequest();
+    message.clientId = object.clientId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryDecodeVaultClientIdResponse(): QueryDecodeVaultClientIdResponse {
+  return {
+    side: 0,
+    blockParity: 0,
+    layer: 0
+  };
+}
+
+export const QueryDecodeVaultClientIdResponse = {
+  encode(message: QueryDecodeVaultClientIdResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.side !== 0) {
+      writer.uint32(8).int32(message.side);
+    }
+
+    if (message.blockParity !== 0) {
+      writer.uint32(16).uint32(message.blockParity);
+    }
+
+    if (message.layer !== 0) {
+      writer.uint32(24).uint32(message.layer);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryDecodeVaultClientIdResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryDecodeVaultClientIdResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.side = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.blockParity = reader.uint32();
+          break;
+
+        case 3:
+          message.layer = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryDecodeVaultClientIdResponse>): QueryDecodeVaultClientIdResponse {
+    const message = createBaseQueryDecodeVaultClientIdResponse();
+    message.side = object.side ?? 0;
+    message.blockParity = object.blockParity ?? 0;
+    message.layer = object.layer ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultFillStatsRequest(): QueryVaultFillStatsRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultFillStatsRequest = {
+  encode(message: QueryVaultFillStatsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultFillStatsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultFillStatsRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultFillStatsRequest>): QueryVaultFillStatsRequest {
+    const message = createBaseQueryVaultFillStatsRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultFillStatsResponse(): QueryVaultFillStatsResponse {
+  return {
+    stats: undefined
+  };
+}
+
+export const QueryVaultFillStatsResponse = {
+  encode(message: QueryVaultFillStatsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.stats !== undefined) {
+      VaultFillStats.encode(message.stats, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultFillStatsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultFillStatsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.stats = VaultFillStats.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultFillStatsResponse>): QueryVaultFillStatsResponse {
+    const message = createBaseQueryVaultFillStatsResponse();
+    message.stats = object.stats !== undefined && object.stats !== null ? VaultFillStats.fromPartial(object.stats) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultMarginRequest(): QueryVaultMarginRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultMarginRequest = {
+  encode(message: QueryVaultMarginRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultMarginRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultMarginRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultMarginRequest>): QueryVaultMarginRequest {
+    const message = createBaseQueryVaultMarginRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultMarginResponse(): QueryVaultMarginResponse {
+  return {
+    initialMarginQuoteQuantums: new Uint8Array(),
+    maintenanceMarginQuoteQuantums: new Uint8Array(),
+    freeCollateralQuoteQuantums: new Uint8Array()
+  };
+}
+
+export const QueryVaultMarginResponse = {
+  encode(message: QueryVaultMarginResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.initialMarginQuoteQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.initialMarginQuoteQuantums);
+    }
+
+    if (message.maintenanceMarginQuoteQuantums.length !== 0) {
+      writer.uint32(18).bytes(message.maintenanceMarginQuoteQuantums);
+    }
+
+    if (message.freeCollateralQuoteQuantums.length !== 0) {
+      writer.uint32(26).bytes(message.freeCollateralQuoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultMarginResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultMarginResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.initialMarginQuoteQuantums = reader.bytes();
+          break;
+
+        case 2:
+          message.maintenanceMarginQuoteQuantums = reader.bytes();
+          break;
+
+        case 3:
+          message.freeCollateralQuoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultMarginResponse>): QueryVaultMarginResponse {
+    const message = createBaseQueryVaultMarginResponse();
+    message.initialMarginQuoteQuantums = object.initialMarginQuoteQuantums ?? new Uint8Array();
+    message.maintenanceMarginQuoteQuantums = object.maintenanceMarginQuoteQuantums ?? new Uint8Array();
+    message.freeCollateralQuoteQuantums = object.freeCollateralQuoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultActivityLogRequest(): QueryVaultActivityLogRequest {
+  return {
+    type: 0,
+    number: 0,
+    pagination: undefined
+  };
+}
+
+export const QueryVaultActivityLogRequest = {
+  encode(message: QueryVaultActivityLogRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.pagination !== undefined) {
+      PageRequest.encode(message.pagination, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultActivityLogRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultActivityLogRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.pagination = PageRequest.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultActivityLogRequest>): QueryVaultActivityLogRequest {
+    const message = createBaseQueryVaultActivityLogRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageRequest.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultActivityLogResponse(): QueryVaultActivityLogResponse {
+  return {
+    activities: [],
+    pagination: undefined
+  };
+}
+
+export const QueryVaultActivityLogResponse = {
+  encode(message: QueryVaultActivityLogResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.activities) {
+      VaultActivity.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.pagination !== undefined) {
+      PageResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultActivityLogResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultActivityLogResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.activities.push(VaultActivity.decode(reader, reader.uint32()));
+          break;
+
+        case 2:
+          message.pagination = PageResponse.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultActivityLogResponse>): QueryVaultActivityLogResponse {
+    const message = createBaseQueryVaultActivityLogResponse();
+    message.activities = object.activities?.map(e => VaultActivity.fromPartial(e)) || [];
+    message.pagination = object.pagination !== undefined && object.pagination !== null ? PageResponse.fromPartial(object.pagination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultRefreshHistoryRequest(): QueryVaultRefreshHistoryRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultRefreshHistoryRequest = {
+  encode(message: QueryVaultRefreshHistoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultRefreshHistoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultRefreshHistoryRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultRefreshHistoryRequest>): QueryVaultRefreshHistoryRequest {
+    const message = createBaseQueryVaultRefreshHistoryRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultRefreshHistoryResponse(): QueryVaultRefreshHistoryResponse {
+  return {
+    refreshes: []
+  };
+}
+
+export const QueryVaultRefreshHistoryResponse = {
+  encode(message: QueryVaultRefreshHistoryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.refreshes) {
+      VaultRefresh.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultRefreshHistoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultRefreshHistoryResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.refreshes.push(VaultRefresh.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultRefreshHistoryResponse>): QueryVaultRefreshHistoryResponse {
+    const message = createBaseQueryVaultRefreshHistoryResponse();
+    message.refreshes = object.refreshes?.map(e => VaultRefresh.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultValueAtRiskRequest(): QueryVaultValueAtRiskRequest {
+  return {
+    type: 0,
+    number: 0,
+    confidencePpm: 0
+  };
+}
+
+export const QueryVaultValueAtRiskRequest = {
+  encode(message: QueryVaultValueAtRiskRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.confidencePpm !== 0) {
+      writer.uint32(24).uint32(message.confidencePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultValueAtRiskRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultValueAtRiskRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.confidencePpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultValueAtRiskRequest>): QueryVaultValueAtRiskRequest {
+    const message = createBaseQueryVaultValueAtRiskRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    message.confidencePpm = object.confidencePpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultValueAtRiskResponse(): QueryVaultValueAtRiskResponse {
+  return {
+    valueAtRiskQuoteQuantums: new Uint8Array()
+  };
+}
+
+export const QueryVaultValueAtRiskResponse = {
+  encode(message: QueryVaultValueAtRiskResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.valueAtRiskQuoteQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.valueAtRiskQuoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultValueAtRiskResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultValueAtRiskResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.valueAtRiskQuoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultValueAtRiskResponse>): QueryVaultValueAtRiskResponse {
+    const message = createBaseQueryVaultValueAtRiskResponse();
+    message.valueAtRiskQuoteQuantums = object.valueAtRiskQuoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryOwnerUnrealizedPnlRequest(): QueryOwnerUnrealizedPnlRequest {
+  return {
+    type: 0,
+    number: 0,
+    owner: ""
+  };
+}
+
+export const QueryOwnerUnrealizedPnlRequest = {
+  encode(message: QueryOwnerUnrealizedPnlRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.owner !== "") {
+      writer.uint32(26).string(message.owner);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOwnerUnrealizedPnlRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOwnerUnrealizedPnlRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.owner = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOwnerUnrealizedPnlRequest>): QueryOwnerUnrealizedPnlRequest {
+    const message = createBaseQueryOwnerUnrealizedPnlRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    message.owner = object.owner ?? "";
+    return message;
+  }
+
+};
+
+function createBaseQueryOwnerUnrealizedPnlResponse(): QueryOwnerUnrealizedPnlResponse {
+  return {
+    unrealizedPnlQuoteQuantums: new Uint8Array(),
+    costBasisQuoteQuantums: new Uint8Array()
+  };
+}
+
+export const QueryOwnerUnrealizedPnlResponse = {
+  encode(message: QueryOwnerUnrealizedPnlResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.unrealizedPnlQuoteQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.unrealizedPnlQuoteQuantums);
+    }
+
+    if (message.costBasisQuoteQuantums.length !== 0) {
+      writer.uint32(18).bytes(message.costBasisQuoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOwnerUnrealizedPnlResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOwnerUnrealizedPnlResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.unrealizedPnlQuoteQuantums = reader.bytes();
+          break;
+
+        case 2:
+          message.costBasisQuoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOwnerUnrealizedPnlResponse>): QueryOwnerUnrealizedPnlResponse {
+    const message = createBaseQueryOwnerUnrealizedPnlResponse();
+    message.unrealizedPnlQuoteQuantums = object.unrealizedPnlQuoteQuantums ?? new Uint8Array();
+    message.costBasisQuoteQuantums = object.costBasisQuoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalVaultTvlRequest(): QueryTotalVaultTvlRequest {
+  return {};
+}
+
+export const QueryTotalVaultTvlRequest = {
+  encode(_: QueryTotalVaultTvlRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalVaultTvlRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalVaultTvlRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryTotalVaultTvlRequest>): QueryTotalVaultTvlRequest {
+    const message = createBaseQueryTotalVaultTvlRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalVaultTvlResponse(): QueryTotalVaultTvlResponse {
+  return {
+    totalEquityQuoteQuantums: new Uint8Array(),
+    vaultCount: 0
+  };
+}
+
+export const QueryTotalVaultTvlResponse = {
+  encode(message: QueryTotalVaultTvlResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.totalEquityQuoteQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.totalEquityQuoteQuantums);
+    }
+
+    if (message.vaultCount !== 0) {
+      writer.uint32(16).uint32(message.vaultCount);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalVaultTvlResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalVaultTvlResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.totalEquityQuoteQuantums = reader.bytes();
+          break;
+
+        case 2:
+          message.vaultCount = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryTotalVaultTvlResponse>): QueryTotalVaultTvlResponse {
+    const message = createBaseQueryTotalVaultTvlResponse();
+    message.totalEquityQuoteQuantums = object.totalEquityQuoteQuantums ?? new Uint8Array();
+    message.vaultCount = object.vaultCount ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalVaultInventoryRequest(): QueryTotalVaultInventoryRequest {
+  return {
+    clobPairId: 0
+  };
+}
+
+export const QueryTotalVaultInventoryRequest = {
+  encode(message: QueryTotalVaultInventoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clobPairId !== 0) {
+      writer.uint32(8).uint32(message.clobPairId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalVaultInventoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalVaultInventoryRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.clobPairId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryTotalVaultInventoryRequest>): QueryTotalVaultInventoryRequest {
+    const message = createBaseQueryTotalVaultInventoryRequest();
+    message.clobPairId = object.clobPairId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryTotalVaultInventoryResponse(): QueryTotalVaultInventoryResponse {
+  return {
+    inventoryBaseQuantums: new Uint8Array()
+  };
+}
+
+export const QueryTotalVaultInventoryResponse = {
+  encode(message: QueryTotalVaultInventoryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.inventoryBaseQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.inventoryBaseQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryTotalVaultInventoryResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryTotalVaultInventoryResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.inventoryBaseQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryTotalVaultInventoryResponse>): QueryTotalVaultInventoryResponse {
+    const message = createBaseQueryTotalVaultInventoryResponse();
+    message.inventoryBaseQuantums = object.inventoryBaseQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseQueryExplainVaultOrderRequest(): QueryExplainVaultOrderRequest {
+  return {
+    type: 0,
+    number: 0,
+    side: 0,
+    layer: 0
+  };
+}
+
+export const QueryExplainVaultOrderRequest = {
+  encode(message: QueryExplainVaultOrderRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    if (message.side !== 0) {
+      writer.uint32(24).int32(message.side);
+    }
+
+    if (message.layer !== 0) {
+      writer.uint32(32).uint32(message.layer);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryExplainVaultOrderRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryExplainVaultOrderRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        case 3:
+          message.side = (reader.int32() as any);
+          break;
+
+        case 4:
+          message.layer = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryExplainVaultOrderRequest>): QueryExplainVaultOrderRequest {
+    const message = createBaseQueryExplainVaultOrderRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    message.side = object.side ?? 0;
+    message.layer = object.layer ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryExplainVaultOrderResponse(): QueryExplainVaultOrderResponse {
+  return {
+    explanation: undefined
+  };
+}
+
+export const QueryExplainVaultOrderResponse = {
+  encode(message: QueryExplainVaultOrderResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.explanation !== undefined) {
+      VaultOrderExplanation.encode(message.explanation, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryExplainVaultOrderResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryExplainVaultOrderResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.explanation = VaultOrderExplanation.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryExplainVaultOrderResponse>): QueryExplainVaultOrderResponse {
+    const message = createBaseQueryExplainVaultOrderResponse();
+    message.explanation = object.explanation !== undefined && object.explanation !== null ? VaultOrderExplanation.fromPartial(object.explanation) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseVaultOrderExplanation(): VaultOrderExplanation {
+  return {
+    side: 0,
+    layer: 0,
+    spreadPpm: Long.UZERO,
+    leveragePpm: new Uint8Array(),
+    skewPpm: new Uint8Array(),
+    rawSubticks: new Uint8Array(),
+    boundedSubticks: new Uint8Array(),
+    roundedSubticks: Long.UZERO,
+    sizeBaseQuantums: Long.UZERO
+  };
+}
+
+export const VaultOrderExplanation = {
+  encode(message: VaultOrderExplanation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.side !== 0) {
+      writer.uint32(8).int32(message.side);
+    }
+
+    if (message.layer !== 0) {
+      writer.uint32(16).uint32(message.layer);
+    }
+
+    if (!message.spreadPpm.isZero()) {
+      writer.uint32(24).uint64(message.spreadPpm);
+    }
+
+    if (message.leveragePpm.length !== 0) {
+      writer.uint32(34).bytes(message.leveragePpm);
+    }
+
+    if (message.skewPpm.length !== 0) {
+      writer.uint32(42).bytes(message.skewPpm);
+    }
+
+    if (message.rawSubticks.length !== 0) {
+      writer.uint32(50).bytes(message.rawSubticks);
+    }
+
+    if (message.boundedSubticks.length !== 0) {
+      writer.uint32(58).bytes(message.boundedSubticks);
+    }
+
+    if (!message.roundedSubticks.isZero()) {
+      writer.uint32(64).uint64(message.roundedSubticks);
+    }
+
+    if (!message.sizeBaseQuantums.isZero()) {
+      writer.uint32(72).uint64(message.sizeBaseQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VaultOrderExplanation {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVaultOrderExplanation();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.side = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.layer = reader.uint32();
+          break;
+
+        case 3:
+          message.spreadPpm = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.leveragePpm = reader.bytes();
+          break;
+
+        case 5:
+          message.skewPpm = reader.bytes();
+          break;
+
+        case 6:
+          message.rawSubticks = reader.bytes();
+          break;
+
+        case 7:
+          message.boundedSubticks = reader.bytes();
+          break;
+
+        case 8:
+          message.roundedSubticks = (reader.uint64() as Long);
+          break;
+
+        case 9:
+          message.sizeBaseQuantums = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<VaultOrderExplanation>): VaultOrderExplanation {
+    const message = createBaseVaultOrderExplanation();
+    message.side = object.side ?? 0;
+    message.layer = object.layer ?? 0;
+    message.spreadPpm = object.spreadPpm !== undefined && object.spreadPpm !== null ? Long.fromValue(object.spreadPpm) : Long.UZERO;
+    message.leveragePpm = object.leveragePpm ?? new Uint8Array();
+    message.skewPpm = object.skewPpm ?? new Uint8Array();
+    message.rawSubticks = object.rawSubticks ?? new Uint8Array();
+    message.boundedSubticks = object.boundedSubticks ?? new Uint8Array();
+    message.roundedSubticks = object.roundedSubticks !== undefined && object.roundedSubticks !== null ? Long.fromValue(object.roundedSubticks) : Long.UZERO;
+    message.sizeBaseQuantums = object.sizeBaseQuantums !== undefined && object.sizeBaseQuantums !== null ? Long.fromValue(object.sizeBaseQuantums) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryOrphanedVaultOrdersRequest(): QueryOrphanedVaultOrdersRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryOrphanedVaultOrdersRequest = {
+  encode(message: QueryOrphanedVaultOrdersRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOrphanedVaultOrdersRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOrphanedVaultOrdersRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOrphanedVaultOrdersRequest>): QueryOrphanedVaultOrdersRequest {
+    const message = createBaseQueryOrphanedVaultOrdersRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryOrphanedVaultOrdersResponse(): QueryOrphanedVaultOrdersResponse {
+  return {
+    orders: []
+  };
+}
+
+export const QueryOrphanedVaultOrdersResponse = {
+  encode(message: QueryOrphanedVaultOrdersResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.orders) {
+      Order.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryOrphanedVaultOrdersResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryOrphanedVaultOrdersResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.orders.push(Order.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryOrphanedVaultOrdersResponse>): QueryOrphanedVaultOrdersResponse {
+    const message = createBaseQueryOrphanedVaultOrdersResponse();
+    message.orders = object.orders?.map(e => Order.fromPartial(e)) || [];
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultMakerEdgeRequest(): QueryVaultMakerEdgeRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultMakerEdgeRequest = {
+  encode(message: QueryVaultMakerEdgeRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultMakerEdgeRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultMakerEdgeRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultMakerEdgeRequest>): QueryVaultMakerEdgeRequest {
+    const message = createBaseQueryVaultMakerEdgeRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultMakerEdgeResponse(): QueryVaultMakerEdgeResponse {
+  return {
+    makerEdgePpm: Long.ZERO
+  };
+}
+
+export const QueryVaultMakerEdgeResponse = {
+  encode(message: QueryVaultMakerEdgeResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.makerEdgePpm.isZero()) {
+      writer.uint32(8).sint64(message.makerEdgePpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultMakerEdgeResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultMakerEdgeResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.makerEdgePpm = (reader.sint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultMakerEdgeResponse>): QueryVaultMakerEdgeResponse {
+    const message = createBaseQueryVaultMakerEdgeResponse();
+    message.makerEdgePpm = object.makerEdgePpm !== undefined && object.makerEdgePpm !== null ? Long.fromValue(object.makerEdgePpm) : Long.ZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryCurrentBlockClientIdParityRequest(): QueryCurrentBlockClientIdParityRequest {
+  return {};
+}
+
+export const QueryCurrentBlockClientIdParityRequest = {
+  encode(_: QueryCurrentBlockClientIdParityRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCurrentBlockClientIdParityRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryCurrentBlockClientIdParityRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<QueryCurrentBlockClientIdParityRequest>): QueryCurrentBlockClientIdParityRequest {
+    const message = createBaseQueryCurrentBlockClientIdParityRequest();
+    return message;
+  }
+
+};
+
+function createBaseQueryCurrentBlockClientIdParityResponse(): QueryCurrentBlockClientIdParityResponse {
+  return {
+    blockParity: 0
+  };
+}
+
+export const QueryCurrentBlockClientIdParityResponse = {
+  encode(message: QueryCurrentBlockClientIdParityResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.blockParity !== 0) {
+      writer.uint32(8).uint32(message.blockParity);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryCurrentBlockClientIdParityResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryCurrentBlockClientIdParityResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.blockParity = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryCurrentBlockClientIdParityResponse>): QueryCurrentBlockClientIdParityResponse {
+    const message = createBaseQueryCurrentBlockClientIdParityResponse();
+    message.blockParity = object.blockParity ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultLastQuotePriceRequest(): QueryVaultLastQuotePriceRequest {
+  return {
+    type: 0,
+    number: 0
+  };
+}
+
+export const QueryVaultLastQuotePriceRequest = {
+  encode(message: QueryVaultLastQuotePriceRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+
+    if (message.number !== 0) {
+      writer.uint32(16).uint32(message.number);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultLastQuotePriceRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultLastQuotePriceRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 2:
+          message.number = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultLastQuotePriceRequest>): QueryVaultLastQuotePriceRequest {
+    const message = createBaseQueryVaultLastQuotePriceRequest();
+    message.type = object.type ?? 0;
+    message.number = object.number ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryVaultLastQuotePriceResponse(): QueryVaultLastQuotePriceResponse {
+  return {
+    marketId: 0,
+    exponent: 0,
+    lastQuotePrice: Long.UZERO,
+    oraclePrice: Long.UZERO
+  };
+}
+
+export const QueryVaultLastQuotePriceResponse = {
+  encode(message: QueryVaultLastQuotePriceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marketId !== 0) {
+      writer.uint32(8).uint32(message.marketId);
+    }
+
+    if (message.exponent !== 0) {
+      writer.uint32(16).sint32(message.exponent);
+    }
+
+    if (!message.lastQuotePrice.isZero()) {
+      writer.uint32(24).uint64(message.lastQuotePrice);
+    }
+
+    if (!message.oraclePrice.isZero()) {
+      writer.uint32(32).uint64(message.oraclePrice);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryVaultLastQuotePriceResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryVaultLastQuotePriceResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marketId = reader.uint32();
+          break;
+
+        case 2:
+          message.exponent = reader.sint32();
+          break;
+
+        case 3:
+          message.lastQuotePrice = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.oraclePrice = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryVaultLastQuotePriceResponse>): QueryVaultLastQuotePriceResponse {
+    const message = createBaseQueryVaultLastQuotePriceResponse();
+    message.marketId = object.marketId ?? 0;
+    message.exponent = object.exponent ?? 0;
+    message.lastQuotePrice = object.lastQuotePrice !== undefined && object.lastQuotePrice !== null ? Long.fromValue(object.lastQuotePrice) : Long.UZERO;
+    message.oraclePrice = object.oraclePrice !== undefined && object.oraclePrice !== null ? Long.fromValue(object.oraclePrice) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketVolatilityRequest(): QueryMarketVolatilityRequest {
+  return {
+    marketId: 0
+  };
+}
+
+export const QueryMarketVolatilityRequest = {
+  encode(message: QueryMarketVolatilityRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marketId !== 0) {
+      writer.uint32(8).uint32(message.marketId);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketVolatilityRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketVolatilityRequest();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marketId = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketVolatilityRequest>): QueryMarketVolatilityRequest {
+    const message = createBaseQueryMarketVolatilityRequest();
+    message.marketId = object.marketId ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseQueryMarketVolatilityResponse(): QueryMarketVolatilityResponse {
+  return {
+    volatility: undefined,
+    insufficientData: false
+  };
+}
+
+export const QueryMarketVolatilityResponse = {
+  encode(message: QueryMarketVolatilityResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.volatility !== undefined) {
+      MarketVolatility.encode(message.volatility, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.insufficientData === true) {
+      writer.uint32(16).bool(message.insufficientData);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryMarketVolatilityResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryMarketVolatilityResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.volatility = MarketVolatility.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.insufficientData = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<QueryMarketVolatilityResponse>): QueryMarketVolatilityResponse {
+    const message = createBaseQueryMarketVolatilityResponse();
+    message.volatility = object.volatility !== undefined && object.volatility !== null ? MarketVolatility.fromPartial(object.volatility) : undefined;
+    message.insufficientData = object.insufficientData ?? false;
+    return message;
+  }
+
 };
\ No newline at end of file
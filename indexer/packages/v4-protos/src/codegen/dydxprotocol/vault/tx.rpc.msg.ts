@@ -1,6 +1,6 @@
 import { Rpc } from "../../helpers";
 import * as _m0 from "protobufjs/minimal";
-import { MsgDepositToVault, MsgDepositToVaultResponse, MsgWithdrawFromVault, MsgWithdrawFromVaultResponse, MsgUpdateParams, MsgUpdateParamsResponse } from "./tx";
+import { MsgDepositToVault, MsgDepositToVaultResponse, MsgWithdrawFromVault, MsgWithdrawFromVaultResponse, MsgUpdateParams, MsgUpdateParamsResponse, MsgSetVaultLabel, MsgSetVaultLabelResponse, MsgFreezeVaultShares, MsgFreezeVaultSharesResponse, MsgUnfreezeVaultShares, MsgUnfreezeVaultSharesResponse, MsgRepairVaultShares, MsgRepairVaultSharesResponse, MsgCloseVault, MsgCloseVaultResponse, MsgOperatorUpdateVaultParams, MsgOperatorUpdateVaultParamsResponse, MsgRefreshVaultOrders, MsgRefreshVaultOrdersResponse } from "./tx";
 /** Msg defines the Msg service. */
 
 export interface Msg {
@@ -12,6 +12,39 @@ export interface Msg {
   /** UpdateParams updates the Params in state. */
 
   updateParams(request: MsgUpdateParams): Promise<MsgUpdateParamsResponse>;
+  /** SetVaultLabel sets the label of a vault on behalf of the vault operator. */
+
+  setVaultLabel(request: MsgSetVaultLabel): Promise<MsgSetVaultLabelResponse>;
+  /** FreezeVaultShares freezes an owner's shares in a vault. */
+
+  freezeVaultShares(request: MsgFreezeVaultShares): Promise<MsgFreezeVaultSharesResponse>;
+  /** UnfreezeVaultShares unfreezes an owner's shares in a vault. */
+
+  unfreezeVaultShares(request: MsgUnfreezeVaultShares): Promise<MsgUnfreezeVaultSharesResponse>;
+  /**
+   * RepairVaultShares sets total shares of a vault to the sum of its owner
+   * shares.
+   */
+
+  repairVaultShares(request: MsgRepairVaultShares): Promise<MsgRepairVaultSharesResponse>;
+  /**
+   * CloseVault transfers all equity of a deactivated vault without outstanding
+   * shares to a destination subaccount.
+   */
+
+  closeVault(request: MsgCloseVault): Promise<MsgCloseVaultResponse>;
+  /**
+   * OperatorUpdateVaultParams updates a whitelisted subset of params within
+   * gov-set bounds, signed by the vault operator.
+   */
+
+  operatorUpdateVaultParams(request: MsgOperatorUpdateVaultParams): Promise<MsgOperatorUpdateVaultParamsResponse>;
+  /**
+   * RefreshVaultOrders refreshes orders of a vault immediately instead of in
+   * the end blocker, signed by the vault operator.
+   */
+
+  refreshVaultOrders(request: MsgRefreshVaultOrders): Promise<MsgRefreshVaultOrdersResponse>;
 }
 export class MsgClientImpl implements Msg {
   private readonly rpc: Rpc;
@@ -21,6 +54,13 @@ export class MsgClientImpl implements Msg {
     this.depositToVault = this.depositToVault.bind(this);
     this.withdrawFromVault = this.withdrawFromVault.bind(this);
     this.updateParams = this.updateParams.bind(this);
+    this.setVaultLabel = this.setVaultLabel.bind(this);
+    this.freezeVaultShares = this.freezeVaultShares.bind(this);
+    this.unfreezeVaultShares = this.unfreezeVaultShares.bind(this);
+    this.repairVaultShares = this.repairVaultShares.bind(this);
+    this.closeVault = this.closeVault.bind(this);
+    this.operatorUpdateVaultParams = this.operatorUpdateVaultParams.bind(this);
+    this.refreshVaultOrders = this.refreshVaultOrders.bind(this);
   }
 
   depositToVault(request: MsgDepositToVault): Promise<MsgDepositToVaultResponse> {
@@ -41,4 +81,46 @@ export class MsgClientImpl implements Msg {
     return promise.then(data => MsgUpdateParamsResponse.decode(new _m0.Reader(data)));
   }
 
+  setVaultLabel(request: MsgSetVaultLabel): Promise<MsgSetVaultLabelResponse> {
+    const data = MsgSetVaultLabel.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "SetVaultLabel", data);
+    return promise.then(data => MsgSetVaultLabelResponse.decode(new _m0.Reader(data)));
+  }
+
+  freezeVaultShares(request: MsgFreezeVaultShares): Promise<MsgFreezeVaultSharesResponse> {
+    const data = MsgFreezeVaultShares.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "FreezeVaultShares", data);
+    return promise.then(data => MsgFreezeVaultSharesResponse.decode(new _m0.Reader(data)));
+  }
+
+  unfreezeVaultShares(request: MsgUnfreezeVaultShares): Promise<MsgUnfreezeVaultSharesResponse> {
+    const data = MsgUnfreezeVaultShares.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "UnfreezeVaultShares", data);
+    return promise.then(data => MsgUnfreezeVaultSharesResponse.decode(new _m0.Reader(data)));
+  }
+
+  repairVaultShares(request: MsgRepairVaultShares): Promise<MsgRepairVaultSharesResponse> {
+    const data = MsgRepairVaultShares.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "RepairVaultShares", data);
+    return promise.then(data => MsgRepairVaultSharesResponse.decode(new _m0.Reader(data)));
+  }
+
+  closeVault(request: MsgCloseVault): Promise<MsgCloseVaultResponse> {
+    const data = MsgCloseVault.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "CloseVault", data);
+    return promise.then(data => MsgCloseVaultResponse.decode(new _m0.Reader(data)));
+  }
+
+  operatorUpdateVaultParams(request: MsgOperatorUpdateVaultParams): Promise<MsgOperatorUpdateVaultParamsResponse> {
+    const data = MsgOperatorUpdateVaultParams.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "OperatorUpdateVaultParams", data);
+    return promise.then(data => MsgOperatorUpdateVaultParamsResponse.decode(new _m0.Reader(data)));
+  }
+
+  refreshVaultOrders(request: MsgRefreshVaultOrders): Promise<MsgRefreshVaultOrdersResponse> {
+    const data = MsgRefreshVaultOrders.encode(request).finish();
+    const promise = this.rpc.request("dydxprotocol.vault.Msg", "RefreshVaultOrders", data);
+    return promise.then(data => MsgRefreshVaultOrdersResponse.decode(new _m0.Reader(data)));
+  }
+
 }
\ No newline at end of file
@@ -143,6 +143,214 @@ export interface MsgUpdateParamsResponse {}
 /** MsgUpdateParamsResponse is the Msg/UpdateParams response type. */
 
 export interface MsgUpdateParamsResponseSDKType {}
+/** MsgSetVaultLabel is the Msg/SetVaultLabel request type. */
+
+export interface MsgSetVaultLabel {
+  operator: string;
+  /** The vault to set the label of. */
+
+  vaultId?: VaultId;
+  /** The label to set. An empty label removes the vault's label. */
+
+  label: string;
+}
+/** MsgSetVaultLabel is the Msg/SetVaultLabel request type. */
+
+export interface MsgSetVaultLabelSDKType {
+  operator: string;
+  /** The vault to set the label of. */
+
+  vault_id?: VaultIdSDKType;
+  /** The label to set. An empty label removes the vault's label. */
+
+  label: string;
+}
+/** MsgSetVaultLabelResponse is the Msg/SetVaultLabel response type. */
+
+export interface MsgSetVaultLabelResponse {}
+/** MsgSetVaultLabelResponse is the Msg/SetVaultLabel response type. */
+
+export interface MsgSetVaultLabelResponseSDKType {}
+/** MsgFreezeVaultShares is the Msg/FreezeVaultShares request type. */
+
+export interface MsgFreezeVaultShares {
+  authority: string;
+  /** The vault to freeze shares in. */
+
+  vaultId?: VaultId;
+  /** The owner whose shares to freeze. Frozen shares can't be withdrawn. */
+
+  owner: string;
+}
+/** MsgFreezeVaultShares is the Msg/FreezeVaultShares request type. */
+
+export interface MsgFreezeVaultSharesSDKType {
+  authority: string;
+  /** The vault to freeze shares in. */
+
+  vault_id?: VaultIdSDKType;
+  /** The owner whose shares to freeze. Frozen shares can't be withdrawn. */
+
+  owner: string;
+}
+/** MsgFreezeVaultSharesResponse is the Msg/FreezeVaultShares response type. */
+
+export interface MsgFreezeVaultSharesResponse {}
+/** MsgFreezeVaultSharesResponse is the Msg/FreezeVaultShares response type. */
+
+export interface MsgFreezeVaultSharesResponseSDKType {}
+/** MsgUnfreezeVaultShares is the Msg/UnfreezeVaultShares request type. */
+
+export interface MsgUnfreezeVaultShares {
+  authority: string;
+  /** The vault to unfreeze shares in. */
+
+  vaultId?: VaultId;
+  /** The owner whose shares to unfreeze. */
+
+  owner: string;
+}
+/** MsgUnfreezeVaultShares is the Msg/UnfreezeVaultShares request type. */
+
+export interface MsgUnfreezeVaultSharesSDKType {
+  authority: string;
+  /** The vault to unfreeze shares in. */
+
+  vault_id?: VaultIdSDKType;
+  /** The owner whose shares to unfreeze. */
+
+  owner: string;
+}
+/** MsgUnfreezeVaultSharesResponse is the Msg/UnfreezeVaultShares response type. */
+
+export interface MsgUnfreezeVaultSharesResponse {}
+/** MsgUnfreezeVaultSharesResponse is the Msg/UnfreezeVaultShares response type. */
+
+export interface MsgUnfreezeVaultSharesResponseSDKType {}
+/** MsgRepairVaultShares is the Msg/RepairVaultShares request type. */
+
+export interface MsgRepairVaultShares {
+  authority: string;
+  /** The vault whose total shares to recompute from owner shares. */
+
+  vaultId?: VaultId;
+}
+/** MsgRepairVaultShares is the Msg/RepairVaultShares request type. */
+
+export interface MsgRepairVaultSharesSDKType {
+  authority: string;
+  /** The vault whose total shares to recompute from owner shares. */
+
+  vault_id?: VaultIdSDKType;
+}
+/** MsgRepairVaultSharesResponse is the Msg/RepairVaultShares response type. */
+
+export interface MsgRepairVaultSharesResponse {}
+/** MsgRepairVaultSharesResponse is the Msg/RepairVaultShares response type. */
+
+export interface MsgRepairVaultSharesResponseSDKType {}
+/** MsgCloseVault is the Msg/CloseVault request type. */
+
+export interface MsgCloseVault {
+  authority: string;
+  /**
+   * The vault to close. Must be deactivated and have no resting orders and no
+   * outstanding shares.
+   */
+
+  vaultId?: VaultId;
+  /** The subaccount to transfer all of the vault's equity to. */
+
+  destination?: SubaccountId;
+}
+/** MsgCloseVault is the Msg/CloseVault request type. */
+
+export interface MsgCloseVaultSDKType {
+  authority: string;
+  /**
+   * The vault to close. Must be deactivated and have no resting orders and no
+   * outstanding shares.
+   */
+
+  vault_id?: VaultIdSDKType;
+  /** The subaccount to transfer all of the vault's equity to. */
+
+  destination?: SubaccountIdSDKType;
+}
+/** MsgCloseVaultResponse is the Msg/CloseVault response type. */
+
+export interface MsgCloseVaultResponse {
+  /** Number of quote quantums transferred to the destination subaccount. */
+  quoteQuantums: Uint8Array;
+}
+/** MsgCloseVaultResponse is the Msg/CloseVault response type. */
+
+export interface MsgCloseVaultResponseSDKType {
+  /** Number of quote quantums transferred to the destination subaccount. */
+  quote_quantums: Uint8Array;
+}
+/**
+ * MsgOperatorUpdateVaultParams is the Msg/OperatorUpdateVaultParams request
+ * type.
+ */
+
+export interface MsgOperatorUpdateVaultParams {
+  operator: string;
+  /** The new `spread_min_ppm`. Must be within operator param bounds. */
+
+  spreadMinPpm: number;
+  /** The new `order_size_pct_ppm`. Must be within operator param bounds. */
+
+  orderSizePctPpm: number;
+}
+/**
+ * MsgOperatorUpdateVaultParams is the Msg/OperatorUpdateVaultParams request
+ * type.
+ */
+
+export interface MsgOperatorUpdateVaultParamsSDKType {
+  operator: string;
+  /** The new `spread_min_ppm`. Must be within operator param bounds. */
+
+  spread_min_ppm: number;
+  /** The new `order_size_pct_ppm`. Must be within operator param bounds. */
+
+  order_size_pct_ppm: number;
+}
+/**
+ * MsgOperatorUpdateVaultParamsResponse is the Msg/OperatorUpdateVaultParams
+ * response type.
+ */
+
+export interface MsgOperatorUpdateVaultParamsResponse {}
+/**
+ * MsgOperatorUpdateVaultParamsResponse is the Msg/OperatorUpdateVaultParams
+ * response type.
+ */
+
+export interface MsgOperatorUpdateVaultParamsResponseSDKType {}
+/** MsgRefreshVaultOrders is the Msg/RefreshVaultOrders request type. */
+
+export interface MsgRefreshVaultOrders {
+  signer: string;
+  /** The vault whose orders to refresh. */
+
+  vaultId?: VaultId;
+}
+/** MsgRefreshVaultOrders is the Msg/RefreshVaultOrders request type. */
+
+export interface MsgRefreshVaultOrdersSDKType {
+  signer: string;
+  /** The vault whose orders to refresh. */
+
+  vault_id?: VaultIdSDKType;
+}
+/** MsgRefreshVaultOrdersResponse is the Msg/RefreshVaultOrders response type. */
+
+export interface MsgRefreshVaultOrdersResponse {}
+/** MsgRefreshVaultOrdersResponse is the Msg/RefreshVaultOrders response type. */
+
+export interface MsgRefreshVaultOrdersResponseSDKType {}
 
 function createBaseMsgDepositToVault(): MsgDepositToVault {
   return {
@@ -480,4 +688,688 @@ export const MsgUpdateParamsResponse = {
     return message;
   }
 
+};
+
+function createBaseMsgSetVaultLabel(): MsgSetVaultLabel {
+  return {
+    operator: "",
+    vaultId: undefined,
+    label: ""
+  };
+}
+
+export const MsgSetVaultLabel = {
+  encode(message: MsgSetVaultLabel, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operator !== "") {
+      writer.uint32(10).string(message.operator);
+    }
+
+    if (message.vaultId !== undefined) {
+      VaultId.encode(message.vaultId, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.label !== "") {
+      writer.uint32(26).string(message.label);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetVaultLabel {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetVaultLabel();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.operator = reader.string();
+          break;
+
+        case 2:
+          message.vaultId = VaultId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.label = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgSetVaultLabel>): MsgSetVaultLabel {
+    const message = createBaseMsgSetVaultLabel();
+    message.operator = object.operator ?? "";
+    message.vaultId = object.vaultId !== undefined && object.vaultId !== null ? VaultId.fromPartial(object.vaultId) : undefined;
+    message.label = object.label ?? "";
+    return message;
+  }
+
+};
+
+function createBaseMsgSetVaultLabelResponse(): MsgSetVaultLabelResponse {
+  return {};
+}
+
+export const MsgSetVaultLabelResponse = {
+  encode(_: MsgSetVaultLabelResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgSetVaultLabelResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgSetVaultLabelResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgSetVaultLabelResponse>): MsgSetVaultLabelResponse {
+    const message = createBaseMsgSetVaultLabelResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgFreezeVaultShares(): MsgFreezeVaultShares {
+  return {
+    authority: "",
+    vaultId: undefined,
+    owner: ""
+  };
+}
+
+export const MsgFreezeVaultShares = {
+  encode(message: MsgFreezeVaultShares, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.vaultId !== undefined) {
+      VaultId.encode(message.vaultId, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.owner !== "") {
+      writer.uint32(26).string(message.owner);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgFreezeVaultShares {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgFreezeVaultShares();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.vaultId = VaultId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.owner = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgFreezeVaultShares>): MsgFreezeVaultShares {
+    const message = createBaseMsgFreezeVaultShares();
+    message.authority = object.authority ?? "";
+    message.vaultId = object.vaultId !== undefined && object.vaultId !== null ? VaultId.fromPartial(object.vaultId) : undefined;
+    message.owner = object.owner ?? "";
+    return message;
+  }
+
+};
+
+function createBaseMsgFreezeVaultSharesResponse(): MsgFreezeVaultSharesResponse {
+  return {};
+}
+
+export const MsgFreezeVaultSharesResponse = {
+  encode(_: MsgFreezeVaultSharesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgFreezeVaultSharesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgFreezeVaultSharesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgFreezeVaultSharesResponse>): MsgFreezeVaultSharesResponse {
+    const message = createBaseMsgFreezeVaultSharesResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgUnfreezeVaultShares(): MsgUnfreezeVaultShares {
+  return {
+    authority: "",
+    vaultId: undefined,
+    owner: ""
+  };
+}
+
+export const MsgUnfreezeVaultShares = {
+  encode(message: MsgUnfreezeVaultShares, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.vaultId !== undefined) {
+      VaultId.encode(message.vaultId, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.owner !== "") {
+      writer.uint32(26).string(message.owner);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgUnfreezeVaultShares {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgUnfreezeVaultShares();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.vaultId = VaultId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.owner = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgUnfreezeVaultShares>): MsgUnfreezeVaultShares {
+    const message = createBaseMsgUnfreezeVaultShares();
+    message.authority = object.authority ?? "";
+    message.vaultId = object.vaultId !== undefined && object.vaultId !== null ? VaultId.fromPartial(object.vaultId) : undefined;
+    message.owner = object.owner ?? "";
+    return message;
+  }
+
+};
+
+function createBaseMsgUnfreezeVaultSharesResponse(): MsgUnfreezeVaultSharesResponse {
+  return {};
+}
+
+export const MsgUnfreezeVaultSharesResponse = {
+  encode(_: MsgUnfreezeVaultSharesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgUnfreezeVaultSharesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgUnfreezeVaultSharesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgUnfreezeVaultSharesResponse>): MsgUnfreezeVaultSharesResponse {
+    const message = createBaseMsgUnfreezeVaultSharesResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgRepairVaultShares(): MsgRepairVaultShares {
+  return {
+    authority: "",
+    vaultId: undefined
+  };
+}
+
+export const MsgRepairVaultShares = {
+  encode(message: MsgRepairVaultShares, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.vaultId !== undefined) {
+      VaultId.encode(message.vaultId, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgRepairVaultShares {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgRepairVaultShares();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.vaultId = VaultId.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgRepairVaultShares>): MsgRepairVaultShares {
+    const message = createBaseMsgRepairVaultShares();
+    message.authority = object.authority ?? "";
+    message.vaultId = object.vaultId !== undefined && object.vaultId !== null ? VaultId.fromPartial(object.vaultId) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMsgRepairVaultSharesResponse(): MsgRepairVaultSharesResponse {
+  return {};
+}
+
+export const MsgRepairVaultSharesResponse = {
+  encode(_: MsgRepairVaultSharesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgRepairVaultSharesResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgRepairVaultSharesResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgRepairVaultSharesResponse>): MsgRepairVaultSharesResponse {
+    const message = createBaseMsgRepairVaultSharesResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgCloseVault(): MsgCloseVault {
+  return {
+    authority: "",
+    vaultId: undefined,
+    destination: undefined
+  };
+}
+
+export const MsgCloseVault = {
+  encode(message: MsgCloseVault, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authority !== "") {
+      writer.uint32(10).string(message.authority);
+    }
+
+    if (message.vaultId !== undefined) {
+      VaultId.encode(message.vaultId, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.destination !== undefined) {
+      SubaccountId.encode(message.destination, writer.uint32(26).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgCloseVault {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgCloseVault();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.authority = reader.string();
+          break;
+
+        case 2:
+          message.vaultId = VaultId.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.destination = SubaccountId.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgCloseVault>): MsgCloseVault {
+    const message = createBaseMsgCloseVault();
+    message.authority = object.authority ?? "";
+    message.vaultId = object.vaultId !== undefined && object.vaultId !== null ? VaultId.fromPartial(object.vaultId) : undefined;
+    message.destination = object.destination !== undefined && object.destination !== null ? SubaccountId.fromPartial(object.destination) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMsgCloseVaultResponse(): MsgCloseVaultResponse {
+  return {
+    quoteQuantums: new Uint8Array()
+  };
+}
+
+export const MsgCloseVaultResponse = {
+  encode(message: MsgCloseVaultResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.quoteQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.quoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgCloseVaultResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgCloseVaultResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.quoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgCloseVaultResponse>): MsgCloseVaultResponse {
+    const message = createBaseMsgCloseVaultResponse();
+    message.quoteQuantums = object.quoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseMsgOperatorUpdateVaultParams(): MsgOperatorUpdateVaultParams {
+  return {
+    operator: "",
+    spreadMinPpm: 0,
+    orderSizePctPpm: 0
+  };
+}
+
+export const MsgOperatorUpdateVaultParams = {
+  encode(message: MsgOperatorUpdateVaultParams, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operator !== "") {
+      writer.uint32(10).string(message.operator);
+    }
+
+    if (message.spreadMinPpm !== 0) {
+      writer.uint32(16).uint32(message.spreadMinPpm);
+    }
+
+    if (message.orderSizePctPpm !== 0) {
+      writer.uint32(24).uint32(message.orderSizePctPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgOperatorUpdateVaultParams {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgOperatorUpdateVaultParams();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.operator = reader.string();
+          break;
+
+        case 2:
+          message.spreadMinPpm = reader.uint32();
+          break;
+
+        case 3:
+          message.orderSizePctPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgOperatorUpdateVaultParams>): MsgOperatorUpdateVaultParams {
+    const message = createBaseMsgOperatorUpdateVaultParams();
+    message.operator = object.operator ?? "";
+    message.spreadMinPpm = object.spreadMinPpm ?? 0;
+    message.orderSizePctPpm = object.orderSizePctPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMsgOperatorUpdateVaultParamsResponse(): MsgOperatorUpdateVaultParamsResponse {
+  return {};
+}
+
+export const MsgOperatorUpdateVaultParamsResponse = {
+  encode(_: MsgOperatorUpdateVaultParamsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgOperatorUpdateVaultParamsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgOperatorUpdateVaultParamsResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgOperatorUpdateVaultParamsResponse>): MsgOperatorUpdateVaultParamsResponse {
+    const message = createBaseMsgOperatorUpdateVaultParamsResponse();
+    return message;
+  }
+
+};
+
+function createBaseMsgRefreshVaultOrders(): MsgRefreshVaultOrders {
+  return {
+    signer: "",
+    vaultId: undefined
+  };
+}
+
+export const MsgRefreshVaultOrders = {
+  encode(message: MsgRefreshVaultOrders, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.signer !== "") {
+      writer.uint32(10).string(message.signer);
+    }
+
+    if (message.vaultId !== undefined) {
+      VaultId.encode(message.vaultId, writer.uint32(18).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgRefreshVaultOrders {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgRefreshVaultOrders();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.signer = reader.string();
+          break;
+
+        case 2:
+          message.vaultId = VaultId.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MsgRefreshVaultOrders>): MsgRefreshVaultOrders {
+    const message = createBaseMsgRefreshVaultOrders();
+    message.signer = object.signer ?? "";
+    message.vaultId = object.vaultId !== undefined && object.vaultId !== null ? VaultId.fromPartial(object.vaultId) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseMsgRefreshVaultOrdersResponse(): MsgRefreshVaultOrdersResponse {
+  return {};
+}
+
+export const MsgRefreshVaultOrdersResponse = {
+  encode(_: MsgRefreshVaultOrdersResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MsgRefreshVaultOrdersResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMsgRefreshVaultOrdersResponse();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(_: DeepPartial<MsgRefreshVaultOrdersResponse>): MsgRefreshVaultOrdersResponse {
+    const message = createBaseMsgRefreshVaultOrdersResponse();
+    return message;
+  }
+
 };
\ No newline at end of file
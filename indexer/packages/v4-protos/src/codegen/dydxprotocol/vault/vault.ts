@@ -1,6 +1,7 @@
 import { MarketPrice, MarketPriceSDKType } from "../prices/market_price";
+import { UInt32Value, UInt32ValueSDKType } from "../../google/protobuf/wrappers";
 import * as _m0 from "protobufjs/minimal";
-import { DeepPartial } from "../../helpers";
+import { DeepPartial, Long } from "../../helpers";
 /** VaultType represents different types of vaults. */
 
 export enum VaultType {
@@ -50,6 +51,129 @@ export function vaultTypeToJSON(object: VaultType): string {
       return "UNRECOGNIZED";
   }
 }
+/**
+ * InventoryMarkSource represents different price sources that a vault's
+ * inventory can be marked at.
+ */
+
+export enum InventoryMarkSource {
+  /** INVENTORY_MARK_SOURCE_ORACLE - Default value. Inventory is marked at the oracle price. */
+  INVENTORY_MARK_SOURCE_ORACLE = 0,
+
+  /** INVENTORY_MARK_SOURCE_TWAP - Inventory is marked at the TWAP of the oracle price. */
+  INVENTORY_MARK_SOURCE_TWAP = 1,
+  UNRECOGNIZED = -1,
+}
+/**
+ * InventoryMarkSource represents different price sources that a vault's
+ * inventory can be marked at.
+ */
+
+export enum InventoryMarkSourceSDKType {
+  /** INVENTORY_MARK_SOURCE_ORACLE - Default value. Inventory is marked at the oracle price. */
+  INVENTORY_MARK_SOURCE_ORACLE = 0,
+
+  /** INVENTORY_MARK_SOURCE_TWAP - Inventory is marked at the TWAP of the oracle price. */
+  INVENTORY_MARK_SOURCE_TWAP = 1,
+  UNRECOGNIZED = -1,
+}
+export function inventoryMarkSourceFromJSON(object: any): InventoryMarkSource {
+  switch (object) {
+    case 0:
+    case "INVENTORY_MARK_SOURCE_ORACLE":
+      return InventoryMarkSource.INVENTORY_MARK_SOURCE_ORACLE;
+
+    case 1:
+    case "INVENTORY_MARK_SOURCE_TWAP":
+      return InventoryMarkSource.INVENTORY_MARK_SOURCE_TWAP;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return InventoryMarkSource.UNRECOGNIZED;
+  }
+}
+export function inventoryMarkSourceToJSON(object: InventoryMarkSource): string {
+  switch (object) {
+    case InventoryMarkSource.INVENTORY_MARK_SOURCE_ORACLE:
+      return "INVENTORY_MARK_SOURCE_ORACLE";
+
+    case InventoryMarkSource.INVENTORY_MARK_SOURCE_TWAP:
+      return "INVENTORY_MARK_SOURCE_TWAP";
+
+    case InventoryMarkSource.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+/**
+ * VaultActivityType represents different types of entries in a vault's
+ * activity log.
+ */
+
+export enum VaultActivityType {
+  /** VAULT_ACTIVITY_TYPE_UNSPECIFIED - Default value, invalid and unused. */
+  VAULT_ACTIVITY_TYPE_UNSPECIFIED = 0,
+
+  /** VAULT_ACTIVITY_TYPE_PARAMS_CHANGE - Individual params of the vault are set. */
+  VAULT_ACTIVITY_TYPE_PARAMS_CHANGE = 1,
+
+  /** VAULT_ACTIVITY_TYPE_STATUS_CHANGE - Vault is activated or deactivated. */
+  VAULT_ACTIVITY_TYPE_STATUS_CHANGE = 2,
+  UNRECOGNIZED = -1,
+}
+/**
+ * VaultActivityType represents different types of entries in a vault's
+ * activity log.
+ */
+
+export enum VaultActivityTypeSDKType {
+  /** VAULT_ACTIVITY_TYPE_UNSPECIFIED - Default value, invalid and unused. */
+  VAULT_ACTIVITY_TYPE_UNSPECIFIED = 0,
+
+  /** VAULT_ACTIVITY_TYPE_PARAMS_CHANGE - Individual params of the vault are set. */
+  VAULT_ACTIVITY_TYPE_PARAMS_CHANGE = 1,
+
+  /** VAULT_ACTIVITY_TYPE_STATUS_CHANGE - Vault is activated or deactivated. */
+  VAULT_ACTIVITY_TYPE_STATUS_CHANGE = 2,
+  UNRECOGNIZED = -1,
+}
+export function vaultActivityTypeFromJSON(object: any): VaultActivityType {
+  switch (object) {
+    case 0:
+    case "VAULT_ACTIVITY_TYPE_UNSPECIFIED":
+      return VaultActivityType.VAULT_ACTIVITY_TYPE_UNSPECIFIED;
+
+    case 1:
+    case "VAULT_ACTIVITY_TYPE_PARAMS_CHANGE":
+      return VaultActivityType.VAULT_ACTIVITY_TYPE_PARAMS_CHANGE;
+
+    case 2:
+    case "VAULT_ACTIVITY_TYPE_STATUS_CHANGE":
+      return VaultActivityType.VAULT_ACTIVITY_TYPE_STATUS_CHANGE;
+
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return VaultActivityType.UNRECOGNIZED;
+  }
+}
+export function vaultActivityTypeToJSON(object: VaultActivityType): string {
+  switch (object) {
+    case VaultActivityType.VAULT_ACTIVITY_TYPE_UNSPECIFIED:
+      return "VAULT_ACTIVITY_TYPE_UNSPECIFIED";
+
+    case VaultActivityType.VAULT_ACTIVITY_TYPE_PARAMS_CHANGE:
+      return "VAULT_ACTIVITY_TYPE_PARAMS_CHANGE";
+
+    case VaultActivityType.VAULT_ACTIVITY_TYPE_STATUS_CHANGE:
+      return "VAULT_ACTIVITY_TYPE_STATUS_CHANGE";
+
+    case VaultActivityType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 /** VaultId uniquely identifies a vault by its type and number. */
 
 export interface VaultId {
@@ -80,29 +204,547 @@ export interface NumSharesSDKType {
   /** Number of shares. */
   num_shares: Uint8Array;
 }
+/**
+ * OwnerCostBasis is the cost basis of an owner's shares in a vault, i.e. total
+ * quote quantums that the owner has deposited into the vault.
+ */
+
+export interface OwnerCostBasis {
+  costBasisQuoteQuantums: Uint8Array;
+}
+/**
+ * OwnerCostBasis is the cost basis of an owner's shares in a vault, i.e. total
+ * quote quantums that the owner has deposited into the vault.
+ */
+
+export interface OwnerCostBasisSDKType {
+  cost_basis_quote_quantums: Uint8Array;
+}
+/**
+ * OwnerEpochShareWeight is the time-weighted share balance of an owner in a
+ * vault during an epoch, tracked from start of the epoch to the last time that
+ * the owner's shares changed in the epoch.
+ */
+
+export interface OwnerEpochShareWeight {
+  /**
+   * Shares of the owner times seconds held (share-seconds) from start of the
+   * epoch to `last_update_time`.
+   */
+  weight: Uint8Array;
+  /** Shares of the owner at start of the epoch. */
+
+  startShares: Uint8Array;
+  /** Shares of the owner as of `last_update_time`. */
+
+  endShares: Uint8Array;
+  /**
+   * Unix time (in seconds) at which shares of the owner last changed in the
+   * epoch.
+   */
+
+  lastUpdateTime: Long;
+}
+/**
+ * OwnerEpochShareWeight is the time-weighted share balance of an owner in a
+ * vault during an epoch, tracked from start of the epoch to the last time that
+ * the owner's shares changed in the epoch.
+ */
+
+export interface OwnerEpochShareWeightSDKType {
+  /**
+   * Shares of the owner times seconds held (share-seconds) from start of the
+   * epoch to `last_update_time`.
+   */
+  weight: Uint8Array;
+  /** Shares of the owner at start of the epoch. */
+
+  start_shares: Uint8Array;
+  /** Shares of the owner as of `last_update_time`. */
+
+  end_shares: Uint8Array;
+  /**
+   * Unix time (in seconds) at which shares of the owner last changed in the
+   * epoch.
+   */
+
+  last_update_time: Long;
+}
 /** OwnerShare is a type for owner shares in a vault. */
 
 export interface OwnerShare {
   owner: string;
   shares?: NumShares;
+  /** Whether the owner's shares are frozen, i.e. can't be withdrawn. */
+
+  frozen: boolean;
 }
 /** OwnerShare is a type for owner shares in a vault. */
 
 export interface OwnerShareSDKType {
   owner: string;
   shares?: NumSharesSDKType;
+  /** Whether the owner's shares are frozen, i.e. can't be withdrawn. */
+
+  frozen: boolean;
 }
 /** VaultParams is the individual parameters of a vault. */
 
 export interface VaultParams {
   /** Lagged price that the vault quotes at. */
   laggedPrice?: MarketPrice;
+  /** Optional human-readable label of the vault. */
+
+  label: string;
+  /**
+   * Minimum oracle price (in the market's price units) at which the vault
+   * quotes. Zero means no lower bound.
+   */
+
+  minOraclePrice: Long;
+  /**
+   * Maximum oracle price (in the market's price units) at which the vault
+   * quotes. Zero means no upper bound.
+   */
+
+  maxOraclePrice: Long;
+  /**
+   * Optional id of the market whose price the vault quotes at, instead of
+   * the market of the vault's clob pair. Unset means no override.
+   */
+
+  priceMarketIdOverride?: UInt32Value;
+  /**
+   * Optional markets whose prices are blended with given weights into the
+   * price that the vault quotes at. Weights must sum to 1_000_000. Empty means
+   * no blending.
+   */
+
+  priceBlend: PriceBlendComponent[];
+  /**
+   * Optional perpetuals that make up the index that the vault quotes. If set,
+   * leverage of the vault is computed from its positions in these perpetuals
+   * weighted by their weights instead of its position in the perpetual of its
+   * clob pair. Weights must sum to 1_000_000. Empty means no index.
+   */
+
+  indexConstituents: IndexConstituent[];
+  /**
+   * Price source that the vault's inventory is marked at when computing
+   * leverage.
+   */
+
+  inventoryMarkSource: InventoryMarkSource;
+  /**
+   * Optional step size (in base quantums) that the vault rounds its order sizes
+   * to instead of the step size of its clob pair, which allows quoting in
+   * coarser lots. Must be a multiple of the clob pair's step size. Zero means
+   * no override.
+   */
+
+  orderStepBaseQuantumsOverride: Long;
+  /**
+   * Optional price that the vault quotes around instead of the oracle price
+   * until it expires, e.g. during an incident with an unreliable oracle. Unset
+   * means no manual reference price.
+   */
+
+  manualReferencePrice?: ManualReferencePrice;
+  /**
+   * Optional daily windows (in UTC) during which the vault widens its spread by
+   * the window's multiplier, e.g. during known low-liquidity hours. If windows
+   * overlap, the largest multiplier applies. Empty means no schedule.
+   */
+
+  spreadSchedule: SpreadScheduleWindow[];
+  /**
+   * Optional id of a spread oracle market whose price (in basis points, i.e.
+   * `price * 10^exponent` bps) overrides `spread_min_ppm` of the vault, which
+   * allows off-chain systems to adjust the vault's spread via the price feed.
+   * Unset means no override.
+   */
+
+  spreadOracleMarketId?: UInt32Value;
 }
 /** VaultParams is the individual parameters of a vault. */
 
 export interface VaultParamsSDKType {
   /** Lagged price that the vault quotes at. */
   lagged_price?: MarketPriceSDKType;
+  /** Optional human-readable label of the vault. */
+
+  label: string;
+  /**
+   * Minimum oracle price (in the market's price units) at which the vault
+   * quotes. Zero means no lower bound.
+   */
+
+  min_oracle_price: Long;
+  /**
+   * Maximum oracle price (in the market's price units) at which the vault
+   * quotes. Zero means no upper bound.
+   */
+
+  max_oracle_price: Long;
+  /**
+   * Optional id of the market whose price the vault quotes at, instead of
+   * the market of the vault's clob pair. Unset means no override.
+   */
+
+  price_market_id_override?: UInt32ValueSDKType;
+  /**
+   * Optional markets whose prices are blended with given weights into the
+   * price that the vault quotes at. Weights must sum to 1_000_000. Empty means
+   * no blending.
+   */
+
+  price_blend: PriceBlendComponentSDKType[];
+  /**
+   * Optional perpetuals that make up the index that the vault quotes. If set,
+   * leverage of the vault is computed from its positions in these perpetuals
+   * weighted by their weights instead of its position in the perpetual of its
+   * clob pair. Weights must sum to 1_000_000. Empty means no index.
+   */
+
+  index_constituents: IndexConstituentSDKType[];
+  /**
+   * Price source that the vault's inventory is marked at when computing
+   * leverage.
+   */
+
+  inventory_mark_source: InventoryMarkSourceSDKType;
+  /**
+   * Optional step size (in base quantums) that the vault rounds its order sizes
+   * to instead of the step size of its clob pair, which allows quoting in
+   * coarser lots. Must be a multiple of the clob pair's step size. Zero means
+   * no override.
+   */
+
+  order_step_base_quantums_override: Long;
+  /**
+   * Optional price that the vault quotes around instead of the oracle price
+   * until it expires, e.g. during an incident with an unreliable oracle. Unset
+   * means no manual reference price.
+   */
+
+  manual_reference_price?: ManualReferencePriceSDKType;
+  /**
+   * Optional daily windows (in UTC) during which the vault widens its spread by
+   * the window's multiplier, e.g. during known low-liquidity hours. If windows
+   * overlap, the largest multiplier applies. Empty means no schedule.
+   */
+
+  spread_schedule: SpreadScheduleWindowSDKType[];
+  /**
+   * Optional id of a spread oracle market whose price (in basis points, i.e.
+   * `price * 10^exponent` bps) overrides `spread_min_ppm` of the vault, which
+   * allows off-chain systems to adjust the vault's spread via the price feed.
+   * Unset means no override.
+   */
+
+  spread_oracle_market_id?: UInt32ValueSDKType;
+}
+/**
+ * SpreadScheduleWindow is a daily window (in UTC) during which a vault
+ * multiplies its spread.
+ */
+
+export interface SpreadScheduleWindow {
+  /** Start of the window (inclusive) in seconds since midnight UTC. */
+  startSeconds: number;
+  /**
+   * End of the window (exclusive) in seconds since midnight UTC. A window whose
+   * end is before its start wraps around midnight.
+   */
+
+  endSeconds: number;
+  /**
+   * Multiplier (in ppm) of the vault's spread during the window. Must be at
+   * least 1_000_000.
+   */
+
+  spreadMultiplierPpm: number;
+}
+/**
+ * SpreadScheduleWindow is a daily window (in UTC) during which a vault
+ * multiplies its spread.
+ */
+
+export interface SpreadScheduleWindowSDKType {
+  /** Start of the window (inclusive) in seconds since midnight UTC. */
+  start_seconds: number;
+  /**
+   * End of the window (exclusive) in seconds since midnight UTC. A window whose
+   * end is before its start wraps around midnight.
+   */
+
+  end_seconds: number;
+  /**
+   * Multiplier (in ppm) of the vault's spread during the window. Must be at
+   * least 1_000_000.
+   */
+
+  spread_multiplier_ppm: number;
+}
+/**
+ * ManualReferencePrice is a manually-set price that a vault quotes around
+ * instead of the oracle price until it expires.
+ */
+
+export interface ManualReferencePrice {
+  /** Price (in the market's price units) that the vault quotes around. */
+  price: Long;
+  /**
+   * Block time (in seconds since unix epoch) at which the price expires and
+   * the vault reverts to quoting around the oracle price.
+   */
+
+  expiryTime: number;
+}
+/**
+ * ManualReferencePrice is a manually-set price that a vault quotes around
+ * instead of the oracle price until it expires.
+ */
+
+export interface ManualReferencePriceSDKType {
+  /** Price (in the market's price units) that the vault quotes around. */
+  price: Long;
+  /**
+   * Block time (in seconds since unix epoch) at which the price expires and
+   * the vault reverts to quoting around the oracle price.
+   */
+
+  expiry_time: number;
+}
+/**
+ * PriceBlendComponent is the weight of a market's price in a vault's blended
+ * price.
+ */
+
+export interface PriceBlendComponent {
+  /** Id of the market. */
+  marketId: number;
+  /** Weight of the market's price in parts per million. */
+
+  weightPpm: number;
+}
+/**
+ * PriceBlendComponent is the weight of a market's price in a vault's blended
+ * price.
+ */
+
+export interface PriceBlendComponentSDKType {
+  /** Id of the market. */
+  market_id: number;
+  /** Weight of the market's price in parts per million. */
+
+  weight_ppm: number;
+}
+/**
+ * IndexConstituent is the weight of a perpetual in the index that a vault
+ * quotes.
+ */
+
+export interface IndexConstituent {
+  /** Id of the perpetual. */
+  perpetualId: number;
+  /** Weight of the perpetual in parts per million. */
+
+  weightPpm: number;
+}
+/**
+ * IndexConstituent is the weight of a perpetual in the index that a vault
+ * quotes.
+ */
+
+export interface IndexConstituentSDKType {
+  /** Id of the perpetual. */
+  perpetual_id: number;
+  /** Weight of the perpetual in parts per million. */
+
+  weight_ppm: number;
+}
+/**
+ * MarketVolatility is the realized volatility of a market's oracle price,
+ * tracked as an EWMA of absolute per-block price returns, along with its
+ * short-term drift, tracked as an EWMA of signed per-block price returns.
+ */
+
+export interface MarketVolatility {
+  /** Oracle price of the market when volatility was last updated. */
+  lastPrice: Long;
+  /** Exponent of `last_price`. */
+
+  lastExponent: number;
+  /** EWMA of absolute per-block returns (in ppm) of the oracle price. */
+
+  ewmaAbsReturnPpm: Long;
+  /**
+   * EWMA of signed per-block returns (in ppm) of the oracle price, i.e.
+   * short-term price drift.
+   */
+
+  ewmaReturnPpm: Long;
+  /** Number of per-block returns folded into the EWMAs. */
+
+  numReturns: Long;
+}
+/**
+ * MarketVolatility is the realized volatility of a market's oracle price,
+ * tracked as an EWMA of absolute per-block price returns, along with its
+ * short-term drift, tracked as an EWMA of signed per-block price returns.
+ */
+
+export interface MarketVolatilitySDKType {
+  /** Oracle price of the market when volatility was last updated. */
+  last_price: Long;
+  /** Exponent of `last_price`. */
+
+  last_exponent: number;
+  /** EWMA of absolute per-block returns (in ppm) of the oracle price. */
+
+  ewma_abs_return_ppm: Long;
+  /**
+   * EWMA of signed per-block returns (in ppm) of the oracle price, i.e.
+   * short-term price drift.
+   */
+
+  ewma_return_ppm: Long;
+  /** Number of per-block returns folded into the EWMAs. */
+
+  num_returns: Long;
+}
+/**
+ * MarketTwap is the time-weighted average of a market's oracle price, tracked
+ * as an EWMA of per-block oracle prices.
+ */
+
+export interface MarketTwap {
+  /** TWAP of the oracle price. */
+  price: Long;
+  /** Exponent of `price`. */
+
+  exponent: number;
+}
+/**
+ * MarketTwap is the time-weighted average of a market's oracle price, tracked
+ * as an EWMA of per-block oracle prices.
+ */
+
+export interface MarketTwapSDKType {
+  /** TWAP of the oracle price. */
+  price: Long;
+  /** Exponent of `price`. */
+
+  exponent: number;
+}
+/** VaultFillStats is the cumulative statistics of fills of a vault's orders. */
+
+export interface VaultFillStats {
+  /** Number of fills. */
+  numFills: Long;
+  /** Total volume (in quote quantums) of fills. */
+
+  volumeQuoteQuantums: Uint8Array;
+  /**
+   * Total realized spread (in quote quantums) of fills, i.e. sum of how much
+   * better than oracle price at fill time each fill is, where fills worse than
+   * oracle price count negatively.
+   */
+
+  realizedSpreadQuoteQuantums: Uint8Array;
+}
+/** VaultFillStats is the cumulative statistics of fills of a vault's orders. */
+
+export interface VaultFillStatsSDKType {
+  /** Number of fills. */
+  num_fills: Long;
+  /** Total volume (in quote quantums) of fills. */
+
+  volume_quote_quantums: Uint8Array;
+  /**
+   * Total realized spread (in quote quantums) of fills, i.e. sum of how much
+   * better than oracle price at fill time each fill is, where fills worse than
+   * oracle price count negatively.
+   */
+
+  realized_spread_quote_quantums: Uint8Array;
+}
+/** VaultActivity is an entry in a vault's append-only activity log. */
+
+export interface VaultActivity {
+  /** Block height at which the activity happened. */
+  blockHeight: number;
+  /** Type of the activity. */
+
+  type: VaultActivityType;
+  /** Individual params of the vault after a params change. */
+
+  vaultParams?: VaultParams;
+  /** Whether the vault is activated after a status change. */
+
+  activated: boolean;
+}
+/** VaultActivity is an entry in a vault's append-only activity log. */
+
+export interface VaultActivitySDKType {
+  /** Block height at which the activity happened. */
+  block_height: number;
+  /** Type of the activity. */
+
+  type: VaultActivityTypeSDKType;
+  /** Individual params of the vault after a params change. */
+
+  vault_params?: VaultParamsSDKType;
+  /** Whether the vault is activated after a status change. */
+
+  activated: boolean;
+}
+/**
+ * VaultRefresh is an entry in a vault's refresh history, recording one attempt
+ * of the vault to refresh its orders.
+ */
+
+export interface VaultRefresh {
+  /** Block height at which the vault attempted to refresh its orders. */
+  blockHeight: number;
+  /** Number of orders placed. */
+
+  numOrdersPlaced: number;
+  /** Number of orders cancelled. */
+
+  numOrdersCancelled: number;
+  /** Reason why the vault didn't place orders, or empty if it did. */
+
+  skipReason: string;
+}
+/**
+ * VaultRefresh is an entry in a vault's refresh history, recording one attempt
+ * of the vault to refresh its orders.
+ */
+
+export interface VaultRefreshSDKType {
+  /** Block height at which the vault attempted to refresh its orders. */
+  block_height: number;
+  /** Number of orders placed. */
+
+  num_orders_placed: number;
+  /** Number of orders cancelled. */
+
+  num_orders_cancelled: number;
+  /** Reason why the vault didn't place orders, or empty if it did. */
+
+  skip_reason: string;
+}
+/** VaultRefreshHistory is the most recent refreshes of a vault, oldest first. */
+
+export interface VaultRefreshHistory {
+  refreshes: VaultRefresh[];
+}
+/** VaultRefreshHistory is the most recent refreshes of a vault, oldest first. */
+
+export interface VaultRefreshHistorySDKType {
+  refreshes: VaultRefreshSDKType[];
 }
 
 function createBaseVaultId(): VaultId {
@@ -205,41 +847,104 @@ export const NumShares = {
 
 };
 
-function createBaseOwnerShare(): OwnerShare {
+function createBaseOwnerCostBasis(): OwnerCostBasis {
   return {
-    owner: "",
-    shares: undefined
+    costBasisQuoteQuantums: new Uint8Array()
   };
 }
 
-export const OwnerShare = {
-  encode(message: OwnerShare, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.owner !== "") {
-      writer.uint32(10).string(message.owner);
+export const OwnerCostBasis = {
+  encode(message: OwnerCostBasis, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.costBasisQuoteQuantums.length !== 0) {
+      writer.uint32(10).bytes(message.costBasisQuoteQuantums);
     }
 
-    if (message.shares !== undefined) {
-      NumShares.encode(message.shares, writer.uint32(18).fork()).ldelim();
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OwnerCostBasis {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOwnerCostBasis();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.costBasisQuoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<OwnerCostBasis>): OwnerCostBasis {
+    const message = createBaseOwnerCostBasis();
+    message.costBasisQuoteQuantums = object.costBasisQuoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseOwnerEpochShareWeight(): OwnerEpochShareWeight {
+  return {
+    weight: new Uint8Array(),
+    startShares: new Uint8Array(),
+    endShares: new Uint8Array(),
+    lastUpdateTime: Long.UZERO
+  };
+}
+
+export const OwnerEpochShareWeight = {
+  encode(message: OwnerEpochShareWeight, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.weight.length !== 0) {
+      writer.uint32(10).bytes(message.weight);
+    }
+
+    if (message.startShares.length !== 0) {
+      writer.uint32(18).bytes(message.startShares);
+    }
+
+    if (message.endShares.length !== 0) {
+      writer.uint32(26).bytes(message.endShares);
+    }
+
+    if (!message.lastUpdateTime.isZero()) {
+      writer.uint32(32).uint64(message.lastUpdateTime);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OwnerShare {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OwnerEpochShareWeight {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOwnerShare();
+    const message = createBaseOwnerEpochShareWeight();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.owner = reader.string();
+          message.weight = reader.bytes();
           break;
 
         case 2:
-          message.shares = NumShares.decode(reader, reader.uint32());
+          message.startShares = reader.bytes();
+          break;
+
+        case 3:
+          message.endShares = reader.bytes();
+          break;
+
+        case 4:
+          message.lastUpdateTime = (reader.uint64() as Long);
           break;
 
         default:
@@ -251,41 +956,61 @@ export const OwnerShare = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<OwnerShare>): OwnerShare {
-    const message = createBaseOwnerShare();
-    message.owner = object.owner ?? "";
-    message.shares = object.shares !== undefined && object.shares !== null ? NumShares.fromPartial(object.shares) : undefined;
+  fromPartial(object: DeepPartial<OwnerEpochShareWeight>): OwnerEpochShareWeight {
+    const message = createBaseOwnerEpochShareWeight();
+    message.weight = object.weight ?? new Uint8Array();
+    message.startShares = object.startShares ?? new Uint8Array();
+    message.endShares = object.endShares ?? new Uint8Array();
+    message.lastUpdateTime = object.lastUpdateTime !== undefined && object.lastUpdateTime !== null ? Long.fromValue(object.lastUpdateTime) : Long.UZERO;
     return message;
   }
 
 };
 
-function createBaseVaultParams(): VaultParams {
+function createBaseOwnerShare(): OwnerShare {
   return {
-    laggedPrice: undefined
+    owner: "",
+    shares: undefined,
+    frozen: false
   };
 }
 
-export const VaultParams = {
-  encode(message: VaultParams, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.laggedPrice !== undefined) {
-      MarketPrice.encode(message.laggedPrice, writer.uint32(10).fork()).ldelim();
+export const OwnerShare = {
+  encode(message: OwnerShare, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.owner !== "") {
+      writer.uint32(10).string(message.owner);
+    }
+
+    if (message.shares !== undefined) {
+      NumShares.encode(message.shares, writer.uint32(18).fork()).ldelim();
+    }
+
+    if (message.frozen === true) {
+      writer.uint32(24).bool(message.frozen);
     }
 
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): VaultParams {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OwnerShare {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseVaultParams();
+    const message = createBaseOwnerShare();
 
     while (reader.pos < end) {
       const tag = reader.uint32();
 
       switch (tag >>> 3) {
         case 1:
-          message.laggedPrice = MarketPrice.decode(reader, reader.uint32());
+          message.owner = reader.string();
+          break;
+
+        case 2:
+          message.shares = NumShares.decode(reader, reader.uint32());
+          break;
+
+        case 3:
+          message.frozen = reader.bool();
           break;
 
         default:
@@ -297,9 +1022,796 @@ export const VaultParams = {
     return message;
   },
 
-  fromPartial(object: DeepPartial<VaultParams>): VaultParams {
-    const message = createBaseVaultParams();
+  fromPartial(object: DeepPartial<OwnerShare>): OwnerShare {
+    const message = createBaseOwnerShare();
+    message.owner = object.owner ?? "";
+    message.shares = object.shares !== undefined && object.shares !== null ? NumShares.fromPartial(object.shares) : undefined;
+    message.frozen = object.frozen ?? false;
+    return message;
+  }
+
+};
+
+function createBaseVaultParams(): VaultParams {
+  return {
+    laggedPrice: undefined,
+    label: "",
+    minOraclePrice: Long.UZERO,
+    maxOraclePrice: Long.UZERO,
+    priceMarketIdOverride: undefined,
+    priceBlend: [],
+    indexConstituents: [],
+    inventoryMarkSource: 0,
+    orderStepBaseQuantumsOverride: Long.UZERO,
+    manualReferencePrice: undefined,
+    spreadSchedule: [],
+    spreadOracleMarketId: undefined
+  };
+}
+
+export const VaultParams = {
+  encode(message: VaultParams, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.laggedPrice !== undefined) {
+      MarketPrice.encode(message.laggedPrice, writer.uint32(10).fork()).ldelim();
+    }
+
+    if (message.label !== "") {
+      writer.uint32(18).string(message.label);
+    }
+
+    if (!message.minOraclePrice.isZero()) {
+      writer.uint32(24).uint64(message.minOraclePrice);
+    }
+
+    if (!message.maxOraclePrice.isZero()) {
+      writer.uint32(32).uint64(message.maxOraclePrice);
+    }
+
+    if (message.priceMarketIdOverride !== undefined) {
+      UInt32Value.encode(message.priceMarketIdOverride, writer.uint32(42).fork()).ldelim();
+    }
+
+    for (const v of message.priceBlend) {
+      PriceBlendComponent.encode(v!, writer.uint32(50).fork()).ldelim();
+    }
+
+    for (const v of message.indexConstituents) {
+      IndexConstituent.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
+
+    if (message.inventoryMarkSource !== 0) {
+      writer.uint32(64).int32(message.inventoryMarkSource);
+    }
+
+    if (!message.orderStepBaseQuantumsOverride.isZero()) {
+      writer.uint32(72).uint64(message.orderStepBaseQuantumsOverride);
+    }
+
+    if (message.manualReferencePrice !== undefined) {
+      ManualReferencePrice.encode(message.manualReferencePrice, writer.uint32(82).fork()).ldelim();
+    }
+
+    for (const v of message.spreadSchedule) {
+      SpreadScheduleWindow.encode(v!, writer.uint32(90).fork()).ldelim();
+    }
+
+    if (message.spreadOracleMarketId !== undefined) {
+      UInt32Value.encode(message.spreadOracleMarketId, writer.uint32(98).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VaultParams {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVaultParams();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.laggedPrice = MarketPrice.decode(reader, reader.uint32());
+          break;
+
+        case 2:
+          message.label = reader.string();
+          break;
+
+        case 3:
+          message.minOraclePrice = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.maxOraclePrice = (reader.uint64() as Long);
+          break;
+
+        case 5:
+          message.priceMarketIdOverride = UInt32Value.decode(reader, reader.uint32());
+          break;
+
+        case 6:
+          message.priceBlend.push(PriceBlendComponent.decode(reader, reader.uint32()));
+          break;
+
+        case 7:
+          message.indexConstituents.push(IndexConstituent.decode(reader, reader.uint32()));
+          break;
+
+        case 8:
+          message.inventoryMarkSource = (reader.int32() as any);
+          break;
+
+        case 9:
+          message.orderStepBaseQuantumsOverride = (reader.uint64() as Long);
+          break;
+
+        case 10:
+          message.manualReferencePrice = ManualReferencePrice.decode(reader, reader.uint32());
+          break;
+
+        case 11:
+          message.spreadSchedule.push(SpreadScheduleWindow.decode(reader, reader.uint32()));
+          break;
+
+        case 12:
+          message.spreadOracleMarketId = UInt32Value.decode(reader, reader.uint32());
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<VaultParams>): VaultParams {
+    const message = createBaseVaultParams();
     message.laggedPrice = object.laggedPrice !== undefined && object.laggedPrice !== null ? MarketPrice.fromPartial(object.laggedPrice) : undefined;
+    message.label = object.label ?? "";
+    message.minOraclePrice = object.minOraclePrice !== undefined && object.minOraclePrice !== null ? Long.fromValue(object.minOraclePrice) : Long.UZERO;
+    message.maxOraclePrice = object.maxOraclePrice !== undefined && object.maxOraclePrice !== null ? Long.fromValue(object.maxOraclePrice) : Long.UZERO;
+    message.priceMarketIdOverride = object.priceMarketIdOverride !== undefined && object.priceMarketIdOverride !== null ? UInt32Value.fromPartial(object.priceMarketIdOverride) : undefined;
+    message.priceBlend = object.priceBlend?.map(e => PriceBlendComponent.fromPartial(e)) || [];
+    message.indexConstituents = object.indexConstituents?.map(e => IndexConstituent.fromPartial(e)) || [];
+    message.inventoryMarkSource = object.inventoryMarkSource ?? 0;
+    message.orderStepBaseQuantumsOverride = object.orderStepBaseQuantumsOverride !== undefined && object.orderStepBaseQuantumsOverride !== null ? Long.fromValue(object.orderStepBaseQuantumsOverride) : Long.UZERO;
+    message.manualReferencePrice = object.manualReferencePrice !== undefined && object.manualReferencePrice !== null ? ManualReferencePrice.fromPartial(object.manualReferencePrice) : undefined;
+    message.spreadSchedule = object.spreadSchedule?.map(e => SpreadScheduleWindow.fromPartial(e)) || [];
+    message.spreadOracleMarketId = object.spreadOracleMarketId !== undefined && object.spreadOracleMarketId !== null ? UInt32Value.fromPartial(object.spreadOracleMarketId) : undefined;
+    return message;
+  }
+
+};
+
+function createBaseSpreadScheduleWindow(): SpreadScheduleWindow {
+  return {
+    startSeconds: 0,
+    endSeconds: 0,
+    spreadMultiplierPpm: 0
+  };
+}
+
+export const SpreadScheduleWindow = {
+  encode(message: SpreadScheduleWindow, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.startSeconds !== 0) {
+      writer.uint32(8).uint32(message.startSeconds);
+    }
+
+    if (message.endSeconds !== 0) {
+      writer.uint32(16).uint32(message.endSeconds);
+    }
+
+    if (message.spreadMultiplierPpm !== 0) {
+      writer.uint32(24).uint32(message.spreadMultiplierPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SpreadScheduleWindow {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSpreadScheduleWindow();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.startSeconds = reader.uint32();
+          break;
+
+        case 2:
+          message.endSeconds = reader.uint32();
+          break;
+
+        case 3:
+          message.spreadMultiplierPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<SpreadScheduleWindow>): SpreadScheduleWindow {
+    const message = createBaseSpreadScheduleWindow();
+    message.startSeconds = object.startSeconds ?? 0;
+    message.endSeconds = object.endSeconds ?? 0;
+    message.spreadMultiplierPpm = object.spreadMultiplierPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseManualReferencePrice(): ManualReferencePrice {
+  return {
+    price: Long.UZERO,
+    expiryTime: 0
+  };
+}
+
+export const ManualReferencePrice = {
+  encode(message: ManualReferencePrice, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.price.isZero()) {
+      writer.uint32(8).uint64(message.price);
+    }
+
+    if (message.expiryTime !== 0) {
+      writer.uint32(16).uint32(message.expiryTime);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ManualReferencePrice {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseManualReferencePrice();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.price = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.expiryTime = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<ManualReferencePrice>): ManualReferencePrice {
+    const message = createBaseManualReferencePrice();
+    message.price = object.price !== undefined && object.price !== null ? Long.fromValue(object.price) : Long.UZERO;
+    message.expiryTime = object.expiryTime ?? 0;
+    return message;
+  }
+
+};
+
+function createBasePriceBlendComponent(): PriceBlendComponent {
+  return {
+    marketId: 0,
+    weightPpm: 0
+  };
+}
+
+export const PriceBlendComponent = {
+  encode(message: PriceBlendComponent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.marketId !== 0) {
+      writer.uint32(8).uint32(message.marketId);
+    }
+
+    if (message.weightPpm !== 0) {
+      writer.uint32(16).uint32(message.weightPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PriceBlendComponent {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePriceBlendComponent();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.marketId = reader.uint32();
+          break;
+
+        case 2:
+          message.weightPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<PriceBlendComponent>): PriceBlendComponent {
+    const message = createBasePriceBlendComponent();
+    message.marketId = object.marketId ?? 0;
+    message.weightPpm = object.weightPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseIndexConstituent(): IndexConstituent {
+  return {
+    perpetualId: 0,
+    weightPpm: 0
+  };
+}
+
+export const IndexConstituent = {
+  encode(message: IndexConstituent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.perpetualId !== 0) {
+      writer.uint32(8).uint32(message.perpetualId);
+    }
+
+    if (message.weightPpm !== 0) {
+      writer.uint32(16).uint32(message.weightPpm);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexConstituent {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIndexConstituent();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.perpetualId = reader.uint32();
+          break;
+
+        case 2:
+          message.weightPpm = reader.uint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<IndexConstituent>): IndexConstituent {
+    const message = createBaseIndexConstituent();
+    message.perpetualId = object.perpetualId ?? 0;
+    message.weightPpm = object.weightPpm ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseMarketVolatility(): MarketVolatility {
+  return {
+    lastPrice: Long.UZERO,
+    lastExponent: 0,
+    ewmaAbsReturnPpm: Long.UZERO,
+    ewmaReturnPpm: Long.ZERO,
+    numReturns: Long.UZERO
+  };
+}
+
+export const MarketVolatility = {
+  encode(message: MarketVolatility, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.lastPrice.isZero()) {
+      writer.uint32(8).uint64(message.lastPrice);
+    }
+
+    if (message.lastExponent !== 0) {
+      writer.uint32(16).sint32(message.lastExponent);
+    }
+
+    if (!message.ewmaAbsReturnPpm.isZero()) {
+      writer.uint32(24).uint64(message.ewmaAbsReturnPpm);
+    }
+
+    if (!message.ewmaReturnPpm.isZero()) {
+      writer.uint32(32).sint64(message.ewmaReturnPpm);
+    }
+
+    if (!message.numReturns.isZero()) {
+      writer.uint32(40).uint64(message.numReturns);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarketVolatility {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarketVolatility();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.lastPrice = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.lastExponent = reader.sint32();
+          break;
+
+        case 3:
+          message.ewmaAbsReturnPpm = (reader.uint64() as Long);
+          break;
+
+        case 4:
+          message.ewmaReturnPpm = (reader.sint64() as Long);
+          break;
+
+        case 5:
+          message.numReturns = (reader.uint64() as Long);
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MarketVolatility>): MarketVolatility {
+    const message = createBaseMarketVolatility();
+    message.lastPrice = object.lastPrice !== undefined && object.lastPrice !== null ? Long.fromValue(object.lastPrice) : Long.UZERO;
+    message.lastExponent = object.lastExponent ?? 0;
+    message.ewmaAbsReturnPpm = object.ewmaAbsReturnPpm !== undefined && object.ewmaAbsReturnPpm !== null ? Long.fromValue(object.ewmaAbsReturnPpm) : Long.UZERO;
+    message.ewmaReturnPpm = object.ewmaReturnPpm !== undefined && object.ewmaReturnPpm !== null ? Long.fromValue(object.ewmaReturnPpm) : Long.ZERO;
+    message.numReturns = object.numReturns !== undefined && object.numReturns !== null ? Long.fromValue(object.numReturns) : Long.UZERO;
+    return message;
+  }
+
+};
+
+function createBaseMarketTwap(): MarketTwap {
+  return {
+    price: Long.UZERO,
+    exponent: 0
+  };
+}
+
+export const MarketTwap = {
+  encode(message: MarketTwap, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.price.isZero()) {
+      writer.uint32(8).uint64(message.price);
+    }
+
+    if (message.exponent !== 0) {
+      writer.uint32(16).sint32(message.exponent);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarketTwap {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarketTwap();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.price = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.exponent = reader.sint32();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<MarketTwap>): MarketTwap {
+    const message = createBaseMarketTwap();
+    message.price = object.price !== undefined && object.price !== null ? Long.fromValue(object.price) : Long.UZERO;
+    message.exponent = object.exponent ?? 0;
+    return message;
+  }
+
+};
+
+function createBaseVaultFillStats(): VaultFillStats {
+  return {
+    numFills: Long.UZERO,
+    volumeQuoteQuantums: new Uint8Array(),
+    realizedSpreadQuoteQuantums: new Uint8Array()
+  };
+}
+
+export const VaultFillStats = {
+  encode(message: VaultFillStats, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.numFills.isZero()) {
+      writer.uint32(8).uint64(message.numFills);
+    }
+
+    if (message.volumeQuoteQuantums.length !== 0) {
+      writer.uint32(18).bytes(message.volumeQuoteQuantums);
+    }
+
+    if (message.realizedSpreadQuoteQuantums.length !== 0) {
+      writer.uint32(26).bytes(message.realizedSpreadQuoteQuantums);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VaultFillStats {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVaultFillStats();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.numFills = (reader.uint64() as Long);
+          break;
+
+        case 2:
+          message.volumeQuoteQuantums = reader.bytes();
+          break;
+
+        case 3:
+          message.realizedSpreadQuoteQuantums = reader.bytes();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<VaultFillStats>): VaultFillStats {
+    const message = createBaseVaultFillStats();
+    message.numFills = object.numFills !== undefined && object.numFills !== null ? Long.fromValue(object.numFills) : Long.UZERO;
+    message.volumeQuoteQuantums = object.volumeQuoteQuantums ?? new Uint8Array();
+    message.realizedSpreadQuoteQuantums = object.realizedSpreadQuoteQuantums ?? new Uint8Array();
+    return message;
+  }
+
+};
+
+function createBaseVaultActivity(): VaultActivity {
+  return {
+    blockHeight: 0,
+    type: 0,
+    vaultParams: undefined,
+    activated: false
+  };
+}
+
+export const VaultActivity = {
+  encode(message: VaultActivity, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.blockHeight !== 0) {
+      writer.uint32(8).uint32(message.blockHeight);
+    }
+
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
+    }
+
+    if (message.vaultParams !== undefined) {
+      VaultParams.encode(message.vaultParams, writer.uint32(26).fork()).ldelim();
+    }
+
+    if (message.activated === true) {
+      writer.uint32(32).bool(message.activated);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VaultActivity {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVaultActivity();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.blockHeight = reader.uint32();
+          break;
+
+        case 2:
+          message.type = (reader.int32() as any);
+          break;
+
+        case 3:
+          message.vaultParams = VaultParams.decode(reader, reader.uint32());
+          break;
+
+        case 4:
+          message.activated = reader.bool();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<VaultActivity>): VaultActivity {
+    const message = createBaseVaultActivity();
+    message.blockHeight = object.blockHeight ?? 0;
+    message.type = object.type ?? 0;
+    message.vaultParams = object.vaultParams !== undefined && object.vaultParams !== null ? VaultParams.fromPartial(object.vaultParams) : undefined;
+    message.activated = object.activated ?? false;
+    return message;
+  }
+
+};
+
+function createBaseVaultRefresh(): VaultRefresh {
+  return {
+    blockHeight: 0,
+    numOrdersPlaced: 0,
+    numOrdersCancelled: 0,
+    skipReason: ""
+  };
+}
+
+export const VaultRefresh = {
+  encode(message: VaultRefresh, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.blockHeight !== 0) {
+      writer.uint32(8).uint32(message.blockHeight);
+    }
+
+    if (message.numOrdersPlaced !== 0) {
+      writer.uint32(16).uint32(message.numOrdersPlaced);
+    }
+
+    if (message.numOrdersCancelled !== 0) {
+      writer.uint32(24).uint32(message.numOrdersCancelled);
+    }
+
+    if (message.skipReason !== "") {
+      writer.uint32(34).string(message.skipReason);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VaultRefresh {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVaultRefresh();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.blockHeight = reader.uint32();
+          break;
+
+        case 2:
+          message.numOrdersPlaced = reader.uint32();
+          break;
+
+        case 3:
+          message.numOrdersCancelled = reader.uint32();
+          break;
+
+        case 4:
+          message.skipReason = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<VaultRefresh>): VaultRefresh {
+    const message = createBaseVaultRefresh();
+    message.blockHeight = object.blockHeight ?? 0;
+    message.numOrdersPlaced = object.numOrdersPlaced ?? 0;
+    message.numOrdersCancelled = object.numOrdersCancelled ?? 0;
+    message.skipReason = object.skipReason ?? "";
+    return message;
+  }
+
+};
+
+function createBaseVaultRefreshHistory(): VaultRefreshHistory {
+  return {
+    refreshes: []
+  };
+}
+
+export const VaultRefreshHistory = {
+  encode(message: VaultRefreshHistory, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.refreshes) {
+      VaultRefresh.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VaultRefreshHistory {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVaultRefreshHistory();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.refreshes.push(VaultRefresh.decode(reader, reader.uint32()));
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<VaultRefreshHistory>): VaultRefreshHistory {
+    const message = createBaseVaultRefreshHistory();
+    message.refreshes = object.refreshes?.map(e => VaultRefresh.fromPartial(e)) || [];
     return message;
   }
 
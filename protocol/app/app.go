@@ -445,6 +445,7 @@ func New(
 		rewardsmoduletypes.TransientStoreKey,
 		indexer_manager.TransientStoreKey,
 		perpetualsmoduletypes.TransientStoreKey,
+		vaultmoduletypes.TransientStoreKey,
 	)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, clobmoduletypes.MemStoreKey)
 
@@ -1114,7 +1115,10 @@ func New(
 	app.VaultKeeper = *vaultmodulekeeper.NewKeeper(
 		appCodec,
 		keys[vaultmoduletypes.StoreKey],
+		tkeys[vaultmoduletypes.TransientStoreKey],
+		app.BlockTimeKeeper,
 		app.ClobKeeper,
+		app.FeeTiersKeeper,
 		app.PerpetualsKeeper,
 		app.PricesKeeper,
 		app.SendingKeeper,
@@ -1126,6 +1130,7 @@ func New(
 		},
 	)
 	vaultModule := vaultmodule.NewAppModule(appCodec, app.VaultKeeper)
+	app.ClobKeeper.SetVaultKeeper(app.VaultKeeper)
 
 	app.ListingKeeper = *listingmodulekeeper.NewKeeper(
 		appCodec,
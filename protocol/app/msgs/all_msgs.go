@@ -239,12 +239,26 @@ var (
 		"/dydxprotocol.stats.MsgUpdateParamsResponse": {},
 
 		// vault
-		"/dydxprotocol.vault.MsgDepositToVault":            {},
-		"/dydxprotocol.vault.MsgDepositToVaultResponse":    {},
-		"/dydxprotocol.vault.MsgWithdrawFromVault":         {},
-		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse": {},
-		"/dydxprotocol.vault.MsgUpdateParams":              {},
-		"/dydxprotocol.vault.MsgUpdateParamsResponse":      {},
+		"/dydxprotocol.vault.MsgDepositToVault":                    {},
+		"/dydxprotocol.vault.MsgDepositToVaultResponse":            {},
+		"/dydxprotocol.vault.MsgWithdrawFromVault":                 {},
+		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse":         {},
+		"/dydxprotocol.vault.MsgUpdateParams":                      {},
+		"/dydxprotocol.vault.MsgUpdateParamsResponse":              {},
+		"/dydxprotocol.vault.MsgSetVaultLabel":                     {},
+		"/dydxprotocol.vault.MsgSetVaultLabelResponse":             {},
+		"/dydxprotocol.vault.MsgFreezeVaultShares":                 {},
+		"/dydxprotocol.vault.MsgFreezeVaultSharesResponse":         {},
+		"/dydxprotocol.vault.MsgUnfreezeVaultShares":               {},
+		"/dydxprotocol.vault.MsgUnfreezeVaultSharesResponse":       {},
+		"/dydxprotocol.vault.MsgRepairVaultShares":                 {},
+		"/dydxprotocol.vault.MsgRepairVaultSharesResponse":         {},
+		"/dydxprotocol.vault.MsgCloseVault":                        {},
+		"/dydxprotocol.vault.MsgCloseVaultResponse":                {},
+		"/dydxprotocol.vault.MsgOperatorUpdateVaultParams":         {},
+		"/dydxprotocol.vault.MsgOperatorUpdateVaultParamsResponse": {},
+		"/dydxprotocol.vault.MsgRefreshVaultOrders":                {},
+		"/dydxprotocol.vault.MsgRefreshVaultOrdersResponse":        {},
 
 		// vest
 		"/dydxprotocol.vest.MsgSetVestEntry":            {},
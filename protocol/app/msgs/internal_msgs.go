@@ -186,8 +186,16 @@ var (
 		"/dydxprotocol.stats.MsgUpdateParamsResponse": nil,
 
 		// vault
-		"/dydxprotocol.vault.MsgUpdateParams":         &vault.MsgUpdateParams{},
-		"/dydxprotocol.vault.MsgUpdateParamsResponse": nil,
+		"/dydxprotocol.vault.MsgCloseVault":                  &vault.MsgCloseVault{},
+		"/dydxprotocol.vault.MsgCloseVaultResponse":          nil,
+		"/dydxprotocol.vault.MsgFreezeVaultShares":           &vault.MsgFreezeVaultShares{},
+		"/dydxprotocol.vault.MsgFreezeVaultSharesResponse":   nil,
+		"/dydxprotocol.vault.MsgRepairVaultShares":           &vault.MsgRepairVaultShares{},
+		"/dydxprotocol.vault.MsgRepairVaultSharesResponse":   nil,
+		"/dydxprotocol.vault.MsgUnfreezeVaultShares":         &vault.MsgUnfreezeVaultShares{},
+		"/dydxprotocol.vault.MsgUnfreezeVaultSharesResponse": nil,
+		"/dydxprotocol.vault.MsgUpdateParams":                &vault.MsgUpdateParams{},
+		"/dydxprotocol.vault.MsgUpdateParamsResponse":        nil,
 
 		// vest
 		"/dydxprotocol.vest.MsgSetVestEntry":            &vest.MsgSetVestEntry{},
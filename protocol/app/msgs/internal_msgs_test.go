@@ -144,6 +144,14 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.stats.MsgUpdateParamsResponse",
 
 		// vault
+		"/dydxprotocol.vault.MsgCloseVault",
+		"/dydxprotocol.vault.MsgCloseVaultResponse",
+		"/dydxprotocol.vault.MsgFreezeVaultShares",
+		"/dydxprotocol.vault.MsgFreezeVaultSharesResponse",
+		"/dydxprotocol.vault.MsgRepairVaultShares",
+		"/dydxprotocol.vault.MsgRepairVaultSharesResponse",
+		"/dydxprotocol.vault.MsgUnfreezeVaultShares",
+		"/dydxprotocol.vault.MsgUnfreezeVaultSharesResponse",
 		"/dydxprotocol.vault.MsgUpdateParams",
 		"/dydxprotocol.vault.MsgUpdateParamsResponse",
 
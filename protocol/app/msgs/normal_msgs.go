@@ -236,9 +236,15 @@ var (
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse": nil,
 
 		// vault
-		"/dydxprotocol.vault.MsgDepositToVault":            &vault.MsgDepositToVault{},
-		"/dydxprotocol.vault.MsgDepositToVaultResponse":    nil,
-		"/dydxprotocol.vault.MsgWithdrawFromVault":         &vault.MsgWithdrawFromVault{},
-		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse": nil,
+		"/dydxprotocol.vault.MsgDepositToVault":                    &vault.MsgDepositToVault{},
+		"/dydxprotocol.vault.MsgDepositToVaultResponse":            nil,
+		"/dydxprotocol.vault.MsgOperatorUpdateVaultParams":         &vault.MsgOperatorUpdateVaultParams{},
+		"/dydxprotocol.vault.MsgOperatorUpdateVaultParamsResponse": nil,
+		"/dydxprotocol.vault.MsgRefreshVaultOrders":                &vault.MsgRefreshVaultOrders{},
+		"/dydxprotocol.vault.MsgRefreshVaultOrdersResponse":        nil,
+		"/dydxprotocol.vault.MsgSetVaultLabel":                     &vault.MsgSetVaultLabel{},
+		"/dydxprotocol.vault.MsgSetVaultLabelResponse":             nil,
+		"/dydxprotocol.vault.MsgWithdrawFromVault":                 &vault.MsgWithdrawFromVault{},
+		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse":         nil,
 	}
 )
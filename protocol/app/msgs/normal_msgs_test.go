@@ -141,6 +141,12 @@ func TestNormalMsgs_Key(t *testing.T) {
 		// vault
 		"/dydxprotocol.vault.MsgDepositToVault",
 		"/dydxprotocol.vault.MsgDepositToVaultResponse",
+		"/dydxprotocol.vault.MsgOperatorUpdateVaultParams",
+		"/dydxprotocol.vault.MsgOperatorUpdateVaultParamsResponse",
+		"/dydxprotocol.vault.MsgRefreshVaultOrders",
+		"/dydxprotocol.vault.MsgRefreshVaultOrdersResponse",
+		"/dydxprotocol.vault.MsgSetVaultLabel",
+		"/dydxprotocol.vault.MsgSetVaultLabelResponse",
 		"/dydxprotocol.vault.MsgWithdrawFromVault",
 		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse",
 
@@ -1,10 +1,14 @@
 package process
 
 import (
+	"math/big"
 	"reflect"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	gometrics "github.com/hashicorp/go-metrics"
@@ -63,6 +67,8 @@ func DecodeAcknowledgeBridgesTx(
 // - first bridge event ID is not the one to be next acknowledged.
 // - last bridge event ID has not been recognized.
 // - a bridge event's content is not the same as in server state.
+// - acknowledging bridge events would exceed the daily acknowledged amount cap.
+// - a bridge event was recognized less than `acknowledgement_delay_blocks` blocks ago.
 func (abt *AcknowledgeBridgesTx) Validate() error {
 	// `ValidateBasic` validates that bridge event IDs are consecutive.
 	if err := abt.msg.ValidateBasic(); err != nil {
@@ -82,7 +88,9 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 	if len(abt.msg.Events) == 0 {
 		// If there is no bridge event, return nil.
 		return nil
-	} else if abt.bridgeKeeper.GetSafetyParams(abt.ctx).IsDisabled {
+	}
+	safetyParams := abt.bridgeKeeper.GetSafetyParams(abt.ctx)
+	if safetyParams.IsDisabled {
 		// If there is any bridge event when bridging is disabled, return error.
 		return types.ErrBridgingDisabled
 	}
@@ -119,14 +127,93 @@ func (abt *AcknowledgeBridgesTx) Validate() error {
 		return types.ErrBridgeIdNotRecognized
 	}
 
-	// Validate that bridge events' content is the same as in server state.
+	// Validate that bridge events' content (amount, address, and Ethereum block height) is the
+	// same as that of the recognized event with the same ID in server state, so that a proposer
+	// can't acknowledge a tampered event.
 	for _, event := range abt.msg.Events {
 		eventInState, found := abt.bridgeKeeper.GetBridgeEventFromServer(abt.ctx, event.Id)
 		if !found {
-			return types.ErrBridgeEventNotFound
+			return errorsmod.Wrapf(types.ErrBridgeEventNotFound, "bridge event ID: %d", event.Id)
 		}
 		if !eventInState.Equal(event) {
-			return types.ErrBridgeEventContentMismatch
+			telemetry.IncrCounterWithLabels(
+				[]string{
+					ModuleName,
+					metrics.AcknowledgeBridgesTx,
+					metrics.Validate,
+					metrics.Error,
+				},
+				1,
+				[]gometrics.Label{
+					metrics.GetLabelForStringValue(metrics.Error, types.ErrBridgeEventContentMismatch.Error()),
+				},
+			)
+			return errorsmod.Wrapf(
+				types.ErrBridgeEventContentMismatch,
+				"bridge event ID: %d, mismatched fields (acknowledged != recognized): %s",
+				event.Id,
+				strings.Join(event.GetMismatchedFields(eventInState), ", "),
+			)
+		}
+	}
+
+	// Validate that each bridge event was recognized at least `acknowledgement_delay_blocks` blocks ago.
+	if safetyParams.AcknowledgementDelayBlocks > 0 {
+		blockHeight := lib.MustConvertIntegerToUint32(abt.ctx.BlockHeight())
+		for _, event := range abt.msg.Events {
+			recognizedBlockHeight, found := abt.bridgeKeeper.GetBridgeEventBlockHeightFromServer(abt.ctx, event.Id)
+			if !found {
+				return errorsmod.Wrapf(types.ErrBridgeEventNotFound, "bridge event ID: %d", event.Id)
+			}
+			if !safetyParams.IsAcknowledgementDelayPassed(recognizedBlockHeight, blockHeight) {
+				telemetry.IncrCounterWithLabels(
+					[]string{
+						ModuleName,
+						metrics.AcknowledgeBridgesTx,
+						metrics.Validate,
+						metrics.Error,
+					},
+					1,
+					[]gometrics.Label{
+						metrics.GetLabelForStringValue(metrics.Error, types.ErrBridgeEventTooRecent.Error()),
+					},
+				)
+				return errorsmod.Wrapf(
+					types.ErrBridgeEventTooRecent,
+					"bridge event ID: %d, recognized block height: %d, block height: %d",
+					event.Id,
+					recognizedBlockHeight,
+					blockHeight,
+				)
+			}
+		}
+	}
+
+	// Validate that acknowledging bridge events doesn't exceed the daily acknowledged amount cap.
+	if safetyParams.HasDailyAcknowledgedAmountCap() {
+		acknowledgedAmount := new(big.Int).Add(
+			abt.bridgeKeeper.GetDailyAcknowledgedAmount(abt.ctx),
+			types.GetBridgeEventsAmount(abt.msg.Events),
+		)
+		if safetyParams.ExceedsDailyAcknowledgedAmountCap(acknowledgedAmount) {
+			telemetry.IncrCounterWithLabels(
+				[]string{
+					ModuleName,
+					metrics.AcknowledgeBridgesTx,
+					metrics.Validate,
+					metrics.Error,
+				},
+				1,
+				[]gometrics.Label{
+					metrics.GetLabelForStringValue(metrics.Error, types.ErrBridgeDailyCapExceeded.Error()),
+				},
+			)
+			return errorsmod.Wrapf(
+				types.ErrBridgeDailyCapExceeded,
+				"daily acknowledged amount with bridge events: %s, daily acknowledged amount cap: %s",
+				acknowledgedAmount,
+				safetyParams.DailyAcknowledgedAmountCap.BigInt(),
+			)
 		}
 	}
 
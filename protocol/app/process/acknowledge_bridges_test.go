@@ -2,12 +2,14 @@ package process_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/app/process"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/encoding"
@@ -90,9 +92,15 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 		bridgeEventsInServer  []types.BridgeEvent // events in bridge server that a bridge tx is validated against.
 		acknowledgedEventInfo types.BridgeEventInfo
 		recognizedEventInfo   types.BridgeEventInfo
+		dailyAmountCap        int64  // daily acknowledged amount cap.
+		dailyAmount           int64  // amount already acknowledged today.
+		ackDelayBlocks        uint32 // acknowledgement delay blocks.
+		recognizedBlockHeight uint32 // block height at which events in bridge server were recognized.
+		blockHeight           int64  // current block height.
 
 		// Expectations.
-		expectedErr error
+		expectedErr         error
+		expectedErrContains string // additional substring expected in the error message.
 	}{
 		"Error: bridge event ID not next to be acknowledged": {
 			txBytes:              constants.MsgAcknowledgeBridges_Id55_Height15_TxBytes,
@@ -146,7 +154,8 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 				NextId:         56,
 				EthBlockHeight: 14,
 			},
-			expectedErr: types.ErrBridgeEventContentMismatch,
+			expectedErr:         types.ErrBridgeEventContentMismatch,
+			expectedErrContains: "eth_block_height: 15 != 14",
 		},
 		"Error: second bridge event has incorrect amount": {
 			txBytes: constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
@@ -167,6 +176,25 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
 			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
 			expectedErr:           types.ErrBridgeEventContentMismatch,
+			expectedErrContains:   "bridge event ID: 1, mismatched fields (acknowledged != recognized): coin: ",
+		},
+		"Error: bridge event has mismatched address": {
+			txBytes: constants.MsgAcknowledgeBridges_Id0_Height0_TxBytes,
+			bridgeEventsInServer: []types.BridgeEvent{
+				func(event types.BridgeEvent) types.BridgeEvent {
+					return types.BridgeEvent{
+						Id:             event.Id,
+						Coin:           event.Coin,
+						Address:        constants.BobAccAddress.String(), // mismatched address.
+						EthBlockHeight: event.EthBlockHeight,
+					}
+				}(constants.BridgeEvent_Id0_Height0),
+			},
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			expectedErr:           types.ErrBridgeEventContentMismatch,
+			expectedErrContains: "address: " + constants.BridgeEvent_Id0_Height0.Address +
+				" != " + constants.BobAccAddress.String(),
 		},
 		"Error: one event and bridging disabled": {
 			txBytes:               constants.MsgAcknowledgeBridges_Id0_Height0_TxBytes,
@@ -201,21 +229,67 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
 			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
 		},
+		"Valid: two events under daily cap": {
+			txBytes:               constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Ids0_1_Height0.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			dailyAmountCap:        2_000,
+			dailyAmount:           224, // 224 + 888 * 2 = 2_000
+		},
+		"Error: two events over daily cap": {
+			txBytes:               constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Ids0_1_Height0.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			dailyAmountCap:        2_000,
+			dailyAmount:           225, // 225 + 888 * 2 = 2_001
+			expectedErr:           types.ErrBridgeDailyCapExceeded,
+			expectedErrContains: "daily acknowledged amount with bridge events: 2001, " +
+				"daily acknowledged amount cap: 2000",
+		},
+		"Valid: two events recognized more than acknowledgement delay blocks ago": {
+			txBytes:               constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Ids0_1_Height0.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			ackDelayBlocks:        5,
+			recognizedBlockHeight: 10,
+			blockHeight:           16,
+		},
+		"Error: two events recognized within acknowledgement delay blocks": {
+			txBytes:               constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+			bridgeEventsInServer:  constants.MsgAcknowledgeBridges_Ids0_1_Height0.Events,
+			acknowledgedEventInfo: constants.AcknowledgedEventInfo_Id0_Height0,
+			recognizedEventInfo:   constants.RecognizedEventInfo_Id2_Height0,
+			ackDelayBlocks:        5,
+			recognizedBlockHeight: 10,
+			blockHeight:           15,
+			expectedErr:           types.ErrBridgeEventTooRecent,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Setup.
 			ctx, _, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+			ctx = ctx.WithBlockHeight(tc.blockHeight)
 			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
 			mockBridgeKeeper.On("GetSafetyParams", ctx).Return(types.SafetyParams{
-				IsDisabled:  tc.bridgingDisabled,
-				DelayBlocks: 7, // dummy value
+				IsDisabled:                 tc.bridgingDisabled,
+				DelayBlocks:                7, // dummy value
+				DailyAcknowledgedAmountCap: dtypes.NewInt(tc.dailyAmountCap),
+				AcknowledgementDelayBlocks: tc.ackDelayBlocks,
 			})
+			mockBridgeKeeper.On("GetDailyAcknowledgedAmount", ctx).Return(big.NewInt(tc.dailyAmount))
 			mockBridgeKeeper.On("GetAcknowledgedEventInfo", ctx).Return(tc.acknowledgedEventInfo)
 			mockBridgeKeeper.On("GetRecognizedEventInfo", ctx).Return(tc.recognizedEventInfo)
 			for _, event := range tc.bridgeEventsInServer {
 				mockBridgeKeeper.On("GetBridgeEventFromServer", ctx, event.Id).Return(event, true)
+				mockBridgeKeeper.On("GetBridgeEventBlockHeightFromServer", ctx, event.Id).Return(
+					tc.recognizedBlockHeight,
+					true,
+				)
 			}
 
 			abt, err := process.DecodeAcknowledgeBridgesTx(
@@ -230,6 +304,7 @@ func TestAcknowledgeBridgesTx_Validate(t *testing.T) {
 			err = abt.Validate()
 			if tc.expectedErr != nil {
 				require.ErrorContains(t, err, tc.expectedErr.Error())
+				require.ErrorContains(t, err, tc.expectedErrContains)
 			} else {
 				require.NoError(t, err)
 			}
@@ -2,6 +2,7 @@ package process
 
 import (
 	"context"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -51,5 +52,7 @@ type ProcessBridgeKeeper interface {
 		ctx sdk.Context,
 	) (recognizedEventInfo bridgetypes.BridgeEventInfo)
 	GetBridgeEventFromServer(ctx sdk.Context, id uint32) (event bridgetypes.BridgeEvent, found bool)
+	GetBridgeEventBlockHeightFromServer(ctx sdk.Context, id uint32) (blockHeight uint32, found bool)
 	GetSafetyParams(ctx sdk.Context) (safetyParams bridgetypes.SafetyParams)
+	GetDailyAcknowledgedAmount(ctx sdk.Context) *big.Int
 }
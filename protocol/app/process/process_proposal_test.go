@@ -57,6 +57,9 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 		txsBytes             [][]byte
 		bridgeEventsInServer []bridgetypes.BridgeEvent
 		bridgingDisabled     bool
+		ackDelayBlocks       uint32 // acknowledgement delay blocks.
+		recognizedAtHeight   uint32 // block height at which events in bridge server were recognized.
+		blockHeight          int64
 
 		expectedResponse abci.ResponseProcessProposal
 	}{
@@ -138,6 +141,19 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 			},
 			expectedResponse: rejectResponse,
 		},
+		"Reject: bridge event recognized within acknowledgement delay blocks": {
+			txsBytes: [][]byte{
+				validOperationsTx,
+				validAcknowledgeBridgesTx,
+				validAddFundingTx,
+				validUpdatePriceTx,
+			},
+			bridgeEventsInServer: validAcknowledgeBridgesMsg.Events,
+			ackDelayBlocks:       5,
+			recognizedAtHeight:   10,
+			blockHeight:          15,
+			expectedResponse:     rejectResponse,
+		},
 		"Error: place order type is not allowed": {
 			txsBytes: [][]byte{
 				validOperationsTx,
@@ -253,12 +269,26 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 			bridgeEventsInServer: validAcknowledgeBridgesMsg.Events,
 			expectedResponse:     acceptResponse,
 		},
+		"Accept: bridge events recognized acknowledgement delay blocks ago": {
+			txsBytes: [][]byte{
+				validOperationsTx,
+				validAcknowledgeBridgesTx,
+				validAddFundingTx,
+				validUpdatePriceTx,
+			},
+			bridgeEventsInServer: validAcknowledgeBridgesMsg.Events,
+			ackDelayBlocks:       5,
+			recognizedAtHeight:   10,
+			blockHeight:          16,
+			expectedResponse:     acceptResponse,
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Setup.
 			ctx, pricesKeeper, _, indexPriceCache, mockTimeProvider, _ := keepertest.PricesKeepers(t)
+			ctx = ctx.WithBlockHeight(tc.blockHeight)
 			mockTimeProvider.On("Now").Return(constants.TimeT)
 			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
 			indexPriceCache.UpdatePrices(constants.AtTimeTSingleExchangePriceUpdate)
@@ -269,13 +299,18 @@ func TestProcessProposalHandler_Error(t *testing.T) {
 
 			mockBridgeKeeper := &mocks.ProcessBridgeKeeper{}
 			mockBridgeKeeper.On("GetSafetyParams", mock.Anything).Return(bridgetypes.SafetyParams{
-				IsDisabled:  tc.bridgingDisabled,
-				DelayBlocks: 5, // dummy value, not considered by ProcessProposal.
+				IsDisabled:                 tc.bridgingDisabled,
+				DelayBlocks:                5, // dummy value, not considered by ProcessProposal.
+				AcknowledgementDelayBlocks: tc.ackDelayBlocks,
 			})
 			mockBridgeKeeper.On("GetAcknowledgedEventInfo", mock.Anything).Return(constants.AcknowledgedEventInfo_Id0_Height0)
 			mockBridgeKeeper.On("GetRecognizedEventInfo", mock.Anything).Return(constants.RecognizedEventInfo_Id2_Height0)
 			for _, bridgeEvent := range tc.bridgeEventsInServer {
 				mockBridgeKeeper.On("GetBridgeEventFromServer", mock.Anything, bridgeEvent.Id).Return(bridgeEvent, true).Once()
+				mockBridgeKeeper.On("GetBridgeEventBlockHeightFromServer", mock.Anything, bridgeEvent.Id).Return(
+					tc.recognizedAtHeight,
+					true,
+				)
 			}
 
 			handler := process.ProcessProposalHandler(
@@ -15,6 +15,7 @@ import (
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	pricetypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	revsharetypes "github.com/dydxprotocol/v4-chain/protocol/x/revshare/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
 func removeStatefulFOKOrders(ctx sdk.Context, k clobtypes.ClobKeeper) {
@@ -68,12 +69,30 @@ func initRevShareModuleState(
 	}
 }
 
+// migrateVaultParams sets params that were added to `x/vault` params since the last upgrade
+// and whose zero value, which is what stored params decode them as, doesn't preserve a
+// vault's existing behaviour.
+func migrateVaultParams(
+	ctx sdk.Context,
+	vaultKeeper vaulttypes.VaultKeeper,
+) {
+	params := vaultKeeper.GetParams(ctx)
+	// Vaults have always skewed their orders based on their inventory.
+	params.SkewEnabled = true
+	// Vaults with exactly the activation threshold have always activated.
+	params.ActivationInclusive = true
+	if err := vaultKeeper.SetParams(ctx, params); err != nil {
+		panic(fmt.Sprintf("failed to migrate vault params: %s", err))
+	}
+}
+
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
 	clobKeeper clobtypes.ClobKeeper,
 	revShareKeeper revsharetypes.RevShareKeeper,
 	priceKeeper pricetypes.PricesKeeper,
+	vaultKeeper vaulttypes.VaultKeeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		sdkCtx := lib.UnwrapSDKContext(ctx, "app/upgrades")
@@ -85,6 +104,13 @@ func CreateUpgradeHandler(
 		// Initialize the rev share module state.
 		initRevShareModuleState(sdkCtx, revShareKeeper, priceKeeper)
 
+		// Migrate vault params. This must happen before activation statuses are initialized as
+		// activation depends on params.
+		migrateVaultParams(sdkCtx, vaultKeeper)
+
+		// Initialize activation statuses of all existing vaults.
+		vaultKeeper.InitializeVaultActivationStatuses(sdkCtx)
+
 		sdkCtx.Logger().Info("Successfully removed stateful orders from state")
 
 		return mm.RunMigrations(ctx, configurator, vm)
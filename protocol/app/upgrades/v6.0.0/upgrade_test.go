@@ -0,0 +1,90 @@
+package v_6_0_0_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/store/rootmulti"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	v_6_0_0 "github.com/dydxprotocol/v4-chain/protocol/app/upgrades/v6.0.0"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeHandler_VaultParamsAndActivationStatuses(t *testing.T) {
+	// A vault with no perpetual positions and exactly its activation threshold of USDC, which
+	// activates under the params before this upgrade.
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000),
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+	require.NoError(t, err)
+
+	// Store params as they were before this upgrade, i.e. without any of the params added since,
+	// which decode as their zero values.
+	preUpgradeParams := vaulttypes.Params{
+		Layers:                           2,
+		SpreadMinPpm:                     10_000,
+		SpreadBufferPpm:                  1_500,
+		SkewFactorPpm:                    2_000_000,
+		OrderSizePctPpm:                  100_000,
+		OrderExpirationSeconds:           2,
+		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+		MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+	}
+	storeKey := tApp.App.CommitMultiStore().(*rootmulti.Store).StoreKeysByName()[vaulttypes.StoreKey]
+	ctx.KVStore(storeKey).Set(
+		[]byte(vaulttypes.ParamsKey),
+		tApp.App.AppCodec().MustMarshal(&preUpgradeParams),
+	)
+	require.False(t, k.GetParams(ctx).ActivationInclusive)
+
+	handler := v_6_0_0.CreateUpgradeHandler(
+		tApp.App.ModuleManager,
+		module.NewConfigurator(tApp.App.AppCodec(), tApp.App.MsgServiceRouter(), tApp.App.GRPCQueryRouter()),
+		tApp.App.ClobKeeper,
+		tApp.App.RevShareKeeper,
+		tApp.App.PricesKeeper,
+		&tApp.App.VaultKeeper,
+	)
+	_, err = handler(ctx, upgradetypes.Plan{Name: v_6_0_0.UpgradeName}, tApp.App.ModuleManager.GetVersionMap())
+	require.NoError(t, err)
+
+	// Check that params preserve behaviour of vaults before this upgrade.
+	params := k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.True(t, params.SkewEnabled)
+	require.True(t, params.ActivationInclusive)
+
+	// Check that activation status is initialized with migrated params, i.e. that the vault at
+	// exactly its activation threshold is active.
+	require.True(t, k.GetVaultActivated(ctx, vaultId))
+}
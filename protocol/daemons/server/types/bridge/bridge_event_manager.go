@@ -28,15 +28,20 @@ type BridgeEventManager struct {
 	// - The block height of the last recognized event (`EthBlockHeight`)
 	recognizedEventInfo types.BridgeEventInfo
 
+	// Height of the latest block that the chain has processed, which stamps
+	// events that are recognized afterwards. Zero if no block has been processed.
+	blockHeight uint32
+
 	// Time provider than can mocked out if necessary
 	timeProvider libtime.TimeProvider
 }
 
 // BridgeEventWithTime is a type that wraps BridgeEvent but also
-// holds an additional timestamp.
+// holds an additional timestamp and block height of recognition.
 type BridgeEventWithTime struct {
-	event     types.BridgeEvent
-	timestamp time.Time
+	event       types.BridgeEvent
+	timestamp   time.Time
+	blockHeight uint32
 }
 
 // NewBridgeEventManager creates a new BridgeEventManager.
@@ -85,8 +90,9 @@ func (b *BridgeEventManager) AddBridgeEvents(
 
 		// Update BridgeEventManager with the new event.
 		b.events[event.Id] = BridgeEventWithTime{
-			event:     event,
-			timestamp: now,
+			event:       event,
+			timestamp:   now,
+			blockHeight: b.blockHeight,
 		}
 		// Update recognized event info of BridgeEventManager.
 		b.recognizedEventInfo = types.BridgeEventInfo{
@@ -133,6 +139,45 @@ func (b *BridgeEventManager) GetBridgeEventById(
 	return eventWithTime.event, eventWithTime.timestamp, true
 }
 
+// GetBridgeEventBlockHeight returns the block height at which a bridge event was recognized.
+// Found is false if the manager does not have the event.
+func (b *BridgeEventManager) GetBridgeEventBlockHeight(
+	id uint32,
+) (
+	blockHeight uint32,
+	found bool,
+) {
+	b.Lock()
+	defer b.Unlock()
+
+	eventWithTime, found := b.events[id]
+	if !found {
+		return 0, false
+	}
+	return eventWithTime.blockHeight, true
+}
+
+// SetBlockHeight sets the height of the latest block that the chain has processed. Events
+// that are recognized afterwards are stamped with this height. Events that were recognized
+// before any block was processed (e.g. right after a restart) are stamped with this height
+// as well, so that they are never considered older than they are.
+func (b *BridgeEventManager) SetBlockHeight(
+	blockHeight uint32,
+) {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.blockHeight == 0 {
+		for id, eventWithTime := range b.events {
+			if eventWithTime.blockHeight == 0 {
+				eventWithTime.blockHeight = blockHeight
+				b.events[id] = eventWithTime
+			}
+		}
+	}
+	b.blockHeight = blockHeight
+}
+
 // GetRecognizedEventInfo returns `recognizedEventInfo`.
 func (b *BridgeEventManager) GetRecognizedEventInfo() types.BridgeEventInfo {
 	b.Lock()
@@ -218,3 +218,37 @@ func TestBridgeEventManager_GetBridgeEventById_Success(t *testing.T) {
 	require.Equal(t, constants.BridgeEvent_Id0_Height0, result)
 	require.Equal(t, constants.TimeT, timestamp)
 }
+
+func TestBridgeEventManager_GetBridgeEventBlockHeight(t *testing.T) {
+	bem := setupEventManager()
+
+	_, found := bem.GetBridgeEventBlockHeight(constants.BridgeEvent_Id0_Height0.Id)
+	require.False(t, found)
+
+	// Event recognized before any block is processed is stamped with the first block height.
+	err := bem.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_Id0_Height0,
+	})
+	require.NoError(t, err)
+	blockHeight, found := bem.GetBridgeEventBlockHeight(constants.BridgeEvent_Id0_Height0.Id)
+	require.True(t, found)
+	require.Equal(t, uint32(0), blockHeight)
+
+	bem.SetBlockHeight(5)
+	blockHeight, found = bem.GetBridgeEventBlockHeight(constants.BridgeEvent_Id0_Height0.Id)
+	require.True(t, found)
+	require.Equal(t, uint32(5), blockHeight)
+
+	// Event recognized afterwards is stamped with the latest block height.
+	bem.SetBlockHeight(7)
+	err = bem.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_Id1_Height0,
+	})
+	require.NoError(t, err)
+	blockHeight, found = bem.GetBridgeEventBlockHeight(constants.BridgeEvent_Id1_Height0.Id)
+	require.True(t, found)
+	require.Equal(t, uint32(7), blockHeight)
+	blockHeight, found = bem.GetBridgeEventBlockHeight(constants.BridgeEvent_Id0_Height0.Id)
+	require.True(t, found)
+	require.Equal(t, uint32(5), blockHeight)
+}
@@ -126,6 +126,10 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*stats.MsgUpdateParams,
 
 		// vault
+		*vault.MsgCloseVault,
+		*vault.MsgFreezeVaultShares,
+		*vault.MsgRepairVaultShares,
+		*vault.MsgUnfreezeVaultShares,
 		*vault.MsgUpdateParams,
 
 		// vest
@@ -244,13 +244,25 @@ const (
 	TreasuryBalanceAfterDistribution = "treasury_balance_after_distribution"
 
 	// Vault.
-	NumActiveVaults  = "num_active_vaults"
-	VaultCancelOrder = "vault_cancel_order"
-	VaultPlaceOrder  = "vault_place_order"
-	VaultType        = "vault_type"
-	VaultId          = "vault_id"
-	VaultEquity      = "vault_equity"
-	TotalShares      = "total_shares"
+	NumActiveVaults     = "num_active_vaults"
+	VaultCancelOrder    = "vault_cancel_order"
+	VaultPlaceOrder     = "vault_place_order"
+	VaultType           = "vault_type"
+	VaultId             = "vault_id"
+	VaultEquity         = "vault_equity"
+	VaultLiquidatable   = "vault_liquidatable"
+	VaultCloseOnly      = "vault_close_only"
+	VaultMarketInactive = "vault_market_inactive"
+	VaultWatchdog       = "vault_watchdog"
+	VaultValueAtRisk    = "vault_value_at_risk"
+	VaultOrderDeviation = "vault_order_deviation"
+	VaultMakerEdge      = "vault_maker_edge"
+	VaultFill           = "vault_fill"
+	VaultFillVolume     = "vault_fill_volume"
+	VaultRealizedSpread = "vault_realized_spread"
+	TotalVaultTvl       = "total_vault_tvl"
+	TotalVaultInventory = "total_vault_inventory"
+	TotalShares         = "total_shares"
 
 	// Vest.
 	GetVestEntry          = "get_vest_entry"
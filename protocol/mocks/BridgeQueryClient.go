@@ -54,6 +54,43 @@ func (_m *BridgeQueryClient) AcknowledgedEventInfo(ctx context.Context, in *type
 	return r0, r1
 }
 
+// BridgeEvent provides a mock function with given fields: ctx, in, opts
+func (_m *BridgeQueryClient) BridgeEvent(ctx context.Context, in *types.QueryBridgeEventRequest, opts ...grpc.CallOption) (*types.QueryBridgeEventResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeEvent")
+	}
+
+	var r0 *types.QueryBridgeEventResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBridgeEventRequest, ...grpc.CallOption) (*types.QueryBridgeEventResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBridgeEventRequest, ...grpc.CallOption) *types.QueryBridgeEventResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBridgeEventResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBridgeEventRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DelayedCompleteBridgeMessages provides a mock function with given fields: ctx, in, opts
 func (_m *BridgeQueryClient) DelayedCompleteBridgeMessages(ctx context.Context, in *types.QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*types.QueryDelayedCompleteBridgeMessagesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	mock "github.com/stretchr/testify/mock"
 
@@ -32,6 +34,34 @@ func (_m *ProcessBridgeKeeper) GetAcknowledgedEventInfo(ctx types.Context) bridg
 	return r0
 }
 
+// GetBridgeEventBlockHeightFromServer provides a mock function with given fields: ctx, id
+func (_m *ProcessBridgeKeeper) GetBridgeEventBlockHeightFromServer(ctx types.Context, id uint32) (uint32, bool) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBridgeEventBlockHeightFromServer")
+	}
+
+	var r0 uint32
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) (uint32, bool)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) uint32); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, uint32) bool); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetBridgeEventFromServer provides a mock function with given fields: ctx, id
 func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, id uint32) (bridgetypes.BridgeEvent, bool) {
 	ret := _m.Called(ctx, id)
@@ -60,6 +90,26 @@ func (_m *ProcessBridgeKeeper) GetBridgeEventFromServer(ctx types.Context, id ui
 	return r0, r1
 }
 
+// GetDailyAcknowledgedAmount provides a mock function with given fields: ctx
+func (_m *ProcessBridgeKeeper) GetDailyAcknowledgedAmount(ctx types.Context) *big.Int {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDailyAcknowledgedAmount")
+	}
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(types.Context) *big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // GetRecognizedEventInfo provides a mock function with given fields: ctx
 func (_m *ProcessBridgeKeeper) GetRecognizedEventInfo(ctx types.Context) bridgetypes.BridgeEventInfo {
 	ret := _m.Called(ctx)
@@ -142,6 +142,11 @@ func (_m *VaultKeeper) GetVaultEquity(ctx types.Context, vaultId vaulttypes.Vaul
 	return r0, r1
 }
 
+// InitializeVaultActivationStatuses provides a mock function with given fields: ctx
+func (_m *VaultKeeper) InitializeVaultActivationStatuses(ctx types.Context) {
+	_m.Called(ctx)
+}
+
 // MintShares provides a mock function with given fields: ctx, vaultId, owner, quantumsToDeposit
 func (_m *VaultKeeper) MintShares(ctx types.Context, vaultId vaulttypes.VaultId, owner string, quantumsToDeposit *big.Int) error {
 	ret := _m.Called(ctx, vaultId, owner, quantumsToDeposit)
@@ -7,6 +7,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
@@ -28,8 +29,9 @@ var (
 		SkipIfBlockDelayedByDuration: time.Minute,
 	}
 	GenesisSafetyParams = bridgetypes.SafetyParams{
-		IsDisabled:  false,
-		DelayBlocks: 10,
+		IsDisabled:                 false,
+		DelayBlocks:                10,
+		DailyAcknowledgedAmountCap: dtypes.NewInt(0),
 	}
 	// Modified params.
 	ModifiedEventParams = bridgetypes.EventParams{
@@ -44,8 +46,9 @@ var (
 		SkipIfBlockDelayedByDuration: time.Second,
 	}
 	ModifiedSafetyParams = bridgetypes.SafetyParams{
-		IsDisabled:  true,
-		DelayBlocks: 5,
+		IsDisabled:                 true,
+		DelayBlocks:                5,
+		DailyAcknowledgedAmountCap: dtypes.NewInt(1_000_000),
 	}
 	// Invalid authority address.
 	InvalidBridgeAuthority = constants.AliceAccAddress.String()
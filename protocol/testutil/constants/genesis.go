@@ -205,7 +205,9 @@ const GenesisState = `{
       },
       "safety_params": {
         "is_disabled": false,
-        "delay_blocks": 86400
+        "delay_blocks": 86400,
+        "daily_acknowledged_amount_cap": "0",
+        "acknowledgement_delay_blocks": 0
       },
       "acknowledged_event_info": {
         "next_id": 0,
@@ -923,7 +925,8 @@ const GenesisState = `{
           "id": 0,
           "min_exchanges": 1,
           "min_price_change_ppm": 1000,
-          "pair": "BTC-USD"
+          "pair": "BTC-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ETHUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ETHUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tETHUSD\"},{\"exchangeName\":\"Bitstamp\",\"ticker\":\"ETH/USD\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"ETHUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ETH-USD\"},{\"exchangeName\":\"CryptoCom\",\"ticker\":\"ETH_USD\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XETHZUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ETH-USDT\"}]}",
@@ -931,7 +934,8 @@ const GenesisState = `{
           "id": 1,
           "min_exchanges": 1,
           "min_price_change_ppm": 1000,
-          "pair": "ETH-USD"
+          "pair": "ETH-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"LINKUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"LINKUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"LINK-USD\"},{\"exchangeName\":\"CryptoCom\",\"ticker\":\"LINK_USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"linkusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"LINKUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"LINK-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"LINK-USDT\"}]}",
@@ -939,7 +943,8 @@ const GenesisState = `{
           "id": 2,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "LINK-USD"
+          "pair": "LINK-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"MATICUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"MATICUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"MATIC-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"MATIC_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"maticusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"MATIC-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"MATIC-USDT\"}]}",
@@ -947,7 +952,8 @@ const GenesisState = `{
           "id": 3,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "MATIC-USD"
+          "pair": "MATIC-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"CRVUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"CRVUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"CRVUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"CRV-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"CRV_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"crvusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"CRVUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"CRV-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"CRV-USDT\"}]}",
@@ -955,7 +961,8 @@ const GenesisState = `{
           "id": 4,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "CRV-USD"
+          "pair": "CRV-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"SOLUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"SOLUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tSOLUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"SOL-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"solusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"SOLUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"SOL-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"SOL-USDT\"}]}",
@@ -963,7 +970,8 @@ const GenesisState = `{
           "id": 5,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "SOL-USD"
+          "pair": "SOL-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ADAUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ADAUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tADAUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ADA-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"ADA_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"adausdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ADAUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ADA-USDT\"}]}",
@@ -971,7 +979,8 @@ const GenesisState = `{
           "id": 6,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ADA-USD"
+          "pair": "ADA-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"AVAXUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"AVAXUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tAVAX:USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"AVAX_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"avaxusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"AVAX-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"AVAX-USDT\"}]}",
@@ -979,7 +988,8 @@ const GenesisState = `{
           "id": 7,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "AVAX-USD"
+          "pair": "AVAX-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"FILUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"FILUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"FIL-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"filusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"FILUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"FIL-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"FIL-USDT\"}]}",
@@ -987,7 +997,8 @@ const GenesisState = `{
           "id": 8,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "FIL-USD"
+          "pair": "FIL-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"AAVEUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"AAVEUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"AAVE-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"aaveusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"AAVEUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"AAVE-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"AAVE-USDT\"}]}",
@@ -995,7 +1006,8 @@ const GenesisState = `{
           "id": 9,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "AAVE-USD"
+          "pair": "AAVE-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"LTCUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"LTCUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"LTCUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"LTC-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"ltcusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XLTCZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"LTC-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"LTC-USDT\"}]}",
@@ -1003,7 +1015,8 @@ const GenesisState = `{
           "id": 10,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "LTC-USD"
+          "pair": "LTC-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"DOGEUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"DOGEUSD\\\"\"},{\"exchangeName\":\"Gate\",\"ticker\":\"DOGE_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"dogeusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"DOGE-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"DOGE-USDT\"}]}",
@@ -1011,7 +1024,8 @@ const GenesisState = `{
           "id": 11,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "DOGE-USD"
+          "pair": "DOGE-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ICPUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ICPUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ICP-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"ICP_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"icpusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ICP-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ICP-USDT\"}]}",
@@ -1019,7 +1033,8 @@ const GenesisState = `{
           "id": 12,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ICP-USD"
+          "pair": "ICP-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ATOMUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ATOMUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"ATOMUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ATOM-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"atomusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ATOMUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ATOM-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ATOM-USDT\"}]}",
@@ -1027,7 +1042,8 @@ const GenesisState = `{
           "id": 13,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ATOM-USD"
+          "pair": "ATOM-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"DOTUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"DOTUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tDOTUSD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"DOT_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"dotusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"DOTUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"DOT-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"DOT-USDT\"}]}",
@@ -1035,7 +1051,8 @@ const GenesisState = `{
           "id": 14,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "DOT-USD"
+          "pair": "DOT-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"XTZUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"XTZUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tXTZUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"XTZ-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"XTZ_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"xtzusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XTZUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"XTZ-USDT\"}]}",
@@ -1043,7 +1060,8 @@ const GenesisState = `{
           "id": 15,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "XTZ-USD"
+          "pair": "XTZ-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"UNIUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"UNIUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"UNIUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"UNI-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"UNI_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"uniusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"UNIUSD\"},{\"exchangeName\":\"Mexc\",\"ticker\":\"UNI_USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"UNI-USDT\"}]}",
@@ -1051,7 +1069,8 @@ const GenesisState = `{
           "id": 16,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "UNI-USD"
+          "pair": "UNI-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"BCHUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"BCHUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"BCH-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"BCH_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"bchusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"BCHUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"BCH-USDT\"}]}",
@@ -1059,7 +1078,8 @@ const GenesisState = `{
           "id": 17,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "BCH-USD"
+          "pair": "BCH-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"EOSUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"EOSUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tEOSUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"EOS-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"eosusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"EOSUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"EOS-USDT\"}]}",
@@ -1067,7 +1087,8 @@ const GenesisState = `{
           "id": 18,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "EOS-USD"
+          "pair": "EOS-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"EOSUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"EOSUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tEOSUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"EOS-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"eosusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"EOSUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"EOS-USDT\"}]}",
@@ -1075,7 +1096,8 @@ const GenesisState = `{
           "id": 19,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "TRX-USD"
+          "pair": "TRX-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ALGOUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ALGOUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ALGO-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"algousdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ALGOUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ALGO-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ALGO-USDT\"}]}",
@@ -1083,7 +1105,8 @@ const GenesisState = `{
           "id": 20,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ALGO-USD"
+          "pair": "ALGO-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"NEARUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"NEARUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"NEARUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"NEAR-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"NEAR_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"nearusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"NEAR-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"NEAR-USDT\"}]}",
@@ -1091,7 +1114,8 @@ const GenesisState = `{
           "id": 21,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "NEAR-USD"
+          "pair": "NEAR-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"SNXUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"SNXUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tSNXUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"SNX-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"snxusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"SNXUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"SNX-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"SNX-USDT\"}]}",
@@ -1099,7 +1123,8 @@ const GenesisState = `{
           "id": 22,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "SNX-USD"
+          "pair": "SNX-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"MKRUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"MKRUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tMKRUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"MKR-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"MKR_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"mkrusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"MKR-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"MKR-USDT\"}]}",
@@ -1107,7 +1132,8 @@ const GenesisState = `{
           "id": 23,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "MKR-USD"
+          "pair": "MKR-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"SUSHIUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"SUSHIUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tSUSHI:USD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"SUSHI-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"SUSHI_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"sushiusdt\"},{\"exchangeName\":\"Okx\",\"ticker\":\"SUSHI-USDT\"}]}",
@@ -1115,7 +1141,8 @@ const GenesisState = `{
           "id": 24,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "SUSHI-USD"
+          "pair": "SUSHI-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"XLMUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"XLMUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tXLMUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"XLM-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"XLM_USDT\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XXLMZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"XLM-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"XLM-USDT\"}]}",
@@ -1123,7 +1150,8 @@ const GenesisState = `{
           "id": 25,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "XLM-USD"
+          "pair": "XLM-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"XMRUSDT\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tXMRUSD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"XMR_USDT\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XXMRZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"XMR-USDT\"},{\"exchangeName\":\"Mexc\",\"ticker\":\"XMR_USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"XMR-USDT\"}]}",
@@ -1131,7 +1159,8 @@ const GenesisState = `{
           "id": 26,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "XMR-USD"
+          "pair": "XMR-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ETCUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ETCUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ETC-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"ETC_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"etcusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XETCZUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ETC-USDT\"}]}",
@@ -1139,7 +1168,8 @@ const GenesisState = `{
           "id": 27,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ETC-USD"
+          "pair": "ETC-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"1INCHUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"1INCHUSD\\\"\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"1INCH-USD\"},{\"exchangeName\":\"Gate\",\"ticker\":\"1INCH_USDT\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"1inchusdt\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"1INCH-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"1INCH-USDT\"}]}",
@@ -1147,7 +1177,8 @@ const GenesisState = `{
           "id": 28,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "1INCH-USD"
+          "pair": "1INCH-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"COMPUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"COMPUSD\\\"\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"COMPUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"COMP-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"compusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"COMPUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"COMP-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"COMP-USDT\"}]}",
@@ -1155,7 +1186,8 @@ const GenesisState = `{
           "id": 29,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "COMP-USD"
+          "pair": "COMP-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ZECUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ZECUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tZECUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ZEC-USD\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"XZECZUSD\"},{\"exchangeName\":\"Kucoin\",\"ticker\":\"ZEC-USDT\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ZEC-USDT\"}]}",
@@ -1163,7 +1195,8 @@ const GenesisState = `{
           "id": 30,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ZEC-USD"
+          "pair": "ZEC-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"ZRXUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"ZRXUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tZRXUSD\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"ZRX-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"zrxusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"ZRXUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"ZRX-USDT\"}]}",
@@ -1171,7 +1204,8 @@ const GenesisState = `{
           "id": 31,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "ZRX-USD"
+          "pair": "ZRX-USD",
+          "update_interval_seconds": 0
         },
         {
           "exchange_config_json": "{\"exchanges\":[{\"exchangeName\":\"Binance\",\"ticker\":\"\\\"YFIUSDT\\\"\"},{\"exchangeName\":\"BinanceUS\",\"ticker\":\"\\\"YFIUSD\\\"\"},{\"exchangeName\":\"Bitfinex\",\"ticker\":\"tYFIUSD\"},{\"exchangeName\":\"Bybit\",\"ticker\":\"YFIUSDT\"},{\"exchangeName\":\"CoinbasePro\",\"ticker\":\"YFI-USD\"},{\"exchangeName\":\"Huobi\",\"ticker\":\"yfiusdt\"},{\"exchangeName\":\"Kraken\",\"ticker\":\"YFIUSD\"},{\"exchangeName\":\"Okx\",\"ticker\":\"YFI-USDT\"}]}",
@@ -1179,7 +1213,8 @@ const GenesisState = `{
           "id": 32,
           "min_exchanges": 1,
           "min_price_change_ppm": 2000,
-          "pair": "YFI-USD"
+          "pair": "YFI-USD",
+          "update_interval_seconds": 0
         }
       ],
       "market_prices": [
@@ -1510,7 +1545,51 @@ const GenesisState = `{
         "skew_factor_ppm": 2000000,
         "order_size_pct_ppm": 100000,
         "order_expiration_seconds": 2,
-        "activation_threshold_quote_quantums": "1000000000"
+        "activation_threshold_quote_quantums": "1000000000",
+        "min_equity_per_layer_quote_quantums": "0",
+        "skew_enabled": true,
+        "min_refresh_interval_blocks": 0,
+        "min_lot_base_quantums": "0",
+        "spread_multiplier_ppm_by_layer": [],
+        "max_position_delta_per_block_base_quantums": "0",
+        "hard_max_order_age_seconds": 0,
+        "min_ticks_from_oracle_per_side": 0,
+        "order_size_vol_scale_ppm": 0,
+        "subticks_jitter_enabled": false,
+        "size_profile": "SIZE_PROFILE_FLAT",
+        "include_fee_floor": false,
+        "soft_inventory_band_base_quantums": "0",
+        "operator": "",
+        "operator_update_interval_blocks": 0,
+        "operator_param_bounds": {
+          "spread_min_ppm_min": 0,
+          "spread_min_ppm_max": 0,
+          "order_size_pct_ppm_min": 0,
+          "order_size_pct_ppm_max": 0
+        },
+        "activation_inclusive": true,
+        "requote_fill_threshold_pct_ppm": 0,
+        "max_vault_orders_per_block": 0,
+        "renew_buffer_blocks": 0,
+        "min_order_lifetime_seconds": 0,
+        "inventory_dead_band_base_quantums": "0",
+        "round_to_mid": false,
+        "cross_vault_netting": false,
+        "max_subticks_deviation_ppm": 0,
+        "strict_subticks_deviation": false,
+        "placement_priority": "PLACEMENT_PRIORITY_INNER_FIRST",
+        "activation_threshold_mode": "ACTIVATION_THRESHOLD_MODE_STATIC",
+        "inventory_spread_scale_ppm": 0,
+        "skip_unchanged_orders": false,
+        "owner_share_epoch_seconds": 0,
+        "watchdog_blocks": 0,
+        "refresh_strategy": "REFRESH_STRATEGY_REPLACE",
+        "max_skew_ppm": 0,
+        "skip_unchanged_outer_layers": false,
+        "client_metadata": 0,
+        "settle_funding_before_share_math": false,
+        "operator_refresh_interval_blocks": 0,
+        "expiration_oracle_ticks": 0
       },
       "vaults": []
     },
@@ -93,6 +93,9 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 
 		// Vault.
 		&vaulttypes.MsgDepositToVault{},
+		&vaulttypes.MsgOperatorUpdateVaultParams{},
+		&vaulttypes.MsgRefreshVaultOrders{},
+		&vaulttypes.MsgSetVaultLabel{},
 		&vaulttypes.MsgWithdrawFromVault{},
 	}
 
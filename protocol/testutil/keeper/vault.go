@@ -48,12 +48,18 @@ func createVaultKeeper(
 	storetypes.StoreKey,
 ) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	vaultTransientStoreKey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(vaultTransientStoreKey, storetypes.StoreTypeTransient, db)
 
+	blockTimeKeeper, _ := createBlockTimeKeeper(stateStore, db, cdc)
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
+		vaultTransientStoreKey,
+		blockTimeKeeper,
 		&mocks.ClobKeeper{},
+		&mocks.FeeTiersKeeper{},
 		&mocks.PerpetualsKeeper{},
 		&mocks.PricesKeeper{},
 		&mocks.SendingKeeper{},
@@ -0,0 +1,13 @@
+package bridge
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/keeper"
+)
+
+func EndBlocker(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+) {
+	keeper.UpdateServerBlockHeight(ctx)
+}
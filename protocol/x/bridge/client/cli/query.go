@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +28,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryAcknowledgedEventInfo())
 	cmd.AddCommand(CmdQueryRecognizedEventInfo())
 	cmd.AddCommand(CmdQueryDelayedCompleteBridgeMessages())
+	cmd.AddCommand(CmdQueryBridgeEvent())
 
 	return cmd
 }
@@ -177,3 +179,35 @@ func CmdQueryDelayedCompleteBridgeMessages() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryBridgeEvent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-bridge-event [id]",
+		Short: "get a bridge event by ID and its status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeEvent(
+				context.Background(),
+				&types.QueryBridgeEventRequest{
+					Id: id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
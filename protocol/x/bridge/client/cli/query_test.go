@@ -96,3 +96,14 @@ func TestQueryDelayedCompleteBridgeMessages(t *testing.T) {
 	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 	require.Equal(t, []types.DelayedCompleteBridgeMessage{}, resp.Messages)
 }
+
+func TestQueryBridgeEvent(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryBridgeEvent(), []string{"0"})
+
+	require.NoError(t, err)
+	var resp types.QueryBridgeEventResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, types.BridgeEventStatus_BRIDGE_EVENT_STATUS_UNRECOGNIZED, resp.Status)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math/big"
 	"math/rand"
 	"time"
 
@@ -48,6 +49,9 @@ func (k Keeper) GetAcknowledgeBridges(
 	)
 	acknowledgedEventInfo := k.GetAcknowledgedEventInfo(ctx)
 	recognizedCutoffTime := wallClock.Add(-proposeParams.ProposeDelayDuration)
+	safetyParams := k.GetSafetyParams(ctx)
+	acknowledgedAmount := k.GetDailyAcknowledgedAmount(ctx)
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
 	events := make([]types.BridgeEvent, 0)
 	for i := uint32(0); i < proposeParams.MaxBridgesPerBlock; i++ {
 		// 1. Try to retrieve recognized event with id `NextId + i` from BridgeEventManager.
@@ -60,13 +64,29 @@ func (k Keeper) GetAcknowledgeBridges(
 		}
 
 		// 2. Append the new event if it is recognized before the cutoff time.
-		if eventRecognizedAt.Before(recognizedCutoffTime) {
-			events = append(events, eventToAcknowledge)
-		} else {
+		if !eventRecognizedAt.Before(recognizedCutoffTime) {
 			// Stop looking for events with higher IDs if event with current ID is not old enough.
 			// This assumes that events with lower IDs are recognized before events with higher IDs.
 			break
 		}
+
+		// 3. Stop looking for events with higher IDs if event with current ID was recognized less
+		// than `acknowledgement_delay_blocks` blocks ago.
+		recognizedBlockHeight, _ := k.bridgeEventManager.GetBridgeEventBlockHeight(eventToAcknowledge.Id)
+		if !safetyParams.IsAcknowledgementDelayPassed(recognizedBlockHeight, blockHeight) {
+			break
+		}
+
+		// 4. Stop looking for events with higher IDs if acknowledging the event would exceed
+		// the daily acknowledged amount cap.
+		acknowledgedAmount.Add(
+			acknowledgedAmount,
+			types.GetBridgeEventsAmount([]types.BridgeEvent{eventToAcknowledge}),
+		)
+		if safetyParams.ExceedsDailyAcknowledgedAmountCap(acknowledgedAmount) {
+			break
+		}
+		events = append(events, eventToAcknowledge)
 	}
 
 	return &types.MsgAcknowledgeBridges{
@@ -76,6 +96,7 @@ func (k Keeper) GetAcknowledgeBridges(
 
 // AcknowledgeBridges acknowledges a list of bridge events and returns an error if any of following
 // - bridging is disabled.
+// - acknowledging the bridge events would exceed the daily acknowledged amount cap.
 // - fails to delay a `MsgCompleteBridge` for any bridge event.
 // - fails to update `AcknowledgedEventInfo` in state.
 func (k Keeper) AcknowledgeBridges(
@@ -90,6 +111,12 @@ func (k Keeper) AcknowledgeBridges(
 		// Do not acknowledge bridges if bridging is disabled.
 		return types.ErrBridgingDisabled
 	}
+	acknowledgedAmount := types.GetBridgeEventsAmount(bridgeEvents)
+	if safetyParams.ExceedsDailyAcknowledgedAmountCap(
+		new(big.Int).Add(k.GetDailyAcknowledgedAmount(ctx), acknowledgedAmount),
+	) {
+		return types.ErrBridgeDailyCapExceeded
+	}
 
 	// Measure latency if there are bridge events to acknowledge.
 	defer telemetry.ModuleMeasureSince(
@@ -128,6 +155,7 @@ func (k Keeper) AcknowledgeBridges(
 	}); err != nil {
 		return err
 	}
+	k.AddDailyAcknowledgedAmount(ctx, acknowledgedAmount)
 
 	return nil
 }
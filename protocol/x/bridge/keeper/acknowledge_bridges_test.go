@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
@@ -19,12 +21,16 @@ func TestAcknowledgeBridges(t *testing.T) {
 		bridgeEvents []types.BridgeEvent
 		// Whether bridging is disabled.
 		bridgingDisabled bool
+		// Daily acknowledged amount cap.
+		dailyAmountCap int64
 		// Error responses of mock delayMsgKeeper.
 		delayMsgErrors []error
 
 		/* --- Expectations --- */
 		// Expected AcknowledgedEventInfo.
 		expectedAEI types.BridgeEventInfo
+		// Expected daily acknowledged amount.
+		expectedDailyAmount int64
 		// Expected error.
 		expectedError string
 	}{
@@ -44,6 +50,7 @@ func TestAcknowledgeBridges(t *testing.T) {
 				NextId:         56,
 				EthBlockHeight: 15,
 			},
+			expectedDailyAmount: 888,
 		},
 		"Success: 2 events": {
 			bridgeEvents: []types.BridgeEvent{
@@ -55,6 +62,29 @@ func TestAcknowledgeBridges(t *testing.T) {
 				NextId:         2,
 				EthBlockHeight: 0,
 			},
+			expectedDailyAmount: 1_776,
+		},
+		"Success: 2 events at daily cap": {
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+			},
+			dailyAmountCap: 1_776,
+			delayMsgErrors: []error{nil, nil},
+			expectedAEI: types.BridgeEventInfo{
+				NextId:         2,
+				EthBlockHeight: 0,
+			},
+			expectedDailyAmount: 1_776,
+		},
+		"Error: 2 events over daily cap": {
+			bridgeEvents: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+			},
+			dailyAmountCap: 1_775,
+			delayMsgErrors: []error{nil, nil},
+			expectedError:  types.ErrBridgeDailyCapExceeded.Error(),
 		},
 		"Error: bridging disabled": {
 			bridgeEvents: []types.BridgeEvent{
@@ -79,8 +109,9 @@ func TestAcknowledgeBridges(t *testing.T) {
 			// Initialize context, keeper, and mockDelayMsgKeeper.
 			ctx, bridgeKeeper, _, _, _, _, mockDelayMsgKeeper := keepertest.BridgeKeepers(t)
 			err := bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
-				IsDisabled:  tc.bridgingDisabled,
-				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
+				IsDisabled:                 tc.bridgingDisabled,
+				DelayBlocks:                bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
+				DailyAcknowledgedAmountCap: dtypes.NewInt(tc.dailyAmountCap),
 			})
 			require.NoError(t, err)
 			for i := range tc.bridgeEvents {
@@ -100,8 +131,9 @@ func TestAcknowledgeBridges(t *testing.T) {
 				// Verify that error is as expected.
 				require.ErrorContains(t, err, tc.expectedError)
 
-				// Verify that AcknowledgedEventInfo was not updated.
+				// Verify that AcknowledgedEventInfo and daily acknowledged amount were not updated.
 				require.Equal(t, initialAei, bridgeKeeper.GetAcknowledgedEventInfo(ctx))
+				require.Equal(t, 0, bridgeKeeper.GetDailyAcknowledgedAmount(ctx).Sign())
 
 				if tc.bridgingDisabled {
 					// Verify that no messages were delayed.
@@ -115,6 +147,9 @@ func TestAcknowledgeBridges(t *testing.T) {
 				aei := bridgeKeeper.GetAcknowledgedEventInfo(ctx)
 				require.Equal(t, tc.expectedAEI, aei)
 
+				// Verify that daily acknowledged amount is updated in state.
+				require.Equal(t, big.NewInt(tc.expectedDailyAmount), bridgeKeeper.GetDailyAcknowledgedAmount(ctx))
+
 				// Assert mock expectations.
 				mockDelayMsgKeeper.AssertExpectations(t)
 			}
@@ -133,6 +168,10 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 		bridgeEventsToAdd     []types.BridgeEvent
 		acknowledgedEventInfo types.BridgeEventInfo
 		bridgingDisabled      bool
+		dailyAmountCap        int64
+		ackDelayBlocks        uint32
+		eventBlockHeight      uint32 // latest block height when events are recognized.
+		blockHeight           int64
 
 		// Expectations.
 		expectedMsg *types.MsgAcknowledgeBridges
@@ -276,6 +315,71 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 				Events: []types.BridgeEvent{},
 			},
 		},
+		"Events beyond daily acknowledged amount cap are not proposed": {
+			blockTimestamp: timeNow,
+			eventTimestamp: timeNow.Add(-time.Second * 2),
+			proposeParams: types.ProposeParams{
+				SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+				SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+				MaxBridgesPerBlock:           3,           // propose up to 3 events per block.
+				ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+			},
+			bridgeEventsToAdd: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+				constants.BridgeEvent_Id2_Height1, // this event should not be proposed.
+			},
+			dailyAmountCap: 2_000, // allows two events of 888 each.
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Id1_Height0,
+				},
+			},
+		},
+		"Events recognized more than acknowledgement delay blocks ago are proposed": {
+			blockTimestamp: timeNow,
+			eventTimestamp: timeNow.Add(-time.Second * 2),
+			proposeParams: types.ProposeParams{
+				SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+				SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+				MaxBridgesPerBlock:           3,           // propose up to 3 events per block.
+				ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+			},
+			bridgeEventsToAdd: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+			},
+			ackDelayBlocks:   5,
+			eventBlockHeight: 10,
+			blockHeight:      16,
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{
+					constants.BridgeEvent_Id0_Height0,
+					constants.BridgeEvent_Id1_Height0,
+				},
+			},
+		},
+		"Events recognized within acknowledgement delay blocks are not proposed": {
+			blockTimestamp: timeNow,
+			eventTimestamp: timeNow.Add(-time.Second * 2),
+			proposeParams: types.ProposeParams{
+				SkipRatePpm:                  0,           // do not skip based on pseudo-randomness.
+				SkipIfBlockDelayedByDuration: time.Second, // do not skip based on time.
+				MaxBridgesPerBlock:           3,           // propose up to 3 events per block.
+				ProposeDelayDuration:         time.Second, // propose events recognized at least one second ago.
+			},
+			bridgeEventsToAdd: []types.BridgeEvent{
+				constants.BridgeEvent_Id0_Height0,
+				constants.BridgeEvent_Id1_Height0,
+			},
+			ackDelayBlocks:   5,
+			eventBlockHeight: 10,
+			blockHeight:      15,
+			expectedMsg: &types.MsgAcknowledgeBridges{
+				Events: []types.BridgeEvent{},
+			},
+		},
 		"No event is proposed when bridging is disabled": {
 			blockTimestamp: timeNow,
 			eventTimestamp: timeNow.Add(-time.Second * 2),
@@ -310,10 +414,14 @@ func TestGetAcknowledgeBridges(t *testing.T) {
 			err = bridgeKeeper.UpdateProposeParams(ctx, tc.proposeParams)
 			require.NoError(t, err)
 			err = bridgeKeeper.UpdateSafetyParams(ctx, types.SafetyParams{
-				IsDisabled:  tc.bridgingDisabled,
-				DelayBlocks: bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
+				IsDisabled:                 tc.bridgingDisabled,
+				DelayBlocks:                bridgeKeeper.GetSafetyParams(ctx).DelayBlocks,
+				DailyAcknowledgedAmountCap: dtypes.NewInt(tc.dailyAmountCap),
+				AcknowledgementDelayBlocks: tc.ackDelayBlocks,
 			})
 			require.NoError(t, err)
+			ctx = ctx.WithBlockHeight(tc.blockHeight)
+			bridgeEventManager.SetBlockHeight(tc.eventBlockHeight)
 			mockTimeProvider.On("Now").Return(tc.eventTimestamp).Once()
 			err = bridgeEventManager.AddBridgeEvents(tc.bridgeEventsToAdd)
 			require.NoError(t, err)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 )
 
@@ -11,3 +12,41 @@ func (k Keeper) GetBridgeEventFromServer(ctx sdk.Context, id uint32) (event type
 	event, _, found = k.bridgeEventManager.GetBridgeEventById(id)
 	return event, found
 }
+
+// `GetBridgeEventBlockHeightFromServer` returns the block height at which the bridge event with the
+// given id was recognized by the server. `found` is false if the event is not found.
+func (k Keeper) GetBridgeEventBlockHeightFromServer(
+	ctx sdk.Context,
+	id uint32,
+) (blockHeight uint32, found bool) {
+	return k.bridgeEventManager.GetBridgeEventBlockHeight(id)
+}
+
+// UpdateServerBlockHeight updates the server with the height of the current block, which
+// stamps bridge events that are recognized afterwards.
+func (k Keeper) UpdateServerBlockHeight(ctx sdk.Context) {
+	k.bridgeEventManager.SetBlockHeight(lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+}
+
+// `GetBridgeEventWithStatus` returns the bridge event with the given id from the server and the
+// event's status, which is
+// - `ACKNOWLEDGED` if the id is less than `NextId` of `AcknowledgedEventInfo`.
+// - `RECOGNIZED` if not acknowledged and the id is less than `NextId` of `RecognizedEventInfo`.
+// - `UNRECOGNIZED` otherwise.
+// `event` is empty if the event is not found in the server.
+func (k Keeper) GetBridgeEventWithStatus(
+	ctx sdk.Context,
+	id uint32,
+) (
+	event types.BridgeEvent,
+	status types.BridgeEventStatus,
+) {
+	event, _ = k.GetBridgeEventFromServer(ctx, id)
+	if id < k.GetAcknowledgedEventInfo(ctx).NextId {
+		return event, types.BridgeEventStatus_BRIDGE_EVENT_STATUS_ACKNOWLEDGED
+	}
+	if id < k.GetRecognizedEventInfo(ctx).NextId {
+		return event, types.BridgeEventStatus_BRIDGE_EVENT_STATUS_RECOGNIZED
+	}
+	return event, types.BridgeEventStatus_BRIDGE_EVENT_STATUS_UNRECOGNIZED
+}
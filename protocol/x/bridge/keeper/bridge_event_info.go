@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 )
@@ -57,3 +62,81 @@ func (k Keeper) GetRecognizedEventInfo(
 ) (recognizedEventInfo types.BridgeEventInfo) {
 	return k.bridgeEventManager.GetRecognizedEventInfo()
 }
+
+// AcknowledgedAmountWindowHours is the number of hours over which the acknowledged amount is
+// summed and capped by `daily_acknowledged_amount_cap`, i.e. the cap applies to a rolling 24
+// hour window.
+const AcknowledgedAmountWindowHours = 24
+
+// getBlockTimeHour returns the hour (in hours since unix epoch) of block time.
+func getBlockTimeHour(ctx sdk.Context) uint32 {
+	return uint32(ctx.BlockTime().Unix() / (60 * 60))
+}
+
+// GetDailyAcknowledgedAmount returns the total amount of bridge events acknowledged in the
+// rolling window of the last `AcknowledgedAmountWindowHours` hours up to and including the hour
+// of current block time, which is the sum of amounts acknowledged in each hour of the window.
+// Amounts are tracked at hourly granularity, so the window starts at the beginning of an hour.
+func (k Keeper) GetDailyAcknowledgedAmount(
+	ctx sdk.Context,
+) *big.Int {
+	currentHour := getBlockTimeHour(ctx)
+	windowStartHour := uint32(0)
+	if currentHour >= AcknowledgedAmountWindowHours {
+		windowStartHour = currentHour - AcknowledgedAmountWindowHours + 1
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.HourlyAcknowledgedAmountKeyPrefix))
+	iterator := store.Iterator(lib.Uint32ToKey(windowStartHour), lib.Uint32ToKey(currentHour+1))
+	defer iterator.Close()
+
+	totalAmount := new(big.Int)
+	for ; iterator.Valid(); iterator.Next() {
+		var hourlyAcknowledgedAmount types.HourlyAcknowledgedAmount
+		k.cdc.MustUnmarshal(iterator.Value(), &hourlyAcknowledgedAmount)
+		if !hourlyAcknowledgedAmount.Amount.IsNil() {
+			totalAmount.Add(totalAmount, hourlyAcknowledgedAmount.Amount.BigInt())
+		}
+	}
+	return totalAmount
+}
+
+// AddDailyAcknowledgedAmount adds the given amount to the amount of bridge events acknowledged
+// in the hour of current block time and deletes amounts of hours that are no longer within the
+// rolling window of `GetDailyAcknowledgedAmount`.
+func (k Keeper) AddDailyAcknowledgedAmount(
+	ctx sdk.Context,
+	amount *big.Int,
+) {
+	currentHour := getBlockTimeHour(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.HourlyAcknowledgedAmountKeyPrefix))
+
+	// Delete amounts of hours before the window.
+	if currentHour >= AcknowledgedAmountWindowHours {
+		windowStartHour := currentHour - AcknowledgedAmountWindowHours + 1
+		iterator := store.Iterator(nil, lib.Uint32ToKey(windowStartHour))
+		keysToDelete := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keysToDelete = append(keysToDelete, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keysToDelete {
+			store.Delete(key)
+		}
+	}
+
+	hourlyAcknowledgedAmount := types.HourlyAcknowledgedAmount{
+		Hour:   currentHour,
+		Amount: dtypes.NewIntFromBigInt(new(big.Int).Set(amount)),
+	}
+	if b := store.Get(lib.Uint32ToKey(currentHour)); b != nil {
+		var existing types.HourlyAcknowledgedAmount
+		k.cdc.MustUnmarshal(b, &existing)
+		if !existing.Amount.IsNil() {
+			hourlyAcknowledgedAmount.Amount = dtypes.NewIntFromBigInt(
+				new(big.Int).Add(existing.Amount.BigInt(), amount),
+			)
+		}
+	}
+	store.Set(lib.Uint32ToKey(currentHour), k.cdc.MustMarshal(&hourlyAcknowledgedAmount))
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
@@ -44,3 +47,35 @@ func TestSetAcknowledgedEventInfo(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, info2, k.GetAcknowledgedEventInfo(ctx))
 }
+
+func TestDailyAcknowledgedAmount_RollingWindow(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+
+	// Start an hour before midnight (UTC) so that the window crosses a day boundary.
+	start := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	atTime := func(d time.Duration) sdk.Context {
+		return ctx.WithBlockTime(start.Add(d))
+	}
+	require.Equal(t, big.NewInt(0), k.GetDailyAcknowledgedAmount(atTime(0)))
+
+	k.AddDailyAcknowledgedAmount(atTime(0), big.NewInt(100))
+	k.AddDailyAcknowledgedAmount(atTime(30*time.Minute), big.NewInt(50))
+	require.Equal(t, big.NewInt(150), k.GetDailyAcknowledgedAmount(atTime(30*time.Minute)))
+
+	// Amounts acknowledged before midnight still count after midnight.
+	k.AddDailyAcknowledgedAmount(atTime(2*time.Hour), big.NewInt(200))
+	require.Equal(t, big.NewInt(350), k.GetDailyAcknowledgedAmount(atTime(2*time.Hour)))
+	require.Equal(t, big.NewInt(350), k.GetDailyAcknowledgedAmount(atTime(23*time.Hour+59*time.Minute)))
+
+	// Amounts acknowledged more than 24 hours ago no longer count.
+	require.Equal(t, big.NewInt(200), k.GetDailyAcknowledgedAmount(atTime(24*time.Hour)))
+	require.Equal(t, big.NewInt(0), k.GetDailyAcknowledgedAmount(atTime(26*time.Hour)))
+
+	// Adding an amount deletes amounts that are no longer within the window, i.e. amounts
+	// acknowledged before midnight no longer exist.
+	k.AddDailyAcknowledgedAmount(atTime(25*time.Hour), big.NewInt(1))
+	require.Equal(t, big.NewInt(201), k.GetDailyAcknowledgedAmount(atTime(25*time.Hour)))
+	require.Equal(t, big.NewInt(200), k.GetDailyAcknowledgedAmount(atTime(2*time.Hour)))
+}
@@ -51,3 +51,64 @@ func TestGetBridgeEventFromServer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBridgeEventWithStatus(t *testing.T) {
+	// Bridge events with IDs 54 to 59.
+	bridgeEvents := make([]types.BridgeEvent, 0)
+	for id := uint32(54); id < 60; id++ {
+		bridgeEvents = append(bridgeEvents, types.BridgeEvent{
+			Id:             id,
+			Coin:           constants.BridgeEvent_Id55_Height15.Coin,
+			Address:        constants.BridgeEvent_Id55_Height15.Address,
+			EthBlockHeight: 15,
+		})
+	}
+
+	tests := map[string]struct {
+		// Bridge event ID to query.
+		bridgeEventId uint32
+
+		// Expected response.
+		expectedEvent  types.BridgeEvent
+		expectedStatus types.BridgeEventStatus
+	}{
+		"Acknowledged": {
+			bridgeEventId:  54,
+			expectedEvent:  bridgeEvents[0],
+			expectedStatus: types.BridgeEventStatus_BRIDGE_EVENT_STATUS_ACKNOWLEDGED,
+		},
+		"Recognized": {
+			bridgeEventId:  57,
+			expectedEvent:  bridgeEvents[3],
+			expectedStatus: types.BridgeEventStatus_BRIDGE_EVENT_STATUS_RECOGNIZED,
+		},
+		"Unrecognized": {
+			bridgeEventId:  62,
+			expectedEvent:  types.BridgeEvent{},
+			expectedStatus: types.BridgeEventStatus_BRIDGE_EVENT_STATUS_UNRECOGNIZED,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Initialize context, keeper, and bridgeEventManager.
+			ctx, bridgeKeeper, _, mockTimeProvider, bridgeEventManager, _, _ := keepertest.BridgeKeepers(t)
+			mockTimeProvider.On("Now").Return(time.Now())
+			// Acknowledged NextId is 55 and recognized NextId is 60.
+			err := bridgeKeeper.SetAcknowledgedEventInfo(ctx, types.BridgeEventInfo{
+				NextId:         55,
+				EthBlockHeight: 15,
+			})
+			require.NoError(t, err)
+			err = bridgeEventManager.AddBridgeEvents(bridgeEvents)
+			require.NoError(t, err)
+			require.Equal(t, uint32(60), bridgeKeeper.GetRecognizedEventInfo(ctx).NextId)
+
+			event, status := bridgeKeeper.GetBridgeEventWithStatus(ctx, tc.bridgeEventId)
+
+			// Assert expectations.
+			require.Equal(t, tc.expectedEvent, event)
+			require.Equal(t, tc.expectedStatus, status)
+		})
+	}
+}
@@ -119,6 +119,28 @@ func (k Keeper) RecognizedEventInfo(
 	}, nil
 }
 
+// BridgeEvent processes a query request/response for a bridge event by ID and its status.
+// Since recognized events are from memory, the response is not deterministic based on state
+// and therefore may be different between nodes.
+func (k Keeper) BridgeEvent(
+	c context.Context,
+	req *types.QueryBridgeEventRequest,
+) (
+	*types.QueryBridgeEventResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+	event, eventStatus := k.GetBridgeEventWithStatus(ctx, req.Id)
+	return &types.QueryBridgeEventResponse{
+		Event:  event,
+		Status: eventStatus,
+	}, nil
+}
+
 func (k Keeper) DelayedCompleteBridgeMessages(
 	c context.Context,
 	req *types.QueryDelayedCompleteBridgeMessagesRequest,
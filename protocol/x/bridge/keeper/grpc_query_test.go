@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,7 @@ import (
 
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	delaymsgtypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
@@ -200,6 +202,60 @@ func TestRecognizedEventInfo(t *testing.T) {
 	}
 }
 
+func TestBridgeEvent(t *testing.T) {
+	ctx, k, _, mockTimeProvider, bridgeEventManager, _, _ := keepertest.BridgeKeepers(t)
+	mockTimeProvider.On("Now").Return(time.Now())
+	err := k.SetAcknowledgedEventInfo(ctx, constants.AcknowledgedEventInfo_Id0_Height0)
+	require.NoError(t, err)
+	err = bridgeEventManager.AddBridgeEvents([]types.BridgeEvent{
+		constants.BridgeEvent_Id0_Height0,
+		constants.BridgeEvent_Id1_Height0,
+	})
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		req *types.QueryBridgeEventRequest
+		res *types.QueryBridgeEventResponse
+		err error
+	}{
+		"Success - recognized": {
+			req: &types.QueryBridgeEventRequest{
+				Id: 1,
+			},
+			res: &types.QueryBridgeEventResponse{
+				Event:  constants.BridgeEvent_Id1_Height0,
+				Status: types.BridgeEventStatus_BRIDGE_EVENT_STATUS_RECOGNIZED,
+			},
+			err: nil,
+		},
+		"Success - unrecognized": {
+			req: &types.QueryBridgeEventRequest{
+				Id: 2,
+			},
+			res: &types.QueryBridgeEventResponse{
+				Event:  types.BridgeEvent{},
+				Status: types.BridgeEventStatus_BRIDGE_EVENT_STATUS_UNRECOGNIZED,
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.BridgeEvent(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
+
 func TestDelayedCompleteBridgeMessages(t *testing.T) {
 	for name, tc := range map[string]struct {
 		events []types.BridgeEvent
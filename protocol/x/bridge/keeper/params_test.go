@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
@@ -86,8 +87,9 @@ func TestUpdateSafetyParams_Success(t *testing.T) {
 	k := tApp.App.BridgeKeeper
 
 	params := types.SafetyParams{
-		IsDisabled:  true,
-		DelayBlocks: 1234,
+		IsDisabled:                 true,
+		DelayBlocks:                1234,
+		DailyAcknowledgedAmountCap: dtypes.NewInt(5678),
 	}
 	require.NoError(t, params.Validate())
 
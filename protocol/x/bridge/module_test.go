@@ -102,7 +102,9 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 			`"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193"},"propose_params":`+
 			`{"max_bridges_per_block":10,"propose_delay_duration":"60s","skip_rate_ppm":800000,`+
 			`"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,`+
-			`"delay_blocks":86400},"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"}}`,
+			`"delay_blocks":86400,"daily_acknowledged_amount_cap":"0","acknowledgement_delay_blocks":0},`+
+			`"acknowledged_event_info":{"next_id":0,`+
+			`"eth_block_height":"0"}}`,
 		string(json),
 	)
 }
@@ -206,13 +208,14 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "bridge", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 7, len(cmd.Commands()))
 	require.Equal(t, "get-acknowledged-event-info", cmd.Commands()[0].Name())
-	require.Equal(t, "get-delayed-complete-bridge-messages", cmd.Commands()[1].Name())
-	require.Equal(t, "get-event-params", cmd.Commands()[2].Name())
-	require.Equal(t, "get-propose-params", cmd.Commands()[3].Name())
-	require.Equal(t, "get-recognized-event-info", cmd.Commands()[4].Name())
-	require.Equal(t, "get-safety-params", cmd.Commands()[5].Name())
+	require.Equal(t, "get-bridge-event", cmd.Commands()[1].Name())
+	require.Equal(t, "get-delayed-complete-bridge-messages", cmd.Commands()[2].Name())
+	require.Equal(t, "get-event-params", cmd.Commands()[3].Name())
+	require.Equal(t, "get-propose-params", cmd.Commands()[4].Name())
+	require.Equal(t, "get-recognized-event-info", cmd.Commands()[5].Name())
+	require.Equal(t, "get-safety-params", cmd.Commands()[6].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -260,7 +263,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected := `{"event_params":{"denom":"bridge-token","eth_chain_id":"77",`
 	expected += `"eth_address":"0xEf01c3A30eB57c91c40C52E996d29c202ae72193"},"propose_params":{`
 	expected += `"max_bridges_per_block":10,"propose_delay_duration":"60s","skip_rate_ppm":800000,`
-	expected += `"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,"delay_blocks":86400},`
+	expected += `"skip_if_block_delayed_by_duration":"5s"},"safety_params":{"is_disabled":false,"delay_blocks":86400,`
+	expected += `"daily_acknowledged_amount_cap":"0","acknowledgement_delay_blocks":0},`
 	expected += `"acknowledged_event_info":{"next_id":0,"eth_block_height":"0"}}`
 	require.Equal(t, expected, string(genesisJson))
 }
@@ -1,6 +1,44 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+)
+
 func (b BridgeEvent) Equal(other BridgeEvent) bool {
 	return b.Id == other.Id && b.Coin.Equal(other.Coin) &&
 		b.Address == other.Address && b.EthBlockHeight == other.EthBlockHeight
 }
+
+// GetMismatchedFields returns a description of each field that differs between `b` and `other`,
+// in the form `<field>: <b's value> != <other's value>`. Returns an empty slice if the events are equal.
+func (b BridgeEvent) GetMismatchedFields(other BridgeEvent) []string {
+	mismatches := make([]string, 0)
+	if b.Id != other.Id {
+		mismatches = append(mismatches, fmt.Sprintf("id: %d != %d", b.Id, other.Id))
+	}
+	if !b.Coin.Equal(other.Coin) {
+		mismatches = append(mismatches, fmt.Sprintf("coin: %s != %s", b.Coin, other.Coin))
+	}
+	if b.Address != other.Address {
+		mismatches = append(mismatches, fmt.Sprintf("address: %s != %s", b.Address, other.Address))
+	}
+	if b.EthBlockHeight != other.EthBlockHeight {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf("eth_block_height: %d != %d", b.EthBlockHeight, other.EthBlockHeight),
+		)
+	}
+	return mismatches
+}
+
+// GetBridgeEventsAmount returns the total amount of coins of given bridge events.
+func GetBridgeEventsAmount(events []BridgeEvent) *big.Int {
+	amount := new(big.Int)
+	for _, event := range events {
+		if !event.Coin.Amount.IsNil() {
+			amount.Add(amount, event.Coin.Amount.BigInt())
+		}
+	}
+	return amount
+}
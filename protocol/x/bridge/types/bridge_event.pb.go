@@ -25,6 +25,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BridgeEventStatus is the status of a bridge event.
+type BridgeEventStatus int32
+
+const (
+	// Event has not been recognized by the queried node nor acknowledged.
+	BridgeEventStatus_BRIDGE_EVENT_STATUS_UNRECOGNIZED BridgeEventStatus = 0
+	// Event has been recognized by the queried node but not yet acknowledged.
+	BridgeEventStatus_BRIDGE_EVENT_STATUS_RECOGNIZED BridgeEventStatus = 1
+	// Event has been acknowledged in-consensus.
+	BridgeEventStatus_BRIDGE_EVENT_STATUS_ACKNOWLEDGED BridgeEventStatus = 2
+)
+
+var BridgeEventStatus_name = map[int32]string{
+	0: "BRIDGE_EVENT_STATUS_UNRECOGNIZED",
+	1: "BRIDGE_EVENT_STATUS_RECOGNIZED",
+	2: "BRIDGE_EVENT_STATUS_ACKNOWLEDGED",
+}
+
+var BridgeEventStatus_value = map[string]int32{
+	"BRIDGE_EVENT_STATUS_UNRECOGNIZED": 0,
+	"BRIDGE_EVENT_STATUS_RECOGNIZED":   1,
+	"BRIDGE_EVENT_STATUS_ACKNOWLEDGED": 2,
+}
+
+func (x BridgeEventStatus) String() string {
+	return proto.EnumName(BridgeEventStatus_name, int32(x))
+}
+
+func (BridgeEventStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8b4b572ecddaf6f, []int{0}
+}
+
 // BridgeEvent is a recognized event from the Ethereum blockchain.
 type BridgeEvent struct {
 	// The unique id of the Ethereum event log.
@@ -99,6 +131,7 @@ func (m *BridgeEvent) GetEthBlockHeight() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("dydxprotocol.bridge.BridgeEventStatus", BridgeEventStatus_name, BridgeEventStatus_value)
 	proto.RegisterType((*BridgeEvent)(nil), "dydxprotocol.bridge.BridgeEvent")
 }
 
@@ -107,27 +140,32 @@ func init() {
 }
 
 var fileDescriptor_d8b4b572ecddaf6f = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xeb, 0x30,
-	0x18, 0x86, 0xe3, 0x9e, 0xe8, 0x20, 0x52, 0x51, 0xa1, 0xd0, 0x21, 0xed, 0x60, 0x22, 0x06, 0x94,
-	0xa5, 0xb1, 0xda, 0x32, 0xb0, 0x12, 0x84, 0xc4, 0x1c, 0x36, 0x96, 0x28, 0xfe, 0x91, 0x6d, 0xd1,
-	0xc6, 0x55, 0x6c, 0xaa, 0xf6, 0x2e, 0xb8, 0x14, 0x06, 0x2e, 0xa2, 0x63, 0xc5, 0xc4, 0x84, 0x50,
-	0x7b, 0x23, 0x28, 0x76, 0x8a, 0x60, 0xb2, 0xbf, 0xf7, 0x79, 0xfc, 0x5a, 0xfa, 0x82, 0x4b, 0xba,
-	0xa6, 0xab, 0x45, 0xad, 0x8c, 0x22, 0x6a, 0x86, 0x70, 0x2d, 0x29, 0x67, 0xed, 0x51, 0xb0, 0x25,
-	0xab, 0x4c, 0x6a, 0x61, 0x78, 0xf6, 0xdb, 0x4b, 0x9d, 0x30, 0xec, 0x73, 0xc5, 0x95, 0x0d, 0x51,
-	0x73, 0x73, 0xea, 0x70, 0x40, 0x94, 0x9e, 0x2b, 0x5d, 0x38, 0xe0, 0x86, 0x16, 0x41, 0x37, 0x21,
-	0x5c, 0x6a, 0x86, 0x96, 0x63, 0xcc, 0x4c, 0x39, 0x46, 0x44, 0xc9, 0xca, 0xf1, 0x8b, 0x57, 0x10,
-	0x74, 0x33, 0xdb, 0x7d, 0xd7, 0xfc, 0x1d, 0xf6, 0x82, 0x8e, 0xa4, 0x11, 0x88, 0x41, 0x72, 0x92,
-	0x77, 0x24, 0x0d, 0xa7, 0x81, 0xdf, 0xd8, 0x51, 0x27, 0x06, 0x49, 0x77, 0x32, 0x48, 0xdb, 0xf2,
-	0xa6, 0x2e, 0x6d, 0xeb, 0xd2, 0x5b, 0x25, 0xab, 0xcc, 0xdf, 0x7c, 0x9e, 0x7b, 0xb9, 0x95, 0xc3,
-	0x49, 0x70, 0x54, 0x52, 0x5a, 0x33, 0xad, 0xa3, 0x7f, 0x31, 0x48, 0x8e, 0xb3, 0xe8, 0xfd, 0x6d,
-	0xd4, 0x6f, 0x9f, 0xde, 0x38, 0xf2, 0x60, 0x6a, 0x59, 0xf1, 0xfc, 0x20, 0x86, 0x49, 0x70, 0xca,
-	0x8c, 0x28, 0xf0, 0x4c, 0x91, 0xa7, 0x42, 0x30, 0xc9, 0x85, 0x89, 0xfc, 0x18, 0x24, 0x7e, 0xde,
-	0x63, 0x46, 0x64, 0x4d, 0x7c, 0x6f, 0xd3, 0x2c, 0xdf, 0xec, 0x20, 0xd8, 0xee, 0x20, 0xf8, 0xda,
-	0x41, 0xf0, 0xb2, 0x87, 0xde, 0x76, 0x0f, 0xbd, 0x8f, 0x3d, 0xf4, 0x1e, 0xaf, 0xb9, 0x34, 0xe2,
-	0x19, 0xa7, 0x44, 0xcd, 0xd1, 0x9f, 0x2d, 0x2f, 0xaf, 0x46, 0x44, 0x94, 0xb2, 0x42, 0x3f, 0xc9,
-	0xea, 0xb0, 0x79, 0xb3, 0x5e, 0x30, 0x8d, 0xff, 0x5b, 0x30, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0xcc, 0x75, 0xa1, 0x2a, 0x9d, 0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4d, 0x0a, 0xd3, 0x40,
+	0x18, 0x86, 0x33, 0x31, 0x28, 0x4e, 0xb1, 0xd4, 0xd8, 0x45, 0xda, 0xc5, 0x18, 0x8a, 0x48, 0x10,
+	0x9a, 0xd0, 0xd6, 0x85, 0xdb, 0xa6, 0x19, 0x6a, 0x51, 0x52, 0x48, 0x5a, 0x85, 0x6e, 0x42, 0x7e,
+	0x86, 0x64, 0xb0, 0xcd, 0x94, 0x64, 0x5a, 0xda, 0xb5, 0x17, 0xf0, 0x28, 0x2e, 0x3c, 0x44, 0x97,
+	0xc5, 0x95, 0x2b, 0x91, 0xf6, 0x22, 0x92, 0x9f, 0x4a, 0x05, 0x5d, 0x25, 0xdf, 0xfb, 0x3c, 0xf3,
+	0xce, 0xc0, 0x07, 0x5f, 0x46, 0xc7, 0xe8, 0xb0, 0xcd, 0x18, 0x67, 0x21, 0x5b, 0x1b, 0x41, 0x46,
+	0xa3, 0x98, 0xd4, 0x1f, 0x8f, 0xec, 0x49, 0xca, 0xf5, 0x12, 0xca, 0xcf, 0xee, 0x3d, 0xbd, 0x12,
+	0xba, 0xed, 0x98, 0xc5, 0xac, 0x0c, 0x8d, 0xe2, 0xaf, 0x52, 0xbb, 0x9d, 0x90, 0xe5, 0x1b, 0x96,
+	0x7b, 0x15, 0xa8, 0x86, 0x1a, 0xa1, 0x6a, 0x32, 0x02, 0x3f, 0x27, 0xc6, 0x7e, 0x10, 0x10, 0xee,
+	0x0f, 0x8c, 0x90, 0xd1, 0xb4, 0xe2, 0xbd, 0xaf, 0x00, 0x36, 0xcc, 0xb2, 0x1b, 0x17, 0x77, 0xcb,
+	0x4d, 0x28, 0xd2, 0x48, 0x01, 0x2a, 0xd0, 0x9e, 0x38, 0x22, 0x8d, 0xe4, 0x11, 0x94, 0x0a, 0x5b,
+	0x11, 0x55, 0xa0, 0x35, 0x86, 0x1d, 0xbd, 0x2e, 0x2f, 0xea, 0xf4, 0xba, 0x4e, 0x9f, 0x30, 0x9a,
+	0x9a, 0xd2, 0xe9, 0xe7, 0x73, 0xc1, 0x29, 0x65, 0x79, 0x08, 0x1f, 0xf9, 0x51, 0x94, 0x91, 0x3c,
+	0x57, 0x1e, 0xa8, 0x40, 0x7b, 0x6c, 0x2a, 0xdf, 0xbf, 0xf5, 0xdb, 0xf5, 0xd1, 0x71, 0x45, 0x5c,
+	0x9e, 0xd1, 0x34, 0x76, 0x6e, 0xa2, 0xac, 0xc1, 0x16, 0xe1, 0x89, 0x17, 0xac, 0x59, 0xf8, 0xc9,
+	0x4b, 0x08, 0x8d, 0x13, 0xae, 0x48, 0x2a, 0xd0, 0x24, 0xa7, 0x49, 0x78, 0x62, 0x16, 0xf1, 0xdb,
+	0x32, 0x7d, 0xf5, 0x19, 0xc0, 0xa7, 0x77, 0x4f, 0x76, 0xb9, 0xcf, 0x77, 0xb9, 0xfc, 0x02, 0xaa,
+	0xa6, 0x33, 0xb3, 0xa6, 0xd8, 0xc3, 0x1f, 0xb0, 0xbd, 0xf0, 0xdc, 0xc5, 0x78, 0xb1, 0x74, 0xbd,
+	0xa5, 0xed, 0xe0, 0xc9, 0x7c, 0x6a, 0xcf, 0x56, 0xd8, 0x6a, 0x09, 0x72, 0x0f, 0xa2, 0x7f, 0x59,
+	0x77, 0x0e, 0xf8, 0x5f, 0xd3, 0x78, 0xf2, 0xce, 0x9e, 0x7f, 0x7c, 0x8f, 0xad, 0x29, 0xb6, 0x5a,
+	0xa2, 0xe9, 0x9c, 0x2e, 0x08, 0x9c, 0x2f, 0x08, 0xfc, 0xba, 0x20, 0xf0, 0xe5, 0x8a, 0x84, 0xf3,
+	0x15, 0x09, 0x3f, 0xae, 0x48, 0x58, 0xbd, 0x89, 0x29, 0x4f, 0x76, 0x81, 0x1e, 0xb2, 0x8d, 0xf1,
+	0xd7, 0xae, 0xf7, 0xaf, 0xfb, 0x61, 0xe2, 0xd3, 0xd4, 0xf8, 0x93, 0x1c, 0x6e, 0xfb, 0xe7, 0xc7,
+	0x2d, 0xc9, 0x83, 0x87, 0x25, 0x18, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x69, 0x0e, 0xa9,
+	0x23, 0x02, 0x00, 0x00,
 }
 
 func (m *BridgeEvent) Marshal() (dAtA []byte, err error) {
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -79,8 +81,59 @@ func (m *BridgeEventInfo) GetEthBlockHeight() uint64 {
 	return 0
 }
 
+// HourlyAcknowledgedAmount stores the total amount of bridge events
+// acknowledged in an hour.
+type HourlyAcknowledgedAmount struct {
+	// The hour (in hours since unix epoch) of block time at which bridge events
+	// were acknowledged.
+	Hour uint32 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The total amount of bridge events acknowledged in above hour.
+	Amount github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"amount"`
+}
+
+func (m *HourlyAcknowledgedAmount) Reset()         { *m = HourlyAcknowledgedAmount{} }
+func (m *HourlyAcknowledgedAmount) String() string { return proto.CompactTextString(m) }
+func (*HourlyAcknowledgedAmount) ProtoMessage()    {}
+func (*HourlyAcknowledgedAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca20c815789f7707, []int{1}
+}
+func (m *HourlyAcknowledgedAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HourlyAcknowledgedAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HourlyAcknowledgedAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HourlyAcknowledgedAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HourlyAcknowledgedAmount.Merge(m, src)
+}
+func (m *HourlyAcknowledgedAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *HourlyAcknowledgedAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_HourlyAcknowledgedAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HourlyAcknowledgedAmount proto.InternalMessageInfo
+
+func (m *HourlyAcknowledgedAmount) GetHour() uint32 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BridgeEventInfo)(nil), "dydxprotocol.bridge.BridgeEventInfo")
+	proto.RegisterType((*HourlyAcknowledgedAmount)(nil), "dydxprotocol.bridge.HourlyAcknowledgedAmount")
 }
 
 func init() {
@@ -88,20 +141,26 @@ func init() {
 }
 
 var fileDescriptor_ca20c815789f7707 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0x85,
-	0x52, 0xf1, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0xf1, 0x99, 0x79, 0x69, 0xf9, 0x7a, 0x60, 0x15, 0x42,
-	0xc2, 0xc8, 0x8a, 0xf5, 0x20, 0xaa, 0x94, 0x42, 0xb8, 0xf8, 0x9d, 0xc0, 0x2c, 0x57, 0x90, 0x72,
-	0xcf, 0xbc, 0xb4, 0x7c, 0x21, 0x71, 0x2e, 0xf6, 0xbc, 0xd4, 0x8a, 0x92, 0xf8, 0xcc, 0x14, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0xde, 0x20, 0x36, 0x10, 0xd7, 0x33, 0x45, 0x48, 0x83, 0x4b, 0x20, 0xb5,
-	0x24, 0x23, 0x3e, 0x29, 0x27, 0x3f, 0x39, 0x3b, 0x3e, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82,
-	0x49, 0x81, 0x51, 0x83, 0x25, 0x88, 0x2f, 0xb5, 0x24, 0xc3, 0x09, 0x24, 0xec, 0x01, 0x16, 0x75,
-	0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x14, 0xc7, 0x97, 0x99, 0xe8, 0x26, 0x67, 0x24,
-	0x66, 0xe6, 0xe9, 0xc3, 0x45, 0x2a, 0x60, 0x1e, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x4b, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf4, 0xfc, 0xef, 0xf4, 0x00, 0x00, 0x00,
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x73, 0x52, 0x2a, 0x1c, 0xfe, 0x22, 0x0a, 0x16, 0x87, 0x6b, 0xe9, 0x54, 0x10, 0x93,
+	0x41, 0x07, 0x47, 0x1b, 0x10, 0xda, 0x35, 0x3a, 0xb9, 0xc4, 0x24, 0xf7, 0x7a, 0x77, 0xf4, 0x7a,
+	0xaf, 0xc4, 0x4b, 0x6d, 0xfd, 0x2b, 0xfa, 0x67, 0x75, 0xec, 0x28, 0x0e, 0x45, 0xda, 0x7f, 0x44,
+	0x72, 0xad, 0xa2, 0x9b, 0xd3, 0xbd, 0xfb, 0x7e, 0x3f, 0x7c, 0x1e, 0x3c, 0x7a, 0xc9, 0x67, 0x7c,
+	0x3a, 0x2e, 0xd0, 0x62, 0x8e, 0x3a, 0xcc, 0x0a, 0xc5, 0x05, 0xec, 0x9e, 0x04, 0x26, 0x60, 0x6c,
+	0xa2, 0xcc, 0x00, 0x03, 0x47, 0xf8, 0xa7, 0xbf, 0xe1, 0x60, 0x4b, 0x5d, 0x9c, 0x09, 0x14, 0xe8,
+	0xc2, 0xb0, 0x9a, 0xb6, 0x68, 0xfb, 0x91, 0x1e, 0x47, 0xae, 0xbf, 0xaf, 0x24, 0x7d, 0x33, 0x40,
+	0xff, 0x9c, 0xee, 0x1b, 0x98, 0xda, 0x44, 0xf1, 0x06, 0x69, 0x91, 0xce, 0x61, 0x5c, 0xaf, 0xbe,
+	0x7d, 0xee, 0x77, 0xe8, 0x09, 0x58, 0x99, 0x64, 0x1a, 0xf3, 0x61, 0x22, 0x41, 0x09, 0x69, 0x1b,
+	0x7b, 0x2d, 0xd2, 0xa9, 0xc5, 0x47, 0x60, 0x65, 0x54, 0xc5, 0x3d, 0x97, 0xb6, 0xe7, 0x84, 0x36,
+	0x7a, 0x58, 0x16, 0x7a, 0xd6, 0xcd, 0x87, 0x06, 0x5f, 0x35, 0x70, 0x01, 0xbc, 0x3b, 0xc2, 0xd2,
+	0x58, 0xdf, 0xa7, 0x35, 0x89, 0x65, 0xb1, 0x93, 0xbb, 0xd9, 0x7f, 0xa6, 0xf5, 0xd4, 0xb5, 0x4e,
+	0x78, 0x10, 0xf5, 0x16, 0xab, 0xa6, 0xf7, 0xb1, 0x6a, 0xde, 0x09, 0x65, 0x65, 0x99, 0x05, 0x39,
+	0x8e, 0xc2, 0x3f, 0x17, 0x98, 0xdc, 0x5c, 0xe5, 0x32, 0x55, 0x26, 0xfc, 0x49, 0xb8, 0x9d, 0x8d,
+	0xe1, 0x25, 0x78, 0x80, 0x42, 0xa5, 0x5a, 0xbd, 0xa5, 0x99, 0x86, 0xbe, 0xb1, 0xf1, 0xce, 0x1b,
+	0xc5, 0x8b, 0x35, 0x23, 0xcb, 0x35, 0x23, 0x9f, 0x6b, 0x46, 0xe6, 0x1b, 0xe6, 0x2d, 0x37, 0xcc,
+	0x7b, 0xdf, 0x30, 0xef, 0xe9, 0xf6, 0xff, 0x3b, 0xa6, 0xdf, 0x97, 0x77, 0xbb, 0xb2, 0xba, 0x2b,
+	0xae, 0xbf, 0x06, 0x00, 0xef, 0x9f, 0x05, 0x19, 0x9d, 0x01, 0x00, 0x00,
 }
 
 func (m *BridgeEventInfo) Marshal() (dAtA []byte, err error) {
@@ -137,6 +196,44 @@ func (m *BridgeEventInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HourlyAcknowledgedAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HourlyAcknowledgedAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HourlyAcknowledgedAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgeEventInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Hour != 0 {
+		i = encodeVarintBridgeEventInfo(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBridgeEventInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBridgeEventInfo(v)
 	base := offset
@@ -163,6 +260,20 @@ func (m *BridgeEventInfo) Size() (n int) {
 	return n
 }
 
+func (m *HourlyAcknowledgedAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hour != 0 {
+		n += 1 + sovBridgeEventInfo(uint64(m.Hour))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridgeEventInfo(uint64(l))
+	return n
+}
+
 func sovBridgeEventInfo(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -257,6 +368,108 @@ func (m *BridgeEventInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HourlyAcknowledgedAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeEventInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HourlyAcknowledgedAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HourlyAcknowledgedAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeEventInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeEventInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBridgeEventInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeEventInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeEventInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeEventInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBridgeEventInfo(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -112,3 +112,70 @@ func TestBridgeEvent_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestBridgeEvent_GetMismatchedFields(t *testing.T) {
+	event := types.BridgeEvent{
+		Id:             10,
+		Coin:           sdk.NewCoin("test", sdkmath.NewInt(171)),
+		Address:        "address",
+		EthBlockHeight: 1280,
+	}
+	tests := map[string]struct {
+		other    types.BridgeEvent
+		expected []string
+	}{
+		"Equal": {
+			other:    event,
+			expected: []string{},
+		},
+		"Id not equal": {
+			other: types.BridgeEvent{
+				Id:             11,
+				Coin:           event.Coin,
+				Address:        event.Address,
+				EthBlockHeight: event.EthBlockHeight,
+			},
+			expected: []string{"id: 10 != 11"},
+		},
+		"Coin not equal": {
+			other: types.BridgeEvent{
+				Id:             event.Id,
+				Coin:           sdk.NewCoin("test2", sdkmath.NewInt(1711)),
+				Address:        event.Address,
+				EthBlockHeight: event.EthBlockHeight,
+			},
+			expected: []string{"coin: 171test != 1711test2"},
+		},
+		"Address and eth block height not equal": {
+			other: types.BridgeEvent{
+				Id:             event.Id,
+				Coin:           event.Coin,
+				Address:        "address1",
+				EthBlockHeight: 1281,
+			},
+			expected: []string{
+				"address: address != address1",
+				"eth_block_height: 1280 != 1281",
+			},
+		},
+		"All fields not equal": {
+			other: types.BridgeEvent{
+				Id:             11,
+				Coin:           sdk.NewCoin("test", sdkmath.NewInt(172)),
+				Address:        "address1",
+				EthBlockHeight: 1281,
+			},
+			expected: []string{
+				"id: 10 != 11",
+				"coin: 171test != 172test",
+				"address: address != address1",
+				"eth_block_height: 1280 != 1281",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, event.GetMismatchedFields(tc.other))
+		})
+	}
+}
@@ -41,6 +41,16 @@ var (
 		7,
 		"Invalid Ethereum address",
 	)
+	ErrBridgeDailyCapExceeded = errorsmod.Register(
+		ModuleName,
+		8,
+		"Bridge daily acknowledged amount cap exceeded",
+	)
+	ErrBridgeEventTooRecent = errorsmod.Register(
+		ModuleName,
+		9,
+		"Bridge event was recognized too recently to be acknowledged",
+	)
 
 	ErrNegativeDuration = errorsmod.Register(
 		ModuleName,
@@ -57,4 +67,9 @@ var (
 		402,
 		"Bridging is disabled",
 	)
+	ErrNegativeAmountCap = errorsmod.Register(
+		ModuleName,
+		403,
+		"Amount cap is negative",
+	)
 )
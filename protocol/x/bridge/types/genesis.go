@@ -2,6 +2,8 @@ package types
 
 import (
 	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 )
 
 // DefaultGenesis returns the default bridge genesis state.
@@ -21,6 +23,8 @@ func DefaultGenesis() *GenesisState {
 		SafetyParams: SafetyParams{
 			IsDisabled:  false,
 			DelayBlocks: 86_400, // Seconds in a day
+			// No daily acknowledged amount cap.
+			DailyAcknowledgedAmountCap: dtypes.NewInt(0),
 		},
 		AcknowledgedEventInfo: BridgeEventInfo{
 			NextId:         0,
@@ -22,4 +22,8 @@ const (
 
 	// SafetyParamsKey defines the key for the SafetyParams
 	SafetyParamsKey = "SafetyParams"
+
+	// HourlyAcknowledgedAmountKeyPrefix is the prefix to retrieve the HourlyAcknowledgedAmount
+	// of each hour, keyed by hour.
+	HourlyAcknowledgedAmountKeyPrefix = "HourlyAckAmount:"
 )
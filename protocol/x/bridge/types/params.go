@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
@@ -28,5 +30,38 @@ func (m *ProposeParams) Validate() error {
 }
 
 func (m *SafetyParams) Validate() error {
+	if !m.DailyAcknowledgedAmountCap.IsNil() && m.DailyAcknowledgedAmountCap.Sign() < 0 {
+		return ErrNegativeAmountCap
+	}
 	return nil
 }
+
+// IsAcknowledgementDelayPassed returns whether a bridge event recognized after the block at
+// `recognizedBlockHeight` can be acknowledged in the block at `blockHeight`, i.e. whether more
+// than `acknowledgement_delay_blocks` blocks have passed since recognition. An event recognized
+// before any block was processed (`recognizedBlockHeight` of zero) can't be acknowledged if
+// there is a delay.
+func (m *SafetyParams) IsAcknowledgementDelayPassed(recognizedBlockHeight uint32, blockHeight uint32) bool {
+	if m.AcknowledgementDelayBlocks == 0 {
+		return true
+	}
+	if recognizedBlockHeight == 0 {
+		return false
+	}
+	return uint64(blockHeight) > uint64(recognizedBlockHeight)+uint64(m.AcknowledgementDelayBlocks)
+}
+
+// HasDailyAcknowledgedAmountCap returns whether the daily acknowledged amount cap is set.
+func (m *SafetyParams) HasDailyAcknowledgedAmountCap() bool {
+	return !m.DailyAcknowledgedAmountCap.IsNil() && m.DailyAcknowledgedAmountCap.Sign() > 0
+}
+
+// ExceedsDailyAcknowledgedAmountCap returns whether the given total amount acknowledged
+// in a rolling 24 hour window exceeds the daily acknowledged amount cap. Always false if
+// there is no cap.
+func (m *SafetyParams) ExceedsDailyAcknowledgedAmountCap(amount *big.Int) bool {
+	if !m.HasDailyAcknowledgedAmountCap() {
+		return false
+	}
+	return amount.Cmp(m.DailyAcknowledgedAmountCap.BigInt()) > 0
+}
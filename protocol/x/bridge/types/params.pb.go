@@ -9,6 +9,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -180,6 +181,14 @@ type SafetyParams struct {
 	// The number of blocks that bridges accepted in-consensus will be pending
 	// until the minted tokens are granted.
 	DelayBlocks uint32 `protobuf:"varint,2,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	// The maximum total amount of bridge events that can be acknowledged in a
+	// rolling 24 hour window (by block time, at hourly granularity). Zero means
+	// no cap.
+	DailyAcknowledgedAmountCap github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=daily_acknowledged_amount_cap,json=dailyAcknowledgedAmountCap,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"daily_acknowledged_amount_cap"`
+	// The number of blocks after a bridge event is recognized (by a node's
+	// bridge daemon) until it can be acknowledged, during which a bad bridge
+	// can be detected. Zero means no delay.
+	AcknowledgementDelayBlocks uint32 `protobuf:"varint,4,opt,name=acknowledgement_delay_blocks,json=acknowledgementDelayBlocks,proto3" json:"acknowledgement_delay_blocks,omitempty"`
 }
 
 func (m *SafetyParams) Reset()         { *m = SafetyParams{} }
@@ -229,6 +238,13 @@ func (m *SafetyParams) GetDelayBlocks() uint32 {
 	return 0
 }
 
+func (m *SafetyParams) GetAcknowledgementDelayBlocks() uint32 {
+	if m != nil {
+		return m.AcknowledgementDelayBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventParams)(nil), "dydxprotocol.bridge.EventParams")
 	proto.RegisterType((*ProposeParams)(nil), "dydxprotocol.bridge.ProposeParams")
@@ -238,35 +254,41 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/bridge/params.proto", fileDescriptor_29afb5e8a05168cd) }
 
 var fileDescriptor_29afb5e8a05168cd = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x92, 0xd3, 0x3c,
-	0x14, 0x8d, 0xf7, 0xdb, 0x8f, 0x59, 0xe4, 0xb8, 0x11, 0x81, 0x09, 0x3b, 0x8c, 0x93, 0x4d, 0xb5,
-	0x0d, 0xf6, 0xf0, 0x53, 0xd0, 0x62, 0x42, 0xb1, 0x5d, 0x46, 0x54, 0xd0, 0x68, 0xe4, 0xe8, 0xda,
-	0xd1, 0xac, 0x1d, 0x69, 0x24, 0x65, 0x27, 0x79, 0x0b, 0x4a, 0xde, 0x82, 0xd7, 0xd8, 0x72, 0x4b,
-	0x2a, 0x60, 0x92, 0x17, 0x61, 0x7c, 0xe5, 0xec, 0x2c, 0x1d, 0x9d, 0x75, 0xce, 0xf1, 0xb9, 0xe7,
-	0x5c, 0x89, 0x4c, 0xe5, 0x4e, 0x6e, 0x8d, 0xd5, 0x5e, 0x2f, 0x75, 0x93, 0x97, 0x56, 0xc9, 0x1a,
-	0x72, 0x23, 0xac, 0x68, 0x5d, 0x86, 0x30, 0x7d, 0xf2, 0x50, 0x91, 0x05, 0xc5, 0xf9, 0xa8, 0xd6,
-	0xb5, 0x46, 0x30, 0xef, 0xbe, 0x82, 0xf4, 0x3c, 0xad, 0xb5, 0xae, 0x1b, 0xc8, 0xf1, 0x54, 0x6e,
-	0xaa, 0x5c, 0x6e, 0xac, 0xf0, 0x4a, 0xaf, 0x03, 0x3f, 0xab, 0x48, 0xfc, 0xf1, 0x06, 0xd6, 0x7e,
-	0x81, 0xfe, 0x74, 0x44, 0xfe, 0x97, 0xb0, 0xd6, 0xed, 0x38, 0x9a, 0x46, 0x97, 0x8f, 0x59, 0x38,
-	0xd0, 0x29, 0x19, 0x82, 0x5f, 0xf1, 0xe5, 0x4a, 0xa8, 0x35, 0x57, 0x72, 0x7c, 0x32, 0x8d, 0x2e,
-	0x4f, 0x19, 0x01, 0xbf, 0xfa, 0xd0, 0x41, 0x57, 0x92, 0x4e, 0x48, 0xdc, 0x29, 0x84, 0x94, 0x16,
-	0x9c, 0x1b, 0xff, 0x87, 0x7f, 0x77, 0x82, 0xf7, 0x01, 0x99, 0x7d, 0x3f, 0x21, 0xc9, 0xc2, 0x6a,
-	0xa3, 0x1d, 0xf4, 0xa3, 0x5e, 0x91, 0xa7, 0xad, 0xd8, 0xf2, 0x90, 0xde, 0x71, 0x03, 0x96, 0x97,
-	0x8d, 0x5e, 0x5e, 0xe3, 0xe8, 0x84, 0xd1, 0x56, 0x6c, 0x8b, 0xc0, 0x2d, 0xc0, 0x16, 0x1d, 0x43,
-	0x3f, 0x93, 0x67, 0x26, 0x78, 0x70, 0x09, 0x8d, 0xd8, 0xf1, 0x63, 0x19, 0x4c, 0x14, 0xbf, 0x7e,
-	0x9e, 0x85, 0xb6, 0xd9, 0xb1, 0x6d, 0x36, 0xef, 0x05, 0xc5, 0xd9, 0xed, 0xcf, 0xc9, 0xe0, 0xdb,
-	0xaf, 0x49, 0xc4, 0x46, 0xbd, 0xc5, 0xbc, 0x73, 0x38, 0xf2, 0x74, 0x46, 0x12, 0x77, 0xad, 0x0c,
-	0xb7, 0xc2, 0x03, 0x37, 0xa6, 0xc5, 0x0a, 0x09, 0x8b, 0x3b, 0x90, 0x09, 0x0f, 0x0b, 0xd3, 0xd2,
-	0x86, 0x5c, 0xa0, 0x46, 0x55, 0x21, 0x69, 0x08, 0x01, 0x92, 0x97, 0x0f, 0x92, 0x9c, 0xfe, 0x7b,
-	0x92, 0x17, 0x9d, 0xdb, 0x55, 0x85, 0xdd, 0xe6, 0xc1, 0xaa, 0xb8, 0x4f, 0x34, 0x63, 0x64, 0xf8,
-	0x49, 0x54, 0xe0, 0x77, 0xfd, 0xbe, 0x26, 0x24, 0x56, 0x8e, 0x4b, 0xe5, 0x44, 0xd9, 0x80, 0xc4,
-	0x2d, 0x9d, 0x31, 0xa2, 0xdc, 0xbc, 0x47, 0xe8, 0x05, 0x19, 0x86, 0xad, 0x60, 0x38, 0x87, 0x3b,
-	0x49, 0x58, 0x8c, 0x18, 0xce, 0x70, 0x05, 0xbb, 0xdd, 0xa7, 0xd1, 0xdd, 0x3e, 0x8d, 0x7e, 0xef,
-	0xd3, 0xe8, 0xeb, 0x21, 0x1d, 0xdc, 0x1d, 0xd2, 0xc1, 0x8f, 0x43, 0x3a, 0xf8, 0xf2, 0xae, 0x56,
-	0x7e, 0xb5, 0x29, 0xb3, 0xa5, 0x6e, 0xf3, 0xbf, 0xde, 0xdf, 0xcd, 0xdb, 0x97, 0x78, 0xef, 0xf9,
-	0x3d, 0xb2, 0x3d, 0xbe, 0x49, 0xbf, 0x33, 0xe0, 0xca, 0x47, 0x48, 0xbc, 0xf9, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x2b, 0x28, 0x4e, 0x34, 0xb7, 0x02, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x92, 0xd3, 0x3c,
+	0x14, 0xc5, 0xe3, 0x7c, 0xf9, 0x98, 0x45, 0x4e, 0x1a, 0x11, 0x98, 0x90, 0x59, 0x9c, 0x6c, 0xaa,
+	0x6d, 0xb0, 0x87, 0x3f, 0x05, 0xe5, 0xc6, 0x1b, 0x66, 0x48, 0x97, 0xf1, 0x56, 0xd0, 0x68, 0x64,
+	0x4b, 0x71, 0x34, 0xb1, 0x2d, 0x8d, 0xa5, 0x2c, 0x09, 0xaf, 0x40, 0x43, 0x49, 0xcd, 0x0b, 0xf0,
+	0x1a, 0x5b, 0x6e, 0xc9, 0x50, 0x2c, 0x4c, 0xf2, 0x22, 0x8c, 0xaf, 0x9c, 0x25, 0x4b, 0xb5, 0x9d,
+	0x75, 0xee, 0xf1, 0xbd, 0xbf, 0x73, 0x47, 0x42, 0x43, 0xb6, 0x61, 0x6b, 0x55, 0x4a, 0x23, 0x13,
+	0x99, 0x05, 0x71, 0x29, 0x58, 0xca, 0x03, 0x45, 0x4b, 0x9a, 0x6b, 0x1f, 0x64, 0xfc, 0xe8, 0xd0,
+	0xe1, 0x5b, 0x47, 0xbf, 0x9b, 0xca, 0x54, 0x82, 0x18, 0x54, 0x5f, 0xd6, 0xda, 0xf7, 0x52, 0x29,
+	0xd3, 0x8c, 0x07, 0x70, 0x8a, 0x57, 0xf3, 0x80, 0xad, 0x4a, 0x6a, 0x84, 0x2c, 0x6c, 0x7d, 0x34,
+	0x47, 0xee, 0xdb, 0x4b, 0x5e, 0x98, 0x19, 0xf4, 0xc7, 0x5d, 0xf4, 0x3f, 0xe3, 0x85, 0xcc, 0x7b,
+	0xce, 0xd0, 0x39, 0x7d, 0x18, 0xd9, 0x03, 0x1e, 0xa2, 0x36, 0x37, 0x0b, 0x92, 0x2c, 0xa8, 0x28,
+	0x88, 0x60, 0xbd, 0xe6, 0xd0, 0x39, 0x6d, 0x45, 0x88, 0x9b, 0xc5, 0x79, 0x25, 0x4d, 0x19, 0x1e,
+	0x20, 0xb7, 0x72, 0x50, 0xc6, 0x4a, 0xae, 0x75, 0xef, 0x3f, 0xf8, 0xbb, 0x32, 0x8c, 0xad, 0x32,
+	0xfa, 0xde, 0x44, 0x9d, 0x59, 0x29, 0x95, 0xd4, 0xbc, 0x1e, 0xf5, 0x02, 0x3d, 0xce, 0xe9, 0x9a,
+	0x58, 0x7a, 0x4d, 0x14, 0x2f, 0x49, 0x9c, 0xc9, 0x64, 0x09, 0xa3, 0x3b, 0x11, 0xce, 0xe9, 0x3a,
+	0xb4, 0xb5, 0x19, 0x2f, 0xc3, 0xaa, 0x82, 0xdf, 0xa3, 0x27, 0xca, 0xf6, 0x20, 0x8c, 0x67, 0x74,
+	0x43, 0xf6, 0x61, 0x80, 0xc8, 0x7d, 0xf9, 0xd4, 0xb7, 0x69, 0xfd, 0x7d, 0x5a, 0x7f, 0x52, 0x1b,
+	0xc2, 0xa3, 0xab, 0x9b, 0x41, 0xe3, 0xeb, 0xaf, 0x81, 0x13, 0x75, 0xeb, 0x16, 0x93, 0xaa, 0xc3,
+	0xbe, 0x8e, 0x47, 0xa8, 0xa3, 0x97, 0x42, 0x91, 0x92, 0x1a, 0x4e, 0x94, 0xca, 0x21, 0x42, 0x27,
+	0x72, 0x2b, 0x31, 0xa2, 0x86, 0xcf, 0x54, 0x8e, 0x33, 0x74, 0x02, 0x1e, 0x31, 0xb7, 0xa4, 0x16,
+	0x82, 0x33, 0x12, 0x1f, 0x90, 0xb4, 0xee, 0x4f, 0x72, 0x5c, 0x75, 0x9b, 0xce, 0x21, 0xdb, 0xc4,
+	0xb6, 0x0a, 0x6f, 0x89, 0x46, 0xdf, 0x9a, 0xa8, 0x7d, 0x41, 0xe7, 0xdc, 0x6c, 0xea, 0x85, 0x0d,
+	0x90, 0x2b, 0x34, 0x61, 0x42, 0xd3, 0x38, 0xe3, 0x0c, 0xd6, 0x74, 0x14, 0x21, 0xa1, 0x27, 0xb5,
+	0x82, 0x4f, 0x50, 0xdb, 0xae, 0x05, 0xe8, 0x34, 0x2c, 0xa5, 0x13, 0xb9, 0xa0, 0xc1, 0x10, 0x8d,
+	0x3f, 0x3b, 0xe8, 0x19, 0xa3, 0x22, 0xdb, 0x10, 0x9a, 0x2c, 0x0b, 0xf9, 0x31, 0xe3, 0x2c, 0xe5,
+	0x8c, 0xd0, 0x5c, 0xae, 0x0a, 0x43, 0x12, 0xaa, 0x20, 0x77, 0x3b, 0x7c, 0x57, 0x41, 0xfe, 0xbc,
+	0x19, 0x9c, 0xa5, 0xc2, 0x2c, 0x56, 0xb1, 0x9f, 0xc8, 0x3c, 0xb8, 0x73, 0x2d, 0x2f, 0x5f, 0x3f,
+	0x87, 0xeb, 0x10, 0xdc, 0x2a, 0xcc, 0x6c, 0x14, 0xd7, 0xfe, 0x05, 0x2f, 0x05, 0xcd, 0xc4, 0xa7,
+	0x0a, 0x69, 0x5a, 0x98, 0xa8, 0x0f, 0xe3, 0xc6, 0x07, 0xd3, 0xc6, 0x30, 0xec, 0x9c, 0x2a, 0x7c,
+	0x86, 0x8e, 0x0f, 0x30, 0x72, 0x5e, 0x18, 0x72, 0x27, 0x40, 0x0b, 0x02, 0xf4, 0xff, 0xf1, 0x4c,
+	0xfe, 0xe6, 0x09, 0xa3, 0xab, 0xad, 0xe7, 0x5c, 0x6f, 0x3d, 0xe7, 0xf7, 0xd6, 0x73, 0xbe, 0xec,
+	0xbc, 0xc6, 0xf5, 0xce, 0x6b, 0xfc, 0xd8, 0x79, 0x8d, 0x0f, 0x6f, 0xee, 0x4f, 0xbe, 0xde, 0x3f,
+	0x32, 0x48, 0x10, 0x3f, 0x80, 0xc2, 0xab, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x4c, 0xe2,
+	0x39, 0x88, 0x03, 0x00, 0x00,
 }
 
 func (m *EventParams) Marshal() (dAtA []byte, err error) {
@@ -380,6 +402,21 @@ func (m *SafetyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AcknowledgementDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AcknowledgementDelayBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DailyAcknowledgedAmountCap.Size()
+		i -= size
+		if _, err := m.DailyAcknowledgedAmountCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.DelayBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DelayBlocks))
 		i--
@@ -460,6 +497,11 @@ func (m *SafetyParams) Size() (n int) {
 	if m.DelayBlocks != 0 {
 		n += 1 + sovParams(uint64(m.DelayBlocks))
 	}
+	l = m.DailyAcknowledgedAmountCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.AcknowledgementDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AcknowledgementDelayBlocks))
+	}
 	return n
 }
 
@@ -824,6 +866,58 @@ func (m *SafetyParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyAcknowledgedAmountCap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyAcknowledgedAmountCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementDelayBlocks", wireType)
+			}
+			m.AcknowledgementDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgementDelayBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
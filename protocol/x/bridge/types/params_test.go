@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	"github.com/stretchr/testify/require"
@@ -103,6 +104,18 @@ func TestSafetyParams_Validate(t *testing.T) {
 			params: &types.SafetyParams{},
 			err:    nil,
 		},
+		"positive daily acknowledged amount cap is valid": {
+			params: &types.SafetyParams{
+				DailyAcknowledgedAmountCap: dtypes.NewInt(1_000),
+			},
+			err: nil,
+		},
+		"negative daily acknowledged amount cap": {
+			params: &types.SafetyParams{
+				DailyAcknowledgedAmountCap: dtypes.NewInt(-1),
+			},
+			err: types.ErrNegativeAmountCap,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -115,3 +128,55 @@ func TestSafetyParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSafetyParams_IsAcknowledgementDelayPassed(t *testing.T) {
+	tests := map[string]struct {
+		ackDelayBlocks        uint32
+		recognizedBlockHeight uint32
+		blockHeight           uint32
+		expected              bool
+	}{
+		"no delay": {
+			ackDelayBlocks:        0,
+			recognizedBlockHeight: 10,
+			blockHeight:           10,
+			expected:              true,
+		},
+		"no delay and recognized before any block": {
+			ackDelayBlocks:        0,
+			recognizedBlockHeight: 0,
+			blockHeight:           1,
+			expected:              true,
+		},
+		"more than delay blocks passed": {
+			ackDelayBlocks:        5,
+			recognizedBlockHeight: 10,
+			blockHeight:           16,
+			expected:              true,
+		},
+		"exactly delay blocks passed": {
+			ackDelayBlocks:        5,
+			recognizedBlockHeight: 10,
+			blockHeight:           15,
+			expected:              false,
+		},
+		"recognized before any block": {
+			ackDelayBlocks:        5,
+			recognizedBlockHeight: 0,
+			blockHeight:           100,
+			expected:              false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.SafetyParams{
+				AcknowledgementDelayBlocks: tc.ackDelayBlocks,
+			}
+			require.Equal(
+				t,
+				tc.expected,
+				params.IsAcknowledgementDelayPassed(tc.recognizedBlockHeight, tc.blockHeight),
+			)
+		})
+	}
+}
@@ -444,6 +444,106 @@ func (m *QueryRecognizedEventInfoResponse) GetInfo() BridgeEventInfo {
 	return BridgeEventInfo{}
 }
 
+// QueryBridgeEventRequest is a request type for the BridgeEvent RPC method.
+type QueryBridgeEventRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryBridgeEventRequest) Reset()         { *m = QueryBridgeEventRequest{} }
+func (m *QueryBridgeEventRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeEventRequest) ProtoMessage()    {}
+func (*QueryBridgeEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{10}
+}
+func (m *QueryBridgeEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeEventRequest.Merge(m, src)
+}
+func (m *QueryBridgeEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeEventRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeEventRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryBridgeEventResponse is a response type for the BridgeEvent RPC method.
+type QueryBridgeEventResponse struct {
+	// The bridge event, which is empty if the queried node doesn't have the
+	// event in memory.
+	Event  BridgeEvent       `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+	Status BridgeEventStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dydxprotocol.bridge.BridgeEventStatus" json:"status,omitempty"`
+}
+
+func (m *QueryBridgeEventResponse) Reset()         { *m = QueryBridgeEventResponse{} }
+func (m *QueryBridgeEventResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeEventResponse) ProtoMessage()    {}
+func (*QueryBridgeEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ca11b6b8f7f939, []int{11}
+}
+func (m *QueryBridgeEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeEventResponse.Merge(m, src)
+}
+func (m *QueryBridgeEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeEventResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeEventResponse) GetEvent() BridgeEvent {
+	if m != nil {
+		return m.Event
+	}
+	return BridgeEvent{}
+}
+
+func (m *QueryBridgeEventResponse) GetStatus() BridgeEventStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BridgeEventStatus_BRIDGE_EVENT_STATUS_UNRECOGNIZED
+}
+
 // QueryDelayedCompleteBridgeMessagesRequest is a request type for the
 // DelayedCompleteBridgeMessages RPC method.
 type QueryDelayedCompleteBridgeMessagesRequest struct {
@@ -458,7 +558,7 @@ func (m *QueryDelayedCompleteBridgeMessagesRequest) String() string {
 }
 func (*QueryDelayedCompleteBridgeMessagesRequest) ProtoMessage() {}
 func (*QueryDelayedCompleteBridgeMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{10}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{12}
 }
 func (m *QueryDelayedCompleteBridgeMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +608,7 @@ func (m *QueryDelayedCompleteBridgeMessagesResponse) String() string {
 }
 func (*QueryDelayedCompleteBridgeMessagesResponse) ProtoMessage() {}
 func (*QueryDelayedCompleteBridgeMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{11}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{13}
 }
 func (m *QueryDelayedCompleteBridgeMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +656,7 @@ func (m *DelayedCompleteBridgeMessage) Reset()         { *m = DelayedCompleteBri
 func (m *DelayedCompleteBridgeMessage) String() string { return proto.CompactTextString(m) }
 func (*DelayedCompleteBridgeMessage) ProtoMessage()    {}
 func (*DelayedCompleteBridgeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b4ca11b6b8f7f939, []int{12}
+	return fileDescriptor_b4ca11b6b8f7f939, []int{14}
 }
 func (m *DelayedCompleteBridgeMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,6 +710,8 @@ func init() {
 	proto.RegisterType((*QueryAcknowledgedEventInfoResponse)(nil), "dydxprotocol.bridge.QueryAcknowledgedEventInfoResponse")
 	proto.RegisterType((*QueryRecognizedEventInfoRequest)(nil), "dydxprotocol.bridge.QueryRecognizedEventInfoRequest")
 	proto.RegisterType((*QueryRecognizedEventInfoResponse)(nil), "dydxprotocol.bridge.QueryRecognizedEventInfoResponse")
+	proto.RegisterType((*QueryBridgeEventRequest)(nil), "dydxprotocol.bridge.QueryBridgeEventRequest")
+	proto.RegisterType((*QueryBridgeEventResponse)(nil), "dydxprotocol.bridge.QueryBridgeEventResponse")
 	proto.RegisterType((*QueryDelayedCompleteBridgeMessagesRequest)(nil), "dydxprotocol.bridge.QueryDelayedCompleteBridgeMessagesRequest")
 	proto.RegisterType((*QueryDelayedCompleteBridgeMessagesResponse)(nil), "dydxprotocol.bridge.QueryDelayedCompleteBridgeMessagesResponse")
 	proto.RegisterType((*DelayedCompleteBridgeMessage)(nil), "dydxprotocol.bridge.DelayedCompleteBridgeMessage")
@@ -618,54 +720,60 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/bridge/query.proto", fileDescriptor_b4ca11b6b8f7f939) }
 
 var fileDescriptor_b4ca11b6b8f7f939 = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xc1, 0x53, 0xd3, 0x40,
-	0x14, 0xc6, 0xbb, 0x88, 0x80, 0xaf, 0x70, 0x59, 0x74, 0x2c, 0x11, 0x4b, 0x1b, 0x11, 0xab, 0xd2,
-	0x64, 0x40, 0x10, 0xc6, 0x03, 0x20, 0x8a, 0xa3, 0x07, 0x66, 0xb0, 0xdc, 0x18, 0xc7, 0x4e, 0x9a,
-	0x2c, 0x69, 0x86, 0x36, 0x1b, 0x92, 0x80, 0xd4, 0x9b, 0xde, 0xbc, 0x39, 0xe3, 0xc5, 0x83, 0x07,
-	0xff, 0x0d, 0x8f, 0xde, 0xf0, 0xc6, 0x8c, 0x17, 0x4f, 0x8e, 0xd3, 0xfa, 0x87, 0x38, 0xdd, 0x6c,
-	0x3a, 0x49, 0xdd, 0x64, 0x8a, 0xe3, 0x29, 0xb0, 0xfb, 0xbe, 0xf7, 0xfd, 0xde, 0x86, 0xfd, 0x08,
-	0xcc, 0x18, 0x2d, 0xe3, 0xc4, 0x71, 0xa9, 0x4f, 0x75, 0xda, 0x50, 0x6b, 0xae, 0x65, 0x98, 0x44,
-	0x3d, 0x3c, 0x22, 0x6e, 0x4b, 0x61, 0xab, 0x78, 0x32, 0x5a, 0xa0, 0x04, 0x05, 0xd2, 0x65, 0x93,
-	0x9a, 0x94, 0x2d, 0xaa, 0xdd, 0x9f, 0x82, 0x52, 0x69, 0xda, 0xa4, 0xd4, 0x6c, 0x10, 0x55, 0x73,
-	0x2c, 0x55, 0xb3, 0x6d, 0xea, 0x6b, 0xbe, 0x45, 0x6d, 0x8f, 0xef, 0xde, 0x15, 0x39, 0x05, 0x8f,
-	0x2a, 0x39, 0x26, 0xb6, 0x5f, 0xb5, 0xec, 0xfd, 0xb0, 0x55, 0x41, 0x54, 0xec, 0x68, 0xae, 0xd6,
-	0x0c, 0xdb, 0x4d, 0x8b, 0x2a, 0xfc, 0x93, 0x60, 0x57, 0x9e, 0x82, 0xab, 0xcf, 0xbb, 0x43, 0x6c,
-	0x75, 0x1b, 0xef, 0x30, 0x5d, 0x85, 0x1c, 0x1e, 0x11, 0xcf, 0x97, 0xf7, 0x20, 0xf7, 0xf7, 0x96,
-	0xe7, 0x50, 0xdb, 0x23, 0x78, 0x0d, 0x46, 0x02, 0x93, 0x1c, 0x2a, 0xa0, 0x52, 0x76, 0xb1, 0xa0,
-	0x08, 0xa6, 0x57, 0x22, 0xca, 0xcd, 0xe1, 0xd3, 0x9f, 0x33, 0x99, 0x0a, 0x57, 0xc9, 0xd7, 0x60,
-	0x8a, 0xf5, 0xde, 0x71, 0xa9, 0x43, 0x3d, 0x12, 0x37, 0x7e, 0x09, 0x92, 0x68, 0x93, 0x5b, 0x6f,
-	0xf4, 0x59, 0xcb, 0x42, 0xeb, 0x98, 0xb6, 0xcf, 0x5c, 0xe2, 0x83, 0xed, 0x6a, 0xfb, 0xc4, 0x6f,
-	0xc5, 0xbd, 0x5f, 0x70, 0xb0, 0xf8, 0x1e, 0xb7, 0x5e, 0xef, 0xb3, 0x2e, 0x0a, 0xad, 0xa3, 0xd2,
-	0x3e, 0xe7, 0x1b, 0x50, 0x64, 0xdd, 0x1f, 0xea, 0x07, 0x36, 0x7d, 0xd5, 0x20, 0x86, 0x49, 0x0c,
-	0x76, 0x48, 0xcf, 0xec, 0x7d, 0x1a, 0x22, 0x18, 0x20, 0xa7, 0x15, 0xf5, 0xde, 0xc0, 0x70, 0xf7,
-	0xcf, 0x80, 0x93, 0xcc, 0x0a, 0x49, 0x36, 0xd9, 0xa3, 0xa7, 0xe5, 0x30, 0x4c, 0x27, 0x17, 0x61,
-	0x86, 0xb9, 0x54, 0x88, 0x4e, 0x4d, 0xdb, 0x7a, 0x2d, 0x00, 0xa9, 0x41, 0x21, 0xb9, 0xe4, 0x3f,
-	0x61, 0x6c, 0xc1, 0x6d, 0xe6, 0xf1, 0x98, 0x34, 0xb4, 0x16, 0x31, 0x1e, 0xd1, 0xa6, 0xd3, 0x20,
-	0x3e, 0x09, 0x24, 0xdb, 0xc4, 0xf3, 0x34, 0x93, 0x84, 0x2f, 0x07, 0xe7, 0x60, 0x54, 0x33, 0x0c,
-	0x97, 0x78, 0xc1, 0x0b, 0xb8, 0x54, 0x09, 0x7f, 0x95, 0xdf, 0x20, 0xb8, 0x33, 0x48, 0x1f, 0x4e,
-	0xbd, 0x0b, 0x63, 0x4d, 0xbe, 0x96, 0x43, 0x85, 0x0b, 0xa5, 0xec, 0xe2, 0x82, 0x90, 0x3c, 0xad,
-	0x1b, 0x1f, 0xa3, 0xd7, 0x48, 0x7e, 0x87, 0x60, 0x3a, 0x4d, 0x80, 0x9f, 0xc0, 0x28, 0x2f, 0xe6,
-	0xc7, 0x35, 0x27, 0x34, 0xdd, 0xf6, 0xcc, 0xb8, 0x9e, 0x3b, 0x85, 0x62, 0x5c, 0x84, 0xf1, 0x5a,
-	0x83, 0xea, 0x07, 0xd5, 0x3a, 0xb1, 0xcc, 0xba, 0x9f, 0x1b, 0x2a, 0xa0, 0xd2, 0x44, 0x25, 0xcb,
-	0xd6, 0x9e, 0xb2, 0xa5, 0xc5, 0x6f, 0x63, 0x70, 0x91, 0x9d, 0x07, 0xfe, 0x88, 0x20, 0x1b, 0xb9,
-	0x87, 0x78, 0x5e, 0xe8, 0x99, 0x90, 0x01, 0x52, 0x79, 0xc0, 0xea, 0xe0, 0x5c, 0xe5, 0xf9, 0xb7,
-	0xdf, 0x7f, 0x7f, 0x18, 0x9a, 0xc3, 0xb3, 0x6a, 0x2c, 0x74, 0x8e, 0x97, 0xc2, 0xdc, 0x09, 0xf2,
-	0x2b, 0xb8, 0x0d, 0xf8, 0x33, 0x82, 0x89, 0xd8, 0x3d, 0xc5, 0x4a, 0xb2, 0x9d, 0x28, 0x29, 0x24,
-	0x75, 0xe0, 0x7a, 0x0e, 0xa8, 0x30, 0xc0, 0x12, 0x9e, 0x4b, 0x02, 0x74, 0x02, 0x59, 0x88, 0xf8,
-	0x09, 0xc1, 0x78, 0xf4, 0x3e, 0xe3, 0x94, 0x03, 0x11, 0xc4, 0x89, 0xa4, 0x0c, 0x5a, 0xce, 0xf9,
-	0xca, 0x8c, 0xef, 0x16, 0xbe, 0x99, 0xc4, 0xe7, 0x31, 0x55, 0x88, 0xf7, 0x15, 0xc1, 0x15, 0x61,
-	0x4c, 0xe0, 0xfb, 0xc9, 0xc6, 0x69, 0xe1, 0x23, 0xad, 0x9c, 0x5b, 0xc7, 0xc9, 0x57, 0x18, 0xf9,
-	0x02, 0x56, 0x93, 0xc8, 0xb5, 0x88, 0x3c, 0xf2, 0x7f, 0x0c, 0x7f, 0x41, 0x30, 0x29, 0x48, 0x18,
-	0xbc, 0x94, 0x4c, 0x92, 0x9c, 0x59, 0xd2, 0xf2, 0x39, 0x55, 0x9c, 0x7e, 0x99, 0xd1, 0xab, 0xb8,
-	0x9c, 0x44, 0xef, 0xf6, 0xc4, 0x51, 0xf6, 0x36, 0x82, 0xeb, 0xa9, 0x89, 0x83, 0xd7, 0x92, 0x79,
-	0x06, 0x89, 0x3c, 0x69, 0xfd, 0x9f, 0xf5, 0x7c, 0xb2, 0x0d, 0x36, 0xd9, 0x03, 0xbc, 0x9a, 0x34,
-	0x99, 0x11, 0xb4, 0xa9, 0xea, 0xbc, 0x4f, 0x95, 0x7f, 0x6a, 0x84, 0xb9, 0xb6, 0x59, 0x39, 0x6d,
-	0xe7, 0xd1, 0x59, 0x3b, 0x8f, 0x7e, 0xb5, 0xf3, 0xe8, 0x7d, 0x27, 0x9f, 0x39, 0xeb, 0xe4, 0x33,
-	0x3f, 0x3a, 0xf9, 0xcc, 0xde, 0xaa, 0x69, 0xf9, 0xf5, 0xa3, 0x9a, 0xa2, 0xd3, 0x66, 0x7f, 0xf7,
-	0xb2, 0x5e, 0xd7, 0x2c, 0x5b, 0xed, 0xad, 0x9c, 0xf4, 0xbe, 0x3c, 0x5a, 0x0e, 0xf1, 0x6a, 0x23,
-	0x6c, 0xe3, 0xde, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xca, 0x65, 0x64, 0x56, 0x09, 0x00,
-	0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0xf4, 0xe3, 0xc7, 0xc7, 0x14, 0x38, 0x0c, 0xdf, 0x97, 0xaf, 0xec, 0x87, 0xa5, 0x5d,
+	0xb1, 0x16, 0xa1, 0xbb, 0xa1, 0x82, 0x10, 0x63, 0x00, 0x51, 0x8c, 0x1e, 0x48, 0x70, 0xb9, 0x11,
+	0x63, 0xb3, 0xed, 0x0e, 0xdb, 0x0d, 0xed, 0xce, 0xb2, 0xbb, 0x45, 0xaa, 0xf1, 0xa0, 0x37, 0x6f,
+	0x26, 0x5e, 0x38, 0x68, 0xe2, 0xbf, 0xe1, 0xd1, 0x1b, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0x29, 0x5e,
+	0xfc, 0x2f, 0x4c, 0x67, 0x67, 0x9b, 0xdd, 0x32, 0xbb, 0x14, 0xe3, 0x69, 0x61, 0xe6, 0x79, 0xde,
+	0xe7, 0x79, 0xdf, 0x9d, 0x79, 0xba, 0x70, 0x5a, 0x6b, 0x69, 0x47, 0x96, 0x4d, 0x5c, 0x52, 0x25,
+	0x75, 0xb9, 0x62, 0x1b, 0x9a, 0x8e, 0xe5, 0x83, 0x26, 0xb6, 0x5b, 0x12, 0x5d, 0x45, 0x13, 0x41,
+	0x80, 0xe4, 0x01, 0x84, 0x7f, 0x74, 0xa2, 0x13, 0xba, 0x28, 0x77, 0xfe, 0xf2, 0xa0, 0xc2, 0x94,
+	0x4e, 0x88, 0x5e, 0xc7, 0xb2, 0x6a, 0x19, 0xb2, 0x6a, 0x9a, 0xc4, 0x55, 0x5d, 0x83, 0x98, 0x0e,
+	0xdb, 0xcd, 0xf3, 0x94, 0xbc, 0x47, 0x19, 0x1f, 0x62, 0xd3, 0x65, 0xb8, 0xb9, 0x8b, 0x70, 0x65,
+	0xc3, 0xdc, 0xf3, 0x25, 0xb3, 0x3c, 0xb0, 0xa5, 0xda, 0x6a, 0xc3, 0x97, 0x9d, 0xe2, 0x21, 0xdc,
+	0x23, 0x6f, 0x57, 0x9c, 0x84, 0xff, 0x3d, 0xee, 0x34, 0xbb, 0xd9, 0x29, 0xbc, 0x4d, 0x79, 0x0a,
+	0x3e, 0x68, 0x62, 0xc7, 0x15, 0x77, 0x61, 0xfa, 0xfc, 0x96, 0x63, 0x11, 0xd3, 0xc1, 0x68, 0x15,
+	0x0e, 0x79, 0x22, 0x69, 0x90, 0x05, 0x85, 0x54, 0x29, 0x2b, 0x71, 0xa6, 0x24, 0x05, 0x98, 0x1b,
+	0x03, 0x27, 0xdf, 0xa6, 0x13, 0x0a, 0x63, 0x89, 0xff, 0xc3, 0x49, 0x5a, 0x7b, 0xdb, 0x26, 0x16,
+	0x71, 0x70, 0x58, 0xf8, 0x29, 0x14, 0x78, 0x9b, 0x4c, 0x7a, 0xbd, 0x47, 0x5a, 0xe4, 0x4a, 0x87,
+	0xb8, 0x3d, 0xe2, 0x02, 0x6b, 0x6c, 0x47, 0xdd, 0xc3, 0x6e, 0x2b, 0xac, 0xfd, 0x84, 0x19, 0x0b,
+	0xef, 0x31, 0xe9, 0xb5, 0x1e, 0xe9, 0x1c, 0x57, 0x3a, 0x48, 0xed, 0x51, 0xbe, 0x0a, 0x73, 0xb4,
+	0xfa, 0xdd, 0xea, 0xbe, 0x49, 0x9e, 0xd5, 0xb1, 0xa6, 0x63, 0x8d, 0x0e, 0xe9, 0x91, 0xb9, 0x47,
+	0x7c, 0x0b, 0x1a, 0x14, 0xe3, 0x40, 0xdd, 0x37, 0x30, 0xd0, 0x39, 0x06, 0xcc, 0xc9, 0x0c, 0xd7,
+	0xc9, 0x06, 0x7d, 0x74, 0xb9, 0xcc, 0x0c, 0xe5, 0x89, 0x39, 0x38, 0x4d, 0x55, 0x14, 0x5c, 0x25,
+	0xba, 0x69, 0x3c, 0xe7, 0x18, 0xa9, 0xc0, 0x6c, 0x34, 0xe4, 0x0f, 0xd9, 0x98, 0x65, 0xe7, 0x2f,
+	0x80, 0x61, 0xf2, 0x68, 0x1c, 0x26, 0x0d, 0x8d, 0x16, 0x1e, 0x53, 0x92, 0x86, 0x26, 0x1e, 0x03,
+	0xf6, 0xde, 0x42, 0x58, 0xe6, 0xe3, 0x0e, 0x1c, 0xa4, 0x77, 0x23, 0xf6, 0x3c, 0x06, 0x88, 0xcc,
+	0x84, 0x47, 0xea, 0x1c, 0x67, 0xc7, 0x55, 0xdd, 0xa6, 0x93, 0x4e, 0x66, 0x41, 0x61, 0xbc, 0x94,
+	0xbf, 0x88, 0xbe, 0x43, 0xd1, 0x0a, 0x63, 0x89, 0x9b, 0x70, 0x96, 0x3a, 0xbb, 0x8f, 0xeb, 0x6a,
+	0x0b, 0x6b, 0xf7, 0x48, 0xc3, 0xaa, 0x63, 0x17, 0x7b, 0x84, 0x2d, 0xec, 0x38, 0xaa, 0x8e, 0xfd,
+	0x23, 0x86, 0xd2, 0x70, 0x58, 0xd5, 0x34, 0x1b, 0x3b, 0xde, 0x31, 0x1a, 0x51, 0xfc, 0x7f, 0xc5,
+	0x57, 0x00, 0xde, 0xe8, 0xa7, 0x0e, 0xeb, 0x79, 0x07, 0xfe, 0xdd, 0x60, 0x6b, 0x69, 0x90, 0xfd,
+	0xab, 0x90, 0x2a, 0x2d, 0x70, 0x7d, 0xc7, 0x55, 0x63, 0x73, 0xe8, 0x16, 0x12, 0xdf, 0x00, 0x38,
+	0x15, 0x47, 0x40, 0x0f, 0xe0, 0x30, 0x03, 0xb3, 0x59, 0xf3, 0x87, 0xb5, 0xe5, 0xe8, 0x61, 0x3e,
+	0x53, 0xf2, 0xc9, 0x28, 0x07, 0x47, 0x2b, 0x75, 0x52, 0xdd, 0x2f, 0xd7, 0xb0, 0xa1, 0xd7, 0x5c,
+	0x3a, 0xf9, 0x31, 0x25, 0x45, 0xd7, 0x1e, 0xd2, 0xa5, 0xd2, 0xcf, 0x11, 0x38, 0x48, 0xe7, 0x81,
+	0x8e, 0x01, 0x4c, 0x05, 0xd2, 0x04, 0xcd, 0x73, 0x35, 0x23, 0x92, 0x4c, 0x28, 0xf6, 0x89, 0xf6,
+	0xe6, 0x2a, 0xce, 0xbf, 0xfe, 0xf2, 0xe3, 0x5d, 0x32, 0x8f, 0x66, 0xe4, 0x50, 0x74, 0x1e, 0x2e,
+	0xfa, 0xe9, 0xe9, 0xa5, 0xb0, 0x77, 0xa7, 0xd1, 0x47, 0x00, 0xc7, 0x42, 0x69, 0x83, 0xa4, 0x68,
+	0x39, 0x5e, 0xde, 0x09, 0x72, 0xdf, 0x78, 0x66, 0x50, 0xa2, 0x06, 0x0b, 0x28, 0x1f, 0x65, 0xd0,
+	0xf2, 0x68, 0xbe, 0xc5, 0xf7, 0x00, 0x8e, 0x06, 0x53, 0x09, 0xc5, 0x0c, 0x84, 0x13, 0x8a, 0x82,
+	0xd4, 0x2f, 0x9c, 0xf9, 0x2b, 0x52, 0x7f, 0xd7, 0xd1, 0xb5, 0x28, 0x7f, 0x0e, 0x65, 0xf9, 0xf6,
+	0x3e, 0x03, 0xf8, 0x2f, 0x37, 0xec, 0xd0, 0xad, 0x68, 0xe1, 0xb8, 0x08, 0x15, 0x96, 0x2f, 0xcd,
+	0x63, 0xce, 0x97, 0xa9, 0xf3, 0x05, 0x24, 0x47, 0x39, 0x57, 0x03, 0xf4, 0xc0, 0xaf, 0x31, 0xfa,
+	0x04, 0xe0, 0x04, 0x27, 0x27, 0xd1, 0x62, 0xb4, 0x93, 0xe8, 0xe4, 0x15, 0x96, 0x2e, 0xc9, 0x62,
+	0xee, 0x97, 0xa8, 0x7b, 0x19, 0x15, 0xa3, 0xdc, 0xdb, 0x5d, 0x72, 0xd0, 0xfb, 0x07, 0x00, 0x53,
+	0x81, 0x6c, 0x8b, 0xbb, 0x5c, 0xe7, 0x63, 0x3a, 0xee, 0x72, 0x71, 0x82, 0x5a, 0x5c, 0xa0, 0x1e,
+	0xe7, 0xd0, 0x6c, 0x94, 0xc7, 0xe0, 0x97, 0x8e, 0xfc, 0xc2, 0xd0, 0x5e, 0xa2, 0x36, 0x80, 0x57,
+	0x62, 0x13, 0x11, 0xad, 0x46, 0x7b, 0xe8, 0x27, 0x92, 0x85, 0xb5, 0xdf, 0xe6, 0xb3, 0xae, 0xd6,
+	0x69, 0x57, 0xb7, 0xd1, 0x4a, 0x54, 0x57, 0x9a, 0x57, 0xa6, 0x5c, 0x65, 0x75, 0xca, 0xac, 0x4d,
+	0x3f, 0x77, 0x37, 0x94, 0x93, 0x76, 0x06, 0x9c, 0xb6, 0x33, 0xe0, 0x7b, 0x3b, 0x03, 0xde, 0x9e,
+	0x65, 0x12, 0xa7, 0x67, 0x99, 0xc4, 0xd7, 0xb3, 0x4c, 0x62, 0x77, 0x45, 0x37, 0xdc, 0x5a, 0xb3,
+	0x22, 0x55, 0x49, 0xa3, 0xb7, 0x7a, 0xb1, 0x5a, 0x53, 0x0d, 0x53, 0xee, 0xae, 0x1c, 0x75, 0xbf,
+	0xef, 0x5a, 0x16, 0x76, 0x2a, 0x43, 0x74, 0xe3, 0xe6, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x53,
+	0x11, 0xc2, 0x4c, 0xe4, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -694,6 +802,9 @@ type QueryClient interface {
 	// A "recognized" event is one that is finalized on the Ethereum blockchain
 	// and has been identified by the queried node. It is not yet in-consensus.
 	RecognizedEventInfo(ctx context.Context, in *QueryRecognizedEventInfoRequest, opts ...grpc.CallOption) (*QueryRecognizedEventInfoResponse, error)
+	// Queries a bridge event by ID and its status. Since recognized events are
+	// from memory, the response may be different between nodes.
+	BridgeEvent(ctx context.Context, in *QueryBridgeEventRequest, opts ...grpc.CallOption) (*QueryBridgeEventResponse, error)
 	// Queries all `MsgCompleteBridge` messages that are delayed (not yet
 	// executed) and corresponding block heights at which they will execute.
 	DelayedCompleteBridgeMessages(ctx context.Context, in *QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*QueryDelayedCompleteBridgeMessagesResponse, error)
@@ -752,6 +863,15 @@ func (c *queryClient) RecognizedEventInfo(ctx context.Context, in *QueryRecogniz
 	return out, nil
 }
 
+func (c *queryClient) BridgeEvent(ctx context.Context, in *QueryBridgeEventRequest, opts ...grpc.CallOption) (*QueryBridgeEventResponse, error) {
+	out := new(QueryBridgeEventResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/BridgeEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelayedCompleteBridgeMessages(ctx context.Context, in *QueryDelayedCompleteBridgeMessagesRequest, opts ...grpc.CallOption) (*QueryDelayedCompleteBridgeMessagesResponse, error) {
 	out := new(QueryDelayedCompleteBridgeMessagesResponse)
 	err := c.cc.Invoke(ctx, "/dydxprotocol.bridge.Query/DelayedCompleteBridgeMessages", in, out, opts...)
@@ -777,6 +897,9 @@ type QueryServer interface {
 	// A "recognized" event is one that is finalized on the Ethereum blockchain
 	// and has been identified by the queried node. It is not yet in-consensus.
 	RecognizedEventInfo(context.Context, *QueryRecognizedEventInfoRequest) (*QueryRecognizedEventInfoResponse, error)
+	// Queries a bridge event by ID and its status. Since recognized events are
+	// from memory, the response may be different between nodes.
+	BridgeEvent(context.Context, *QueryBridgeEventRequest) (*QueryBridgeEventResponse, error)
 	// Queries all `MsgCompleteBridge` messages that are delayed (not yet
 	// executed) and corresponding block heights at which they will execute.
 	DelayedCompleteBridgeMessages(context.Context, *QueryDelayedCompleteBridgeMessagesRequest) (*QueryDelayedCompleteBridgeMessagesResponse, error)
@@ -801,6 +924,9 @@ func (*UnimplementedQueryServer) AcknowledgedEventInfo(ctx context.Context, req
 func (*UnimplementedQueryServer) RecognizedEventInfo(ctx context.Context, req *QueryRecognizedEventInfoRequest) (*QueryRecognizedEventInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecognizedEventInfo not implemented")
 }
+func (*UnimplementedQueryServer) BridgeEvent(ctx context.Context, req *QueryBridgeEventRequest) (*QueryBridgeEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeEvent not implemented")
+}
 func (*UnimplementedQueryServer) DelayedCompleteBridgeMessages(ctx context.Context, req *QueryDelayedCompleteBridgeMessagesRequest) (*QueryDelayedCompleteBridgeMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelayedCompleteBridgeMessages not implemented")
 }
@@ -899,6 +1025,24 @@ func _Query_RecognizedEventInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BridgeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.bridge.Query/BridgeEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeEvent(ctx, req.(*QueryBridgeEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelayedCompleteBridgeMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelayedCompleteBridgeMessagesRequest)
 	if err := dec(in); err != nil {
@@ -941,6 +1085,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecognizedEventInfo",
 			Handler:    _Query_RecognizedEventInfo_Handler,
 		},
+		{
+			MethodName: "BridgeEvent",
+			Handler:    _Query_BridgeEvent_Handler,
+		},
 		{
 			MethodName: "DelayedCompleteBridgeMessages",
 			Handler:    _Query_DelayedCompleteBridgeMessages_Handler,
@@ -1230,6 +1378,72 @@ func (m *QueryRecognizedEventInfoResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBridgeEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDelayedCompleteBridgeMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1446,6 +1660,32 @@ func (m *QueryRecognizedEventInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBridgeEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryBridgeEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
 func (m *QueryDelayedCompleteBridgeMessagesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2159,6 +2399,177 @@ func (m *QueryRecognizedEventInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBridgeEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeEventStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDelayedCompleteBridgeMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,7 @@ type (
 		pricesKeeper      types.PricesKeeper
 		statsKeeper       types.StatsKeeper
 		rewardsKeeper     types.RewardsKeeper
+		vaultKeeper       types.VaultKeeper
 
 		indexerEventManager indexer_manager.IndexerEventManager
 		streamingManager    streamingtypes.GrpcStreamingManager
@@ -255,6 +256,12 @@ func (k *Keeper) SetAnteHandler(anteHandler sdk.AnteHandler) {
 	k.antehandler = anteHandler
 }
 
+// Sets the vault keeper after it has been constructed. This breaks a cycle between
+// when the vault keeper is constructed and when the clob keeper is constructed.
+func (k *Keeper) SetVaultKeeper(vaultKeeper types.VaultKeeper) {
+	k.vaultKeeper = vaultKeeper
+}
+
 // InitializeNewGrpcStreams initializes new gRPC streams for all uninitialized clob pairs
 // by sending the corresponding orderbook snapshots.
 func (k Keeper) InitializeNewGrpcStreams(ctx sdk.Context) {
@@ -474,6 +474,28 @@ func (k Keeper) persistMatchedOrders(
 		bigFillQuoteQuantums,
 	)
 
+	// Process fill in x/vault, which only records fills of vault subaccounts and requotes
+	// vaults whose orders are filled beyond a threshold of their size.
+	if k.vaultKeeper != nil {
+		for _, order := range []types.MatchableOrder{matchWithOrders.TakerOrder, matchWithOrders.MakerOrder} {
+			k.vaultKeeper.RecordVaultFill(
+				ctx,
+				order.GetSubaccountId(),
+				order.GetClobPairId(),
+				order.IsBuy(),
+				matchWithOrders.FillAmount.ToBigInt(),
+				bigFillQuoteQuantums,
+			)
+			k.vaultKeeper.RequoteVaultOnFill(
+				ctx,
+				order.GetSubaccountId(),
+				order.GetClobPairId(),
+				order.GetBaseQuantums().ToBigInt(),
+				matchWithOrders.FillAmount.ToBigInt(),
+			)
+		}
+	}
+
 	// Emit an event indicating a match occurred.
 	ctx.EventManager().EmitEvent(
 		types.NewCreateMatchEvent(
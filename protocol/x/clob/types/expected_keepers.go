@@ -149,6 +149,24 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
+type VaultKeeper interface {
+	RecordVaultFill(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		clobPairId ClobPairId,
+		isBuy bool,
+		fillBaseQuantums *big.Int,
+		fillQuoteQuantums *big.Int,
+	)
+	RequoteVaultOnFill(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		clobPairId ClobPairId,
+		orderBaseQuantums *big.Int,
+		fillBaseQuantums *big.Int,
+	)
+}
+
 type RewardsKeeper interface {
 	AddRewardSharesForFill(
 		ctx sdk.Context,
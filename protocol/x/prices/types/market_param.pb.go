@@ -45,6 +45,9 @@ type MarketParam struct {
 	// A string of json that encodes the configuration for resolving the price
 	// of this market on various exchanges.
 	ExchangeConfigJson string `protobuf:"bytes,6,opt,name=exchange_config_json,json=exchangeConfigJson,proto3" json:"exchange_config_json,omitempty"`
+	// The expected interval (in seconds) between consecutive price updates of
+	// this market. Zero means the market has no expected update cadence.
+	UpdateIntervalSeconds uint32 `protobuf:"varint,7,opt,name=update_interval_seconds,json=updateIntervalSeconds,proto3" json:"update_interval_seconds,omitempty"`
 }
 
 func (m *MarketParam) Reset()         { *m = MarketParam{} }
@@ -122,6 +125,13 @@ func (m *MarketParam) GetExchangeConfigJson() string {
 	return ""
 }
 
+func (m *MarketParam) GetUpdateIntervalSeconds() uint32 {
+	if m != nil {
+		return m.UpdateIntervalSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MarketParam)(nil), "dydxprotocol.prices.MarketParam")
 }
@@ -131,25 +141,27 @@ func init() {
 }
 
 var fileDescriptor_39174a2dba54f799 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x3f, 0x4b, 0xc4, 0x30,
-	0x1c, 0x86, 0x2f, 0xf5, 0x3c, 0x34, 0x7a, 0xc2, 0xc5, 0x1b, 0x82, 0x43, 0x28, 0x0a, 0xd2, 0xc5,
-	0x56, 0xd0, 0xc1, 0xd9, 0xc3, 0x45, 0x10, 0x4a, 0x47, 0x97, 0x90, 0x4b, 0x63, 0x1b, 0x35, 0x7f,
-	0x68, 0xa3, 0xf4, 0xbe, 0x85, 0x1f, 0xcb, 0xf1, 0x16, 0xc1, 0x51, 0xda, 0x2f, 0x22, 0xcd, 0xd1,
-	0x43, 0xb7, 0xe4, 0x7d, 0x1e, 0x7e, 0xbc, 0xbc, 0xf0, 0x3c, 0x5f, 0xe5, 0x8d, 0xad, 0x8c, 0x33,
-	0xdc, 0xbc, 0x26, 0xb6, 0x92, 0x5c, 0xd4, 0x89, 0x62, 0xd5, 0x8b, 0x70, 0xd4, 0xb2, 0x8a, 0xa9,
-	0xd8, 0x43, 0x74, 0xfc, 0xd7, 0x8b, 0x37, 0xde, 0xe9, 0x17, 0x80, 0x07, 0x0f, 0xde, 0x4d, 0x7b,
-	0x15, 0x1d, 0xc1, 0x40, 0xe6, 0x18, 0x84, 0x20, 0x9a, 0x66, 0x81, 0xcc, 0x11, 0x82, 0x63, 0xcb,
-	0x64, 0x85, 0x83, 0x10, 0x44, 0xfb, 0x99, 0x7f, 0xa3, 0x13, 0xb8, 0x27, 0x1a, 0x6b, 0xb4, 0xd0,
-	0x0e, 0xef, 0x84, 0x20, 0x9a, 0x65, 0xdb, 0x3f, 0x3a, 0x83, 0x53, 0x25, 0x35, 0x15, 0x0d, 0x2f,
-	0x99, 0x2e, 0x44, 0x8d, 0xc7, 0xfe, 0xd4, 0xa1, 0x92, 0xfa, 0x6e, 0xc8, 0x50, 0x02, 0xe7, 0xbd,
-	0xe4, 0x2b, 0xd0, 0x4d, 0x48, 0xad, 0x55, 0x78, 0xd7, 0xbb, 0x33, 0x25, 0x75, 0xda, 0xa3, 0x85,
-	0x27, 0xa9, 0x55, 0xe8, 0x12, 0xce, 0x87, 0x8b, 0x94, 0x1b, 0xfd, 0x24, 0x0b, 0xfa, 0x5c, 0x1b,
-	0x8d, 0x27, 0xbe, 0x15, 0x1a, 0xd8, 0xc2, 0xa3, 0xfb, 0xda, 0xe8, 0xdb, 0xec, 0xb3, 0x25, 0x60,
-	0xdd, 0x12, 0xf0, 0xd3, 0x12, 0xf0, 0xd1, 0x91, 0xd1, 0xba, 0x23, 0xa3, 0xef, 0x8e, 0x8c, 0x1e,
-	0x6f, 0x0a, 0xe9, 0xca, 0xb7, 0x65, 0xcc, 0x8d, 0x4a, 0xfe, 0x2d, 0xf7, 0x7e, 0x7d, 0xc1, 0x4b,
-	0x26, 0x75, 0xb2, 0x4d, 0x9a, 0x61, 0x4d, 0xb7, 0xb2, 0xa2, 0x5e, 0x4e, 0x3c, 0xb8, 0xfa, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x98, 0x21, 0x4b, 0x29, 0x71, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x4b, 0x03, 0x31,
+	0x18, 0xc6, 0x9b, 0xb3, 0x56, 0x8d, 0x56, 0x68, 0xac, 0x18, 0x1c, 0x8e, 0xa2, 0x20, 0x5d, 0xec,
+	0x09, 0x8a, 0x38, 0x5b, 0x1c, 0x14, 0x84, 0x72, 0x6e, 0x2e, 0x21, 0xcd, 0xc5, 0x36, 0xda, 0xfc,
+	0x21, 0x49, 0xcb, 0xf5, 0x43, 0x08, 0x7e, 0x2c, 0xc7, 0x8e, 0x8e, 0xd2, 0x7e, 0x11, 0xb9, 0x9c,
+	0x57, 0x74, 0x4b, 0x9e, 0xdf, 0x8f, 0x37, 0x0f, 0x79, 0xe1, 0x59, 0x36, 0xcf, 0x72, 0x63, 0xb5,
+	0xd7, 0x4c, 0x4f, 0x12, 0x63, 0x05, 0xe3, 0x2e, 0x91, 0xd4, 0xbe, 0x71, 0x4f, 0x0c, 0xb5, 0x54,
+	0xf6, 0x02, 0x44, 0x07, 0x7f, 0xbd, 0x5e, 0xe9, 0x9d, 0xbc, 0x47, 0x70, 0xf7, 0x31, 0xb8, 0x83,
+	0x42, 0x45, 0xfb, 0x30, 0x12, 0x19, 0x06, 0x1d, 0xd0, 0x6d, 0xa6, 0x91, 0xc8, 0x10, 0x82, 0x75,
+	0x43, 0x85, 0xc5, 0x51, 0x07, 0x74, 0x77, 0xd2, 0x70, 0x46, 0xc7, 0x70, 0x9b, 0xe7, 0x46, 0x2b,
+	0xae, 0x3c, 0xde, 0xe8, 0x80, 0x6e, 0x2b, 0x5d, 0xdf, 0xd1, 0x29, 0x6c, 0x4a, 0xa1, 0x08, 0xcf,
+	0xd9, 0x98, 0xaa, 0x11, 0x77, 0xb8, 0x1e, 0x46, 0xed, 0x49, 0xa1, 0xee, 0xaa, 0x0c, 0x25, 0xb0,
+	0x5d, 0x48, 0xa1, 0x02, 0x29, 0x43, 0x62, 0x8c, 0xc4, 0x9b, 0xc1, 0x6d, 0x49, 0xa1, 0x06, 0x05,
+	0xea, 0x07, 0x32, 0x30, 0x12, 0x5d, 0xc0, 0x76, 0x35, 0x91, 0x30, 0xad, 0x5e, 0xc4, 0x88, 0xbc,
+	0x3a, 0xad, 0x70, 0x23, 0xb4, 0x42, 0x15, 0xeb, 0x07, 0xf4, 0xe0, 0xb4, 0x42, 0xd7, 0xf0, 0x68,
+	0x6a, 0x32, 0xea, 0x39, 0x11, 0xca, 0x73, 0x3b, 0xa3, 0x13, 0xe2, 0x38, 0xd3, 0x2a, 0x73, 0x78,
+	0x2b, 0xbc, 0x72, 0x58, 0xe2, 0xfb, 0x5f, 0xfa, 0x54, 0xc2, 0xdb, 0xf4, 0x73, 0x19, 0x83, 0xc5,
+	0x32, 0x06, 0xdf, 0xcb, 0x18, 0x7c, 0xac, 0xe2, 0xda, 0x62, 0x15, 0xd7, 0xbe, 0x56, 0x71, 0xed,
+	0xf9, 0x66, 0x24, 0xfc, 0x78, 0x3a, 0xec, 0x31, 0x2d, 0x93, 0x7f, 0x3f, 0x3e, 0xbb, 0x3a, 0x67,
+	0x63, 0x2a, 0x54, 0xb2, 0x4e, 0xf2, 0x6a, 0x0b, 0x7e, 0x6e, 0xb8, 0x1b, 0x36, 0x02, 0xb8, 0xfc,
+	0x19, 0x00, 0xc2, 0x4f, 0x51, 0x60, 0xa9, 0x01, 0x00, 0x00,
 }
 
 func (m *MarketParam) Marshal() (dAtA []byte, err error) {
@@ -172,6 +184,11 @@ func (m *MarketParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpdateIntervalSeconds != 0 {
+		i = encodeVarintMarketParam(dAtA, i, uint64(m.UpdateIntervalSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ExchangeConfigJson) > 0 {
 		i -= len(m.ExchangeConfigJson)
 		copy(dAtA[i:], m.ExchangeConfigJson)
@@ -246,6 +263,9 @@ func (m *MarketParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarketParam(uint64(l))
 	}
+	if m.UpdateIntervalSeconds != 0 {
+		n += 1 + sovMarketParam(uint64(m.UpdateIntervalSeconds))
+	}
 	return n
 }
 
@@ -426,6 +446,25 @@ func (m *MarketParam) Unmarshal(dAtA []byte) error {
 			}
 			m.ExchangeConfigJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateIntervalSeconds", wireType)
+			}
+			m.UpdateIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketParam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateIntervalSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarketParam(dAtA[iNdEx:])
@@ -16,5 +16,8 @@ func EndBlocker(
 	ctx sdk.Context,
 	keeper *keeper.Keeper,
 ) {
+	keeper.UpdateMarketVolatilities(ctx)
+	keeper.UpdateMarketTwaps(ctx)
 	keeper.RefreshAllVaultOrders(ctx)
+	keeper.SweepStaleVaultOrders(ctx)
 }
@@ -27,6 +27,25 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryVault())
 	cmd.AddCommand(CmdQueryListVault())
 	cmd.AddCommand(CmdQueryListOwnerShares())
+	cmd.AddCommand(CmdQueryVaultQuotingStatus())
+	cmd.AddCommand(CmdQueryQuoteDeposit())
+	cmd.AddCommand(CmdQueryVaultQuoteCurve())
+	cmd.AddCommand(CmdQueryVaultQuotedNotional())
+	cmd.AddCommand(CmdQueryDecodeVaultClientId())
+	cmd.AddCommand(CmdQueryVaultFillStats())
+	cmd.AddCommand(CmdQueryVaultMargin())
+	cmd.AddCommand(CmdQueryVaultActivityLog())
+	cmd.AddCommand(CmdQueryVaultRefreshHistory())
+	cmd.AddCommand(CmdQueryVaultValueAtRisk())
+	cmd.AddCommand(CmdQueryOwnerUnrealizedPnl())
+	cmd.AddCommand(CmdQueryTotalVaultTvl())
+	cmd.AddCommand(CmdQueryTotalVaultInventory())
+	cmd.AddCommand(CmdQueryExplainVaultOrder())
+	cmd.AddCommand(CmdQueryOrphanedVaultOrders())
+	cmd.AddCommand(CmdQueryVaultMakerEdge())
+	cmd.AddCommand(CmdQueryCurrentBlockClientIdParity())
+	cmd.AddCommand(CmdQueryVaultLastQuotePrice())
+	cmd.AddCommand(CmdQueryMarketVolatility())
 
 	return cmd
 }
@@ -176,3 +195,763 @@ func CmdQueryListOwnerShares() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryVaultQuotingStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quoting-status [type] [number]",
+		Short: "get whether a vault is quoting and if not, why",
+		Long:  "get whether a vault is quoting and if not, why. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultQuotingStatus(
+				context.Background(),
+				&types.QueryVaultQuotingStatusRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryQuoteDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-deposit [type] [number] [quote_quantums]",
+		Short: "get shares that a deposit to a vault would mint",
+		Long:  "get shares that a deposit to a vault would mint. Current support types are: clob.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			// Parse quote quantums.
+			quoteQuantums, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuoteDeposit(
+				context.Background(),
+				&types.QueryQuoteDepositRequest{
+					Type:          vaultType,
+					Number:        uint32(vaultNumber),
+					QuoteQuantums: quoteQuantums,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultQuoteCurve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-curve [type] [number]",
+		Short: "get price and size of each order of a vault",
+		Long:  "get price and size of each order of a vault. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultQuoteCurve(
+				context.Background(),
+				&types.QueryVaultQuoteCurveRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultQuotedNotional() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quoted-notional [type] [number]",
+		Short: "get notional value that a vault quotes on each side",
+		Long:  "get notional value that a vault quotes on each side. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultQuotedNotional(
+				context.Background(),
+				&types.QueryVaultQuotedNotionalRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDecodeVaultClientId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-client-id [client-id]",
+		Short: "decode client ID of a vault order into its side, block parity, and layer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse client ID.
+			clientId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DecodeVaultClientId(
+				context.Background(),
+				&types.QueryDecodeVaultClientIdRequest{
+					ClientId: uint32(clientId),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultFillStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fill-stats [type] [number]",
+		Short: "get fill statistics of a vault",
+		Long:  "get fill statistics of a vault. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultFillStats(
+				context.Background(),
+				&types.QueryVaultFillStatsRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultMargin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "margin [type] [number]",
+		Short: "get margin requirements and free collateral of a vault",
+		Long:  "get margin requirements and free collateral of a vault. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultMargin(
+				context.Background(),
+				&types.QueryVaultMarginRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultRefreshHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh-history [type] [number]",
+		Short: "get most recent order refreshes of a vault",
+		Long:  "get most recent order refreshes of a vault, oldest first. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultRefreshHistory(
+				context.Background(),
+				&types.QueryVaultRefreshHistoryRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultValueAtRisk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "value-at-risk [type] [number] [confidence_ppm]",
+		Short: "get one-block value at risk of a vault's position at a confidence",
+		Long: "get one-block value at risk of a vault's position at a confidence in ppm, " +
+			"e.g. 990000 for 99%. Current support types are: clob.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			// Parse confidence.
+			confidencePpm, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultValueAtRisk(
+				context.Background(),
+				&types.QueryVaultValueAtRiskRequest{
+					Type:          vaultType,
+					Number:        uint32(vaultNumber),
+					ConfidencePpm: uint32(confidencePpm),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOwnerUnrealizedPnl() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-unrealized-pnl [type] [number] [owner]",
+		Short: "get unrealized PnL of an owner's shares in a vault",
+		Long:  "get unrealized PnL of an owner's shares in a vault. Current support types are: clob.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OwnerUnrealizedPnl(
+				context.Background(),
+				&types.QueryOwnerUnrealizedPnlRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+					Owner:  args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultActivityLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activity-log [type] [number]",
+		Short: "list activity log of a vault by its type and number",
+		Long:  "list activity log of a vault by its type and number. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryVaultActivityLogRequest{
+				Type:       vaultType,
+				Number:     uint32(vaultNumber),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.VaultActivityLog(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTotalVaultTvl() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-tvl",
+		Short: "get total value locked across all vaults",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TotalVaultTvl(
+				context.Background(),
+				&types.QueryTotalVaultTvlRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTotalVaultInventory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-inventory [clob_pair_id]",
+		Short: "get net inventory of all vaults in the perpetual of a clob pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse clob pair ID.
+			clobPairId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TotalVaultInventory(
+				context.Background(),
+				&types.QueryTotalVaultInventoryRequest{
+					ClobPairId: uint32(clobPairId),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryExplainVaultOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain-order [type] [number] [side] [layer]",
+		Short: "get intermediate values of how a vault order is quoted",
+		Long: "get intermediate values of how a vault order is quoted. Current support types are: clob. " +
+			"Current support sides are: buy, sell.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			// Parse order side.
+			side, err := GetOrderSideFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Parse layer.
+			layer, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExplainVaultOrder(
+				context.Background(),
+				&types.QueryExplainVaultOrderRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+					Side:   side,
+					Layer:  uint32(layer),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOrphanedVaultOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orphaned-orders [type] [number]",
+		Short: "get resting orders of a vault that the vault no longer generates",
+		Long:  "get resting orders of a vault that the vault no longer generates. Current support types are: clob.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrphanedVaultOrders(
+				context.Background(),
+				&types.QueryOrphanedVaultOrdersRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultMakerEdge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maker-edge [type] [number]",
+		Short: "get the estimated maker edge of a vault",
+		Long: "get the estimated maker edge (in ppm) of a vault, i.e. its effective spread minus estimated " +
+			"adverse selection. Current support types are: clob.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultMakerEdge(
+				context.Background(),
+				&types.QueryVaultMakerEdgeRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCurrentBlockClientIdParity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-id-parity",
+		Short: "get the block height parity that client IDs of vault orders placed in the current block encode",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CurrentBlockClientIdParity(
+				context.Background(),
+				&types.QueryCurrentBlockClientIdParityRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVaultLastQuotePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-quote-price [type] [number]",
+		Short: "get the oracle price that a vault's orders were based on at its last refresh",
+		Long: "get the oracle price that a vault's orders were based on at its last refresh along with " +
+			"the current oracle price. Current support types are: clob.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VaultLastQuotePrice(
+				context.Background(),
+				&types.QueryVaultLastQuotePriceRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryMarketVolatility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-volatility [market_id]",
+		Short: "get realized volatility of a market's oracle price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse market ID.
+			marketId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MarketVolatility(
+				context.Background(),
+				&types.QueryMarketVolatilityRequest{
+					MarketId: uint32(marketId),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,6 +25,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdDepositToVault())
+	cmd.AddCommand(CmdOperatorUpdateVaultParams())
+	cmd.AddCommand(CmdRefreshVaultOrders())
+	cmd.AddCommand(CmdSetVaultLabel())
 
 	return cmd
 }
@@ -88,3 +91,134 @@ func CmdDepositToVault() *cobra.Command {
 
 	return cmd
 }
+
+func CmdOperatorUpdateVaultParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-update-vault-params [spread_min_ppm] [order_size_pct_ppm]",
+		Short: "Broadcast message OperatorUpdateVaultParams, signed by the vault operator (--from)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Parse spread min ppm.
+			spreadMinPpm, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse order size pct ppm.
+			orderSizePctPpm, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create MsgOperatorUpdateVaultParams.
+			msg := &types.MsgOperatorUpdateVaultParams{
+				Operator:        clientCtx.GetFromAddress().String(),
+				SpreadMinPpm:    spreadMinPpm,
+				OrderSizePctPpm: orderSizePctPpm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRefreshVaultOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh-vault-orders [vault_type] [vault_number]",
+		Short: "Broadcast message RefreshVaultOrders, signed by the vault operator (--from)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create MsgRefreshVaultOrders.
+			msg := &types.MsgRefreshVaultOrders{
+				Signer: clientCtx.GetFromAddress().String(),
+				VaultId: types.VaultId{
+					Type:   vaultType,
+					Number: vaultNumber,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetVaultLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-vault-label [vault_type] [vault_number] [label]",
+		Short: "Broadcast message SetVaultLabel, signed by the vault operator (--from)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create MsgSetVaultLabel.
+			msg := &types.MsgSetVaultLabel{
+				Operator: clientCtx.GetFromAddress().String(),
+				VaultId: types.VaultId{
+					Type:   vaultType,
+					Number: vaultNumber,
+				},
+				Label: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
@@ -15,3 +16,15 @@ func GetVaultTypeFromString(rawType string) (vaultType types.VaultType, err erro
 		return vaultType, fmt.Errorf("invalid vault type: %s", rawType)
 	}
 }
+
+// GetOrderSideFromString returns an order side from a string.
+func GetOrderSideFromString(rawSide string) (side clobtypes.Order_Side, err error) {
+	switch rawSide {
+	case "buy":
+		return clobtypes.Order_SIDE_BUY, nil
+	case "sell":
+		return clobtypes.Order_SIDE_SELL, nil
+	default:
+		return side, fmt.Errorf("invalid order side: %s", rawSide)
+	}
+}
@@ -14,7 +14,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
-	// Set total shares, owner shares, and vault params of each vault.
+	// Set total shares, owner shares (and whether they're frozen), and vault params of each vault.
 	for _, vault := range genState.Vaults {
 		if err := k.SetTotalShares(ctx, *vault.VaultId, *vault.TotalShares); err != nil {
 			panic(err)
@@ -23,6 +23,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			if err := k.SetOwnerShares(ctx, *vault.VaultId, ownerShares.Owner, *ownerShares.Shares); err != nil {
 				panic(err)
 			}
+			k.SetOwnerSharesFrozen(ctx, *vault.VaultId, ownerShares.Owner, ownerShares.Frozen)
 		}
 		if vault.VaultParams != nil {
 			if err := k.SetVaultParams(ctx, *vault.VaultId, *vault.VaultParams); err != nil {
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultActivated returns whether a vault was active in the last block. Returns false
+// if the vault's activation status has never been set.
+func (k Keeper) GetVaultActivated(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (activated bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ActivatedKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return false
+	}
+
+	var value gogotypes.BoolValue
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value
+}
+
+// IsVaultActive returns whether a vault is active in the current state, i.e. whether
+// `RefreshAllVaultOrders` would refresh its orders. Refresh, activation statuses, and queries
+// such as `VaultQuotingStatus` all decide activation with `getVaultInactiveReason`, so that a
+// vault at exactly its activation threshold is active everywhere if `activation_inclusive` is
+// true and inactive everywhere otherwise. Returns false if the vault doesn't exist.
+func (k Keeper) IsVaultActive(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) bool {
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return false
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	return k.getVaultInactiveReason(ctx, vaultId, totalShares, k.GetParams(ctx), vaultParams) == ""
+}
+
+// SetVaultActivated sets whether a vault was active in the current block.
+func (k Keeper) SetVaultActivated(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	activated bool,
+) {
+	value := gogotypes.BoolValue{Value: activated}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ActivatedKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// updateVaultActivated sets whether a vault is active in the current block. If the vault
+// was inactive (active) in the last block, it emits a vault_activated (vault_deactivated)
+// event and appends the status change to the vault's activity log. As a deactivated vault
+// no longer refreshes its orders, its resting orders are cancelled upon deactivation.
+func (k Keeper) updateVaultActivated(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	activated bool,
+) {
+	if k.GetVaultActivated(ctx, vaultId) == activated {
+		return
+	}
+	k.SetVaultActivated(ctx, vaultId, activated)
+	k.appendVaultActivity(ctx, vaultId, types.VaultActivity{
+		Type:      types.VaultActivityType_VAULT_ACTIVITY_TYPE_STATUS_CHANGE,
+		Activated: activated,
+	})
+	ctx.EventManager().EmitEvent(
+		types.NewVaultActivationEvent(vaultId, activated),
+	)
+	if !activated {
+		if _, err := k.CancelAllOrdersForVaultSubaccount(ctx, vaultId); err != nil {
+			log.ErrorLogWithError(ctx, "Failed to cancel orders of deactivated vault", err, "vaultId", vaultId)
+		}
+	}
+}
+
+// InitializeVaultActivationStatuses sets the activation status of all existing vaults
+// based on their current state without emitting any events. This is used when upgrading
+// a chain whose vaults don't have activation statuses yet so that the first block after
+// the upgrade doesn't emit spurious activation events.
+func (k Keeper) InitializeVaultActivationStatuses(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+		var totalShares types.NumShares
+		k.cdc.MustUnmarshal(totalSharesIterator.Value(), &totalShares)
+
+		vaultParams, _ := k.GetVaultParams(ctx, *vaultId)
+		k.SetVaultActivated(
+			ctx,
+			*vaultId,
+			k.getVaultInactiveReason(ctx, *vaultId, totalShares, params, vaultParams) == "",
+		)
+	}
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultActivationStatusTransitions(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault asset.
+		asset *big.Int
+		// Existing activation status of the vault.
+		existingActivated bool
+		// Whether to initialize activation statuses, as is done in an upgrade, before refreshing orders.
+		initialize bool
+
+		/* --- Expectations --- */
+		// Expected activation status after refreshing orders.
+		expectedActivated bool
+		// Expected vault activation events emitted when refreshing orders.
+		expectedEvents []sdk.Event
+	}{
+		"Active vault, initialized: no event": {
+			asset:             big.NewInt(1_000_000_000), // 1,000 USDC
+			initialize:        true,
+			expectedActivated: true,
+			expectedEvents:    []sdk.Event{},
+		},
+		"Active vault, not initialized: vault_activated event": {
+			asset:             big.NewInt(1_000_000_000), // 1,000 USDC
+			initialize:        false,
+			expectedActivated: true,
+			expectedEvents: []sdk.Event{
+				vaulttypes.NewVaultActivationEvent(constants.Vault_Clob0, true),
+			},
+		},
+		"Inactive vault, initialized: no event": {
+			asset:             big.NewInt(999_999_999),
+			initialize:        true,
+			expectedActivated: false,
+			expectedEvents:    []sdk.Event{},
+		},
+		"Inactive vault, previously active: vault_deactivated event": {
+			asset:             big.NewInt(999_999_999),
+			existingActivated: true,
+			initialize:        false,
+			expectedActivated: false,
+			expectedEvents: []sdk.Event{
+				vaulttypes.NewVaultActivationEvent(constants.Vault_Clob0, false),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: constants.Vault_Clob0.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.asset,
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+
+			// Set up vault, which doesn't have an activation status, as is the case before an upgrade.
+			err := k.SetTotalShares(ctx, constants.Vault_Clob0, vaulttypes.BigIntToNumShares(big.NewInt(300)))
+			require.NoError(t, err)
+			if tc.existingActivated {
+				k.SetVaultActivated(ctx, constants.Vault_Clob0, true)
+
+				// Place orders of a previously active vault.
+				orders, err := k.GetVaultClobOrders(ctx.WithBlockHeight(ctx.BlockHeight()-1), constants.Vault_Clob0)
+				require.NoError(t, err)
+				for _, order := range orders {
+					err := k.PlaceVaultClobOrder(ctx, order)
+					require.NoError(t, err)
+				}
+				require.NotEmpty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+			}
+			if tc.initialize {
+				k.InitializeVaultActivationStatuses(ctx)
+			}
+
+			// Refresh all vault orders and check emitted vault activation events.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			k.RefreshAllVaultOrders(ctx)
+			events := []sdk.Event{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == vaulttypes.EventTypeVaultActivated ||
+					event.Type == vaulttypes.EventTypeVaultDeactivated {
+					events = append(events, event)
+				}
+			}
+			require.Equal(t, tc.expectedEvents, events)
+			require.Equal(t, tc.expectedActivated, k.GetVaultActivated(ctx, constants.Vault_Clob0))
+
+			// Check that an inactive vault has no resting orders.
+			if !tc.expectedActivated {
+				require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// getVaultActivityLogStore returns the store of a vault's activity log, where entries are
+// keyed by their big-endian sequence number so that iteration is in insertion order.
+func (k Keeper) getVaultActivityLogStore(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	activityLogStore := prefix.NewStore(store, []byte(types.ActivityLogKeyPrefix))
+	return prefix.NewStore(activityLogStore, vaultId.ToStateKeyPrefix())
+}
+
+// appendVaultActivity appends an entry at current block height to a vault's activity log.
+func (k Keeper) appendVaultActivity(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	activity types.VaultActivity,
+) {
+	store := k.getVaultActivityLogStore(ctx, vaultId)
+
+	// Sequence number of the new entry is one more than that of the last entry.
+	sequence := uint64(0)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, []byte{})
+	if iterator.Valid() {
+		sequence = binary.BigEndian.Uint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	activity.BlockHeight = lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	store.Set(binary.BigEndian.AppendUint64(nil, sequence), k.cdc.MustMarshal(&activity))
+}
+
+// GetVaultActivityLog returns all entries of a vault's activity log in the order they
+// were appended.
+func (k Keeper) GetVaultActivityLog(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) []types.VaultActivity {
+	activities := []types.VaultActivity{}
+	iterator := storetypes.KVStorePrefixIterator(k.getVaultActivityLogStore(ctx, vaultId), []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var activity types.VaultActivity
+		k.cdc.MustUnmarshal(iterator.Value(), &activity)
+		activities = append(activities, activity)
+	}
+	return activities
+}
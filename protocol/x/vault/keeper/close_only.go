@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultCloseOnly returns whether a vault is in close-only mode. Returns false if the
+// vault's close-only mode has never been set.
+func (k Keeper) GetVaultCloseOnly(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (closeOnly bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CloseOnlyKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return false
+	}
+
+	var value gogotypes.BoolValue
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value
+}
+
+// SetVaultCloseOnly sets whether a vault is in close-only mode.
+func (k Keeper) SetVaultCloseOnly(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	closeOnly bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CloseOnlyKeyPrefix))
+	if !closeOnly {
+		store.Delete(vaultId.ToStateKey())
+		return
+	}
+	value := gogotypes.BoolValue{Value: closeOnly}
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// getVaultCloseOnlyOrders returns the given orders of a CLOB vault that reduce its position
+// in the perpetual of its clob pair, i.e. asks if the vault is long and bids if it is short.
+// No orders are returned if the vault has no position.
+func (k Keeper) getVaultCloseOnlyOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	clobPair clobtypes.ClobPair,
+	orders []*clobtypes.Order,
+) []*clobtypes.Order {
+	inventory := k.GetVaultInventoryInPerpetual(ctx, vaultId, clobPair.MustGetPerpetualId())
+	closeOnlyOrders := make([]*clobtypes.Order, 0, len(orders))
+	for _, order := range orders {
+		if (inventory.Sign() > 0 && order.Side == clobtypes.Order_SIDE_SELL) ||
+			(inventory.Sign() < 0 && order.Side == clobtypes.Order_SIDE_BUY) {
+			closeOnlyOrders = append(closeOnlyOrders, order)
+		}
+	}
+	return closeOnlyOrders
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetOwnerCostBasis returns the cost basis (in quote quantums) of an owner's shares in a
+// vault, which is zero if the owner has never deposited into the vault.
+func (k Keeper) GetOwnerCostBasis(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+) *big.Int {
+	store := k.getVaultOwnerCostBasisStore(ctx, vaultId)
+
+	b := store.Get([]byte(owner))
+	if b == nil {
+		return big.NewInt(0)
+	}
+
+	var costBasis types.OwnerCostBasis
+	k.cdc.MustUnmarshal(b, &costBasis)
+	return costBasis.CostBasisQuoteQuantums.BigInt()
+}
+
+// SetOwnerCostBasis sets the cost basis (in quote quantums) of an owner's shares in a vault.
+func (k Keeper) SetOwnerCostBasis(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+	costBasisQuoteQuantums *big.Int,
+) {
+	store := k.getVaultOwnerCostBasisStore(ctx, vaultId)
+	costBasis := types.OwnerCostBasis{
+		CostBasisQuoteQuantums: dtypes.NewIntFromBigInt(costBasisQuoteQuantums),
+	}
+	store.Set([]byte(owner), k.cdc.MustMarshal(&costBasis))
+}
+
+// getVaultOwnerCostBasisStore returns the store for cost basis of owner shares of a given vault.
+func (k Keeper) getVaultOwnerCostBasisStore(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OwnerCostBasisKeyPrefix))
+	return prefix.NewStore(store, vaultId.ToStateKeyPrefix())
+}
+
+// GetOwnerUnrealizedPnl returns the unrealized PnL (in quote quantums) of an owner's shares
+// in a vault, i.e. `owner_shares * vault_equity / total_shares - cost_basis` where share value
+// is rounded down.
+func (k Keeper) GetOwnerUnrealizedPnl(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+) (*big.Int, error) {
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", vaultId)
+	}
+	ownerShares, exists := k.GetOwnerShares(ctx, vaultId, owner)
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrOwnerShareNotFound, "VaultId: %v, Owner: %v", vaultId, owner)
+	}
+	equity, err := k.GetVaultEquity(ctx, vaultId)
+	if err != nil {
+		return nil, err
+	}
+
+	shareValue := big.NewInt(0)
+	if totalShares.NumShares.Sign() > 0 {
+		shareValue.Mul(ownerShares.NumShares.BigInt(), equity)
+		shareValue.Quo(shareValue, totalShares.NumShares.BigInt())
+	}
+	return shareValue.Sub(shareValue, k.GetOwnerCostBasis(ctx, vaultId, owner)), nil
+}
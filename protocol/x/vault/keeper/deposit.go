@@ -4,65 +4,49 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
 // MintShares mints shares of a vault for `owner` based on `quantumsToDeposit` by:
-// 1. Increasing total shares of the vault.
-// 2. Increasing owner shares of the vault for given `owner`.
+// 1. Settling pending funding of the vault if `settle_funding_before_share_math` is true.
+// 2. Increasing total shares of the vault.
+// 3. Increasing owner shares of the vault for given `owner`.
+// 4. Increasing cost basis of owner shares by `quantumsToDeposit`.
+// 5. Emitting a vault_deposit event.
+// Shares to mint account for pending funding regardless of whether it's settled first, as
+// vault equity is computed from the vault's subaccount with pending funding settled.
 func (k Keeper) MintShares(
 	ctx sdk.Context,
 	vaultId types.VaultId,
 	owner string,
 	quantumsToDeposit *big.Int,
 ) error {
-	// Quantums to deposit should be positive.
-	if quantumsToDeposit.Sign() <= 0 {
-		return types.ErrInvalidDepositAmount
+	if k.GetParams(ctx).SettleFundingBeforeShareMath {
+		if err := k.settleVaultFunding(ctx, vaultId); err != nil {
+			return err
+		}
+	}
+
+	// Calculate shares to mint.
+	sharesToMint, _, err := k.getSharesToMint(ctx, vaultId, quantumsToDeposit)
+	if err != nil {
+		return err
 	}
 	// Get existing TotalShares of the vault.
 	totalShares, exists := k.GetTotalShares(ctx, vaultId)
 	existingTotalShares := totalShares.NumShares.BigInt()
-	// Calculate shares to mint.
-	var sharesToMint *big.Int
 	if !exists || existingTotalShares.Sign() <= 0 {
-		// Mint `quoteQuantums` number of shares.
-		sharesToMint = new(big.Int).Set(quantumsToDeposit)
 		// Initialize existingTotalShares as 0.
 		existingTotalShares = big.NewInt(0)
-	} else {
-		// Get vault equity.
-		equity, err := k.GetVaultEquity(ctx, vaultId)
-		if err != nil {
-			return err
-		}
-		// Don't mint shares if equity is non-positive.
-		if equity.Sign() <= 0 {
-			return types.ErrNonPositiveEquity
-		}
-		// Mint `deposit (in quote quantums) * existing shares / vault equity (in quote quantums)`
-		// number of shares.
-		// For example:
-		// - a vault currently has 5000 shares and 4000 equity (in quote quantums)
-		// - each quote quantum is worth 5000 / 4000 = 1.25 shares
-		// - a deposit of 1000 quote quantums should thus be given 1000 * 1.25 = 1250 shares
-		sharesToMint = new(big.Int).Set(quantumsToDeposit)
-		sharesToMint = sharesToMint.Mul(sharesToMint, existingTotalShares)
-		sharesToMint = sharesToMint.Quo(sharesToMint, equity)
-
-		// Return error if `sharesToMint` is rounded down to 0.
-		if sharesToMint.Sign() == 0 {
-			return types.ErrZeroSharesToMint
-		}
 	}
 
 	// Increase TotalShares of the vault.
-	err := k.SetTotalShares(
+	totalSharesAfter := new(big.Int).Add(existingTotalShares, sharesToMint)
+	err = k.SetTotalShares(
 		ctx,
 		vaultId,
-		types.BigIntToNumShares(
-			existingTotalShares.Add(existingTotalShares, sharesToMint),
-		),
+		types.BigIntToNumShares(totalSharesAfter),
 	)
 	if err != nil {
 		return err
@@ -97,5 +81,63 @@ func (k Keeper) MintShares(
 		}
 	}
 
+	// Increase cost basis of owner shares.
+	costBasis := k.GetOwnerCostBasis(ctx, vaultId, owner)
+	k.SetOwnerCostBasis(ctx, vaultId, owner, costBasis.Add(costBasis, quantumsToDeposit))
+
+	ctx.EventManager().EmitEvent(
+		types.NewVaultDepositEvent(vaultId, owner, quantumsToDeposit, sharesToMint, totalSharesAfter),
+	)
+
 	return nil
 }
+
+// getSharesToMint returns the number of shares of a vault that a deposit of `quantumsToDeposit`
+// would mint and the price of one share (in quote quantums, in ppm) without mutating state.
+func (k Keeper) getSharesToMint(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	quantumsToDeposit *big.Int,
+) (sharesToMint *big.Int, sharePricePpm *big.Int, err error) {
+	// Quantums to deposit should be positive.
+	if quantumsToDeposit.Sign() <= 0 {
+		return nil, nil, types.ErrInvalidDepositAmount
+	}
+	// Get existing TotalShares of the vault.
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	existingTotalShares := totalShares.NumShares.BigInt()
+	if !exists || existingTotalShares.Sign() <= 0 {
+		// Mint `quoteQuantums` number of shares, i.e. each share is worth one quote quantum.
+		return new(big.Int).Set(quantumsToDeposit), lib.BigIntOneMillion(), nil
+	}
+
+	// Get vault equity.
+	equity, err := k.GetVaultEquity(ctx, vaultId)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Don't mint shares if equity is non-positive.
+	if equity.Sign() <= 0 {
+		return nil, nil, types.ErrNonPositiveEquity
+	}
+	// Mint `deposit (in quote quantums) * existing shares / vault equity (in quote quantums)`
+	// number of shares.
+	// For example:
+	// - a vault currently has 5000 shares and 4000 equity (in quote quantums)
+	// - each quote quantum is worth 5000 / 4000 = 1.25 shares
+	// - a deposit of 1000 quote quantums should thus be given 1000 * 1.25 = 1250 shares
+	sharesToMint = new(big.Int).Set(quantumsToDeposit)
+	sharesToMint = sharesToMint.Mul(sharesToMint, existingTotalShares)
+	sharesToMint = sharesToMint.Quo(sharesToMint, equity)
+
+	// Return error if `sharesToMint` is rounded down to 0.
+	if sharesToMint.Sign() == 0 {
+		return nil, nil, types.ErrZeroSharesToMint
+	}
+
+	// Share price (in ppm) = `equity * 1_000_000 / existing shares`.
+	sharePricePpm = new(big.Int).Mul(equity, lib.BigIntOneMillion())
+	sharePricePpm = sharePricePpm.Quo(sharePricePpm, existingTotalShares)
+
+	return sharesToMint, sharePricePpm, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
@@ -199,6 +200,7 @@ func TestMintShares(t *testing.T) {
 			}
 
 			// Mint shares.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			err := tApp.App.VaultKeeper.MintShares(
 				ctx,
 				tc.vaultId,
@@ -236,7 +238,125 @@ func TestMintShares(t *testing.T) {
 					vaulttypes.BigIntToNumShares(tc.expectedOwnerShares),
 					ownerShares,
 				)
+				// Check that a deposit event with minted shares and total shares after is emitted.
+				expectedSharesMinted := new(big.Int).Set(tc.expectedOwnerShares)
+				if tc.ownerShares != nil {
+					expectedSharesMinted.Sub(expectedSharesMinted, tc.ownerShares)
+				}
+				require.Contains(
+					t,
+					ctx.EventManager().Events(),
+					vaulttypes.NewVaultDepositEvent(
+						tc.vaultId,
+						tc.owner,
+						tc.quantumsToDeposit,
+						expectedSharesMinted,
+						tc.expectedTotalShares,
+					),
+				)
 			}
 		})
 	}
 }
+
+func TestMintShares_PendingFunding(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Funding index delta of the vault's perpetual since the vault's position last settled.
+		fundingIndexDelta *big.Int
+		// Whether to settle funding before share math.
+		settleFundingBeforeShareMath bool
+
+		/* --- Expectations --- */
+		// Expected minted shares.
+		expectedSharesMinted *big.Int
+		// Expected usdc position of the vault after minting.
+		expectedUsdcPosition *big.Int
+		// Expected funding index of the vault's position after minting.
+		expectedFundingIndex *big.Int
+	}{
+		"No pending funding": {
+			fundingIndexDelta: big.NewInt(0),
+			// 1_000_000 * 1_020_000_000 / 1_020_000_000
+			expectedSharesMinted: big.NewInt(1_000_000),
+			expectedUsdcPosition: big.NewInt(1_000_000_000),
+			expectedFundingIndex: big.NewInt(0),
+		},
+		"Pending funding, not settled before share math": {
+			fundingIndexDelta: big.NewInt(100_000),
+			// 1_000_000 * 1_020_000_000 / 1_019_000_000
+			expectedSharesMinted: big.NewInt(1_000_981),
+			expectedUsdcPosition: big.NewInt(1_000_000_000),
+			expectedFundingIndex: big.NewInt(0),
+		},
+		"Pending funding, settled before share math": {
+			fundingIndexDelta:            big.NewInt(100_000),
+			settleFundingBeforeShareMath: true,
+			// 1_000_000 * 1_020_000_000 / 1_019_000_000
+			expectedSharesMinted: big.NewInt(1_000_981),
+			// 1_000_000_000 - 100_000 * 10_000_000 / 1_000_000
+			expectedUsdcPosition: big.NewInt(999_000_000),
+			expectedFundingIndex: big.NewInt(100_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			owner := constants.AliceAccAddress.String()
+			// Initialize vault with 1,000 USDC and a long position of 0.001 BTC ($20).
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										0,
+										big.NewInt(1_000_000_000),
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										big.NewInt(10_000_000),
+										big.NewInt(0),
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.SettleFundingBeforeShareMath = tc.settleFundingBeforeShareMath
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_020_000_000)))
+			require.NoError(t, err)
+			err = tApp.App.PerpetualsKeeper.ModifyFundingIndex(ctx, 0, tc.fundingIndexDelta)
+			require.NoError(t, err)
+
+			// Mint shares.
+			err = k.MintShares(ctx, vaultId, owner, big.NewInt(1_000_000))
+			require.NoError(t, err)
+			ownerShares, exists := k.GetOwnerShares(ctx, vaultId, owner)
+			require.True(t, exists)
+			require.Equal(t, vaulttypes.BigIntToNumShares(tc.expectedSharesMinted), ownerShares)
+
+			// Check whether pending funding was settled into the vault's subaccount.
+			subaccount := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+			require.Equal(t, tc.expectedUsdcPosition, subaccount.GetUsdcPosition())
+			require.Len(t, subaccount.PerpetualPositions, 1)
+			require.Equal(t, tc.expectedFundingIndex, subaccount.PerpetualPositions[0].FundingIndex.BigInt())
+		})
+	}
+}
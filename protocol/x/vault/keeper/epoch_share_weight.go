@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetOwnerEpochShareWeight returns the time-weighted share balance (in share-seconds) of an
+// owner in a vault during an epoch, i.e. the owner's shares integrated over time from start of
+// the epoch to its end, or to current block time if the epoch is the current epoch. An owner's
+// weight in an epoch divided by total weight of all owners in the epoch is the owner's portion
+// of rewards distributed for the epoch. Returns zero for future epochs and an error if epochs
+// are disabled or the epoch is more than `OwnerShareEpochRetention` epochs old.
+func (k Keeper) GetOwnerEpochShareWeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+	epoch uint32,
+) (*big.Int, error) {
+	epochSeconds := uint64(k.GetParams(ctx).OwnerShareEpochSeconds)
+	if epochSeconds == 0 {
+		return nil, types.ErrOwnerShareEpochsDisabled
+	}
+	now := uint64(ctx.BlockTime().Unix())
+	currentEpoch := uint32(now / epochSeconds)
+	if epoch > currentEpoch {
+		return big.NewInt(0), nil
+	}
+	if currentEpoch-epoch > types.OwnerShareEpochRetention {
+		return nil, errorsmod.Wrapf(
+			types.ErrOwnerShareEpochExpired,
+			"Epoch: %d, CurrentEpoch: %d",
+			epoch,
+			currentEpoch,
+		)
+	}
+	epochStart := uint64(epoch) * epochSeconds
+	epochEnd := lib.Min(epochStart+epochSeconds, now)
+
+	// If owner shares changed in the epoch, weight is accumulated weight plus shares since
+	// the last change.
+	store := k.getOwnerEpochShareWeightStore(ctx, vaultId, owner)
+	if b := store.Get(lib.Uint32ToKey(epoch)); b != nil {
+		var epochWeight types.OwnerEpochShareWeight
+		k.cdc.MustUnmarshal(b, &epochWeight)
+		weight := new(big.Int).Mul(
+			epochWeight.EndShares.BigInt(),
+			lib.BigU(epochEnd-epochWeight.LastUpdateTime),
+		)
+		return weight.Add(weight, epochWeight.Weight.BigInt()), nil
+	}
+
+	// Otherwise, owner shares were constant throughout the epoch and equal to shares at start
+	// of the next epoch in which they changed, or current shares if they haven't changed since.
+	shares := big.NewInt(0)
+	iterator := store.Iterator(lib.Uint32ToKey(epoch), nil)
+	defer iterator.Close()
+	if iterator.Valid() {
+		var epochWeight types.OwnerEpochShareWeight
+		k.cdc.MustUnmarshal(iterator.Value(), &epochWeight)
+		shares = epochWeight.StartShares.BigInt()
+	} else if ownerShares, exists := k.GetOwnerShares(ctx, vaultId, owner); exists {
+		shares = ownerShares.NumShares.BigInt()
+	}
+	return shares.Mul(shares, lib.BigU(epochEnd-epochStart)), nil
+}
+
+// updateOwnerEpochShareWeight accumulates the time-weighted share balance of an owner in a vault
+// in the current epoch upon the owner's shares changing from `oldShares` to `newShares`, and
+// expires balances of epochs more than `OwnerShareEpochRetention` epochs old. This is a no-op
+// if epochs are disabled.
+func (k Keeper) updateOwnerEpochShareWeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+	oldShares *big.Int,
+	newShares *big.Int,
+) {
+	epochSeconds := uint64(k.GetParams(ctx).OwnerShareEpochSeconds)
+	if epochSeconds == 0 {
+		return
+	}
+	now := uint64(ctx.BlockTime().Unix())
+	epoch := uint32(now / epochSeconds)
+
+	// Shares are `oldShares` since the last change or start of the epoch.
+	store := k.getOwnerEpochShareWeightStore(ctx, vaultId, owner)
+	epochWeight := types.OwnerEpochShareWeight{
+		Weight:         dtypes.NewInt(0),
+		StartShares:    dtypes.NewIntFromBigInt(oldShares),
+		EndShares:      dtypes.NewIntFromBigInt(oldShares),
+		LastUpdateTime: uint64(epoch) * epochSeconds,
+	}
+	if b := store.Get(lib.Uint32ToKey(epoch)); b != nil {
+		k.cdc.MustUnmarshal(b, &epochWeight)
+	}
+	weight := new(big.Int).Mul(oldShares, lib.BigU(now-epochWeight.LastUpdateTime))
+	weight.Add(weight, epochWeight.Weight.BigInt())
+	epochWeight.Weight = dtypes.NewIntFromBigInt(weight)
+	epochWeight.EndShares = dtypes.NewIntFromBigInt(newShares)
+	epochWeight.LastUpdateTime = now
+	store.Set(lib.Uint32ToKey(epoch), k.cdc.MustMarshal(&epochWeight))
+
+	// Expire balances of old epochs.
+	if epoch <= types.OwnerShareEpochRetention {
+		return
+	}
+	iterator := store.Iterator(nil, lib.Uint32ToKey(epoch-types.OwnerShareEpochRetention))
+	expiredKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
+
+// getOwnerEpochShareWeightStore returns the store for time-weighted share balances per epoch
+// of an owner in a given vault.
+func (k Keeper) getOwnerEpochShareWeightStore(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OwnerEpochShareWeightKeyPrefix))
+	store = prefix.NewStore(store, vaultId.ToStateKeyPrefix())
+	return prefix.NewStore(store, []byte(owner+"/"))
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOwnerEpochShareWeight(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	vaultId := constants.Vault_Clob0
+	alice := constants.AliceAccAddress.String()
+	bob := constants.BobAccAddress.String()
+
+	// Epochs are disabled by default.
+	_, err := k.GetOwnerEpochShareWeight(ctx, vaultId, alice, 0)
+	require.ErrorIs(t, err, vaulttypes.ErrOwnerShareEpochsDisabled)
+
+	// Epoch 10 spans [1_000, 1_100) and epoch 11 spans [1_100, 1_200).
+	params := k.GetParams(ctx)
+	params.OwnerShareEpochSeconds = 100
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	setOwnerSharesAt := func(unixTime int64, owner string, shares int64) {
+		err := k.SetOwnerShares(
+			ctx.WithBlockTime(time.Unix(unixTime, 0)),
+			vaultId,
+			owner,
+			vaulttypes.BigIntToNumShares(big.NewInt(shares)),
+		)
+		require.NoError(t, err)
+	}
+	getWeightAt := func(unixTime int64, owner string, epoch uint32) *big.Int {
+		weight, err := k.GetOwnerEpochShareWeight(ctx.WithBlockTime(time.Unix(unixTime, 0)), vaultId, owner, epoch)
+		require.NoError(t, err)
+		return weight
+	}
+
+	// Alice deposits for 100 shares 20 seconds into epoch 10, Bob deposits for 50 shares 50
+	// seconds into epoch 10, and Alice deposits for 200 more shares 80 seconds into epoch 10.
+	setOwnerSharesAt(1_020, alice, 100)
+	setOwnerSharesAt(1_050, bob, 50)
+	// Weight of the current epoch is up to current block time.
+	require.Equal(t, big.NewInt(100*30), getWeightAt(1_050, alice, 10))
+	setOwnerSharesAt(1_080, alice, 300)
+	require.Equal(t, big.NewInt(100*60+300*10), getWeightAt(1_090, alice, 10))
+	require.Equal(t, big.NewInt(50*40), getWeightAt(1_090, bob, 10))
+
+	// Weight of a past epoch is up to the end of the epoch.
+	require.Equal(t, big.NewInt(100*60+300*20), getWeightAt(1_150, alice, 10))
+	require.Equal(t, big.NewInt(50*50), getWeightAt(1_150, bob, 10))
+
+	// Shares that didn't change in an epoch are weighted by time elapsed in the epoch.
+	require.Equal(t, big.NewInt(300*50), getWeightAt(1_150, alice, 11))
+	require.Equal(t, big.NewInt(50*50), getWeightAt(1_150, bob, 11))
+
+	// No weight before deposits or in future epochs.
+	require.Equal(t, big.NewInt(0), getWeightAt(1_150, alice, 9))
+	require.Equal(t, big.NewInt(0), getWeightAt(1_150, alice, 12))
+
+	// Weight of an epoch without changes uses shares held throughout the epoch, even after
+	// shares change in a later epoch.
+	setOwnerSharesAt(1_350, alice, 400)
+	require.Equal(t, big.NewInt(300*100), getWeightAt(1_350, alice, 12))
+	require.Equal(t, big.NewInt(300*50), getWeightAt(1_350, alice, 13))
+
+	// Weight of an epoch more than `OwnerShareEpochRetention` epochs old is expired.
+	expiredTime := int64(10+vaulttypes.OwnerShareEpochRetention+1) * 100
+	_, err = k.GetOwnerEpochShareWeight(ctx.WithBlockTime(time.Unix(expiredTime, 0)), vaultId, alice, 10)
+	require.ErrorIs(t, err, vaulttypes.ErrOwnerShareEpochExpired)
+	require.Equal(t, big.NewInt(50*100), getWeightAt(expiredTime, bob, 11))
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultFillStats returns the fill statistics of a vault, which are zero if the vault
+// has no fills.
+func (k Keeper) GetVaultFillStats(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (stats types.VaultFillStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FillStatsKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return types.VaultFillStats{
+			VolumeQuoteQuantums:         dtypes.NewInt(0),
+			RealizedSpreadQuoteQuantums: dtypes.NewInt(0),
+		}
+	}
+
+	k.cdc.MustUnmarshal(b, &stats)
+	return stats
+}
+
+// SetVaultFillStats sets the fill statistics of a vault.
+func (k Keeper) SetVaultFillStats(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	stats types.VaultFillStats,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FillStatsKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&stats))
+}
+
+// RecordVaultFill updates fill statistics of a vault if the given subaccount is the
+// subaccount of the CLOB vault of the given clob pair, and is a no-op otherwise. Realized
+// spread of the fill is how much better than oracle price (of the vault's price market) the
+// fill is, i.e. `oracle notional - fill notional` for buys and `fill notional - oracle notional`
+// for sells.
+func (k Keeper) RecordVaultFill(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId clobtypes.ClobPairId,
+	isBuy bool,
+	fillBaseQuantums *big.Int,
+	fillQuoteQuantums *big.Int,
+) {
+	vaultId := types.VaultId{
+		Type:   types.VaultType_VAULT_TYPE_CLOB,
+		Number: clobPairId.ToUint32(),
+	}
+	if subaccountId != *vaultId.ToSubaccountId() {
+		return
+	}
+
+	// Get oracle price that the vault quotes at.
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobPairId)
+	if !exists {
+		log.ErrorLog(ctx, "Failed to record vault fill: clob pair not found", "vaultId", vaultId)
+		return
+	}
+	perpId := clobPair.Metadata.(*clobtypes.ClobPair_PerpetualClobMetadata).PerpetualClobMetadata.PerpetualId
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to record vault fill: failed to get perpetual", err, "vaultId", vaultId)
+		return
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	marketPrice, err := k.getVaultMarketPrice(
+		ctx,
+		vaultParams,
+		getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId),
+	)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to record vault fill: failed to get market price", err, "vaultId", vaultId)
+		return
+	}
+
+	// Calculate realized spread.
+	oracleQuoteQuantums := lib.BaseToQuoteQuantums(
+		fillBaseQuantums,
+		perpetual.Params.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	)
+	realizedSpread := new(big.Int).Sub(fillQuoteQuantums, oracleQuoteQuantums)
+	if isBuy {
+		realizedSpread.Neg(realizedSpread)
+	}
+
+	stats := k.GetVaultFillStats(ctx, vaultId)
+	stats.NumFills++
+	stats.VolumeQuoteQuantums = dtypes.NewIntFromBigInt(
+		new(big.Int).Add(stats.VolumeQuoteQuantums.BigInt(), fillQuoteQuantums),
+	)
+	stats.RealizedSpreadQuoteQuantums = dtypes.NewIntFromBigInt(
+		new(big.Int).Add(stats.RealizedSpreadQuoteQuantums.BigInt(), realizedSpread),
+	)
+	k.SetVaultFillStats(ctx, vaultId, stats)
+
+	// Emit metrics on fill statistics.
+	side := metrics.Sell
+	if isBuy {
+		side = metrics.Buy
+	}
+	vaultId.IncrCounterWithLabels(
+		metrics.VaultFill,
+		metrics.GetLabelForStringValue(metrics.OrderSide, side),
+	)
+	vaultId.SetGaugeWithLabels(
+		metrics.VaultFillVolume,
+		float32(stats.VolumeQuoteQuantums.BigInt().Int64()),
+	)
+	vaultId.SetGaugeWithLabels(
+		metrics.VaultRealizedSpread,
+		float32(stats.RealizedSpreadQuoteQuantums.BigInt().Int64()),
+	)
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordVaultFill(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Subaccount of the filled order.
+		subaccountId satypes.SubaccountId
+		// Clob pair of the filled order.
+		clobPairId clobtypes.ClobPairId
+		// Whether the filled order is a buy.
+		isBuy bool
+		// Fill amount in base quantums.
+		fillBaseQuantums *big.Int
+		// Fill amount in quote quantums.
+		fillQuoteQuantums *big.Int
+
+		/* --- Expectations --- */
+		// Vault whose stats are checked.
+		vaultId vaulttypes.VaultId
+		// Expected fill stats of above vault.
+		expectedStats vaulttypes.VaultFillStats
+	}{
+		"Vault sells above oracle price": {
+			subaccountId: *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:   0,
+			isBuy:        false,
+			// Sell 0.005 BTC at $20,200, i.e. $1 above oracle price notional ($100).
+			fillBaseQuantums:  big.NewInt(50_000_000),
+			fillQuoteQuantums: big.NewInt(101_000_000),
+			vaultId:           constants.Vault_Clob0,
+			expectedStats: vaulttypes.VaultFillStats{
+				NumFills:                    1,
+				VolumeQuoteQuantums:         dtypes.NewInt(101_000_000),
+				RealizedSpreadQuoteQuantums: dtypes.NewInt(1_000_000),
+			},
+		},
+		"Vault buys above oracle price": {
+			subaccountId: *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:   0,
+			isBuy:        true,
+			// Buy 0.005 BTC at $20,100, i.e. $0.5 above oracle price notional ($100).
+			fillBaseQuantums:  big.NewInt(50_000_000),
+			fillQuoteQuantums: big.NewInt(100_500_000),
+			vaultId:           constants.Vault_Clob0,
+			expectedStats: vaulttypes.VaultFillStats{
+				NumFills:                    1,
+				VolumeQuoteQuantums:         dtypes.NewInt(100_500_000),
+				RealizedSpreadQuoteQuantums: dtypes.NewInt(-500_000),
+			},
+		},
+		"Non-vault subaccount": {
+			subaccountId:      constants.Alice_Num0,
+			clobPairId:        0,
+			isBuy:             true,
+			fillBaseQuantums:  big.NewInt(50_000_000),
+			fillQuoteQuantums: big.NewInt(100_500_000),
+			vaultId:           constants.Vault_Clob0,
+			expectedStats: vaulttypes.VaultFillStats{
+				VolumeQuoteQuantums:         dtypes.NewInt(0),
+				RealizedSpreadQuoteQuantums: dtypes.NewInt(0),
+			},
+		},
+		"Vault subaccount on a different clob pair": {
+			subaccountId:      *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:        1,
+			isBuy:             true,
+			fillBaseQuantums:  big.NewInt(50_000_000),
+			fillQuoteQuantums: big.NewInt(100_500_000),
+			vaultId:           constants.Vault_Clob0,
+			expectedStats: vaulttypes.VaultFillStats{
+				VolumeQuoteQuantums:         dtypes.NewInt(0),
+				RealizedSpreadQuoteQuantums: dtypes.NewInt(0),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			k.RecordVaultFill(
+				ctx,
+				tc.subaccountId,
+				tc.clobPairId,
+				tc.isBuy,
+				tc.fillBaseQuantums,
+				tc.fillQuoteQuantums,
+			)
+			require.Equal(t, tc.expectedStats, k.GetVaultFillStats(ctx, tc.vaultId))
+		})
+	}
+}
+
+func TestVaultFillStats_MatchedOrder(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = append(genesisState.Subaccounts, satypes.Subaccount{
+					Id: vaultId.ToSubaccountId(),
+					AssetPositions: []*satypes.AssetPosition{
+						testutil.CreateSingleAssetPosition(
+							assettypes.AssetUsdc.Id,
+							big.NewInt(1_000_000_000), // 1,000 USDC
+						),
+					},
+				})
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Vaults = []*vaulttypes.Vault{
+					{
+						VaultId:     &vaultId,
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+
+	// Vault places orders in end blocker, where a_0 sells 0.005 BTC at $20,200.
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	// Alice buys from vault's a_0.
+	checkTx := testapp.MustMakeCheckTx(
+		ctx,
+		tApp.App,
+		testapp.MustMakeCheckTxOptions{
+			AccAddressForSigning: constants.Alice_Num0.Owner,
+		},
+		&clobtypes.MsgPlaceOrder{
+			Order: clobtypes.Order{
+				OrderId: clobtypes.OrderId{
+					SubaccountId: constants.Alice_Num0,
+					ClientId:     0,
+					ClobPairId:   0,
+				},
+				Side:         clobtypes.Order_SIDE_BUY,
+				Quantums:     50_000_000,
+				Subticks:     202_000_000,
+				GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 5},
+			},
+		},
+	)
+	resp := tApp.CheckTx(checkTx)
+	require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+	// Fill notional is $101 and oracle notional is $100, so realized spread is $1.
+	res, err := tApp.App.VaultKeeper.VaultFillStats(ctx, &vaulttypes.QueryVaultFillStatsRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		vaulttypes.VaultFillStats{
+			NumFills:                    1,
+			VolumeQuoteQuantums:         dtypes.NewInt(101_000_000),
+			RealizedSpreadQuoteQuantums: dtypes.NewInt(1_000_000),
+		},
+		res.Stats,
+	)
+}
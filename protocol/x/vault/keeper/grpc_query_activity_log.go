@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) VaultActivityLog(
+	c context.Context,
+	req *types.QueryVaultActivityLogRequest,
+) (*types.QueryVaultActivityLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	var activities []*types.VaultActivity
+	activityLogStore := k.getVaultActivityLogStore(ctx, vaultId)
+	pageRes, err := query.Paginate(activityLogStore, req.Pagination, func(key []byte, value []byte) error {
+		var activity types.VaultActivity
+		k.cdc.MustUnmarshal(value, &activity)
+		activities = append(activities, &activity)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultActivityLogResponse{
+		Activities: activities,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultActivityLog(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+	require.NoError(t, err)
+
+	// Interleave params changes and status changes at different block heights. Max oracle
+	// price is set such that the vault refreshes without placing orders.
+	ctx = ctx.WithBlockHeight(10)
+	err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+		Label:          "label 1",
+		MaxOraclePrice: 1,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	k.RefreshAllVaultOrders(ctx) // Activates vault.
+
+	ctx = ctx.WithBlockHeight(12)
+	err = k.SetVaultLabel(ctx, vaultId, "label 2")
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(13)
+	k.RefreshAllVaultOrders(ctx) // No status change.
+
+	ctx = ctx.WithBlockHeight(14)
+	err = k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(0)))
+	require.NoError(t, err)
+	k.RefreshAllVaultOrders(ctx) // Deactivates vault.
+
+	expectedActivities := []*vaulttypes.VaultActivity{
+		{
+			BlockHeight: 10,
+			Type:        vaulttypes.VaultActivityType_VAULT_ACTIVITY_TYPE_PARAMS_CHANGE,
+			VaultParams: &vaulttypes.VaultParams{Label: "label 1", MaxOraclePrice: 1},
+		},
+		{
+			BlockHeight: 11,
+			Type:        vaulttypes.VaultActivityType_VAULT_ACTIVITY_TYPE_STATUS_CHANGE,
+			Activated:   true,
+		},
+		{
+			BlockHeight: 12,
+			Type:        vaulttypes.VaultActivityType_VAULT_ACTIVITY_TYPE_PARAMS_CHANGE,
+			VaultParams: &vaulttypes.VaultParams{Label: "label 2", MaxOraclePrice: 1},
+		},
+		{
+			BlockHeight: 14,
+			Type:        vaulttypes.VaultActivityType_VAULT_ACTIVITY_TYPE_STATUS_CHANGE,
+			Activated:   false,
+		},
+	}
+
+	// Check that the full log is in order.
+	response, err := k.VaultActivityLog(ctx, &vaulttypes.QueryVaultActivityLogRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedActivities, response.Activities)
+
+	// Check that the log can be paginated.
+	firstPage, err := k.VaultActivityLog(ctx, &vaulttypes.QueryVaultActivityLogRequest{
+		Type:       vaultId.Type,
+		Number:     vaultId.Number,
+		Pagination: &query.PageRequest{Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedActivities[:3], firstPage.Activities)
+	secondPage, err := k.VaultActivityLog(ctx, &vaulttypes.QueryVaultActivityLogRequest{
+		Type:       vaultId.Type,
+		Number:     vaultId.Number,
+		Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedActivities[3:], secondPage.Activities)
+
+	// Check that querying a non-existent vault or with a nil request fails.
+	_, err = k.VaultActivityLog(ctx, &vaulttypes.QueryVaultActivityLogRequest{
+		Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+		Number: 1,
+	})
+	require.ErrorContains(t, err, "vault not found")
+	_, err = k.VaultActivityLog(ctx, nil)
+	require.ErrorContains(t, err, "invalid request")
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) CurrentBlockClientIdParity(
+	c context.Context,
+	req *types.QueryCurrentBlockClientIdParityRequest,
+) (*types.QueryCurrentBlockClientIdParityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	return &types.QueryCurrentBlockClientIdParityResponse{
+		BlockParity: k.GetCurrentBlockClientIdParity(ctx),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentBlockClientIdParity(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Block height.
+		blockHeight int64
+		// Query request.
+		req *vaulttypes.QueryCurrentBlockClientIdParityRequest
+
+		/* --- Expectations --- */
+		expectedBlockParity uint32
+		expectedErr         string
+	}{
+		"Success: even block height": {
+			blockHeight:         2,
+			req:                 &vaulttypes.QueryCurrentBlockClientIdParityRequest{},
+			expectedBlockParity: 0,
+		},
+		"Success: odd block height": {
+			blockHeight:         3,
+			req:                 &vaulttypes.QueryCurrentBlockClientIdParityRequest{},
+			expectedBlockParity: 1,
+		},
+		"Success: negative odd block height": {
+			blockHeight:         -3,
+			req:                 &vaulttypes.QueryCurrentBlockClientIdParityRequest{},
+			expectedBlockParity: 1,
+		},
+		"Error: nil request": {
+			blockHeight: 2,
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain().WithBlockHeight(tc.blockHeight)
+			k := tApp.App.VaultKeeper
+
+			response, err := k.CurrentBlockClientIdParity(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedBlockParity, response.BlockParity)
+
+			// Parity matches that of client IDs of vault orders of current block.
+			orderIds, err := k.GetVaultClobOrderIds(ctx, constants.Vault_Clob0)
+			require.NoError(t, err)
+			require.NotEmpty(t, orderIds)
+			for _, orderId := range orderIds {
+				_, blockParity, _, err := vaulttypes.DecodeVaultClientId(orderId.ClientId)
+				require.NoError(t, err)
+				require.Equal(t, response.BlockParity, blockParity)
+			}
+		})
+	}
+}
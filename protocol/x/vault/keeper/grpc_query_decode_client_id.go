@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) DecodeVaultClientId(
+	c context.Context,
+	req *types.QueryDecodeVaultClientIdRequest,
+) (*types.QueryDecodeVaultClientIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	side, blockParity, layer, err := types.DecodeVaultClientId(req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDecodeVaultClientIdResponse{
+		Side:        side,
+		BlockParity: blockParity,
+		Layer:       uint32(layer),
+	}, nil
+}
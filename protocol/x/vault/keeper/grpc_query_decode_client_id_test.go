@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeVaultClientId(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Query request.
+		req *vaulttypes.QueryDecodeVaultClientIdRequest
+
+		/* --- Expectations --- */
+		expectedResponse *vaulttypes.QueryDecodeVaultClientIdResponse
+		expectedErr      string
+	}{
+		"Success: Sell, Block Height Odd, Layer 2": {
+			req: &vaulttypes.QueryDecodeVaultClientIdRequest{
+				ClientId: 1<<31 | 1<<30 | 2<<22,
+			},
+			expectedResponse: &vaulttypes.QueryDecodeVaultClientIdResponse{
+				Side:        clobtypes.Order_SIDE_SELL,
+				BlockParity: 1,
+				Layer:       2,
+			},
+		},
+		"Success: Buy, Block Height Even, Layer Max Uint8": {
+			req: &vaulttypes.QueryDecodeVaultClientIdRequest{
+				ClientId: 0<<31 | 0<<30 | 255<<22,
+			},
+			expectedResponse: &vaulttypes.QueryDecodeVaultClientIdResponse{
+				Side:        clobtypes.Order_SIDE_BUY,
+				BlockParity: 0,
+				Layer:       255,
+			},
+		},
+		"Error: non-zero lower bits": {
+			req: &vaulttypes.QueryDecodeVaultClientIdRequest{
+				ClientId: 1<<31 | 1<<22 | 1,
+			},
+			expectedErr: vaulttypes.ErrInvalidVaultClientId.Error(),
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			response, err := k.DecodeVaultClientId(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
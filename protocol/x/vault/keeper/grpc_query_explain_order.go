@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) ExplainVaultOrder(
+	c context.Context,
+	req *types.QueryExplainVaultOrderRequest,
+) (*types.QueryExplainVaultOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("clob pair %d doesn't exist", vaultId.Number))
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	_, explanations, err := k.getVaultClobOrdersWithExplanations(
+		ctx,
+		vaultId,
+		clobPair,
+		k.GetParams(ctx),
+		vaultParams,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, explanation := range explanations {
+		if explanation.Side == req.Side && explanation.Layer == req.Layer {
+			return &types.QueryExplainVaultOrderResponse{
+				Explanation: *explanation,
+			}, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "order not found")
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainVaultOrder(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Query request.
+		req *vaulttypes.QueryExplainVaultOrderRequest
+
+		/* --- Expectations --- */
+		expectedExplanation vaulttypes.VaultOrderExplanation
+		expectedErr         string
+	}{
+		"Success - Ask of layer 0": {
+			req: &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Side:   clobtypes.Order_SIDE_SELL,
+				Layer:  0,
+			},
+			expectedExplanation: vaulttypes.VaultOrderExplanation{
+				Side: clobtypes.Order_SIDE_SELL,
+				// spread = max(3_123, 1_500 + 50) = 3_123
+				SpreadPpm: 3_123,
+				// leverage_0 = 0 / 1_000 = 0
+				LeveragePpm: dtypes.NewInt(0),
+				// skew_0 = -0 * 3_123 * 0.554321 = 0
+				SkewPpm: dtypes.NewInt(0),
+				// a_0 = 5e5 * (1 + 0 + 0.003123) = 501_561.5 = 501_562 (rounded up)
+				RawSubticks:     dtypes.NewInt(501_562),
+				BoundedSubticks: dtypes.NewInt(501_562),
+				// Rounded up to a multiple of subticks_per_tick 5.
+				RoundedSubticks: 501_565,
+				// order_size = 10% * $1_000 / $50 = 2 = 20_000_000_000 base quantums
+				SizeBaseQuantums: 20_000_000_000,
+			},
+		},
+		"Success - Bid of layer 1": {
+			req: &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Side:   clobtypes.Order_SIDE_BUY,
+				Layer:  1,
+			},
+			expectedExplanation: vaulttypes.VaultOrderExplanation{
+				Side:  clobtypes.Order_SIDE_BUY,
+				Layer: 1,
+				// spread * 2 = 6_246
+				SpreadPpm: 6_246,
+				// leverage_1 = 0 + 0.1
+				LeveragePpm: dtypes.NewInt(100_000),
+				// skew_1 = -0.1 * 0.003123 * 0.554321 ~= -0.000173
+				SkewPpm: dtypes.NewInt(-173),
+				// b_1 = 5e5 * (1 - 0.000173 - 0.006246) = 496_790.5 = 496_790 (rounded down)
+				RawSubticks:      dtypes.NewInt(496_790),
+				BoundedSubticks:  dtypes.NewInt(496_790),
+				RoundedSubticks:  496_790,
+				SizeBaseQuantums: 20_000_000_000,
+			},
+		},
+		"Error: layer out of range": {
+			req: &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Side:   clobtypes.Order_SIDE_SELL,
+				Layer:  2,
+			},
+			expectedErr: "order not found",
+		},
+		"Error: query non-existent vault": {
+			req: &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1, // Non-existent vault.
+				Side:   clobtypes.Order_SIDE_SELL,
+				Layer:  0,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *pricestypes.GenesisState) {
+						genesisState.MarketParams = []pricestypes.MarketParam{constants.TestMarketParams[0]}
+						genesisState.MarketPrices = []pricestypes.MarketPrice{
+							{
+								Id:       0,
+								Exponent: -5,
+								Price:    5_000_000, // $50
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{constants.ClobPair_Btc}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params = vaulttypes.Params{
+							Layers:                           2,       // 2 layers
+							SpreadMinPpm:                     3_123,   // 31.23 bps
+							SpreadBufferPpm:                  1_500,   // 15 bps
+							SkewFactorPpm:                    554_321, // 0.554321
+							OrderSizePctPpm:                  100_000, // 10%
+							OrderExpirationSeconds:           2,       // 2 seconds
+							ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+							SkewEnabled:                      true,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares.
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+
+			// Check ExplainVaultOrder query response is as expected.
+			response, err := k.ExplainVaultOrder(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedExplanation, response.Explanation)
+
+				// Check that rounded subticks and size match the vault order.
+				orders, err := k.GetVaultClobOrders(ctx, vaultId)
+				require.NoError(t, err)
+				order := orders[2*tc.req.Layer+uint32(tc.req.Side)%2]
+				require.Equal(t, tc.req.Side, order.Side)
+				require.Equal(t, order.Subticks, response.Explanation.RoundedSubticks)
+				require.Equal(t, order.Quantums, response.Explanation.SizeBaseQuantums)
+			}
+		})
+	}
+}
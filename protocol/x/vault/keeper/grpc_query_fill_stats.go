@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) VaultFillStats(
+	c context.Context,
+	req *types.QueryVaultFillStatsRequest,
+) (*types.QueryVaultFillStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	return &types.QueryVaultFillStatsResponse{
+		Stats: k.GetVaultFillStats(ctx, vaultId),
+	}, nil
+}
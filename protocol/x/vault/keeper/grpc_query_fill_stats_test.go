@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultFillStats(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Fill stats of vault, if any.
+		stats *vaulttypes.VaultFillStats
+		// Query request.
+		req *vaulttypes.QueryVaultFillStatsRequest
+
+		/* --- Expectations --- */
+		expectedResponse *vaulttypes.QueryVaultFillStatsResponse
+		expectedErr      string
+	}{
+		"Success: vault with fills": {
+			stats: &vaulttypes.VaultFillStats{
+				NumFills:                    3,
+				VolumeQuoteQuantums:         dtypes.NewInt(1_500_000_000),
+				RealizedSpreadQuoteQuantums: dtypes.NewInt(-2_500_000),
+			},
+			req: &vaulttypes.QueryVaultFillStatsRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedResponse: &vaulttypes.QueryVaultFillStatsResponse{
+				Stats: vaulttypes.VaultFillStats{
+					NumFills:                    3,
+					VolumeQuoteQuantums:         dtypes.NewInt(1_500_000_000),
+					RealizedSpreadQuoteQuantums: dtypes.NewInt(-2_500_000),
+				},
+			},
+		},
+		"Success: vault without fills": {
+			req: &vaulttypes.QueryVaultFillStatsRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedResponse: &vaulttypes.QueryVaultFillStatsResponse{
+				Stats: vaulttypes.VaultFillStats{
+					NumFills:                    0,
+					VolumeQuoteQuantums:         dtypes.NewInt(0),
+					RealizedSpreadQuoteQuantums: dtypes.NewInt(0),
+				},
+			},
+		},
+		"Error: vault not found": {
+			req: &vaulttypes.QueryVaultFillStatsRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set up vault and its fill stats.
+			err := k.SetTotalShares(ctx, constants.Vault_Clob0, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
+			if tc.stats != nil {
+				k.SetVaultFillStats(ctx, constants.Vault_Clob0, *tc.stats)
+			}
+
+			// Check VaultFillStats query response is as expected.
+			response, err := k.VaultFillStats(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) VaultLastQuotePrice(
+	c context.Context,
+	req *types.QueryVaultLastQuotePriceRequest,
+) (*types.QueryVaultLastQuotePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+	lastQuotePrice, exists := k.GetVaultLastQuotePrice(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault has no orders from last refresh")
+	}
+	oraclePrice, err := k.pricesKeeper.GetMarketPrice(ctx, lastQuotePrice.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultLastQuotePriceResponse{
+		MarketId:       lastQuotePrice.Id,
+		Exponent:       lastQuotePrice.Exponent,
+		LastQuotePrice: lastQuotePrice.Price,
+		OraclePrice:    oraclePrice.Price,
+	}, nil
+}
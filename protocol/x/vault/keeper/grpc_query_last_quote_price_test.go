@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultLastQuotePrice(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// BTC price after vault's last refresh, if non-zero.
+		btcPrice uint64
+		// Query request.
+		req *vaulttypes.QueryVaultLastQuotePriceRequest
+
+		/* --- Expectations --- */
+		expectedResponse *vaulttypes.QueryVaultLastQuotePriceResponse
+		expectedErr      string
+	}{
+		"Success: oracle price unchanged since last refresh": {
+			req: &vaulttypes.QueryVaultLastQuotePriceRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedResponse: &vaulttypes.QueryVaultLastQuotePriceResponse{
+				MarketId:       0,
+				Exponent:       -5,
+				LastQuotePrice: 2_000_000_000,
+				OraclePrice:    2_000_000_000,
+			},
+		},
+		"Success: oracle price moved since last refresh": {
+			btcPrice: 2_100_000_000,
+			req: &vaulttypes.QueryVaultLastQuotePriceRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedResponse: &vaulttypes.QueryVaultLastQuotePriceResponse{
+				MarketId:       0,
+				Exponent:       -5,
+				LastQuotePrice: 2_000_000_000,
+				OraclePrice:    2_100_000_000,
+			},
+		},
+		"Error: vault hasn't refreshed its orders": {
+			req: &vaulttypes.QueryVaultLastQuotePriceRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+			},
+			expectedErr: "vault has no orders from last refresh",
+		},
+		"Error: vault not found": {
+			req: &vaulttypes.QueryVaultLastQuotePriceRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 2,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Initialize tApp with a vault that refreshes its orders at block 1.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set up a vault that hasn't refreshed its orders.
+			err := k.SetTotalShares(ctx, constants.Vault_Clob1, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+
+			// Move BTC price.
+			if tc.btcPrice != 0 {
+				err = tApp.App.PricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+					{MarketId: 0, Price: tc.btcPrice},
+				})
+				require.NoError(t, err)
+			}
+
+			// Check VaultLastQuotePrice query response is as expected.
+			response, err := k.VaultLastQuotePrice(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
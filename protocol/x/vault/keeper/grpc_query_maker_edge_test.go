@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultMakerEdge(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// EWMA of signed per-block returns of market 0 in ppm.
+		ewmaReturnPpm int64
+		// Query request.
+		req *vaulttypes.QueryVaultMakerEdgeRequest
+
+		/* --- Expectations --- */
+		expectedMakerEdgePpm int64
+		expectedErr          string
+	}{
+		"Success: stable price, edge is spread": {
+			ewmaReturnPpm: 0,
+			req: &vaulttypes.QueryVaultMakerEdgeRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			// spread = max(spread_min, spread_buffer + min_price_change) = 10_000
+			expectedMakerEdgePpm: 10_000,
+		},
+		"Success: price trending up, edge is reduced by drift": {
+			ewmaReturnPpm: 1_500,
+			req: &vaulttypes.QueryVaultMakerEdgeRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			// 10_000 - 1_500
+			expectedMakerEdgePpm: 8_500,
+		},
+		"Success: price trending down faster than spread, edge is negative": {
+			ewmaReturnPpm: -12_000,
+			req: &vaulttypes.QueryVaultMakerEdgeRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			// 10_000 - 12_000
+			expectedMakerEdgePpm: -2_000,
+		},
+		"Error: vault not found": {
+			req: &vaulttypes.QueryVaultMakerEdgeRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set up vault and drift of its market.
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+			k.SetMarketVolatility(ctx, 0, vaulttypes.MarketVolatility{EwmaReturnPpm: tc.ewmaReturnPpm})
+
+			// Check VaultMakerEdge query response is as expected.
+			response, err := k.VaultMakerEdge(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedMakerEdgePpm, response.MakerEdgePpm)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) VaultMargin(
+	c context.Context,
+	req *types.QueryVaultMarginRequest,
+) (*types.QueryVaultMarginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	initialMargin, maintenanceMargin, freeCollateral, err := k.GetVaultMargin(ctx, vaultId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultMarginResponse{
+		InitialMarginQuoteQuantums:     dtypes.NewIntFromBigInt(initialMargin),
+		MaintenanceMarginQuoteQuantums: dtypes.NewIntFromBigInt(maintenanceMargin),
+		FreeCollateralQuoteQuantums:    dtypes.NewIntFromBigInt(freeCollateral),
+	}, nil
+}
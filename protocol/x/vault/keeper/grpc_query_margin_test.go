@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultMargin(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault asset.
+		asset *big.Int
+		// Vault inventory in perpetual 0. Nil if vault has no perpetual positions.
+		inventory *big.Int
+		// Query request.
+		req *vaulttypes.QueryVaultMarginRequest
+
+		/* --- Expectations --- */
+		expectedResponse *vaulttypes.QueryVaultMarginResponse
+		expectedErr      string
+	}{
+		"Success - No Inventory": {
+			req: &vaulttypes.QueryVaultMarginRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			asset: big.NewInt(1_000_000_000), // 1,000 USDC
+			expectedResponse: &vaulttypes.QueryVaultMarginResponse{
+				InitialMarginQuoteQuantums:     dtypes.NewInt(0),
+				MaintenanceMarginQuoteQuantums: dtypes.NewInt(0),
+				FreeCollateralQuoteQuantums:    dtypes.NewInt(1_000_000_000),
+			},
+		},
+		"Success - Leveraged Inventory": {
+			req: &vaulttypes.QueryVaultMarginRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			asset:     big.NewInt(-1_500_000_000), // -1,500 USDC
+			inventory: big.NewInt(1_000_000_000),  // 0.1 BTC ($2,000 notional, 4x leverage)
+			expectedResponse: &vaulttypes.QueryVaultMarginResponse{
+				// 5% of $2,000 notional.
+				InitialMarginQuoteQuantums: dtypes.NewInt(100_000_000),
+				// 3% of $2,000 notional.
+				MaintenanceMarginQuoteQuantums: dtypes.NewInt(60_000_000),
+				// $500 net collateral - $100 initial margin.
+				FreeCollateralQuoteQuantums: dtypes.NewInt(400_000_000),
+			},
+		},
+		"Error - Vault Not Found": {
+			req: &vaulttypes.QueryVaultMarginRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+			},
+			asset:       big.NewInt(1_000_000_000),
+			expectedErr: "vault not found",
+		},
+		"Error - Nil Request": {
+			req:         nil,
+			asset:       big.NewInt(1_000_000_000),
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						subaccount := satypes.Subaccount{
+							Id: vaultId.ToSubaccountId(),
+							AssetPositions: []*satypes.AssetPosition{
+								testutil.CreateSingleAssetPosition(
+									assettypes.AssetUsdc.Id,
+									tc.asset,
+								),
+							},
+						}
+						if tc.inventory != nil {
+							subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+								testutil.CreateSinglePerpetualPosition(
+									0,
+									tc.inventory,
+									big.NewInt(0),
+								),
+							}
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{subaccount}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+
+			response, err := k.VaultMargin(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
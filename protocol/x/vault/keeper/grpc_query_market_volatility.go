@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) MarketVolatility(
+	c context.Context,
+	req *types.QueryMarketVolatilityRequest,
+) (*types.QueryMarketVolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	if _, exists := k.pricesKeeper.GetMarketParam(ctx, req.MarketId); !exists {
+		return nil, status.Error(codes.NotFound, "market not found")
+	}
+	volatility := k.GetMarketVolatility(ctx, req.MarketId)
+
+	return &types.QueryMarketVolatilityResponse{
+		Volatility:       volatility,
+		InsufficientData: !volatility.HasSufficientData(),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketVolatility(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, constants.Vault_Clob0, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+	require.NoError(t, err)
+
+	// Data is insufficient before volatility is ever updated.
+	response, err := k.MarketVolatility(ctx, &vaulttypes.QueryMarketVolatilityRequest{MarketId: 0})
+	require.NoError(t, err)
+	require.Equal(t, &vaulttypes.QueryMarketVolatilityResponse{InsufficientData: true}, response)
+	ewmaAbsReturnPpm, sufficientData := k.GetMarketRealizedVolatility(ctx, 0)
+	require.Zero(t, ewmaAbsReturnPpm)
+	require.False(t, sufficientData)
+
+	// Data is still insufficient after the first update, which only observes a price.
+	initialPrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	k.UpdateMarketVolatilities(ctx)
+	response, err = k.MarketVolatility(ctx, &vaulttypes.QueryMarketVolatilityRequest{MarketId: 0})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&vaulttypes.QueryMarketVolatilityResponse{
+			Volatility: vaulttypes.MarketVolatility{
+				LastPrice:    initialPrice.Price,
+				LastExponent: initialPrice.Exponent,
+			},
+			InsufficientData: true,
+		},
+		response,
+	)
+	_, sufficientData = k.GetMarketRealizedVolatility(ctx, 0)
+	require.False(t, sufficientData)
+
+	// Feed a price series of returns +1% and -2%.
+	price := initialPrice.Price
+	for _, returnPpm := range []int64{10_000, -20_000} {
+		price = uint64(int64(price) + int64(price)*returnPpm/1_000_000)
+		err := tApp.App.PricesKeeper.UpdateMarketPrices(
+			ctx,
+			[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: price}},
+		)
+		require.NoError(t, err)
+		k.UpdateMarketVolatilities(ctx)
+	}
+	response, err = k.MarketVolatility(ctx, &vaulttypes.QueryMarketVolatilityRequest{MarketId: 0})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&vaulttypes.QueryMarketVolatilityResponse{
+			Volatility: vaulttypes.MarketVolatility{
+				LastPrice:    price,
+				LastExponent: initialPrice.Exponent,
+				// 10% * 20_000 + 90% * (10% * 10_000)
+				EwmaAbsReturnPpm: 2_900,
+				// 10% * -20_000 + 90% * (10% * 10_000)
+				EwmaReturnPpm: -1_100,
+				NumReturns:    2,
+			},
+			InsufficientData: false,
+		},
+		response,
+	)
+	ewmaAbsReturnPpm, sufficientData = k.GetMarketRealizedVolatility(ctx, 0)
+	require.Equal(t, uint64(2_900), ewmaAbsReturnPpm)
+	require.True(t, sufficientData)
+
+	// Error if market doesn't exist.
+	_, err = k.MarketVolatility(ctx, &vaulttypes.QueryMarketVolatilityRequest{MarketId: 999})
+	require.ErrorContains(t, err, "market not found")
+
+	// Error if request is nil.
+	_, err = k.MarketVolatility(ctx, nil)
+	require.ErrorContains(t, err, "invalid request")
+}
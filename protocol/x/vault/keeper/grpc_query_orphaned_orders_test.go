@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphanedVaultOrders(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Layers after vault places orders at 3 layers.
+		layers uint32
+		// Query request.
+		req *vaulttypes.QueryOrphanedVaultOrdersRequest
+
+		/* --- Expectations --- */
+		// Expected layer of each orphaned order, which alternate between sell and buy.
+		expectedLayers []uint8
+		expectedErr    string
+	}{
+		"Success: no orphaned orders": {
+			layers: 3,
+			req: &vaulttypes.QueryOrphanedVaultOrdersRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedLayers: []uint8{},
+		},
+		"Success: orders at layers beyond current layers are orphaned": {
+			layers: 1,
+			req: &vaulttypes.QueryOrphanedVaultOrdersRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedLayers: []uint8{1, 1, 2, 2},
+		},
+		"Error: vault not found": {
+			layers: 3,
+			req: &vaulttypes.QueryOrphanedVaultOrdersRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			layers:      3,
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Initialize tApp with a vault that places orders at 3 layers at block 1.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.Layers = 3
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Update layers.
+			params := k.GetParams(ctx)
+			params.Layers = tc.layers
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			// Check OrphanedVaultOrders query response is as expected.
+			response, err := k.OrphanedVaultOrders(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, response.Orders, len(tc.expectedLayers))
+				for i, order := range response.Orders {
+					side, _, layer, err := vaulttypes.DecodeVaultClientId(order.OrderId.ClientId)
+					require.NoError(t, err)
+					require.Equal(t, tc.expectedLayers[i], layer)
+					if i%2 == 0 {
+						require.Equal(t, clobtypes.Order_SIDE_SELL, side)
+					} else {
+						require.Equal(t, clobtypes.Order_SIDE_BUY, side)
+					}
+					require.Equal(t, *vaultId.ToSubaccountId(), order.OrderId.SubaccountId)
+				}
+			}
+		})
+	}
+}
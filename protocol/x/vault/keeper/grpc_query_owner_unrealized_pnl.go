@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) OwnerUnrealizedPnl(
+	c context.Context,
+	req *types.QueryOwnerUnrealizedPnlRequest,
+) (*types.QueryOwnerUnrealizedPnlResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	unrealizedPnl, err := k.GetOwnerUnrealizedPnl(ctx, vaultId, req.Owner)
+	if errors.Is(err, types.ErrVaultNotFound) {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	} else if errors.Is(err, types.ErrOwnerShareNotFound) {
+		return nil, status.Error(codes.NotFound, "owner shares not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOwnerUnrealizedPnlResponse{
+		UnrealizedPnlQuoteQuantums: dtypes.NewIntFromBigInt(unrealizedPnl),
+		CostBasisQuoteQuantums:     dtypes.NewIntFromBigInt(k.GetOwnerCostBasis(ctx, vaultId, req.Owner)),
+	}, nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnerUnrealizedPnl(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// BTC price after Alice's deposit. Zero if price doesn't change.
+		btcPrice uint64
+		// Query request.
+		req *vaulttypes.QueryOwnerUnrealizedPnlRequest
+
+		/* --- Expectations --- */
+		expectedUnrealizedPnl *big.Int
+		expectedCostBasis     *big.Int
+		expectedErr           string
+	}{
+		"Success: price goes up": {
+			btcPrice: 2_200_000_000, // $22,000
+			req: &vaulttypes.QueryOwnerUnrealizedPnlRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			// Vault equity after deposit = $2,000 + 0.1 BTC * $22,000 = $4,200.
+			// Alice owns 1/4 of shares, worth $1,050, at a cost basis of $1,000.
+			expectedUnrealizedPnl: big.NewInt(50_000_000),
+			expectedCostBasis:     big.NewInt(1_000_000_000),
+		},
+		"Success: price goes down": {
+			btcPrice: 1_800_000_000, // $18,000
+			req: &vaulttypes.QueryOwnerUnrealizedPnlRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			// Vault equity after deposit = $2,000 + 0.1 BTC * $18,000 = $3,800.
+			// Alice owns 1/4 of shares, worth $950, at a cost basis of $1,000.
+			expectedUnrealizedPnl: big.NewInt(-50_000_000),
+			expectedCostBasis:     big.NewInt(1_000_000_000),
+		},
+		"Success: price doesn't change": {
+			req: &vaulttypes.QueryOwnerUnrealizedPnlRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			expectedUnrealizedPnl: big.NewInt(0),
+			expectedCostBasis:     big.NewInt(1_000_000_000),
+		},
+		"Success: owner with shares but no deposits has a zero cost basis": {
+			btcPrice: 2_200_000_000, // $22,000
+			req: &vaulttypes.QueryOwnerUnrealizedPnlRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.BobAccAddress.String(),
+			},
+			// Bob owns 3/4 of shares, worth $3,150.
+			expectedUnrealizedPnl: big.NewInt(3_150_000_000),
+			expectedCostBasis:     big.NewInt(0),
+		},
+		"Error: owner shares not found": {
+			req: &vaulttypes.QueryOwnerUnrealizedPnlRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.CarlAccAddress.String(),
+			},
+			expectedErr: "owner shares not found",
+		},
+		"Error: vault not found": {
+			req: &vaulttypes.QueryOwnerUnrealizedPnlRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										big.NewInt(1_000_000_000), // 0.1 BTC
+										big.NewInt(0),
+									),
+								},
+							},
+							{
+								Id: &constants.Alice_Num0,
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Bob owns all 3,000 shares of the vault, whose equity is $1,000 + 0.1 BTC * $20,000 = $3,000,
+			// such that a share is worth one quote quantum.
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(3_000_000_000)))
+			require.NoError(t, err)
+			err = k.SetOwnerShares(
+				ctx,
+				vaultId,
+				constants.BobAccAddress.String(),
+				vaulttypes.BigIntToNumShares(big.NewInt(3_000_000_000)),
+			)
+			require.NoError(t, err)
+
+			// Alice deposits $1,000 at a share price of one quote quantum.
+			ms := keeper.NewMsgServerImpl(k)
+			_, err = ms.DepositToVault(ctx, &vaulttypes.MsgDepositToVault{
+				VaultId:       &vaultId,
+				SubaccountId:  &constants.Alice_Num0,
+				QuoteQuantums: dtypes.NewInt(1_000_000_000),
+			})
+			require.NoError(t, err)
+
+			// Move BTC price.
+			if tc.btcPrice != 0 {
+				err = tApp.App.PricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+					{MarketId: 0, Price: tc.btcPrice},
+				})
+				require.NoError(t, err)
+			}
+
+			// Check OwnerUnrealizedPnl query response is as expected.
+			response, err := k.OwnerUnrealizedPnl(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Zero(t, tc.expectedUnrealizedPnl.Cmp(response.UnrealizedPnlQuoteQuantums.BigInt()))
+				require.Zero(t, tc.expectedCostBasis.Cmp(response.CostBasisQuoteQuantums.BigInt()))
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) VaultQuoteCurve(
+	c context.Context,
+	req *types.QueryVaultQuoteCurveRequest,
+) (*types.QueryVaultQuoteCurveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	// Get orders that the vault would place, which are ordered as [a_0, b_0, a_1, b_1, ...]
+	// or contain only one side's orders in layer order.
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	points := make([]types.QuoteCurvePoint, len(orders))
+	layers := make(map[clobtypes.Order_Side]uint32, 2)
+	for i, order := range orders {
+		points[i] = types.QuoteCurvePoint{
+			Side:     order.Side,
+			Subticks: order.Subticks,
+			Quantums: order.Quantums,
+			Layer:    layers[order.Side],
+		}
+		layers[order.Side]++
+	}
+
+	// Get oracle price in subticks.
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("clob pair %d doesn't exist", vaultId.Number))
+	}
+	perpId := clobPair.Metadata.(*clobtypes.ClobPair_PerpetualClobMetadata).PerpetualClobMetadata.PerpetualId
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	marketId := getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId)
+	marketParam, exists := k.pricesKeeper.GetMarketParam(ctx, marketId)
+	if !exists {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("market param %d doesn't exist", marketId))
+	}
+	marketPrice, err := k.getVaultMarketPrice(ctx, vaultParams, marketId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	oracleSubticksRat, err := types.ComputeOracleSubticks(marketPrice, marketParam, perpetual, clobPair)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	oracleSubticks := lib.BigRatRound(oracleSubticksRat, false)
+
+	return &types.QueryVaultQuoteCurveResponse{
+		Points:         points,
+		OracleSubticks: oracleSubticks.Uint64(),
+	}, nil
+}
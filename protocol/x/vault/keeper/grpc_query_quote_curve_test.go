@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultQuoteCurve(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Vault asset.
+		asset *big.Int
+		// Vault inventory in perpetual 0. Nil if vault has no perpetual positions.
+		inventory *big.Int
+		// Query request.
+		req *vaulttypes.QueryVaultQuoteCurveRequest
+
+		/* --- Expectations --- */
+		expectedOracleSubticks uint64
+		expectedErr            string
+	}{
+		"Success": {
+			req: &vaulttypes.QueryVaultQuoteCurveRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId: constants.Vault_Clob0,
+			asset:   big.NewInt(1_000_000_000), // 1,000 USDC
+			// oracle price of BTC in default genesis is $20_000.
+			expectedOracleSubticks: 200_000_000,
+		},
+		"Success - Vault with inventory": {
+			req: &vaulttypes.QueryVaultQuoteCurveRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:                constants.Vault_Clob0,
+			asset:                  big.NewInt(1_000_000_000), // 1,000 USDC
+			inventory:              big.NewInt(1_000_000),     // 0.0001 BTC
+			expectedOracleSubticks: 200_000_000,
+		},
+		"Error: query non-existent vault": {
+			req: &vaulttypes.QueryVaultQuoteCurveRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1, // Non-existent vault.
+			},
+			vaultId:     constants.Vault_Clob0,
+			asset:       big.NewInt(1_000_000_000),
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			vaultId:     constants.Vault_Clob0,
+			asset:       big.NewInt(1_000_000_000),
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						subaccount := satypes.Subaccount{
+							Id: tc.vaultId.ToSubaccountId(),
+							AssetPositions: []*satypes.AssetPosition{
+								testutil.CreateSingleAssetPosition(
+									assettypes.AssetUsdc.Id,
+									tc.asset,
+								),
+							},
+						}
+						if tc.inventory != nil {
+							subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+								testutil.CreateSinglePerpetualPosition(
+									0,
+									tc.inventory,
+									big.NewInt(0),
+								),
+							}
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{subaccount}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares.
+			err := k.SetTotalShares(ctx, tc.vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+
+			// Check VaultQuoteCurve query response is as expected.
+			response, err := k.VaultQuoteCurve(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOracleSubticks, response.OracleSubticks)
+
+				// Check that points match vault orders.
+				orders, err := k.GetVaultClobOrders(ctx, tc.vaultId)
+				require.NoError(t, err)
+				require.NotEmpty(t, orders)
+				require.Len(t, response.Points, len(orders))
+				for i, order := range orders {
+					require.Equal(
+						t,
+						vaulttypes.QuoteCurvePoint{
+							Side:     order.Side,
+							Subticks: order.Subticks,
+							Quantums: order.Quantums,
+							Layer:    uint32(i / 2),
+						},
+						response.Points[i],
+					)
+					// Asks are at or above oracle price and bids are at or below.
+					if order.Side == clobtypes.Order_SIDE_SELL {
+						require.GreaterOrEqual(t, order.Subticks, response.OracleSubticks)
+					} else {
+						require.LessOrEqual(t, order.Subticks, response.OracleSubticks)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) QuoteDeposit(
+	c context.Context,
+	req *types.QueryQuoteDepositRequest,
+) (*types.QueryQuoteDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	sharesOut, sharePricePpm, err := k.getSharesToMint(
+		ctx,
+		vaultId,
+		new(big.Int).SetUint64(req.QuoteQuantums),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuoteDepositResponse{
+		SharesOut:     types.BigIntToNumShares(sharesOut),
+		SharePricePpm: dtypes.NewIntFromBigInt(sharePricePpm),
+	}, nil
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteDeposit(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault asset.
+		asset *big.Int
+		// Total shares. Nil if vault has no total shares yet.
+		totalShares *big.Int
+		// Query request.
+		req *vaulttypes.QueryQuoteDepositRequest
+
+		/* --- Expectations --- */
+		expectedSharesOut     *big.Int
+		expectedSharePricePpm *big.Int
+		expectedErr           error
+		expectedErrString     string
+	}{
+		"Success - First deposit": {
+			asset:       big.NewInt(0),
+			totalShares: nil,
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 123,
+			},
+			expectedSharesOut:     big.NewInt(123),
+			expectedSharePricePpm: big.NewInt(1_000_000),
+		},
+		"Success - First deposit, zero total shares": {
+			asset:       big.NewInt(500),
+			totalShares: big.NewInt(0),
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 123,
+			},
+			expectedSharesOut:     big.NewInt(123),
+			expectedSharePricePpm: big.NewInt(1_000_000),
+		},
+		"Success - Existing shares": {
+			asset:       big.NewInt(4_000),
+			totalShares: big.NewInt(5_000),
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 1_000,
+			},
+			// 1_000 * 5_000 / 4_000 = 1_250
+			expectedSharesOut: big.NewInt(1_250),
+			// 4_000 * 1_000_000 / 5_000 = 800_000
+			expectedSharePricePpm: big.NewInt(800_000),
+		},
+		"Success - Existing shares, shares out rounded down": {
+			asset:       big.NewInt(3_000),
+			totalShares: big.NewInt(1_000),
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 100,
+			},
+			// 100 * 1_000 / 3_000 = 33.33 ~= 33
+			expectedSharesOut: big.NewInt(33),
+			// 3_000 * 1_000_000 / 1_000 = 3_000_000
+			expectedSharePricePpm: big.NewInt(3_000_000),
+		},
+		"Failure - Non-positive equity": {
+			asset:       big.NewInt(0),
+			totalShares: big.NewInt(1_000),
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 100,
+			},
+			expectedErr: vaulttypes.ErrNonPositiveEquity,
+		},
+		"Failure - Zero shares to mint": {
+			asset:       big.NewInt(1_000),
+			totalShares: big.NewInt(1),
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 100,
+			},
+			expectedErr: vaulttypes.ErrZeroSharesToMint,
+		},
+		"Failure - Zero deposit": {
+			asset:       big.NewInt(1_000),
+			totalShares: big.NewInt(1_000),
+			req: &vaulttypes.QueryQuoteDepositRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				QuoteQuantums: 0,
+			},
+			expectedErr: vaulttypes.ErrInvalidDepositAmount,
+		},
+		"Failure - Nil request": {
+			asset:             big.NewInt(1_000),
+			totalShares:       big.NewInt(1_000),
+			req:               nil,
+			expectedErrString: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						vault := satypes.Subaccount{
+							Id: constants.Vault_Clob0.ToSubaccountId(),
+						}
+						if tc.asset.Sign() != 0 {
+							vault.AssetPositions = []*satypes.AssetPosition{
+								testutil.CreateSingleAssetPosition(
+									assettypes.AssetUsdc.Id,
+									tc.asset,
+								),
+							}
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{
+							vault,
+							{
+								Id: &constants.Alice_Num0,
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000),
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares.
+			if tc.totalShares != nil {
+				err := k.SetTotalShares(ctx, constants.Vault_Clob0, vaulttypes.BigIntToNumShares(tc.totalShares))
+				require.NoError(t, err)
+			}
+			totalSharesBefore, _ := k.GetTotalShares(ctx, constants.Vault_Clob0)
+
+			// Check QuoteDeposit query response is as expected.
+			response, err := k.QuoteDeposit(ctx, tc.req)
+			if tc.expectedErrString != "" {
+				require.ErrorContains(t, err, tc.expectedErrString)
+				return
+			} else if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					vaulttypes.QueryQuoteDepositResponse{
+						SharesOut:     vaulttypes.BigIntToNumShares(tc.expectedSharesOut),
+						SharePricePpm: dtypes.NewIntFromBigInt(tc.expectedSharePricePpm),
+					},
+					*response,
+				)
+			}
+
+			// Check that query doesn't mutate state.
+			totalSharesAfter, _ := k.GetTotalShares(ctx, constants.Vault_Clob0)
+			require.Equal(t, totalSharesBefore, totalSharesAfter)
+
+			// Check that an actual deposit mints the quoted number of shares or fails with the same error.
+			ms := keeper.NewMsgServerImpl(k)
+			_, err = ms.DepositToVault(ctx, &vaulttypes.MsgDepositToVault{
+				VaultId:       &constants.Vault_Clob0,
+				SubaccountId:  &constants.Alice_Num0,
+				QuoteQuantums: dtypes.NewIntFromUint64(tc.req.QuoteQuantums),
+			})
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				ownerShares, exists := k.GetOwnerShares(ctx, constants.Vault_Clob0, constants.Alice_Num0.Owner)
+				require.True(t, exists)
+				require.Equal(t, response.SharesOut, ownerShares)
+			}
+		})
+	}
+}
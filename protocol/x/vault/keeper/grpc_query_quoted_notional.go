@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) VaultQuotedNotional(
+	c context.Context,
+	req *types.QueryVaultQuotedNotionalRequest,
+) (*types.QueryVaultQuotedNotionalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	_, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	bidNotional, askNotional, err := k.GetVaultQuotedNotional(ctx, vaultId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultQuotedNotionalResponse{
+		BidNotional: dtypes.NewIntFromBigInt(bidNotional),
+		AskNotional: dtypes.NewIntFromBigInt(askNotional),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultQuotedNotional(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Vault asset.
+		asset *big.Int
+		// Query request.
+		req *vaulttypes.QueryVaultQuotedNotionalRequest
+
+		/* --- Expectations --- */
+		expectedBidNotional *big.Int
+		expectedAskNotional *big.Int
+		expectedErr         string
+	}{
+		"Success": {
+			req: &vaulttypes.QueryVaultQuotedNotionalRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId: constants.Vault_Clob0,
+			asset:   big.NewInt(1_000_000_000), // 1,000 USDC
+			// oracle price of BTC in default genesis is $20_000 and spread is 1%.
+			// order_size = 10% * $1_000 / $20_000 = 0.005 BTC = 50_000_000 base quantums.
+			// a_0 = $20_000 * 1.01 = $20_200, a_1 = $20_000 * (1 + 0.002 + 0.02) = $20_440.
+			// b_0 = $20_000 * 0.99 = $19_800, b_1 = $20_000 * (1 - 0.002 - 0.02) = $19_560.
+			// bid_notional = 0.005 * ($19_800 + $19_560) = $196.8
+			// ask_notional = 0.005 * ($20_200 + $20_440) = $203.2
+			expectedBidNotional: big.NewInt(196_800_000),
+			expectedAskNotional: big.NewInt(203_200_000),
+		},
+		"Success - Zero notional when vault doesn't quote": {
+			req: &vaulttypes.QueryVaultQuotedNotionalRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:             constants.Vault_Clob0,
+			asset:               big.NewInt(1), // order size rounds down to 0.
+			expectedBidNotional: big.NewInt(0),
+			expectedAskNotional: big.NewInt(0),
+		},
+		"Error: query non-existent vault": {
+			req: &vaulttypes.QueryVaultQuotedNotionalRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1, // Non-existent vault.
+			},
+			vaultId:     constants.Vault_Clob0,
+			asset:       big.NewInt(1_000_000_000),
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			vaultId:     constants.Vault_Clob0,
+			asset:       big.NewInt(1_000_000_000),
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: tc.vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.asset,
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares.
+			err := k.SetTotalShares(ctx, tc.vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+
+			// Check VaultQuotedNotional query response is as expected.
+			response, err := k.VaultQuotedNotional(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					vaulttypes.QueryVaultQuotedNotionalResponse{
+						BidNotional: dtypes.NewIntFromBigInt(tc.expectedBidNotional),
+						AskNotional: dtypes.NewIntFromBigInt(tc.expectedAskNotional),
+					},
+					*response,
+				)
+			}
+		})
+	}
+}
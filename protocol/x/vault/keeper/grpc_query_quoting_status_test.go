@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultQuotingStatus(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Vault asset.
+		asset *big.Int
+		// Vault inventory in perpetual 0. Nil if vault has no perpetual positions.
+		inventory *big.Int
+		// Total shares.
+		totalShares *big.Int
+		// Query request.
+		req *vaulttypes.QueryVaultQuotingStatusRequest
+
+		/* --- Expectations --- */
+		expectedQuoting bool
+		expectedReason  string
+		expectedErr     string
+	}{
+		"Quoting": {
+			req: &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:         constants.Vault_Clob0,
+			asset:           big.NewInt(1_000_000_000), // 1,000 USDC
+			totalShares:     big.NewInt(300),
+			expectedQuoting: true,
+			expectedReason:  "",
+		},
+		"Not Quoting: non-positive total shares": {
+			req: &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:         constants.Vault_Clob0,
+			asset:           big.NewInt(1_000_000_000), // 1,000 USDC
+			totalShares:     big.NewInt(0),
+			expectedQuoting: false,
+			expectedReason:  vaulttypes.QuotingStatusReasonNonPositiveShares,
+		},
+		"Not Quoting: below activation threshold": {
+			req: &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:         constants.Vault_Clob0,
+			asset:           big.NewInt(999_999_999),
+			totalShares:     big.NewInt(300),
+			expectedQuoting: false,
+			expectedReason:  vaulttypes.QuotingStatusReasonBelowActivationThreshold,
+		},
+		"Not Quoting: non-positive equity": {
+			req: &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:         constants.Vault_Clob0,
+			asset:           big.NewInt(100),
+			inventory:       big.NewInt(-200), // equity = 100 - 400 = -300
+			totalShares:     big.NewInt(300),
+			expectedQuoting: false,
+			expectedReason:  vaulttypes.QuotingStatusReasonNonPositiveEquity,
+		},
+		"Not Quoting: zero order size": {
+			req: &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:         constants.Vault_Clob0,
+			asset:           big.NewInt(-350),
+			inventory:       big.NewInt(200), // equity = -350 + 400 = 50
+			totalShares:     big.NewInt(300),
+			expectedQuoting: false,
+			expectedReason:  vaulttypes.QuotingStatusReasonZeroOrderSize,
+		},
+		"Error: query non-existent vault": {
+			req: &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1, // Non-existent vault.
+			},
+			vaultId:     constants.Vault_Clob0,
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(300),
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			vaultId:     constants.Vault_Clob0,
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(300),
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						subaccount := satypes.Subaccount{
+							Id: tc.vaultId.ToSubaccountId(),
+							AssetPositions: []*satypes.AssetPosition{
+								testutil.CreateSingleAssetPosition(
+									assettypes.AssetUsdc.Id,
+									tc.asset,
+								),
+							},
+						}
+						if tc.inventory != nil {
+							subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+								testutil.CreateSinglePerpetualPosition(
+									0,
+									tc.inventory,
+									big.NewInt(0),
+								),
+							}
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{subaccount}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares.
+			err := k.SetTotalShares(ctx, tc.vaultId, vaulttypes.BigIntToNumShares(tc.totalShares))
+			require.NoError(t, err)
+
+			// Check VaultQuotingStatus query response is as expected.
+			response, err := k.VaultQuotingStatus(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					vaulttypes.QueryVaultQuotingStatusResponse{
+						Quoting: tc.expectedQuoting,
+						Reason:  tc.expectedReason,
+					},
+					*response,
+				)
+			}
+		})
+	}
+}
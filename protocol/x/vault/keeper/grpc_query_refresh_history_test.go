@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultRefreshHistoryQuery(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Number of consecutive blocks in which vault refreshes its orders.
+		numRefreshes int
+		// Query request.
+		req *vaulttypes.QueryVaultRefreshHistoryRequest
+
+		/* --- Expectations --- */
+		// Expected refreshes, with block heights relative to block of first refresh.
+		expectedRefreshes []vaulttypes.VaultRefresh
+		expectedErr       string
+	}{
+		"Success: vault has refreshed its orders": {
+			numRefreshes: 2,
+			req: &vaulttypes.QueryVaultRefreshHistoryRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedRefreshes: []vaulttypes.VaultRefresh{
+				{
+					BlockHeight:     0,
+					NumOrdersPlaced: 4,
+				},
+				{
+					BlockHeight:        1,
+					NumOrdersPlaced:    4,
+					NumOrdersCancelled: 4,
+				},
+			},
+		},
+		"Success: vault hasn't refreshed its orders": {
+			numRefreshes: 0,
+			req: &vaulttypes.QueryVaultRefreshHistoryRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			expectedRefreshes: []vaulttypes.VaultRefresh{},
+		},
+		"Error: vault not found": {
+			numRefreshes: 2,
+			req: &vaulttypes.QueryVaultRefreshHistoryRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			numRefreshes: 2,
+			req:          nil,
+			expectedErr:  "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
+
+			// Refresh vault orders in consecutive blocks.
+			startBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+			for i := 0; i < tc.numRefreshes; i++ {
+				if i > 0 {
+					ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+					tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+						BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+					})
+				}
+				err := k.RefreshVaultClobOrders(ctx, vaultId)
+				require.NoError(t, err)
+			}
+
+			// Check VaultRefreshHistory query response is as expected.
+			response, err := k.VaultRefreshHistory(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				for i := range tc.expectedRefreshes {
+					tc.expectedRefreshes[i].BlockHeight += startBlockHeight
+				}
+				require.Equal(
+					t,
+					&vaulttypes.QueryVaultRefreshHistoryResponse{Refreshes: tc.expectedRefreshes},
+					response,
+				)
+			}
+		})
+	}
+}
@@ -40,6 +40,7 @@ func (k Keeper) OwnerShares(
 		ownerShares = append(ownerShares, &types.OwnerShare{
 			Owner:  owner,
 			Shares: &shares,
+			Frozen: k.IsOwnerSharesFrozen(ctx, vaultId, owner),
 		})
 
 		return nil
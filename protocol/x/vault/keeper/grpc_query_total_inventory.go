@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) TotalVaultInventory(
+	c context.Context,
+	req *types.QueryTotalVaultInventoryRequest,
+) (*types.QueryTotalVaultInventoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	clobPairId := clobtypes.ClobPairId(req.ClobPairId)
+	if _, exists := k.clobKeeper.GetClobPair(ctx, clobPairId); !exists {
+		return nil, status.Error(codes.NotFound, "clob pair not found")
+	}
+
+	return &types.QueryTotalVaultInventoryResponse{
+		InventoryBaseQuantums: dtypes.NewIntFromBigInt(k.GetTotalVaultInventory(ctx, clobPairId)),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalVaultInventory(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault IDs.
+		vaultIds []vaulttypes.VaultId
+		// Inventory of each vault in perpetual 0. Nil if vault has no perpetual positions.
+		inventories []*big.Int
+		// Query request.
+		req *vaulttypes.QueryTotalVaultInventoryRequest
+
+		/* --- Expectations --- */
+		expectedInventory *big.Int
+		expectedErr       string
+	}{
+		"Success: one long vault and one short vault": {
+			req:      &vaulttypes.QueryTotalVaultInventoryRequest{ClobPairId: 0},
+			vaultIds: []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1},
+			inventories: []*big.Int{
+				big.NewInt(300_000_000),
+				big.NewInt(-100_000_000),
+			},
+			expectedInventory: big.NewInt(200_000_000),
+		},
+		"Success: vaults without positions in the perpetual of the clob pair": {
+			req:      &vaulttypes.QueryTotalVaultInventoryRequest{ClobPairId: 1},
+			vaultIds: []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1},
+			inventories: []*big.Int{
+				big.NewInt(300_000_000),
+				nil,
+			},
+			expectedInventory: big.NewInt(0),
+		},
+		"Success: no vaults": {
+			req:               &vaulttypes.QueryTotalVaultInventoryRequest{ClobPairId: 0},
+			expectedInventory: big.NewInt(0),
+		},
+		"Error: clob pair not found": {
+			req:         &vaulttypes.QueryTotalVaultInventoryRequest{ClobPairId: 797},
+			expectedErr: "clob pair not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = make([]satypes.Subaccount, len(tc.vaultIds))
+						for i, vaultId := range tc.vaultIds {
+							genesisState.Subaccounts[i] = satypes.Subaccount{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(10_000_000_000), // 10,000 USDC
+									),
+								},
+							}
+							if tc.inventories[i] != nil {
+								genesisState.Subaccounts[i].PerpetualPositions = []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										tc.inventories[i],
+										big.NewInt(0),
+									),
+								}
+							}
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares of each vault.
+			for _, vaultId := range tc.vaultIds {
+				err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+				require.NoError(t, err)
+			}
+
+			// Check TotalVaultInventory query response is as expected.
+			response, err := k.TotalVaultInventory(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					vaulttypes.QueryTotalVaultInventoryResponse{
+						InventoryBaseQuantums: dtypes.NewIntFromBigInt(tc.expectedInventory),
+					},
+					*response,
+				)
+			}
+		})
+	}
+}
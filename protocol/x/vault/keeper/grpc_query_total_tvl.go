@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) TotalVaultTvl(
+	c context.Context,
+	req *types.QueryTotalVaultTvlRequest,
+) (*types.QueryTotalVaultTvlResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	totalEquity, vaultCount, err := k.GetTotalVaultTvl(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalVaultTvlResponse{
+		TotalEquityQuoteQuantums: dtypes.NewIntFromBigInt(totalEquity),
+		VaultCount:               vaultCount,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalVaultTvl(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault IDs.
+		vaultIds []vaulttypes.VaultId
+		// Asset of each vault.
+		assets []*big.Int
+		// Inventory of each vault in perpetual 0. Nil if vault has no perpetual positions.
+		inventories []*big.Int
+		// Query request.
+		req *vaulttypes.QueryTotalVaultTvlRequest
+
+		/* --- Expectations --- */
+		expectedTotalEquity *big.Int
+		expectedVaultCount  uint32
+		expectedErr         string
+	}{
+		"Success: three vaults": {
+			req: &vaulttypes.QueryTotalVaultTvlRequest{},
+			vaultIds: []vaulttypes.VaultId{
+				constants.Vault_Clob0,
+				constants.Vault_Clob1,
+				{
+					Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+					Number: 2,
+				},
+			},
+			assets: []*big.Int{
+				big.NewInt(1_000_000_000), // 1,000 USDC
+				big.NewInt(500_000_000),   // 500 USDC
+				big.NewInt(-100_000_000),  // -100 USDC
+			},
+			inventories: []*big.Int{
+				nil,
+				nil,
+				big.NewInt(200_000_000), // 0.02 BTC ($400 notional) when BTC is at $20,000
+			},
+			// 1,000 + 500 + (400 - 100) = 1,800 USDC
+			expectedTotalEquity: big.NewInt(1_800_000_000),
+			expectedVaultCount:  3,
+		},
+		"Success: no vaults": {
+			req:                 &vaulttypes.QueryTotalVaultTvlRequest{},
+			expectedTotalEquity: big.NewInt(0),
+			expectedVaultCount:  0,
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = make([]satypes.Subaccount, len(tc.vaultIds))
+						for i, vaultId := range tc.vaultIds {
+							genesisState.Subaccounts[i] = satypes.Subaccount{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.assets[i],
+									),
+								},
+							}
+							if tc.inventories[i] != nil {
+								genesisState.Subaccounts[i].PerpetualPositions = []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										tc.inventories[i],
+										big.NewInt(0),
+									),
+								}
+							}
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set total shares of each vault.
+			for _, vaultId := range tc.vaultIds {
+				err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+				require.NoError(t, err)
+			}
+
+			// Check TotalVaultTvl query response is as expected.
+			response, err := k.TotalVaultTvl(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					vaulttypes.QueryTotalVaultTvlResponse{
+						TotalEquityQuoteQuantums: dtypes.NewIntFromBigInt(tc.expectedTotalEquity),
+						VaultCount:               tc.expectedVaultCount,
+					},
+					*response,
+				)
+			}
+		})
+	}
+}
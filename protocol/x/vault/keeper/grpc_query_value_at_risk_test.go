@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultValueAtRisk(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault inventory in perpetual 0. Nil if vault has no perpetual positions.
+		inventory *big.Int
+		// EWMA of absolute per-block returns of market 0 in ppm.
+		ewmaAbsReturnPpm uint64
+		// Query request.
+		req *vaulttypes.QueryVaultValueAtRiskRequest
+
+		/* --- Expectations --- */
+		expectedValueAtRisk *big.Int
+		expectedErr         string
+	}{
+		"Success: long inventory at 99% confidence": {
+			inventory:        big.NewInt(10_000_000), // 0.001 BTC
+			ewmaAbsReturnPpm: 1_000,                  // 0.1%
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				ConfidencePpm: 990_000,
+			},
+			// 20_000_000 * 1_000 * 1_253_314 * 2_326_348 / 10^18
+			expectedValueAtRisk: big.NewInt(58_312),
+		},
+		"Success: short inventory at 95% confidence": {
+			inventory:        big.NewInt(-10_000_000), // -0.001 BTC
+			ewmaAbsReturnPpm: 1_000,                   // 0.1%
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				ConfidencePpm: 950_000,
+			},
+			// 20_000_000 * 1_000 * 1_253_314 * 1_644_854 / 10^18
+			expectedValueAtRisk: big.NewInt(41_230),
+		},
+		"Success: no inventory": {
+			ewmaAbsReturnPpm: 1_000,
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				ConfidencePpm: 990_000,
+			},
+			expectedValueAtRisk: big.NewInt(0),
+		},
+		"Success: zero volatility": {
+			inventory: big.NewInt(10_000_000),
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				ConfidencePpm: 990_000,
+			},
+			expectedValueAtRisk: big.NewInt(0),
+		},
+		"Error: confidence below 50%": {
+			inventory:        big.NewInt(10_000_000),
+			ewmaAbsReturnPpm: 1_000,
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				ConfidencePpm: 499_999,
+			},
+			expectedErr: vaulttypes.ErrInvalidConfidencePpm.Error(),
+		},
+		"Error: confidence above 99.9%": {
+			inventory:        big.NewInt(10_000_000),
+			ewmaAbsReturnPpm: 1_000,
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        0,
+				ConfidencePpm: 999_001,
+			},
+			expectedErr: vaulttypes.ErrInvalidConfidencePpm.Error(),
+		},
+		"Error: vault not found": {
+			req: &vaulttypes.QueryVaultValueAtRiskRequest{
+				Type:          vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number:        1,
+				ConfidencePpm: 990_000,
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						subaccount := satypes.Subaccount{
+							Id: vaultId.ToSubaccountId(),
+							AssetPositions: []*satypes.AssetPosition{
+								testutil.CreateSingleAssetPosition(
+									assettypes.AssetUsdc.Id,
+									big.NewInt(1_000_000_000), // 1,000 USDC
+								),
+							},
+						}
+						if tc.inventory != nil {
+							subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+								testutil.CreateSinglePerpetualPosition(
+									0,
+									tc.inventory,
+									big.NewInt(0),
+								),
+							}
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{subaccount}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set up vault and volatility of its market.
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+			k.SetMarketVolatility(ctx, 0, vaulttypes.MarketVolatility{EwmaAbsReturnPpm: tc.ewmaAbsReturnPpm})
+
+			// Check VaultValueAtRisk query response is as expected.
+			response, err := k.VaultValueAtRisk(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					0,
+					tc.expectedValueAtRisk.Cmp(response.ValueAtRiskQuoteQuantums.BigInt()),
+					"expected %v, got %v",
+					tc.expectedValueAtRisk,
+					response.ValueAtRiskQuoteQuantums.BigInt(),
+				)
+			}
+		})
+	}
+}
@@ -16,7 +16,10 @@ type (
 	Keeper struct {
 		cdc                 codec.BinaryCodec
 		storeKey            storetypes.StoreKey
+		transientStoreKey   storetypes.StoreKey
+		blockTimeKeeper     types.BlockTimeKeeper
 		clobKeeper          types.ClobKeeper
+		feeTiersKeeper      types.FeeTiersKeeper
 		perpetualsKeeper    types.PerpetualsKeeper
 		pricesKeeper        types.PricesKeeper
 		sendingKeeper       types.SendingKeeper
@@ -29,7 +32,10 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	transientStoreKey storetypes.StoreKey,
+	blockTimeKeeper types.BlockTimeKeeper,
 	clobKeeper types.ClobKeeper,
+	feeTiersKeeper types.FeeTiersKeeper,
 	perpetualsKeeper types.PerpetualsKeeper,
 	pricesKeeper types.PricesKeeper,
 	sendingKeeper types.SendingKeeper,
@@ -40,7 +46,10 @@ func NewKeeper(
 	return &Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
+		transientStoreKey:   transientStoreKey,
+		blockTimeKeeper:     blockTimeKeeper,
 		clobKeeper:          clobKeeper,
+		feeTiersKeeper:      feeTiersKeeper,
 		perpetualsKeeper:    perpetualsKeeper,
 		pricesKeeper:        pricesKeeper,
 		sendingKeeper:       sendingKeeper,
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultMakerEdgePpm returns the estimated maker edge (in ppm) of a CLOB vault, i.e. its
+// effective spread minus estimated adverse selection, where
+// - effective spread is the spread of the vault's innermost layer, after fee floor and spread
+// schedule are applied
+// - adverse selection is the absolute short-term drift of the vault's price market, i.e. EWMA
+// of signed per-block returns, which is the expected move against the vault's orders per block
+// A negative edge indicates that quoting is likely unprofitable. Returns zero if the vault
+// places no orders and an error if the vault's clob pair or perpetual doesn't exist.
+func (k Keeper) GetVaultMakerEdgePpm(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (int64, error) {
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return 0, errorsmod.Wrapf(types.ErrClobPairNotFound, "VaultId: %v", vaultId)
+	}
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return 0, err
+	}
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		return 0, err
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	_, explanations, err := k.getVaultClobOrdersWithExplanations(
+		ctx,
+		vaultId,
+		clobPair,
+		k.GetParams(ctx),
+		vaultParams,
+	)
+	if err != nil {
+		return 0, err
+	}
+	volatility := k.GetMarketVolatility(ctx, getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId))
+	return getVaultMakerEdgePpm(explanations, volatility), nil
+}
+
+// getVaultMakerEdgePpm returns the estimated maker edge (in ppm) of a vault given explanations
+// of its orders and volatility of its price market. Returns zero if there are no orders.
+func getVaultMakerEdgePpm(
+	explanations []*types.VaultOrderExplanation,
+	volatility types.MarketVolatility,
+) int64 {
+	if len(explanations) == 0 {
+		return 0
+	}
+
+	// edge = spread - |drift|
+	driftPpm := volatility.EwmaReturnPpm
+	if driftPpm < 0 {
+		driftPpm = -driftPpm
+	}
+	return int64(explanations[0].SpreadPpm) - driftPpm
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	sendingtypes "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// CloseVault transfers all equity of a deactivated vault to a destination subaccount
+// and decommissions the vault. Fails if the vault still has resting orders, outstanding
+// shares, or open perpetual positions so that no depositor funds are swept.
+func (k msgServer) CloseVault(
+	goCtx context.Context,
+	msg *types.MsgCloseVault,
+) (*types.MsgCloseVaultResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	// 1. Vault exists.
+	totalShares, exists := k.GetTotalShares(ctx, msg.VaultId)
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", msg.VaultId)
+	}
+
+	// 2. Vault is deactivated.
+	if k.GetVaultActivated(ctx, msg.VaultId) {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotDeactivated, "VaultId: %v", msg.VaultId)
+	}
+
+	// 3. Vault has no outstanding shares.
+	if totalShares.NumShares.Sign() > 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrVaultHasOutstandingShares,
+			"VaultId: %v, TotalShares: %v",
+			msg.VaultId,
+			totalShares.NumShares.BigInt(),
+		)
+	}
+	for _, ownerShare := range k.GetAllOwnerShares(ctx, msg.VaultId) {
+		if ownerShare.Shares.NumShares.Sign() > 0 {
+			return nil, errorsmod.Wrapf(
+				types.ErrVaultHasOutstandingShares,
+				"VaultId: %v, Owner: %v, OwnerShares: %v",
+				msg.VaultId,
+				ownerShare.Owner,
+				ownerShare.Shares.NumShares.BigInt(),
+			)
+		}
+	}
+
+	// 4. Vault has no resting orders.
+	restingOrders, err := k.GetRestingVaultOrders(ctx, msg.VaultId)
+	if err != nil {
+		return nil, err
+	}
+	if len(restingOrders) > 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrVaultHasRestingOrders,
+			"VaultId: %v, NumRestingOrders: %d",
+			msg.VaultId,
+			len(restingOrders),
+		)
+	}
+
+	// 5. Vault has no open perpetual positions, so that its equity is fully in quote asset.
+	vaultSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, *msg.VaultId.ToSubaccountId())
+	if len(vaultSubaccount.PerpetualPositions) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrVaultHasOpenPositions, "VaultId: %v", msg.VaultId)
+	}
+
+	// Transfer all equity from vault to destination.
+	quoteQuantums, err := k.GetVaultEquity(ctx, msg.VaultId)
+	if err != nil {
+		return nil, err
+	}
+	if quoteQuantums.Sign() > 0 {
+		err = k.sendingKeeper.ProcessTransfer(
+			ctx,
+			&sendingtypes.Transfer{
+				Sender:    *msg.VaultId.ToSubaccountId(),
+				Recipient: msg.Destination,
+				AssetId:   assettypes.AssetUsdc.Id,
+				Amount:    quoteQuantums.Uint64(),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		quoteQuantums = big.NewInt(0)
+	}
+
+	// Zero out shares and other state of the vault.
+	k.DecommissionVault(ctx, msg.VaultId)
+
+	ctx.EventManager().EmitEvent(
+		types.NewVaultCloseEvent(msg.VaultId, msg.Destination, quoteQuantums),
+	)
+
+	return &types.MsgCloseVaultResponse{
+		QuoteQuantums: dtypes.NewIntFromBigInt(quoteQuantums),
+	}, nil
+}
@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCloseVault(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault asset.
+		asset *big.Int
+		// Vault inventory in perpetual 0. Nil if vault has no perpetual positions.
+		inventory *big.Int
+		// Total shares of the vault.
+		totalShares *big.Int
+		// Shares of Alice in the vault. Nil if Alice has no shares.
+		aliceShares *big.Int
+		// Whether the vault is activated.
+		activated bool
+		// Whether to place vault orders before the msg.
+		placeOrders bool
+		// Msg.
+		msg *vaulttypes.MsgCloseVault
+
+		/* --- Expectations --- */
+		// Expected quote quantums transferred to destination.
+		expectedQuoteQuantums *big.Int
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - Transfer all equity": {
+			asset:       big.NewInt(1_000_000_000), // 1,000 USDC
+			totalShares: big.NewInt(0),
+			aliceShares: big.NewInt(0),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedQuoteQuantums: big.NewInt(1_000_000_000),
+		},
+		"Success - Zero equity": {
+			asset:       big.NewInt(0),
+			totalShares: big.NewInt(0),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedQuoteQuantums: big.NewInt(0),
+		},
+		"Failure - Invalid Authority": {
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(0),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   constants.AliceAccAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure - Vault Not Found": {
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(0),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob1,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: vaulttypes.ErrVaultNotFound.Error(),
+		},
+		"Failure - Vault Not Deactivated": {
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(0),
+			activated:   true,
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: vaulttypes.ErrVaultNotDeactivated.Error(),
+		},
+		"Failure - Outstanding Total Shares": {
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(1),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: vaulttypes.ErrVaultHasOutstandingShares.Error(),
+		},
+		"Failure - Outstanding Owner Shares": {
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(0),
+			aliceShares: big.NewInt(7),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: vaulttypes.ErrVaultHasOutstandingShares.Error(),
+		},
+		"Failure - Resting Orders": {
+			asset:       big.NewInt(1_000_000_000),
+			totalShares: big.NewInt(0),
+			placeOrders: true,
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: vaulttypes.ErrVaultHasRestingOrders.Error(),
+		},
+		"Failure - Open Perpetual Positions": {
+			asset:       big.NewInt(1_000_000_000),
+			inventory:   big.NewInt(1_000),
+			totalShares: big.NewInt(0),
+			msg: &vaulttypes.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Carl_Num0,
+			},
+			expectedErr: vaulttypes.ErrVaultHasOpenPositions.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						subaccount := satypes.Subaccount{
+							Id: vaultId.ToSubaccountId(),
+						}
+						if tc.asset.Sign() != 0 {
+							subaccount.AssetPositions = []*satypes.AssetPosition{
+								testutil.CreateSingleAssetPosition(
+									assettypes.AssetUsdc.Id,
+									tc.asset,
+								),
+							}
+						}
+						if tc.inventory != nil {
+							subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+								testutil.CreateSinglePerpetualPosition(
+									0,
+									tc.inventory,
+									big.NewInt(0),
+								),
+							}
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{subaccount}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+
+			// Set up vault state.
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(tc.totalShares))
+			require.NoError(t, err)
+			if tc.aliceShares != nil {
+				err = k.SetOwnerShares(
+					ctx,
+					vaultId,
+					constants.AliceAccAddress.String(),
+					vaulttypes.BigIntToNumShares(tc.aliceShares),
+				)
+				require.NoError(t, err)
+			}
+			k.SetVaultActivated(ctx, vaultId, tc.activated)
+			if tc.placeOrders {
+				orders, err := k.GetVaultClobOrders(ctx, vaultId)
+				require.NoError(t, err)
+				for _, order := range orders {
+					err := k.PlaceVaultClobOrder(ctx, order)
+					require.NoError(t, err)
+				}
+			}
+			vaultEquityBefore, err := k.GetVaultEquity(ctx, vaultId)
+			require.NoError(t, err)
+			destination := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, tc.msg.Destination)
+			destinationUsdcBefore := destination.GetUsdcPosition()
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			response, err := ms.CloseVault(ctx, tc.msg)
+			destination = tApp.App.SubaccountsKeeper.GetSubaccount(ctx, tc.msg.Destination)
+			destinationUsdcAfter := destination.GetUsdcPosition()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, ctx.EventManager().Events())
+
+				// Check that vault is not closed and its equity stays the same.
+				_, exists := k.GetTotalShares(ctx, vaultId)
+				require.True(t, exists)
+				vaultEquityAfter, err := k.GetVaultEquity(ctx, vaultId)
+				require.NoError(t, err)
+				require.Equal(t, vaultEquityBefore, vaultEquityAfter)
+				require.Equal(t, destinationUsdcBefore, destinationUsdcAfter)
+			} else {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&vaulttypes.MsgCloseVaultResponse{
+						QuoteQuantums: dtypes.NewIntFromBigInt(tc.expectedQuoteQuantums),
+					},
+					response,
+				)
+				require.Contains(
+					t,
+					ctx.EventManager().Events(),
+					vaulttypes.NewVaultCloseEvent(vaultId, tc.msg.Destination, tc.expectedQuoteQuantums),
+				)
+
+				// Check that equity is transferred to destination.
+				require.Equal(
+					t,
+					new(big.Int).Add(destinationUsdcBefore, tc.expectedQuoteQuantums),
+					destinationUsdcAfter,
+				)
+				vaultEquityAfter, err := k.GetVaultEquity(ctx, vaultId)
+				require.NoError(t, err)
+				require.Equal(t, 0, vaultEquityAfter.Sign())
+
+				// Check that vault shares are deleted.
+				_, exists := k.GetTotalShares(ctx, vaultId)
+				require.False(t, exists)
+				require.Empty(t, k.GetAllOwnerShares(ctx, vaultId))
+			}
+		})
+	}
+}
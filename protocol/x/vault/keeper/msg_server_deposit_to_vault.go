@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
@@ -11,7 +13,8 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
-// DepositToVault deposits from a subaccount to a vault.
+// DepositToVault deposits from a subaccount to a vault. Deposits are rejected if the vault
+// operator refreshed the vault's orders earlier in the same block (see `IsVaultRefreshLocked`).
 func (k msgServer) DepositToVault(
 	goCtx context.Context,
 	msg *types.MsgDepositToVault,
@@ -19,6 +22,11 @@ func (k msgServer) DepositToVault(
 	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
 	quoteQuantums := msg.QuoteQuantums.BigInt()
 
+	// Vault must not be locked by a refresh in the current block.
+	if k.IsVaultRefreshLocked(ctx, *msg.VaultId) {
+		return nil, errorsmod.Wrapf(types.ErrVaultRefreshLocked, "VaultId: %v", *msg.VaultId)
+	}
+
 	// Mint shares for the vault.
 	err := k.MintShares(
 		ctx,
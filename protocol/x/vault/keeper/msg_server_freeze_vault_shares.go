@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// FreezeVaultShares freezes an owner's shares in a vault so that they can't be withdrawn.
+func (k msgServer) FreezeVaultShares(
+	goCtx context.Context,
+	msg *types.MsgFreezeVaultShares,
+) (*types.MsgFreezeVaultSharesResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	if _, exists := k.GetTotalShares(ctx, msg.VaultId); !exists {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", msg.VaultId)
+	}
+	if _, exists := k.GetOwnerShares(ctx, msg.VaultId, msg.Owner); !exists {
+		return nil, errorsmod.Wrapf(
+			types.ErrOwnerShareNotFound,
+			"VaultId: %v, Owner: %v",
+			msg.VaultId,
+			msg.Owner,
+		)
+	}
+	k.SetOwnerSharesFrozen(ctx, msg.VaultId, msg.Owner, true)
+
+	return &types.MsgFreezeVaultSharesResponse{}, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFreezeVaultShares(t *testing.T) {
+	tests := map[string]struct {
+		// Msg.
+		msg *types.MsgFreezeVaultShares
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - Freeze Alice's Shares": {
+			msg: &types.MsgFreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.Alice_Num0.Owner,
+			},
+		},
+		"Failure - Invalid Authority": {
+			msg: &types.MsgFreezeVaultShares{
+				Authority: constants.AliceAccAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.Alice_Num0.Owner,
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure - Vault Not Found": {
+			msg: &types.MsgFreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob1,
+				Owner:     constants.Alice_Num0.Owner,
+			},
+			expectedErr: types.ErrVaultNotFound.Error(),
+		},
+		"Failure - Owner Shares Not Found": {
+			msg: &types.MsgFreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.Carl_Num0.Owner,
+			},
+			expectedErr: types.ErrOwnerShareNotFound.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+			setVaultState(t, tApp, ctx, constants.Vault_Clob0_MultiOwner_Alice0_1000_Bob0_2500)
+
+			_, err := ms.FreezeVaultShares(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.False(t, k.IsOwnerSharesFrozen(ctx, tc.msg.VaultId, tc.msg.Owner))
+			} else {
+				require.NoError(t, err)
+				require.True(t, k.IsOwnerSharesFrozen(ctx, tc.msg.VaultId, tc.msg.Owner))
+			}
+		})
+	}
+}
+
+func TestFreezeVaultShares_Withdrawal(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	setVaultState(t, tApp, ctx, constants.Vault_Clob0_MultiOwner_Alice0_1000_Bob0_2500)
+
+	withdrawAlice := &types.MsgWithdrawFromVault{
+		VaultId:      &constants.Vault_Clob0,
+		SubaccountId: &constants.Alice_Num0,
+		Shares:       &types.NumShares{NumShares: dtypes.NewInt(1)},
+	}
+	withdrawBob := &types.MsgWithdrawFromVault{
+		VaultId:      &constants.Vault_Clob0,
+		SubaccountId: &constants.Bob_Num0,
+		Shares:       &types.NumShares{NumShares: dtypes.NewInt(1)},
+	}
+
+	// Freeze Alice's shares.
+	_, err := ms.FreezeVaultShares(ctx, &types.MsgFreezeVaultShares{
+		Authority: lib.GovModuleAddress.String(),
+		VaultId:   constants.Vault_Clob0,
+		Owner:     constants.Alice_Num0.Owner,
+	})
+	require.NoError(t, err)
+
+	// Frozen Alice can't withdraw while unfrozen Bob can.
+	_, err = ms.WithdrawFromVault(ctx, withdrawAlice)
+	require.ErrorIs(t, err, types.ErrOwnerSharesFrozen)
+	_, err = ms.WithdrawFromVault(ctx, withdrawBob)
+	require.NoError(t, err)
+
+	// Frozen status is included in owner shares query.
+	allOwnerShares := k.GetAllOwnerShares(ctx, constants.Vault_Clob0)
+	require.Len(t, allOwnerShares, 2)
+	for _, ownerShare := range allOwnerShares {
+		require.Equal(t, ownerShare.Owner == constants.Alice_Num0.Owner, ownerShare.Frozen)
+	}
+
+	// Unfreeze Alice's shares, after which Alice can withdraw.
+	_, err = ms.UnfreezeVaultShares(ctx, &types.MsgUnfreezeVaultShares{
+		Authority: lib.GovModuleAddress.String(),
+		VaultId:   constants.Vault_Clob0,
+		Owner:     constants.Alice_Num0.Owner,
+	})
+	require.NoError(t, err)
+	_, err = ms.WithdrawFromVault(ctx, withdrawAlice)
+	require.NoError(t, err)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// OperatorUpdateVaultParams updates `spread_min_ppm` and `order_size_pct_ppm` in params
+// on behalf of the vault operator. New values must be within the operator param bounds
+// set by gov and updates must be at least `operator_update_interval_blocks` apart.
+func (k msgServer) OperatorUpdateVaultParams(
+	goCtx context.Context,
+	msg *types.MsgOperatorUpdateVaultParams,
+) (*types.MsgOperatorUpdateVaultParamsResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	params := k.GetParams(ctx)
+
+	// Signer must be the operator.
+	if params.Operator == "" || msg.Operator != params.Operator {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidOperator,
+			"invalid operator %s",
+			msg.Operator,
+		)
+	}
+
+	// New values must be within bounds.
+	bounds := params.OperatorParamBounds
+	if msg.SpreadMinPpm < bounds.SpreadMinPpmMin || msg.SpreadMinPpm > bounds.SpreadMinPpmMax {
+		return nil, errorsmod.Wrapf(
+			types.ErrOperatorParamOutOfBounds,
+			"spread_min_ppm %d is not within [%d, %d]",
+			msg.SpreadMinPpm,
+			bounds.SpreadMinPpmMin,
+			bounds.SpreadMinPpmMax,
+		)
+	}
+	if msg.OrderSizePctPpm < bounds.OrderSizePctPpmMin || msg.OrderSizePctPpm > bounds.OrderSizePctPpmMax {
+		return nil, errorsmod.Wrapf(
+			types.ErrOperatorParamOutOfBounds,
+			"order_size_pct_ppm %d is not within [%d, %d]",
+			msg.OrderSizePctPpm,
+			bounds.OrderSizePctPpmMin,
+			bounds.OrderSizePctPpmMax,
+		)
+	}
+
+	// Operator must not have updated params in the last `operator_update_interval_blocks` blocks.
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	lastUpdateBlockHeight, exists := k.GetLastOperatorUpdateBlockHeight(ctx)
+	if exists && blockHeight < lastUpdateBlockHeight+params.OperatorUpdateIntervalBlocks {
+		return nil, errorsmod.Wrapf(
+			types.ErrOperatorUpdateTooFrequent,
+			"last update at block %d, next update allowed at block %d",
+			lastUpdateBlockHeight,
+			lastUpdateBlockHeight+params.OperatorUpdateIntervalBlocks,
+		)
+	}
+
+	params.SpreadMinPpm = msg.SpreadMinPpm
+	params.OrderSizePctPpm = msg.OrderSizePctPpm
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+	k.SetLastOperatorUpdateBlockHeight(ctx, blockHeight)
+
+	return &types.MsgOperatorUpdateVaultParamsResponse{}, nil
+}
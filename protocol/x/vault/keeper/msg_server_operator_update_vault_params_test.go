@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOperatorUpdateVaultParams(t *testing.T) {
+	tests := map[string]struct {
+		// Block height of the last operator update. Nil if operator has never updated params.
+		lastUpdateBlockHeight *uint32
+		// Block height at which msg is sent.
+		blockHeight int64
+		// Msg.
+		msg *types.MsgOperatorUpdateVaultParams
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - First Update": {
+			blockHeight: 10,
+			msg: &types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 200_000,
+			},
+		},
+		"Success - In-bounds Update at Bounds after Interval": {
+			lastUpdateBlockHeight: func() *uint32 { h := uint32(5); return &h }(),
+			blockHeight:           10,
+			msg: &types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    20_000,
+				OrderSizePctPpm: 50_000,
+			},
+		},
+		"Failure - Not Operator": {
+			blockHeight: 10,
+			msg: &types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.BobAccAddress.String(),
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 200_000,
+			},
+			expectedErr: types.ErrInvalidOperator.Error(),
+		},
+		"Failure - Spread Min Ppm below Bounds": {
+			blockHeight: 10,
+			msg: &types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    4_999,
+				OrderSizePctPpm: 200_000,
+			},
+			expectedErr: types.ErrOperatorParamOutOfBounds.Error(),
+		},
+		"Failure - Order Size Pct Ppm above Bounds": {
+			blockHeight: 10,
+			msg: &types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 200_001,
+			},
+			expectedErr: types.ErrOperatorParamOutOfBounds.Error(),
+		},
+		"Failure - Too Frequent": {
+			lastUpdateBlockHeight: func() *uint32 { h := uint32(6); return &h }(),
+			blockHeight:           10,
+			msg: &types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 200_000,
+			},
+			expectedErr: types.ErrOperatorUpdateTooFrequent.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain().WithBlockHeight(tc.blockHeight)
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+
+			// Set Alice as operator who can update params every 5 blocks.
+			params := types.DefaultParams()
+			params.Operator = constants.AliceAccAddress.String()
+			params.OperatorUpdateIntervalBlocks = 5
+			params.OperatorParamBounds = types.OperatorParamBounds{
+				SpreadMinPpmMin:    5_000,
+				SpreadMinPpmMax:    20_000,
+				OrderSizePctPpmMin: 50_000,
+				OrderSizePctPpmMax: 200_000,
+			}
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+			if tc.lastUpdateBlockHeight != nil {
+				k.SetLastOperatorUpdateBlockHeight(ctx, *tc.lastUpdateBlockHeight)
+			}
+
+			_, err = ms.OperatorUpdateVaultParams(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Equal(t, params, k.GetParams(ctx))
+				lastUpdateBlockHeight, exists := k.GetLastOperatorUpdateBlockHeight(ctx)
+				if tc.lastUpdateBlockHeight != nil {
+					require.True(t, exists)
+					require.Equal(t, *tc.lastUpdateBlockHeight, lastUpdateBlockHeight)
+				} else {
+					require.False(t, exists)
+				}
+			} else {
+				require.NoError(t, err)
+				expectedParams := params
+				expectedParams.SpreadMinPpm = tc.msg.SpreadMinPpm
+				expectedParams.OrderSizePctPpm = tc.msg.OrderSizePctPpm
+				require.Equal(t, expectedParams, k.GetParams(ctx))
+				lastUpdateBlockHeight, exists := k.GetLastOperatorUpdateBlockHeight(ctx)
+				require.True(t, exists)
+				require.Equal(t, uint32(tc.blockHeight), lastUpdateBlockHeight)
+			}
+		})
+	}
+}
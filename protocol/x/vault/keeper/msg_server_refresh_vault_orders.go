@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// RefreshVaultOrders refreshes orders of an active vault immediately on behalf of the vault
+// operator, e.g. after an oracle price update, regardless of `min_refresh_interval_blocks`.
+// A relayer can refresh orders via an authz grant from the operator. As orders to place have
+// the same client IDs as resting orders in a block with the same parity as the block of last
+// refresh, refreshes are rejected in such blocks, which limits refreshes of a vault to at most
+// one per block. Refreshes are also rejected if fewer than `operator_refresh_interval_blocks`
+// blocks have passed since the vault's last refresh. Orders are only refreshed during block
+// execution, i.e. a msg that passes validation in CheckTx doesn't place or cancel any orders.
+// A refresh locks the vault until the end of the block, which rejects any later deposit to or
+// withdrawal from the vault in the same block.
+func (k msgServer) RefreshVaultOrders(
+	goCtx context.Context,
+	msg *types.MsgRefreshVaultOrders,
+) (*types.MsgRefreshVaultOrdersResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	params := k.GetParams(ctx)
+
+	// Signer must be the operator.
+	if params.Operator == "" || msg.Signer != params.Operator {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidOperator,
+			"invalid operator %s",
+			msg.Signer,
+		)
+	}
+
+	// Vault must exist and be active.
+	totalShares, exists := k.GetTotalShares(ctx, msg.VaultId)
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", msg.VaultId)
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, msg.VaultId)
+	if reason := k.getVaultInactiveReason(ctx, msg.VaultId, totalShares, params, vaultParams); reason != "" {
+		return nil, errorsmod.Wrapf(types.ErrVaultInactive, "VaultId: %v, reason: %s", msg.VaultId, reason)
+	}
+
+	// Current block must have a different parity from the block of last refresh.
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	lastRefreshBlockHeight, exists := k.GetLastRefreshBlockHeight(ctx, msg.VaultId)
+	if exists && (blockHeight-lastRefreshBlockHeight)%2 == 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrVaultRefreshTooFrequent,
+			"last refresh at block %d has the same parity as current block %d",
+			lastRefreshBlockHeight,
+			blockHeight,
+		)
+	}
+
+	// At least `operator_refresh_interval_blocks` blocks must have passed since the block of
+	// last refresh.
+	if exists && blockHeight-lastRefreshBlockHeight < params.OperatorRefreshIntervalBlocks {
+		return nil, errorsmod.Wrapf(
+			types.ErrVaultRefreshTooFrequent,
+			"last refresh at block %d is fewer than %d blocks before current block %d",
+			lastRefreshBlockHeight,
+			params.OperatorRefreshIntervalBlocks,
+			blockHeight,
+		)
+	}
+
+	// Don't refresh orders in CheckTx, where placing and cancelling orders would only update
+	// check state and could be repeated by every submission of this msg.
+	if ctx.IsCheckTx() {
+		return &types.MsgRefreshVaultOrdersResponse{}, nil
+	}
+
+	// Flag vault for a requote so that it refreshes its orders regardless of min refresh interval.
+	k.SetVaultPendingRequote(ctx, msg.VaultId, true)
+	if err := k.RefreshVaultClobOrders(ctx, msg.VaultId); err != nil {
+		return nil, err
+	}
+	k.lockVaultForRefresh(ctx, msg.VaultId)
+
+	return &types.MsgRefreshVaultOrdersResponse{}, nil
+}
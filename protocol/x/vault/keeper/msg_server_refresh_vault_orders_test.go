@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRefreshVaultOrders(t *testing.T) {
+	tests := map[string]struct {
+		// Block height at which msg is sent.
+		blockHeight int64
+		// Whether msg is sent in CheckTx.
+		isCheckTx bool
+		// Operator refresh interval blocks.
+		operatorRefreshIntervalBlocks uint32
+		// Msg.
+		msg *vaulttypes.MsgRefreshVaultOrders
+
+		/* --- Expectations --- */
+		// Expected block at which vault last refreshed its orders.
+		expectedLastRefreshBlock uint32
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - Operator refreshes orders before min refresh interval": {
+			blockHeight: 2,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedLastRefreshBlock: 2,
+		},
+		"Success - Operator refreshes orders after operator refresh interval": {
+			blockHeight:                   4,
+			operatorRefreshIntervalBlocks: 3,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedLastRefreshBlock: 4,
+		},
+		"Success - Orders are not refreshed in CheckTx": {
+			blockHeight: 2,
+			isCheckTx:   true,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedLastRefreshBlock: 1,
+		},
+		"Failure - Within Operator Refresh Interval": {
+			blockHeight:                   2,
+			operatorRefreshIntervalBlocks: 3,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedLastRefreshBlock: 1,
+			expectedErr:              vaulttypes.ErrVaultRefreshTooFrequent.Error(),
+		},
+		"Failure - Not Operator": {
+			blockHeight: 2,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.BobAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedLastRefreshBlock: 1,
+			expectedErr:              vaulttypes.ErrInvalidOperator.Error(),
+		},
+		"Failure - Same Parity as Last Refresh": {
+			blockHeight: 3,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedLastRefreshBlock: 1,
+			expectedErr:              vaulttypes.ErrVaultRefreshTooFrequent.Error(),
+		},
+		"Failure - Vault Not Found": {
+			blockHeight: 2,
+			msg: &vaulttypes.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob1,
+			},
+			expectedLastRefreshBlock: 1,
+			expectedErr:              vaulttypes.ErrVaultNotFound.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Initialize tApp with a vault that refreshes its orders every 5 blocks and with
+			// Alice as operator.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.MinRefreshIntervalBlocks = 5
+						genesisState.Params.Operator = constants.AliceAccAddress.String()
+						genesisState.Params.OperatorRefreshIntervalBlocks = tc.operatorRefreshIntervalBlocks
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			// Vault refreshes its orders for the first time at block 1.
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+			ctx = ctx.WithBlockHeight(tc.blockHeight).WithIsCheckTx(false)
+			tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+				BlockHeight: lib.MustConvertIntegerToUint32(tc.blockHeight),
+			})
+			ctx = ctx.WithIsCheckTx(tc.isCheckTx)
+
+			_, err := ms.RefreshVaultOrders(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// Check block of last refresh and that orders of that block are resting.
+			lastRefreshBlock, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedLastRefreshBlock, lastRefreshBlock)
+			require.False(t, k.GetVaultPendingRequote(ctx, vaultId))
+			orderIds, err := k.GetVaultClobOrderIds(ctx.WithBlockHeight(int64(lastRefreshBlock)), vaultId)
+			require.NoError(t, err)
+			for _, orderId := range orderIds {
+				_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+				require.True(t, exists)
+			}
+		})
+	}
+}
+
+func TestMsgRefreshVaultOrders_RefreshLock(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	// Initialize tApp with a vault that has 1,000 USDC of equity and 1,000 shares such that
+	// one share is worth 1 USDC, and with Alice as operator.
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+					{
+						Id: &constants.Bob_Num0,
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.MinRefreshIntervalBlocks = 5
+				genesisState.Params.Operator = constants.AliceAccAddress.String()
+				genesisState.Vaults = []*vaulttypes.Vault{
+					{
+						VaultId:     &vaultId,
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+						OwnerShares: []*vaulttypes.OwnerShare{
+							{
+								Owner:  constants.AliceAccAddress.String(),
+								Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{}).WithIsCheckTx(false)
+	deposit := &vaulttypes.MsgDepositToVault{
+		VaultId:       &vaultId,
+		SubaccountId:  &constants.Bob_Num0,
+		QuoteQuantums: dtypes.NewInt(100_000_000), // 100 USDC
+	}
+	requireBobShares := func(expected int64) {
+		shares, exists := k.GetOwnerShares(ctx, vaultId, constants.BobAccAddress.String())
+		require.True(t, exists)
+		require.Equal(t, big.NewInt(expected), shares.NumShares.BigInt())
+	}
+
+	// Deposit before refresh in the same block mints shares at 1 USDC per share.
+	require.False(t, k.IsVaultRefreshLocked(ctx, vaultId))
+	_, err := ms.DepositToVault(ctx, deposit)
+	require.NoError(t, err)
+	requireBobShares(100)
+
+	// Refresh locks the vault for the rest of the block.
+	_, err = ms.RefreshVaultOrders(ctx, &vaulttypes.MsgRefreshVaultOrders{
+		Signer:  constants.AliceAccAddress.String(),
+		VaultId: vaultId,
+	})
+	require.NoError(t, err)
+	require.True(t, k.IsVaultRefreshLocked(ctx, vaultId))
+	require.False(t, k.IsVaultRefreshLocked(ctx, constants.Vault_Clob1))
+
+	// Deposit and withdrawal after refresh in the same block are rejected.
+	_, err = ms.DepositToVault(ctx, deposit)
+	require.ErrorIs(t, err, vaulttypes.ErrVaultRefreshLocked)
+	requireBobShares(100)
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	require.True(t, exists)
+	require.Equal(t, big.NewInt(1_100), totalShares.NumShares.BigInt())
+	err = k.ValidateWithdrawFromVault(ctx, &vaulttypes.MsgWithdrawFromVault{
+		VaultId:      &vaultId,
+		SubaccountId: &constants.Bob_Num0,
+		Shares:       &vaulttypes.NumShares{NumShares: dtypes.NewInt(100)},
+	})
+	require.ErrorIs(t, err, vaulttypes.ErrVaultRefreshLocked)
+
+	// The lock is released at the end of the block.
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{}).WithIsCheckTx(false)
+	require.False(t, k.IsVaultRefreshLocked(ctx, vaultId))
+	_, err = ms.DepositToVault(ctx, deposit)
+	require.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// RepairVaultShares recomputes total shares of a vault from its owner shares.
+func (k msgServer) RepairVaultShares(
+	goCtx context.Context,
+	msg *types.MsgRepairVaultShares,
+) (*types.MsgRepairVaultSharesResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	if _, _, err := k.RecomputeTotalShares(ctx, msg.VaultId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRepairVaultSharesResponse{}, nil
+}
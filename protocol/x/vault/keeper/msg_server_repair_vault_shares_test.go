@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRepairVaultShares(t *testing.T) {
+	tests := map[string]struct {
+		// Msg.
+		msg *types.MsgRepairVaultShares
+		// Total shares to desync vault to before the msg.
+		desyncedTotalShares *big.Int
+		// Expected total shares after the msg.
+		expectedTotalShares *big.Int
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - Repair Total Shares greater than Sum of Owner Shares": {
+			msg: &types.MsgRepairVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+			},
+			desyncedTotalShares: big.NewInt(4_000),
+			// Alice has 1_000 shares and Bob has 2_500 shares.
+			expectedTotalShares: big.NewInt(3_500),
+		},
+		"Success - Repair Total Shares less than Sum of Owner Shares": {
+			msg: &types.MsgRepairVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+			},
+			desyncedTotalShares: big.NewInt(1),
+			expectedTotalShares: big.NewInt(3_500),
+		},
+		"Success - Total Shares already in Sync": {
+			msg: &types.MsgRepairVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+			},
+			desyncedTotalShares: big.NewInt(3_500),
+			expectedTotalShares: big.NewInt(3_500),
+		},
+		"Failure - Invalid Authority": {
+			msg: &types.MsgRepairVaultShares{
+				Authority: constants.AliceAccAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+			},
+			desyncedTotalShares: big.NewInt(4_000),
+			expectedTotalShares: big.NewInt(4_000),
+			expectedErr:         "invalid authority",
+		},
+		"Failure - Vault Not Found": {
+			msg: &types.MsgRepairVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob1,
+			},
+			desyncedTotalShares: big.NewInt(4_000),
+			expectedTotalShares: big.NewInt(4_000),
+			expectedErr:         types.ErrVaultNotFound.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+			setVaultState(t, tApp, ctx, constants.Vault_Clob0_MultiOwner_Alice0_1000_Bob0_2500)
+
+			// Desync total shares from owner shares.
+			vaultId := constants.Vault_Clob0
+			err := k.SetTotalShares(ctx, vaultId, types.BigIntToNumShares(tc.desyncedTotalShares))
+			require.NoError(t, err)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			_, err = ms.RepairVaultShares(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.Empty(t, ctx.EventManager().Events())
+			} else {
+				require.NoError(t, err)
+				require.Contains(
+					t,
+					ctx.EventManager().Events(),
+					types.NewVaultRepairSharesEvent(vaultId, tc.desyncedTotalShares, tc.expectedTotalShares),
+				)
+			}
+
+			totalShares, exists := k.GetTotalShares(ctx, vaultId)
+			require.True(t, exists)
+			require.Equal(t, types.BigIntToNumShares(tc.expectedTotalShares), totalShares)
+		})
+	}
+}
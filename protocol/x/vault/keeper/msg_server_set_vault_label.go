@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// SetVaultLabel sets the label of a vault on behalf of the vault operator.
+func (k msgServer) SetVaultLabel(
+	goCtx context.Context,
+	msg *types.MsgSetVaultLabel,
+) (*types.MsgSetVaultLabelResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	params := k.GetParams(ctx)
+
+	// Signer must be the operator.
+	if params.Operator == "" || msg.Operator != params.Operator {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidOperator,
+			"invalid operator %s",
+			msg.Operator,
+		)
+	}
+
+	if err := k.Keeper.SetVaultLabel(ctx, msg.VaultId, msg.Label); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetVaultLabelResponse{}, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	cometbfttypes "github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetVaultLabel(t *testing.T) {
+	tests := map[string]struct {
+		// Existing vault params.
+		existingVaultParams *types.VaultParams
+		// Msg.
+		msg *types.MsgSetVaultLabel
+		// Expected vault params after the msg.
+		expectedVaultParams types.VaultParams
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - Vault without Params": {
+			msg: &types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD Vault",
+			},
+			expectedVaultParams: types.VaultParams{
+				Label: "BTC-USD Vault",
+			},
+		},
+		"Success - Overwrite Label and Keep Lagged Price": {
+			existingVaultParams: &types.VaultParams{
+				LaggedPrice: &pricestypes.MarketPrice{
+					Id:       0,
+					Exponent: -5,
+					Price:    5_000_000,
+				},
+				Label: "Old Label",
+			},
+			msg: &types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD Vault",
+			},
+			expectedVaultParams: types.VaultParams{
+				LaggedPrice: &pricestypes.MarketPrice{
+					Id:       0,
+					Exponent: -5,
+					Price:    5_000_000,
+				},
+				Label: "BTC-USD Vault",
+			},
+		},
+		"Failure - Non-operator Signer": {
+			msg: &types.MsgSetVaultLabel{
+				Operator: constants.BobAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD Vault",
+			},
+			expectedErr: types.ErrInvalidOperator.Error(),
+		},
+		"Failure - Gov Signer": {
+			msg: &types.MsgSetVaultLabel{
+				Operator: lib.GovModuleAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD Vault",
+			},
+			expectedErr: types.ErrInvalidOperator.Error(),
+		},
+		"Failure - Vault Not Found": {
+			msg: &types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob1,
+				Label:    "ETH-USD Vault",
+			},
+			expectedErr: types.ErrVaultNotFound.Error(),
+		},
+		"Failure - Label Too Long": {
+			msg: &types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    strings.Repeat("a", types.MaxVaultLabelLength+1),
+			},
+			expectedErr: types.ErrInvalidVaultLabel.Error(),
+		},
+		"Failure - Label with Control Characters": {
+			msg: &types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD\tVault",
+			},
+			expectedErr: types.ErrInvalidVaultLabel.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cometbfttypes.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *types.GenesisState) {
+						genesisState.Params.Operator = constants.AliceAccAddress.String()
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+
+			// Set up vault with total shares and existing vault params.
+			err := k.SetTotalShares(ctx, constants.Vault_Clob0, types.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+			if tc.existingVaultParams != nil {
+				err = k.SetVaultParams(ctx, constants.Vault_Clob0, *tc.existingVaultParams)
+				require.NoError(t, err)
+			}
+
+			_, err = ms.SetVaultLabel(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				vaultParams, _ := k.GetVaultParams(ctx, tc.msg.VaultId)
+				if tc.existingVaultParams != nil {
+					require.Equal(t, *tc.existingVaultParams, vaultParams)
+				} else {
+					require.Equal(t, "", vaultParams.Label)
+				}
+			} else {
+				require.NoError(t, err)
+				vaultParams, exists := k.GetVaultParams(ctx, tc.msg.VaultId)
+				require.True(t, exists)
+				require.Equal(t, tc.expectedVaultParams, vaultParams)
+
+				// Check that label is included in Vault and AllVaults query responses.
+				vault, err := k.Vault(ctx, &types.QueryVaultRequest{
+					Type:   tc.msg.VaultId.Type,
+					Number: tc.msg.VaultId.Number,
+				})
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Label, vault.Label)
+				allVaults, err := k.AllVaults(ctx, &types.QueryAllVaultsRequest{})
+				require.NoError(t, err)
+				require.Len(t, allVaults.Vaults, 1)
+				require.Equal(t, tc.msg.Label, allVaults.Vaults[0].Label)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// UnfreezeVaultShares unfreezes an owner's shares in a vault.
+func (k msgServer) UnfreezeVaultShares(
+	goCtx context.Context,
+	msg *types.MsgUnfreezeVaultShares,
+) (*types.MsgUnfreezeVaultSharesResponse, error) {
+	if !k.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	if _, exists := k.GetTotalShares(ctx, msg.VaultId); !exists {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", msg.VaultId)
+	}
+	k.SetOwnerSharesFrozen(ctx, msg.VaultId, msg.Owner, false)
+
+	return &types.MsgUnfreezeVaultSharesResponse{}, nil
+}
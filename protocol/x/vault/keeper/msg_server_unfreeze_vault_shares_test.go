@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUnfreezeVaultShares(t *testing.T) {
+	tests := map[string]struct {
+		// Msg.
+		msg *types.MsgUnfreezeVaultShares
+		// Expected error.
+		expectedErr string
+	}{
+		"Success - Unfreeze Alice's Shares": {
+			msg: &types.MsgUnfreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.Alice_Num0.Owner,
+			},
+		},
+		"Success - Unfreeze Bob's Shares, which aren't frozen": {
+			msg: &types.MsgUnfreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.Bob_Num0.Owner,
+			},
+		},
+		"Failure - Invalid Authority": {
+			msg: &types.MsgUnfreezeVaultShares{
+				Authority: constants.AliceAccAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.Alice_Num0.Owner,
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure - Vault Not Found": {
+			msg: &types.MsgUnfreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob1,
+				Owner:     constants.Alice_Num0.Owner,
+			},
+			expectedErr: types.ErrVaultNotFound.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+			setVaultState(t, tApp, ctx, constants.Vault_Clob0_MultiOwner_Alice0_1000_Bob0_2500)
+			k.SetOwnerSharesFrozen(ctx, constants.Vault_Clob0, constants.Alice_Num0.Owner, true)
+
+			_, err := ms.UnfreezeVaultShares(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.True(t, k.IsOwnerSharesFrozen(ctx, constants.Vault_Clob0, constants.Alice_Num0.Owner))
+			} else {
+				require.NoError(t, err)
+				require.False(t, k.IsOwnerSharesFrozen(ctx, tc.msg.VaultId, tc.msg.Owner))
+				// Shares of other owners are unaffected.
+				require.Equal(
+					t,
+					tc.msg.Owner != constants.Alice_Num0.Owner,
+					k.IsOwnerSharesFrozen(ctx, constants.Vault_Clob0, constants.Alice_Num0.Owner),
+				)
+			}
+		})
+	}
+}
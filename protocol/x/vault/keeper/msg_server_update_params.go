@@ -24,9 +24,16 @@ func (k msgServer) UpdateParams(
 	}
 
 	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	oldLayers := k.GetParams(ctx).Layers
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	// Vaults don't replace their resting orders at removed layers when they refresh their
+	// orders, so cancel such orders if `layers` decreased.
+	if msg.Params.Layers < oldLayers {
+		k.SweepOrphanedVaultOrders(ctx)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
@@ -11,7 +12,13 @@ func (k msgServer) WithdrawFromVault(
 	goCtx context.Context,
 	msg *types.MsgWithdrawFromVault,
 ) (*types.MsgWithdrawFromVaultResponse, error) {
-	// TODO(TRA-461): Validate.
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	// Validate withdrawal, which fails if owner shares are frozen.
+	if err := k.ValidateWithdrawFromVault(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// TODO(TRA-462): Calculate effective amount to withdraw + shares to redeem with slippage and user equity.
 	// TODO(TRA-461): Redeem shares for the vault.
 	// TODO(TRA-461): Transfer asset from vault to recipient subaccount.
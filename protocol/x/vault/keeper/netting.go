@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// getCrossVaultNettedSides nets exposure of the given CLOB vaults, with the given vault params,
+// per price market that they quote at and returns the side whose orders each netted vault
+// shouldn't place. A vault is netted if its position is fully offset by opposing positions of
+// other vaults that quote at the same price market, i.e. its inventory notional is non-zero
+// and has the opposite sign of net inventory notional of those vaults or net inventory notional
+// is zero. Orders of a netted vault that reduce its position (asks if long and bids if short)
+// would increase net exposure of those vaults and are thus not placed. Vaults that aren't
+// netted aren't in the returned map.
+func (k Keeper) getCrossVaultNettedSides(
+	ctx sdk.Context,
+	vaultIds []types.VaultId,
+	vaultParamsById map[types.VaultId]types.VaultParams,
+) map[types.VaultId]clobtypes.Order_Side {
+	// Get inventory notional (in quote quantums) of each vault, grouped by price market.
+	marketIds := make([]uint32, 0)
+	vaultIdsByMarket := make(map[uint32][]types.VaultId)
+	notionals := make(map[types.VaultId]*big.Int, len(vaultIds))
+	for _, vaultId := range vaultIds {
+		if vaultId.Type != types.VaultType_VAULT_TYPE_CLOB {
+			continue
+		}
+		clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+		if !exists {
+			continue
+		}
+		perpId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			continue
+		}
+		perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault perpetual", err, "vaultId", vaultId)
+			continue
+		}
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get market price", err, "vaultId", vaultId)
+			continue
+		}
+		marketId := getVaultPriceMarketId(vaultParamsById[vaultId], perpetual.Params.MarketId)
+		if _, exists := vaultIdsByMarket[marketId]; !exists {
+			marketIds = append(marketIds, marketId)
+		}
+		vaultIdsByMarket[marketId] = append(vaultIdsByMarket[marketId], vaultId)
+		notionals[vaultId] = lib.BaseToQuoteQuantums(
+			k.GetVaultInventoryInPerpetual(ctx, vaultId, perpId),
+			perpetual.Params.AtomicResolution,
+			marketPrice.Price,
+			marketPrice.Exponent,
+		)
+	}
+
+	// Net exposure of vaults of each price market that more than one vault quotes at.
+	nettedSides := make(map[types.VaultId]clobtypes.Order_Side)
+	for _, marketId := range marketIds {
+		marketVaultIds := vaultIdsByMarket[marketId]
+		if len(marketVaultIds) < 2 {
+			continue
+		}
+		netNotional := big.NewInt(0)
+		for _, vaultId := range marketVaultIds {
+			netNotional.Add(netNotional, notionals[vaultId])
+		}
+		for _, vaultId := range marketVaultIds {
+			sign := notionals[vaultId].Sign()
+			if sign == 0 || sign == netNotional.Sign() {
+				continue
+			}
+			if sign > 0 {
+				nettedSides[vaultId] = clobtypes.Order_SIDE_SELL
+			} else {
+				nettedSides[vaultId] = clobtypes.Order_SIDE_BUY
+			}
+		}
+	}
+	return nettedSides
+}
+
+// getVaultNettedOrders returns the given orders of a vault that aren't on the netted side.
+func getVaultNettedOrders(
+	orders []*clobtypes.Order,
+	nettedSide clobtypes.Order_Side,
+) []*clobtypes.Order {
+	nettedOrders := make([]*clobtypes.Order, 0, len(orders))
+	for _, order := range orders {
+		if order.Side != nettedSide {
+			nettedOrders = append(nettedOrders, order)
+		}
+	}
+	return nettedOrders
+}
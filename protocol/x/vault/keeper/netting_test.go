@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshAllVaultOrders_CrossVaultNetting(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Whether cross vault netting is enabled.
+		crossVaultNetting bool
+		// Whether ETH vault quotes at BTC market, which BTC vault quotes at.
+		sharePriceMarket bool
+		// Inventory of ETH vault in ETH perpetual.
+		ethInventory *big.Int
+
+		/* --- Expectations --- */
+		// Expected number of resting orders of each vault.
+		expectedNumOrders map[vaulttypes.VaultId]int
+		// Expected side of ETH vault orders that aren't placed, if any.
+		expectedNettedEthSide clobtypes.Order_Side
+	}{
+		"Netting disabled: both vaults place orders on both sides": {
+			crossVaultNetting: false,
+			sharePriceMarket:  true,
+			ethInventory:      big.NewInt(-100_000_000), // -0.1 ETH
+			expectedNumOrders: map[vaulttypes.VaultId]int{
+				constants.Vault_Clob0: 4,
+				constants.Vault_Clob1: 4,
+			},
+		},
+		"Netting enabled: short ETH vault offset by long BTC vault doesn't place bids": {
+			crossVaultNetting: true,
+			sharePriceMarket:  true,
+			ethInventory:      big.NewInt(-100_000_000), // -0.1 ETH
+			expectedNumOrders: map[vaulttypes.VaultId]int{
+				constants.Vault_Clob0: 4,
+				constants.Vault_Clob1: 2,
+			},
+			expectedNettedEthSide: clobtypes.Order_SIDE_BUY,
+		},
+		"Netting enabled: vaults don't quote at the same price market": {
+			crossVaultNetting: true,
+			sharePriceMarket:  false,
+			ethInventory:      big.NewInt(-100_000_000), // -0.1 ETH
+			expectedNumOrders: map[vaulttypes.VaultId]int{
+				constants.Vault_Clob0: 4,
+				constants.Vault_Clob1: 4,
+			},
+		},
+		"Netting enabled: vaults are both long": {
+			crossVaultNetting: true,
+			sharePriceMarket:  true,
+			ethInventory:      big.NewInt(100_000_000), // 0.1 ETH
+			expectedNumOrders: map[vaulttypes.VaultId]int{
+				constants.Vault_Clob0: 4,
+				constants.Vault_Clob1: 4,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultIds := []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1}
+			inventories := []*big.Int{
+				big.NewInt(1_000_000_000), // 0.1 BTC
+				tc.ethInventory,
+			}
+			// Initialize tApp with a BTC vault and an ETH vault that refresh their orders in
+			// EndBlocker.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = make([]satypes.Subaccount, len(vaultIds))
+						for i, vaultId := range vaultIds {
+							genesisState.Subaccounts[i] = satypes.Subaccount{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(10_000_000_000), // 10,000 USDC
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										vaultId.Number,
+										inventories[i],
+										big.NewInt(0),
+									),
+								},
+							}
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.CrossVaultNetting = tc.crossVaultNetting
+						genesisState.Vaults = make([]*vaulttypes.Vault, len(vaultIds))
+						for i := range vaultIds {
+							genesisState.Vaults[i] = &vaulttypes.Vault{
+								VaultId:     &vaultIds[i],
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							}
+						}
+						if tc.sharePriceMarket {
+							genesisState.Vaults[1].VaultParams = &vaulttypes.VaultParams{
+								PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 0},
+							}
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			// Vaults refresh their orders at block 1.
+			ctx := tApp.InitChain()
+
+			// Check number and sides of resting orders of each vault.
+			numOrders := make(map[vaulttypes.VaultId]int)
+			for _, order := range tApp.App.ClobKeeper.GetAllStatefulOrders(ctx) {
+				vaultId := vaulttypes.VaultId{
+					Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+					Number: order.OrderId.ClobPairId,
+				}
+				require.Equal(t, *vaultId.ToSubaccountId(), order.OrderId.SubaccountId)
+				numOrders[vaultId]++
+				if vaultId == constants.Vault_Clob1 && tc.expectedNettedEthSide != clobtypes.Order_SIDE_UNSPECIFIED {
+					require.NotEqual(t, tc.expectedNettedEthSide, order.Side)
+				}
+			}
+			require.Equal(t, tc.expectedNumOrders, numOrders)
+		})
+	}
+}
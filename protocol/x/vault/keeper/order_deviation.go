@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// checkVaultOrdersSubticksDeviation logs a warning and emits telemetry for each of the given
+// orders of a vault whose subticks deviate from oracle subticks by more than
+// `max_subticks_deviation_ppm`, which likely indicates a misconfiguration. Such orders (along
+// with their explanations) are dropped if `strict_subticks_deviation` is true. Orders are
+// returned as is if `max_subticks_deviation_ppm` is zero.
+func (k Keeper) checkVaultOrdersSubticksDeviation(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+	oracleSubticks *big.Rat,
+	orders []*clobtypes.Order,
+	explanations []*types.VaultOrderExplanation,
+) ([]*clobtypes.Order, []*types.VaultOrderExplanation) {
+	if params.MaxSubticksDeviationPpm == 0 || oracleSubticks.Sign() <= 0 {
+		return orders, explanations
+	}
+
+	// Subticks of an order deviate too much if `|subticks - oracle| > oracle * max_deviation`.
+	maxDeviation := new(big.Rat).Mul(oracleSubticks, new(big.Rat).SetFrac(
+		lib.BigU(params.MaxSubticksDeviationPpm),
+		lib.BigIntOneMillion(),
+	))
+	checkedOrders := make([]*clobtypes.Order, 0, len(orders))
+	checkedExplanations := make([]*types.VaultOrderExplanation, 0, len(explanations))
+	for i, order := range orders {
+		deviation := new(big.Rat).SetUint64(order.Subticks)
+		deviation.Sub(deviation, oracleSubticks).Abs(deviation)
+		if deviation.Cmp(maxDeviation) > 0 {
+			deviationPpm := new(big.Rat).Quo(deviation, oracleSubticks)
+			deviationPpm.Mul(deviationPpm, new(big.Rat).SetInt(lib.BigIntOneMillion()))
+			ctx.Logger().Warn(
+				"Vault order subticks deviate from oracle price by more than max subticks deviation",
+				"vaultId", vaultId,
+				"order", order,
+				"oracleSubticks", oracleSubticks.FloatString(0),
+				"deviationPpm", deviationPpm.FloatString(0),
+				"maxSubticksDeviationPpm", params.MaxSubticksDeviationPpm,
+			)
+			vaultId.IncrCounterWithLabels(metrics.VaultOrderDeviation)
+			if params.StrictSubticksDeviation {
+				continue
+			}
+		}
+		checkedOrders = append(checkedOrders, order)
+		if i < len(explanations) {
+			checkedExplanations = append(checkedExplanations, explanations[i])
+		}
+	}
+	return checkedOrders, checkedExplanations
+}
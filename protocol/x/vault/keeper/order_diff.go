@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// vaultOrderClientIdParityMask is the bit of a vault order's client ID that encodes block
+// height parity. See `GetVaultClobOrderClientId`.
+const vaultOrderClientIdParityMask = uint32(1) << 30
+
+// ComputeVaultOrderDiff returns the difference between orders that a CLOB vault generates in
+// the current block and its resting orders from last refresh (or previous block if the vault
+// hasn't refreshed its orders yet). A generated order and a resting order correspond to each
+// other if their client IDs only differ in block height parity, i.e. they are at the same side
+// and layer, and they are the same if they have the same side, size, and subticks.
+func (k Keeper) ComputeVaultOrderDiff(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (diff types.VaultOrderDiff, err error) {
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return diff, errorsmod.Wrapf(types.ErrClobPairNotFound, "VaultId: %v", vaultId)
+	}
+	params := k.GetParams(ctx)
+	lastRefreshBlockHeight, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+	if !exists {
+		lastRefreshBlockHeight = lib.MustConvertIntegerToUint32(ctx.BlockHeight()) - 1
+	}
+	restingOrderIds := k.getVaultClobOrderIds(
+		ctx.WithBlockHeight(int64(lastRefreshBlockHeight)),
+		vaultId,
+		clobPair,
+		params,
+	)
+	ordersToPlace, err := k.getVaultClobOrders(ctx, vaultId, clobPair, params)
+	if err != nil {
+		return diff, err
+	}
+	return k.computeVaultOrderDiff(ctx, restingOrderIds, ordersToPlace), nil
+}
+
+// computeVaultOrderDiff returns the difference between the given orders to place and the
+// resting orders with the given IDs. Orders to place are in the diff in the given order,
+// followed by resting orders that have no corresponding order to place.
+func (k Keeper) computeVaultOrderDiff(
+	ctx sdk.Context,
+	restingOrderIds []*clobtypes.OrderId,
+	ordersToPlace []*clobtypes.Order,
+) (diff types.VaultOrderDiff) {
+	restingOrders := make(map[uint32]clobtypes.Order, len(restingOrderIds))
+	for _, orderId := range restingOrderIds {
+		if placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId); exists {
+			restingOrders[orderId.ClientId&^vaultOrderClientIdParityMask] = placement.Order
+		}
+	}
+
+	matched := make(map[uint32]struct{}, len(ordersToPlace))
+	for _, order := range ordersToPlace {
+		key := order.OrderId.ClientId &^ vaultOrderClientIdParityMask
+		restingOrder, exists := restingOrders[key]
+		if !exists {
+			diff.ToPlace = append(diff.ToPlace, order)
+			continue
+		}
+		matched[key] = struct{}{}
+		if restingOrder.Side != order.Side ||
+			restingOrder.Quantums != order.Quantums ||
+			restingOrder.Subticks != order.Subticks {
+			diff.ToReplace = append(diff.ToReplace, types.VaultOrderReplacement{
+				Old: restingOrder,
+				New: order,
+			})
+		}
+	}
+	for _, orderId := range restingOrderIds {
+		key := orderId.ClientId &^ vaultOrderClientIdParityMask
+		if _, isMatched := matched[key]; isMatched {
+			continue
+		}
+		if restingOrder, exists := restingOrders[key]; exists {
+			diff.ToCancel = append(diff.ToCancel, restingOrder)
+		}
+	}
+	return diff
+}
+
+// keepUnchangedVaultOrders returns the given orders to place of a CLOB vault without orders
+// whose order ID or corresponding order ID of the other block height parity is resting, along
+// with IDs of resting orders that are kept instead of cancelled. A resting order is kept if
+// `skip_unchanged_outer_layers` is true, it's not at layer 0, it's the same as its corresponding
+// order to place (including client metadata), it doesn't expire within `max(renew_buffer_blocks, 1)` blocks, and the vault
+// doesn't have a pending requote. A resting order with the same order ID as an order to place
+// that isn't kept is cancelled (with an indexer order removal event) and the order to place
+// is placed at the vault's next refresh, as an order ID can't be placed again in the block
+// that it's cancelled in. Returns the number of cancelled orders.
+func (k Keeper) keepUnchangedVaultOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+	ordersToPlace []*clobtypes.Order,
+) (
+	remainingOrdersToPlace []*clobtypes.Order,
+	keptOrderIds map[clobtypes.OrderId]struct{},
+	numOrdersCancelled uint32,
+) {
+	pendingRequote := k.GetVaultPendingRequote(ctx, vaultId)
+	isKept := func(order *clobtypes.Order, restingOrder clobtypes.Order) bool {
+		if !params.SkipUnchangedOuterLayers || pendingRequote {
+			return false
+		}
+		_, _, layer, err := types.DecodeVaultClientId(order.OrderId.ClientId)
+		if err != nil || layer == 0 {
+			return false
+		}
+		return restingOrder.Side == order.Side &&
+			restingOrder.Quantums == order.Quantums &&
+			restingOrder.Subticks == order.Subticks &&
+			restingOrder.ClientMetadata == order.ClientMetadata &&
+			!k.isVaultOrderRenewalDue(
+				ctx,
+				[]*clobtypes.OrderId{&restingOrder.OrderId},
+				lib.Max(params.RenewBufferBlocks, 1),
+			)
+	}
+
+	remainingOrdersToPlace = make([]*clobtypes.Order, 0, len(ordersToPlace))
+	keptOrderIds = make(map[clobtypes.OrderId]struct{})
+	for _, order := range ordersToPlace {
+		// An order kept at an earlier refresh has the order ID of the order to place.
+		if placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId); exists {
+			if isKept(order, placement.Order) {
+				keptOrderIds[order.OrderId] = struct{}{}
+			} else {
+				numOrdersCancelled += k.cancelVaultClobOrders(
+					ctx,
+					vaultId,
+					[]*clobtypes.OrderId{&order.OrderId},
+					params,
+					true,
+				)
+			}
+			continue
+		}
+
+		// An order placed at last refresh has the order ID of the other block height parity.
+		restingOrderId := order.OrderId
+		restingOrderId.ClientId ^= vaultOrderClientIdParityMask
+		if placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, restingOrderId); exists &&
+			isKept(order, placement.Order) {
+			keptOrderIds[restingOrderId] = struct{}{}
+			continue
+		}
+		remainingOrdersToPlace = append(remainingOrdersToPlace, order)
+	}
+	return remainingOrdersToPlace, keptOrderIds, numOrdersCancelled
+}
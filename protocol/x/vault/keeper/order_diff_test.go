@@ -0,0 +1,295 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setUpVaultWithOrders returns a test app with a vault that places orders at 2 layers at block 1.
+func setUpVaultWithOrders(t *testing.T, skipUnchangedOrders bool) *testapp.TestApp {
+	vaultId := constants.Vault_Clob0
+	return testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.Layers = 2
+				genesisState.Params.SkipUnchangedOrders = skipUnchangedOrders
+				genesisState.Vaults = []*vaulttypes.Vault{
+					{
+						VaultId:     &vaultId,
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+						OwnerShares: []*vaulttypes.OwnerShare{
+							{
+								Owner:  constants.AliceAccAddress.String(),
+								Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+}
+
+func TestComputeVaultOrderDiff(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := setUpVaultWithOrders(t, false)
+	// Vault places orders at 2 layers at blocks 1 and 2.
+	tApp.InitChain()
+	ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+	k := tApp.App.VaultKeeper
+	restingOrders, err := k.GetRestingVaultOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, restingOrders, 4)
+
+	// Diff is empty if nothing changed.
+	diff, err := k.ComputeVaultOrderDiff(ctx, vaultId)
+	require.NoError(t, err)
+	require.True(t, diff.IsEmpty())
+
+	// Move layer-1 prices by widening spread of layer 1 only. Layer-0 orders stay the same.
+	params := k.GetParams(ctx)
+	params.SpreadMultiplierPpmByLayer = []uint32{1_000_000, 3_000_000}
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(t, restingOrders[0].Subticks, orders[0].Subticks)
+	require.Equal(t, restingOrders[1].Subticks, orders[1].Subticks)
+
+	diff, err = k.ComputeVaultOrderDiff(ctx, vaultId)
+	require.NoError(t, err)
+	require.Empty(t, diff.ToPlace)
+	require.Empty(t, diff.ToCancel)
+	require.Equal(
+		t,
+		[]vaulttypes.VaultOrderReplacement{
+			{Old: restingOrders[2], New: orders[2]},
+			{Old: restingOrders[3], New: orders[3]},
+		},
+		diff.ToReplace,
+	)
+	require.Equal(t, clobtypes.Order_SIDE_SELL, diff.ToReplace[0].New.Side)
+	require.Greater(t, diff.ToReplace[0].New.Subticks, diff.ToReplace[0].Old.Subticks)
+	require.Equal(t, clobtypes.Order_SIDE_BUY, diff.ToReplace[1].New.Side)
+	require.Less(t, diff.ToReplace[1].New.Subticks, diff.ToReplace[1].Old.Subticks)
+
+	// An additional layer has no resting order and is to place.
+	params.SpreadMultiplierPpmByLayer = nil
+	params.Layers = 3
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	orders, err = k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	diff, err = k.ComputeVaultOrderDiff(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(t, []*clobtypes.Order{orders[4], orders[5]}, diff.ToPlace)
+	require.Empty(t, diff.ToCancel)
+	require.Empty(t, diff.ToReplace)
+
+	// Resting orders are to cancel if no orders are generated, e.g. as oracle price is above
+	// the vault's max oracle price.
+	params.Layers = 2
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{MaxOraclePrice: 1})
+	require.NoError(t, err)
+	diff, err = k.ComputeVaultOrderDiff(ctx, vaultId)
+	require.NoError(t, err)
+	require.Empty(t, diff.ToPlace)
+	require.Equal(t, restingOrders, diff.ToCancel)
+	require.Empty(t, diff.ToReplace)
+
+	// Error if clob pair doesn't exist.
+	_, err = k.ComputeVaultOrderDiff(ctx, vaulttypes.VaultId{
+		Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+		Number: 999,
+	})
+	require.ErrorIs(t, err, vaulttypes.ErrClobPairNotFound)
+}
+
+func TestRefreshVaultClobOrders_SkipUnchangedOrders(t *testing.T) {
+	tests := map[string]struct {
+		// Whether vault skips refreshing unchanged orders.
+		skipUnchangedOrders bool
+		// Whether layer-1 prices move after block 2.
+		movePrices bool
+		// Expected block at which vault last refreshed its orders.
+		expectedLastRefreshBlock uint32
+	}{
+		"Skip enabled, orders unchanged": {
+			skipUnchangedOrders:      true,
+			movePrices:               false,
+			expectedLastRefreshBlock: 1,
+		},
+		"Skip enabled, orders changed": {
+			skipUnchangedOrders:      true,
+			movePrices:               true,
+			expectedLastRefreshBlock: 2,
+		},
+		"Skip disabled, orders unchanged": {
+			skipUnchangedOrders:      false,
+			movePrices:               false,
+			expectedLastRefreshBlock: 2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := setUpVaultWithOrders(t, tc.skipUnchangedOrders)
+			// Vault places orders at 2 layers at block 1 and refreshes them at block 2 unless
+			// they are unchanged and skipped.
+			tApp.InitChain()
+			ctx := tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{}).WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			if tc.movePrices {
+				params := k.GetParams(ctx)
+				params.SpreadMultiplierPpmByLayer = []uint32{1_000_000, 3_000_000}
+				err := k.SetParams(ctx, params)
+				require.NoError(t, err)
+			}
+
+			// Refresh vault orders again.
+			err := k.RefreshVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			lastRefreshBlock, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedLastRefreshBlock, lastRefreshBlock)
+
+			// Check that resting orders are the ones placed at last refresh.
+			expectedOrderIds, err := k.GetVaultClobOrderIds(
+				ctx.WithBlockHeight(int64(tc.expectedLastRefreshBlock)),
+				vaultId,
+			)
+			require.NoError(t, err)
+			restingOrders, err := k.GetRestingVaultOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, restingOrders, len(expectedOrderIds))
+			for _, orderId := range expectedOrderIds {
+				_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+				require.True(t, exists)
+			}
+			if !tc.movePrices && tc.skipUnchangedOrders {
+				refreshes := k.GetVaultRefreshHistory(ctx, vaultId).Refreshes
+				require.Equal(t, vaulttypes.RefreshSkipReasonUnchanged, refreshes[len(refreshes)-1].SkipReason)
+			}
+		})
+	}
+}
+
+func TestRefreshVaultClobOrders_SkipUnchangedOuterLayers(t *testing.T) {
+	tests := map[string]struct {
+		// Whether vault keeps unchanged orders of outer layers.
+		skipUnchangedOuterLayers bool
+	}{
+		"Skip enabled": {
+			skipUnchangedOuterLayers: true,
+		},
+		"Skip disabled": {
+			skipUnchangedOuterLayers: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := setUpVaultWithOrders(t, false)
+			// Vault places orders at 2 layers at block 1.
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			params := k.GetParams(ctx)
+			params.SkipUnchangedOuterLayers = tc.skipUnchangedOuterLayers
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+			block1OrderIds, err := k.GetVaultClobOrderIds(ctx, vaultId)
+			require.NoError(t, err)
+			block1Orders, err := k.GetRestingVaultOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, block1Orders, 4)
+
+			refreshAtBlock := func(blockHeight int64) []*clobtypes.OrderId {
+				ctx = ctx.WithBlockHeight(blockHeight)
+				tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+					BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+				})
+				err := k.RefreshVaultClobOrders(ctx, vaultId)
+				require.NoError(t, err)
+				lastRefreshBlock, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+				require.True(t, exists)
+				require.Equal(t, lib.MustConvertIntegerToUint32(blockHeight), lastRefreshBlock)
+				orderIds, err := k.GetVaultClobOrderIds(ctx, vaultId)
+				require.NoError(t, err)
+				return orderIds
+			}
+			requireResting := func(orderId *clobtypes.OrderId, expected bool) {
+				_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+				require.Equal(t, expected, exists, "orderId: %v", orderId)
+			}
+
+			// Prices don't move at blocks 2 and 3. Layer-0 orders are replaced at each refresh while
+			// layer-1 orders are only replaced if skip is disabled.
+			for _, blockHeight := range []int64{2, 3} {
+				orderIds := refreshAtBlock(blockHeight)
+				for i := 0; i < 2; i++ {
+					requireResting(orderIds[i], true)
+				}
+				for i := 2; i < 4; i++ {
+					sameOrderId := *orderIds[i] == *block1OrderIds[i]
+					requireResting(block1OrderIds[i], tc.skipUnchangedOuterLayers || sameOrderId)
+					requireResting(orderIds[i], !tc.skipUnchangedOuterLayers || sameOrderId)
+				}
+				restingOrders, err := k.GetRestingVaultOrders(ctx, vaultId)
+				require.NoError(t, err)
+				require.Len(t, restingOrders, 4)
+				orphanedOrders, err := k.FindOrphanedVaultOrders(ctx, vaultId)
+				require.NoError(t, err)
+				require.Empty(t, orphanedOrders)
+			}
+
+			// Move layer-1 prices by widening spread of layer 1 only. Layer-1 orders are replaced.
+			params.SpreadMultiplierPpmByLayer = []uint32{1_000_000, 3_000_000}
+			err = k.SetParams(ctx, params)
+			require.NoError(t, err)
+			orderIds := refreshAtBlock(4)
+			for i := 0; i < 4; i++ {
+				requireResting(orderIds[i], true)
+			}
+			for i := 2; i < 4; i++ {
+				requireResting(block1OrderIds[i], false)
+				placement, _ := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderIds[i])
+				require.NotEqual(t, block1Orders[i].Subticks, placement.Order.Subticks)
+			}
+		})
+	}
+}
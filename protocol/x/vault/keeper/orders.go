@@ -1,28 +1,49 @@
 package keeper
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexersharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
 // RefreshAllVaultOrders refreshes all orders for all vaults by
 // 1. Cancelling all existing orders.
 // 2. Placing new orders.
+// If `max_vault_orders_per_block` is positive, vaults are refreshed in round-robin order
+// starting from the refresh cursor, and vaults that could place more orders than the limit
+// allows (i.e. `2 * layers` orders each) are deferred to later blocks. The cursor stays at
+// its vault until that vault refreshes its orders and then moves to the first deferred vault.
 func (k Keeper) RefreshAllVaultOrders(ctx sdk.Context) {
-	// Iterate through all vaults.
+	// Iterate through all vaults, update their activation statuses, and accumulate total value
+	// locked and total inventory in each perpetual across all vaults for telemetry, such that
+	// each vault's state is read in a single pass.
 	params := k.GetParams(ctx)
-	numActiveVaults := 0
+	activeVaultIds := make([]types.VaultId, 0)
+	activeVaultParams := make(map[types.VaultId]types.VaultParams)
+	totalEquity := big.NewInt(0)
+	var totalEquityErr error
+	totalInventories := make(map[uint32]*big.Int)
 	totalSharesIterator := k.getTotalSharesIterator(ctx)
 	defer totalSharesIterator.Close()
 	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
@@ -35,80 +56,600 @@ func (k Keeper) RefreshAllVaultOrders(ctx sdk.Context) {
 		var totalShares types.NumShares
 		k.cdc.MustUnmarshal(totalSharesIterator.Value(), &totalShares)
 
-		// Skip if TotalShares is non-positive.
-		if totalShares.NumShares.Sign() <= 0 {
+		if totalEquityErr == nil {
+			equity, err := k.GetVaultEquity(ctx, *vaultId)
+			if err != nil {
+				totalEquityErr = err
+			} else {
+				totalEquity.Add(totalEquity, equity)
+			}
+		}
+		subaccount := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+		for _, p := range subaccount.PerpetualPositions {
+			inventory, exists := totalInventories[p.GetPerpetualId()]
+			if !exists {
+				inventory = big.NewInt(0)
+				totalInventories[p.GetPerpetualId()] = inventory
+			}
+			inventory.Add(inventory, p.GetBigQuantums())
+		}
+
+		// Update activation status of the vault and skip if vault is inactive. Vault params
+		// are read once here and reused for the rest of the vault's refresh.
+		vaultParams, _ := k.GetVaultParams(ctx, *vaultId)
+		activated := k.getVaultInactiveReason(ctx, *vaultId, totalShares, params, vaultParams) == ""
+		k.updateVaultActivated(ctx, *vaultId, activated)
+		if !activated {
 			continue
 		}
+		activeVaultIds = append(activeVaultIds, *vaultId)
+		activeVaultParams[*vaultId] = vaultParams
+	}
 
-		// Skip if vault has no perpetual positions and strictly less than `activation_threshold_quote_quantums` USDC.
-		vault := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
-		if vault.PerpetualPositions == nil || len(vault.PerpetualPositions) == 0 {
-			if vault.GetUsdcPosition().Cmp(params.ActivationThresholdQuoteQuantums.BigInt()) == -1 {
-				continue
+	// Start from the first active vault at or after the refresh cursor, wrapping around.
+	cursor, cursorExists := k.getRefreshCursor(ctx)
+	start := 0
+	if cursorExists {
+		for i, vaultId := range activeVaultIds {
+			if bytes.Compare(vaultId.ToStateKey(), cursor.ToStateKey()) >= 0 {
+				start = i
+				break
 			}
 		}
+	}
+
+	// Net exposure of active vaults that quote at the same price market, if enabled.
+	nettedSides := make(map[types.VaultId]clobtypes.Order_Side)
+	if params.CrossVaultNetting {
+		nettedSides = k.getCrossVaultNettedSides(ctx, activeVaultIds, activeVaultParams)
+	}
 
-		// Count current vault as active.
-		numActiveVaults++
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	startVaultRefreshed := false
+	numOrdersPlaced := uint32(0)
+	var firstDeferredVaultId *types.VaultId
+	for i := range activeVaultIds {
+		vaultId := activeVaultIds[(start+i)%len(activeVaultIds)]
 
-		// Refresh orders depending on vault type.
+		// Defer remaining vaults once max orders per block are placed.
+		if params.MaxVaultOrdersPerBlock > 0 && numOrdersPlaced >= params.MaxVaultOrdersPerBlock {
+			firstDeferredVaultId = &vaultId
+			log.InfoLog(
+				ctx,
+				"Deferring vault order refreshes as max vault orders per block is reached",
+				"firstDeferredVaultId", vaultId,
+				"numDeferredVaults", len(activeVaultIds)-i,
+				"maxVaultOrdersPerBlock", params.MaxVaultOrdersPerBlock,
+			)
+			break
+		}
+
+		// Refresh orders depending on vault type, placing at most the remaining orders of
+		// max orders per block. A vault that can't place all of its orders is deferred such
+		// that it places all of its orders first in a later block.
 		// Currently only supported vault type is CLOB.
+		maxVaultOrders := uint32(0)
+		if params.MaxVaultOrdersPerBlock > 0 {
+			maxVaultOrders = params.MaxVaultOrdersPerBlock - numOrdersPlaced
+		}
+		capped := false
 		switch vaultId.Type {
 		case types.VaultType_VAULT_TYPE_CLOB:
-			err := k.RefreshVaultClobOrders(ctx, *vaultId)
+			var numVaultOrdersPlaced uint32
+			var err error
+			numVaultOrdersPlaced, capped, err = k.refreshVaultClobOrders(
+				ctx,
+				vaultId,
+				params,
+				activeVaultParams[vaultId],
+				nettedSides[vaultId],
+				maxVaultOrders,
+			)
 			if err != nil {
-				log.ErrorLogWithError(ctx, "Failed to refresh vault clob orders", err, "vaultId", *vaultId)
+				log.ErrorLogWithError(ctx, "Failed to refresh vault clob orders", err, "vaultId", vaultId)
 			}
+			numOrdersPlaced += numVaultOrdersPlaced
 		default:
-			log.ErrorLog(ctx, "Failed to refresh vault orders: unknown vault type", "vaultId", *vaultId)
+			log.ErrorLog(ctx, "Failed to refresh vault orders: unknown vault type", "vaultId", vaultId)
 		}
+		if i == 0 {
+			lastRefreshBlockHeight, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+			startVaultRefreshed = exists && lastRefreshBlockHeight == blockHeight
+		}
+		if capped {
+			firstDeferredVaultId = &vaultId
+			log.InfoLog(
+				ctx,
+				"Deferring vault order refreshes as max vault orders per block is reached",
+				"firstDeferredVaultId", vaultId,
+				"numDeferredVaults", len(activeVaultIds)-i,
+				"maxVaultOrdersPerBlock", params.MaxVaultOrdersPerBlock,
+			)
+			break
+		}
+	}
+
+	// Keep the cursor at the start vault until it refreshes its orders, and then move it to
+	// the first deferred vault, if any.
+	switch {
+	case params.MaxVaultOrdersPerBlock > 0 && cursorExists && !startVaultRefreshed && len(activeVaultIds) > 0:
+		k.setRefreshCursor(ctx, activeVaultIds[start])
+	case firstDeferredVaultId != nil:
+		k.setRefreshCursor(ctx, *firstDeferredVaultId)
+	case cursorExists:
+		k.deleteRefreshCursor(ctx)
 	}
 
 	// Emit metric on number of active vaults.
 	metrics.SetGauge(
 		metrics.NumActiveVaults,
-		float32(numActiveVaults),
+		float32(len(activeVaultIds)),
+	)
+
+	// Emit metric on total value locked across all vaults.
+	if totalEquityErr != nil {
+		log.ErrorLogWithError(ctx, "Failed to get total vault TVL", totalEquityErr)
+	} else {
+		metrics.SetGauge(
+			metrics.TotalVaultTvl,
+			metrics.GetMetricValueFromBigInt(totalEquity),
+		)
+	}
+
+	// Emit metric on total inventory across all vaults in the perpetual of each clob pair
+	// that an active vault quotes on.
+	for _, vaultId := range activeVaultIds {
+		clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+		if !exists {
+			continue
+		}
+		perpId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			continue
+		}
+		totalInventory := big.NewInt(0)
+		if inventory, exists := totalInventories[perpId]; exists {
+			totalInventory = inventory
+		}
+		metrics.SetGaugeWithLabels(
+			metrics.TotalVaultInventory,
+			metrics.GetMetricValueFromBigInt(totalInventory),
+			metrics.GetLabelForIntValue(metrics.ClobPairId, int(clobPair.Id)),
+		)
+	}
+}
+
+// getVaultInactiveReason returns the reason why a vault is inactive, i.e. doesn't refresh
+// its orders, or an empty string if the vault is active. This is the only place where a vault's
+// activation is decided (see `IsVaultActive`).
+func (k Keeper) getVaultInactiveReason(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	totalShares types.NumShares,
+	params types.Params,
+	vaultParams types.VaultParams,
+) string {
+	// Inactive if TotalShares is non-positive.
+	if totalShares.NumShares.Sign() <= 0 {
+		return types.QuotingStatusReasonNonPositiveShares
+	}
+
+	// Inactive if vault has no perpetual positions and strictly less than its activation threshold
+	// in USDC, or exactly that amount if `activation_inclusive` is false.
+	vault := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+	if vault.PerpetualPositions == nil || len(vault.PerpetualPositions) == 0 {
+		cmp := vault.GetUsdcPosition().Cmp(k.getVaultActivationThreshold(ctx, vaultId, params, vaultParams))
+		if cmp == -1 || (cmp == 0 && !params.ActivationInclusive) {
+			return types.QuotingStatusReasonBelowActivationThreshold
+		}
+	}
+
+	return ""
+}
+
+// getVaultActivationThreshold returns the quote quantums that a vault with no perpetual
+// positions must have to activate. If `activation_threshold_mode` is dynamic, this is the
+// notional of the smallest order that the vault can place at oracle price times `layers`,
+// so that the vault activates only when it can fund a full book. Falls back to
+// `activation_threshold_quote_quantums` if the vault isn't a CLOB vault or its minimum order
+// notional can't be computed.
+func (k Keeper) getVaultActivationThreshold(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+	vaultParams types.VaultParams,
+) *big.Int {
+	staticThreshold := params.ActivationThresholdQuoteQuantums.BigInt()
+	if params.ActivationThresholdMode != types.ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_DYNAMIC ||
+		vaultId.Type != types.VaultType_VAULT_TYPE_CLOB {
+		return staticThreshold
+	}
+
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return staticThreshold
+	}
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return staticThreshold
+	}
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get vault perpetual", err, "vaultId", vaultId)
+		return staticThreshold
+	}
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(
+		ctx,
+		getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId),
+	)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get market price", err, "vaultId", vaultId)
+		return staticThreshold
+	}
+
+	// Smallest order is one step, or min lot rounded up to a multiple of step if min lot is set.
+	stepSize := lib.BigU(getVaultOrderStepBaseQuantums(vaultParams, clobPair))
+	minOrderBaseQuantums := new(big.Int).Set(stepSize)
+	if params.MinLotBaseQuantums > 0 {
+		minLot := lib.BigIntRoundToMultiple(new(big.Int).SetUint64(params.MinLotBaseQuantums), stepSize, true)
+		minOrderBaseQuantums = lib.BigMax(minOrderBaseQuantums, minLot)
+	}
+	minOrderNotional := lib.BaseToQuoteQuantums(
+		minOrderBaseQuantums,
+		perpetual.Params.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
 	)
+	return minOrderNotional.Mul(minOrderNotional, lib.BigU(params.Layers))
+}
+
+// GetVaultQuotingStatus returns whether a vault is quoting, i.e. places orders, and if not,
+// the reason why. Returns an error if the vault doesn't exist or its orders can't be computed
+// for any other reason.
+func (k Keeper) GetVaultQuotingStatus(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (quoting bool, reason string, err error) {
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return false, "", errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", vaultId)
+	}
+
+	// Check the same conditions as `RefreshAllVaultOrders`.
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	if reason := k.getVaultInactiveReason(ctx, vaultId, totalShares, k.GetParams(ctx), vaultParams); reason != "" {
+		return false, reason, nil
+	}
+
+	// Check whether any orders would be placed.
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	if errors.Is(err, types.ErrNonPositiveEquity) {
+		return false, types.QuotingStatusReasonNonPositiveEquity, nil
+	} else if err != nil {
+		return false, "", err
+	}
+	if len(orders) == 0 {
+		return false, types.QuotingStatusReasonZeroOrderSize, nil
+	}
+
+	return true, "", nil
+}
+
+// GetMarketsWithActiveVaults returns IDs of clob pairs, in ascending order, that have at least
+// one CLOB vault that is active and quoting (see `GetVaultQuotingStatus`). Vaults whose quoting
+// status can't be determined are skipped.
+func (k Keeper) GetMarketsWithActiveVaults(ctx sdk.Context) []uint32 {
+	clobPairIds := make([]uint32, 0)
+	seen := make(map[uint32]struct{})
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+		if vaultId.Type != types.VaultType_VAULT_TYPE_CLOB {
+			continue
+		}
+		if _, exists := seen[vaultId.Number]; exists {
+			continue
+		}
+		quoting, _, err := k.GetVaultQuotingStatus(ctx, *vaultId)
+		if err != nil || !quoting {
+			continue
+		}
+		seen[vaultId.Number] = struct{}{}
+		clobPairIds = append(clobPairIds, vaultId.Number)
+	}
+	sort.Slice(clobPairIds, func(i, j int) bool { return clobPairIds[i] < clobPairIds[j] })
+	return clobPairIds
 }
 
-// RefreshVaultClobOrders refreshes orders of a CLOB vault.
+// RefreshVaultClobOrders refreshes orders of a CLOB vault. This is a no-op if fewer than
+// `min_refresh_interval_blocks` blocks have passed since the vault's last refresh (unless
+// the vault has a pending requote from a large fill) or if current block has the same
+// parity as the block of last refresh. If the vault's subaccount
+// is liquidatable, its resting orders are cancelled and no new orders are placed. If the
+// vault's subaccount is undercollateralized, its resting orders are cancelled and the vault
+// enters close-only mode, in which it only places orders that reduce its position. If
+// `layers` is zero, any resting orders are cancelled, no new orders are placed, and no
+// error is returned. If the clob pair isn't active, resting orders are cancelled and no new
+// orders are placed. If `skip_unchanged_orders` is true, this is a no-op if the vault's order
+// diff (see `ComputeVaultOrderDiff`) is empty and no resting order is about to expire. Resting
+// orders from last refresh that new orders would cross are cancelled before new orders are placed.
+// If `watchdog_blocks` is positive, a vault_watchdog event is emitted once the vault has placed
+// zero orders for that many consecutive blocks. If `refresh_strategy` is cancel-then-place, an
+// order removal indexer event is sent for each cancelled order instead of sending a replacement
+// indexer event for each placed order. If `skip_unchanged_outer_layers` is true, resting orders
+// of outer layers that are the same as orders to place are kept instead of being replaced.
 func (k Keeper) RefreshVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) (err error) {
-	// Cancel CLOB orders from last block.
-	orderIdsToCancel, err := k.GetVaultClobOrderIds(
-		ctx.WithBlockHeight(ctx.BlockHeight()-1),
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	_, _, err = k.refreshVaultClobOrders(
+		ctx,
 		vaultId,
+		k.GetParams(ctx),
+		vaultParams,
+		clobtypes.Order_SIDE_UNSPECIFIED,
+		0,
 	)
+	return err
+}
+
+// setVaultRiskGauges emits metrics on value at risk and maker edge of a CLOB vault, where maker
+// edge is computed from explanations of the orders that the vault places, so that these metrics
+// don't require the vault's orders to be computed again.
+func (k Keeper) setVaultRiskGauges(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	vaultParams types.VaultParams,
+	clobPair clobtypes.ClobPair,
+	explanations []*types.VaultOrderExplanation,
+) {
+	perpId, err := clobPair.GetPerpetualId()
 	if err != nil {
-		log.ErrorLogWithError(ctx, "Failed to get vault clob order IDs to cancel", err, "vaultId", vaultId)
-		return err
+		return
 	}
-	orderExpirationSeconds := k.GetParams(ctx).OrderExpirationSeconds
-	for _, orderId := range orderIdsToCancel {
-		if _, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId); exists {
-			err := k.clobKeeper.HandleMsgCancelOrder(ctx, clobtypes.NewMsgCancelOrderStateful(
-				*orderId,
-				uint32(ctx.BlockTime().Unix())+orderExpirationSeconds,
-			), true)
-			if err != nil {
-				log.ErrorLogWithError(ctx, "Failed to cancel order", err, "orderId", orderId, "vaultId", vaultId)
-			}
-			vaultId.IncrCounterWithLabels(
-				metrics.VaultCancelOrder,
-				metrics.GetLabelForBoolValue(metrics.Success, err == nil),
-			)
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get vault perpetual", err, "vaultId", vaultId)
+		return
+	}
+	volatility := k.GetMarketVolatility(ctx, getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId))
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get vault value at risk", err, "vaultId", vaultId)
+	} else {
+		quantilePpm, _ := types.GetNormalQuantilePpm(types.ValueAtRiskTelemetryConfidencePpm)
+		valueAtRisk := getVaultValueAtRisk(
+			k.GetVaultInventoryInPerpetual(ctx, vaultId, perpId),
+			perpetual,
+			marketPrice,
+			volatility,
+			quantilePpm,
+		)
+		vaultId.SetGaugeWithLabels(metrics.VaultValueAtRisk, metrics.GetMetricValueFromBigInt(valueAtRisk))
+	}
+
+	vaultId.SetGaugeWithLabels(metrics.VaultMakerEdge, float32(getVaultMakerEdgePpm(explanations, volatility)))
+}
+
+// refreshVaultClobOrders refreshes orders of a CLOB vault with the given params and vault
+// params, which allows them to be read only once when refreshing orders of all vaults.
+// Orders on `nettedSide` are not placed unless the vault is in close-only mode, where
+// `SIDE_UNSPECIFIED` means that the vault isn't netted with other vaults. At most
+// `maxOrdersToPlace` orders are placed in order of `placement_priority` if it is non-zero.
+// Each call is recorded in the vault's refresh history. Returns the number of orders placed
+// and whether orders to place were capped by `maxOrdersToPlace`.
+func (k Keeper) refreshVaultClobOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+	vaultParams types.VaultParams,
+	nettedSide clobtypes.Order_Side,
+	maxOrdersToPlace uint32,
+) (numOrdersPlaced uint32, capped bool, err error) {
+	numOrdersCancelled, skipReason := uint32(0), ""
+	defer func() {
+		if err != nil {
+			skipReason = err.Error()
+		}
+		k.appendVaultRefresh(ctx, vaultId, types.VaultRefresh{
+			NumOrdersPlaced:    numOrdersPlaced,
+			NumOrdersCancelled: numOrdersCancelled,
+			SkipReason:         skipReason,
+		})
+	}()
+
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		err = errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+		log.ErrorLogWithError(ctx, "Failed to get vault clob pair", err, "vaultId", vaultId)
+		return 0, false, err
+	}
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+
+	// Orders to cancel are from the block of last refresh, which is last block if
+	// the vault hasn't refreshed its orders yet.
+	lastRefreshBlockHeight, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+
+	// A vault with zero layers places no orders. As its resting orders may have been placed
+	// with more layers, cancel all of its resting orders, which also clears last refresh,
+	// such that this only happens once.
+	if params.Layers == 0 {
+		if exists {
+			numOrdersCancelled = k.cancelAllVaultClobOrders(ctx, vaultId, clobPair, params)
 		}
+		skipReason = types.RefreshSkipReasonZeroLayers
+		return 0, false, nil
+	}
+
+	if !exists {
+		lastRefreshBlockHeight = blockHeight - 1
+	}
+	orderIdsToCancel := k.getVaultClobOrderIds(
+		ctx.WithBlockHeight(int64(lastRefreshBlockHeight)),
+		vaultId,
+		clobPair,
+		params,
+	)
+
+	// If clob pair isn't active, e.g. it's initializing or delisted and in final settlement, orders
+	// can't be placed. Cancel resting orders of the vault and skip placing new orders until the
+	// clob pair is active again.
+	if clobPair.Status != clobtypes.ClobPair_STATUS_ACTIVE {
+		numOrdersCancelled = k.cancelVaultClobOrders(ctx, vaultId, orderIdsToCancel, params, true)
+		skipReason = types.RefreshSkipReasonMarketInactive
+		ctx.EventManager().EmitEvent(types.NewVaultMarketInactiveEvent(vaultId, clobPair.Status))
+		vaultId.IncrCounterWithLabels(metrics.VaultMarketInactive)
+		return 0, false, nil
+	}
+
+	// If vault subaccount is liquidatable, cancel its resting orders and skip placing
+	// new orders until liquidation completes.
+	isLiquidatable, err := k.clobKeeper.IsLiquidatable(ctx, *vaultId.ToSubaccountId())
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to check if vault is liquidatable", err, "vaultId", vaultId)
+		return 0, false, err
+	}
+	if isLiquidatable {
+		numOrdersCancelled = k.cancelVaultClobOrders(ctx, vaultId, orderIdsToCancel, params, true)
+		skipReason = types.RefreshSkipReasonLiquidatable
+		ctx.EventManager().EmitEvent(types.NewVaultLiquidatableEvent(vaultId))
+		vaultId.IncrCounterWithLabels(metrics.VaultLiquidatable)
+		return 0, false, nil
 	}
-	// Place new CLOB orders.
-	ordersToPlace, err := k.GetVaultClobOrders(ctx, vaultId)
+
+	// If vault subaccount is undercollateralized, i.e. has negative free collateral, cancel its
+	// resting orders and enter close-only mode, in which only orders that reduce its position
+	// are placed. Close-only mode is exited once free collateral is non-negative again.
+	_, _, freeCollateral, err := k.GetVaultMargin(ctx, vaultId)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get vault margin", err, "vaultId", vaultId)
+		return 0, false, err
+	}
+	closeOnly := k.GetVaultCloseOnly(ctx, vaultId)
+	if freeCollateral.Sign() < 0 && !closeOnly {
+		numOrdersCancelled = k.cancelVaultClobOrders(ctx, vaultId, orderIdsToCancel, params, true)
+		skipReason = types.RefreshSkipReasonUndercollateralized
+		k.SetVaultCloseOnly(ctx, vaultId, true)
+		ctx.EventManager().EmitEvent(types.NewVaultCloseOnlyEvent(vaultId, freeCollateral))
+		vaultId.IncrCounterWithLabels(metrics.VaultCloseOnly)
+		return 0, false, nil
+	}
+	if freeCollateral.Sign() >= 0 && closeOnly {
+		k.SetVaultCloseOnly(ctx, vaultId, false)
+		closeOnly = false
+	}
+
+	if exists {
+		// Skip if vault refreshed too recently, unless the vault has a pending requote
+		// from a large fill or its resting orders expire within `renew_buffer_blocks`.
+		// Also skip if current block has the same parity as the block of last refresh, as
+		// orders to place would have the same client IDs as orders to cancel. In that case,
+		// a pending requote happens in the next block.
+		blocksSinceLastRefresh := blockHeight - lastRefreshBlockHeight
+		tooRecent := blocksSinceLastRefresh < params.MinRefreshIntervalBlocks &&
+			!k.GetVaultPendingRequote(ctx, vaultId) &&
+			!k.isVaultOrderRenewalDue(ctx, orderIdsToCancel, params.RenewBufferBlocks)
+		if tooRecent {
+			skipReason = types.RefreshSkipReasonTooRecent
+			return 0, false, nil
+		}
+		if blocksSinceLastRefresh%2 == 0 {
+			skipReason = types.RefreshSkipReasonSameParity
+			return 0, false, nil
+		}
+	}
+
+	// Get new CLOB orders to place. Orders from last refresh are still cancelled if orders to
+	// place can't be computed.
+	ordersToPlace, explanations, err := k.getVaultClobOrdersWithExplanations(
+		ctx,
+		vaultId,
+		clobPair,
+		params,
+		vaultParams,
+	)
 	if err != nil {
+		numOrdersCancelled = k.cancelVaultClobOrders(ctx, vaultId, orderIdsToCancel, params, false)
+		k.updateVaultWatchdog(ctx, vaultId, params, 0)
 		log.ErrorLogWithError(ctx, "Failed to get vault clob orders to place", err, "vaultId", vaultId)
-		return err
+		return 0, false, err
 	}
+	k.setVaultRiskGauges(ctx, vaultId, vaultParams, clobPair, explanations)
+	if closeOnly {
+		ordersToPlace = k.getVaultCloseOnlyOrders(ctx, vaultId, clobPair, ordersToPlace)
+	} else if nettedSide != clobtypes.Order_SIDE_UNSPECIFIED {
+		ordersToPlace = getVaultNettedOrders(ordersToPlace, nettedSide)
+	}
+	// Place orders in order of placement priority, and only as many as `maxOrdersToPlace`
+	// if capped, such that the most important orders are placed.
+	sortVaultOrdersByPlacementPriority(ordersToPlace, params.PlacementPriority)
+	if maxOrdersToPlace > 0 && uint32(len(ordersToPlace)) > maxOrdersToPlace {
+		ordersToPlace = ordersToPlace[:maxOrdersToPlace]
+		capped = true
+	}
+
+	// If `skip_unchanged_orders` is true, skip if orders to place are the same as resting orders
+	// from last refresh and none of those expires within `max(renew_buffer_blocks, 1)` blocks,
+	// as replacing them wouldn't change the vault's book.
+	if params.SkipUnchangedOrders &&
+		exists &&
+		!k.GetVaultPendingRequote(ctx, vaultId) &&
+		k.computeVaultOrderDiff(ctx, orderIdsToCancel, ordersToPlace).IsEmpty() &&
+		!k.isVaultOrderRenewalDue(ctx, orderIdsToCancel, lib.Max(params.RenewBufferBlocks, 1)) {
+		skipReason = types.RefreshSkipReasonUnchanged
+		return 0, false, nil
+	}
+
+	// Keep resting orders that don't need to be replaced (see `skip_unchanged_outer_layers`)
+	// and don't place orders that they correspond to.
+	ordersToPlace, keptOrderIds, numOrdersCancelled := k.keepUnchangedVaultOrders(
+		ctx,
+		vaultId,
+		params,
+		ordersToPlace,
+	)
+
+	// Cancel CLOB orders from last refresh that aren't kept. If `refresh_strategy` is replace,
+	// indexer events are sent below along with placement of replacement orders.
+	cancelThenPlace := params.RefreshStrategy == types.RefreshStrategy_REFRESH_STRATEGY_CANCEL_THEN_PLACE
+	unkeptOrderIdsToCancel := make([]*clobtypes.OrderId, 0, len(orderIdsToCancel))
+	for _, orderId := range orderIdsToCancel {
+		if _, kept := keptOrderIds[*orderId]; !kept {
+			unkeptOrderIdsToCancel = append(unkeptOrderIdsToCancel, orderId)
+		}
+	}
+	numOrdersCancelled += k.cancelVaultClobOrders(ctx, vaultId, unkeptOrderIdsToCancel, params, cancelThenPlace)
+	// Cancel resting orders from last refresh that weren't cancelled above and that orders to
+	// place would cross, so that the vault doesn't match against itself.
+	numOrdersCancelled += k.cancelSelfCrossingVaultOrders(
+		ctx,
+		vaultId,
+		clobPair,
+		params,
+		lastRefreshBlockHeight,
+		ordersToPlace,
+	)
 
-	for i, order := range ordersToPlace {
+	// Order IDs of current block at each index replace order IDs to cancel at the same index.
+	orderIdsToPlace := k.getVaultClobOrderIds(ctx, vaultId, clobPair, params)
+	replacedOrderIds := make(map[clobtypes.OrderId]*clobtypes.OrderId, len(orderIdsToPlace))
+	for i, orderId := range orderIdsToPlace {
+		replacedOrderIds[*orderId] = orderIdsToCancel[i]
+	}
+
+	for _, order := range ordersToPlace {
 		err := k.PlaceVaultClobOrder(ctx, order)
 		if err != nil {
 			log.ErrorLogWithError(ctx, "Failed to place order", err, "order", order, "vaultId", vaultId)
+		} else {
+			numOrdersPlaced++
 		}
 
 		vaultId.IncrCounterWithLabels(
@@ -116,10 +657,11 @@ func (k Keeper) RefreshVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) (
 			metrics.GetLabelForBoolValue(metrics.Success, err == nil),
 		)
 
-		// Send indexer messages. We expect orderIdsToCancel and ordersToPlace to have the same length
-		// and the order to place at each index to be a replacement of the order to cancel at the same index.
-		replacedOrderId := orderIdsToCancel[i]
-		if replacedOrderId == nil {
+		// Send indexer messages. An order to place is a replacement of the order to cancel
+		// with the same side and layer, unless orders to cancel were cancelled with their own
+		// indexer events above.
+		replacedOrderId := replacedOrderIds[order.OrderId]
+		if replacedOrderId == nil || cancelThenPlace {
 			k.GetIndexerEventManager().AddTxnEvent(
 				ctx,
 				indexerevents.SubtypeStatefulOrder,
@@ -144,77 +686,808 @@ func (k Keeper) RefreshVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) (
 			)
 		}
 	}
-	return nil
+	// Emit an event if orders were placed around the vault's manual reference price.
+	if numOrdersPlaced > 0 && isVaultManualReferencePriceActive(ctx, vaultParams) {
+		ctx.EventManager().EmitEvent(types.NewVaultManualPriceEvent(vaultId, *vaultParams.ManualReferencePrice))
+	}
+	k.SetLastRefreshBlockHeight(ctx, vaultId, blockHeight)
+	k.SetLastRefreshBlockTime(ctx, vaultId, uint32(ctx.BlockTime().Unix()))
+	if quotePrice, err := k.getVaultQuoteMarketPrice(ctx, vaultParams, clobPair); err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get vault quote price", err, "vaultId", vaultId)
+	} else {
+		k.setVaultLastQuotePrice(ctx, vaultId, quotePrice)
+	}
+	k.SetVaultPendingRequote(ctx, vaultId, false)
+	k.updateVaultWatchdog(ctx, vaultId, params, numOrdersPlaced)
+
+	return numOrdersPlaced, capped, nil
+}
+
+// cancelVaultClobOrders cancels the given vault orders that are still resting on the book.
+// If `sendIndexerEvents` is true, an indexer order removal event is sent for each cancelled order.
+// Returns the number of cancelled orders.
+func (k Keeper) cancelVaultClobOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	orderIdsToCancel []*clobtypes.OrderId,
+	params types.Params,
+	sendIndexerEvents bool,
+) (numOrdersCancelled uint32) {
+	for _, orderId := range orderIdsToCancel {
+		if _, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId); exists {
+			// Cancellation must not expire before the order to cancel, which was placed with
+			// the same per-vault expiration jitter.
+			err := k.clobKeeper.HandleMsgCancelOrder(ctx, clobtypes.NewMsgCancelOrderStateful(
+				*orderId,
+				k.getVaultOrderGoodTilBlockTime(ctx, vaultId, params),
+			), true)
+			if err != nil {
+				log.ErrorLogWithError(ctx, "Failed to cancel order", err, "orderId", orderId, "vaultId", vaultId)
+			} else {
+				numOrdersCancelled++
+				if sendIndexerEvents {
+					k.GetIndexerEventManager().AddTxnEvent(
+						ctx,
+						indexerevents.SubtypeStatefulOrder,
+						indexerevents.StatefulOrderEventVersion,
+						indexer_manager.GetBytes(
+							indexerevents.NewStatefulOrderRemovalEvent(
+								*orderId,
+								indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+							),
+						),
+					)
+				}
+			}
+			vaultId.IncrCounterWithLabels(
+				metrics.VaultCancelOrder,
+				metrics.GetLabelForBoolValue(metrics.Success, err == nil),
+			)
+		}
+	}
+	return numOrdersCancelled
+}
+
+// CancelAllOrdersForVaultSubaccount cancels all resting orders of a CLOB vault, i.e. orders
+// of all possible layers and sides with client IDs of either block height parity, sending an
+// indexer order removal event for each cancelled order. Last refresh of the vault is cleared
+// so that the vault places new orders as soon as it refreshes its orders again. Returns the
+// number of cancelled orders.
+func (k Keeper) CancelAllOrdersForVaultSubaccount(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (numOrdersCancelled uint32, err error) {
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return 0, errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	return k.cancelAllVaultClobOrders(ctx, vaultId, clobPair, k.GetParams(ctx)), nil
+}
+
+// cancelAllVaultClobOrders cancels all resting orders of a CLOB vault with the given params and
+// clob pair and clears last refresh of the vault. Returns the number of cancelled orders.
+func (k Keeper) cancelAllVaultClobOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	clobPair clobtypes.ClobPair,
+	params types.Params,
+) (numOrdersCancelled uint32) {
+	allLayersParams := params
+	allLayersParams.Layers = math.MaxUint8
+	orderIds := append(
+		k.getVaultClobOrderIds(ctx.WithBlockHeight(ctx.BlockHeight()-1), vaultId, clobPair, allLayersParams),
+		k.getVaultClobOrderIds(ctx, vaultId, clobPair, allLayersParams)...,
+	)
+
+	numOrdersCancelled = k.cancelVaultClobOrders(ctx, vaultId, orderIds, params, true)
+	k.deleteLastRefresh(ctx, vaultId)
+	return numOrdersCancelled
+}
+
+// cancelSelfCrossingVaultOrders cancels resting orders of a CLOB vault from the block of last
+// refresh that would cross any of the given orders to place, i.e. asks at or below the highest
+// bid to place and bids at or above the lowest ask to place. Such orders are still resting if
+// their cancellation failed or if they are at layers beyond current `layers`, e.g. after
+// `layers` decreased and a sharp price move. Layers beyond current `layers` are checked until
+// a layer with no resting order on either side. An indexer order removal event is sent only for
+// cancelled orders at layers beyond current `layers`, as other orders are replaced by orders to
+// place. Returns the number of cancelled orders.
+func (k Keeper) cancelSelfCrossingVaultOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	clobPair clobtypes.ClobPair,
+	params types.Params,
+	lastRefreshBlockHeight uint32,
+	ordersToPlace []*clobtypes.Order,
+) (numOrdersCancelled uint32) {
+	var maxBidSubticks, minAskSubticks uint64
+	hasBid, hasAsk := false, false
+	for _, order := range ordersToPlace {
+		if order.Side == clobtypes.Order_SIDE_BUY && (!hasBid || order.Subticks > maxBidSubticks) {
+			maxBidSubticks, hasBid = order.Subticks, true
+		} else if order.Side == clobtypes.Order_SIDE_SELL && (!hasAsk || order.Subticks < minAskSubticks) {
+			minAskSubticks, hasAsk = order.Subticks, true
+		}
+	}
+	if !hasBid && !hasAsk {
+		return 0
+	}
+
+	lastRefreshCtx := ctx.WithBlockHeight(int64(lastRefreshBlockHeight))
+	for layer := uint32(0); layer <= math.MaxUint8; layer++ {
+		isResting := false
+		for _, side := range []clobtypes.Order_Side{clobtypes.Order_SIDE_SELL, clobtypes.Order_SIDE_BUY} {
+			orderId := clobtypes.OrderId{
+				SubaccountId: *vaultId.ToSubaccountId(),
+				ClientId:     k.GetVaultClobOrderClientId(lastRefreshCtx, side, uint8(layer)),
+				OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+				ClobPairId:   clobPair.Id,
+			}
+			placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+			if !exists {
+				continue
+			}
+			isResting = true
+
+			subticks := placement.Order.Subticks
+			if (side == clobtypes.Order_SIDE_SELL && hasBid && subticks <= maxBidSubticks) ||
+				(side == clobtypes.Order_SIDE_BUY && hasAsk && subticks >= minAskSubticks) {
+				log.InfoLog(ctx, "Cancelling self-crossing vault order", "orderId", orderId, "vaultId", vaultId)
+				numOrdersCancelled += k.cancelVaultClobOrders(
+					ctx,
+					vaultId,
+					[]*clobtypes.OrderId{&orderId},
+					params,
+					layer >= params.Layers,
+				)
+			}
+		}
+		if !isResting && layer >= params.Layers {
+			break
+		}
+	}
+	return numOrdersCancelled
+}
+
+// SweepStaleVaultOrders cancels resting orders of all vaults that were placed more than
+// `hard_max_order_age_seconds` ago, regardless of whether vaults refresh their orders.
+// This is a no-op if `hard_max_order_age_seconds` is zero.
+func (k Keeper) SweepStaleVaultOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.HardMaxOrderAgeSeconds == 0 {
+		return
+	}
+	blockTime := uint32(ctx.BlockTime().Unix())
+
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+
+		// All resting orders of a vault are placed when the vault last refreshed its orders.
+		lastRefreshBlockTime, exists := k.GetLastRefreshBlockTime(ctx, *vaultId)
+		if !exists || blockTime-lastRefreshBlockTime <= params.HardMaxOrderAgeSeconds {
+			continue
+		}
+		lastRefreshBlockHeight, _ := k.GetLastRefreshBlockHeight(ctx, *vaultId)
+		orderIdsToCancel, err := k.GetVaultClobOrderIds(
+			ctx.WithBlockHeight(int64(lastRefreshBlockHeight)),
+			*vaultId,
+		)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get stale vault clob order IDs", err, "vaultId", *vaultId)
+			continue
+		}
+		k.cancelVaultClobOrders(ctx, *vaultId, orderIdsToCancel, params, true)
+	}
+}
+
+// SweepOrphanedVaultOrders cancels orphaned resting orders (see `FindOrphanedVaultOrders`) of
+// all vaults that have refreshed their orders, sending an indexer order removal event for each
+// cancelled order.
+func (k Keeper) SweepOrphanedVaultOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+		if _, exists := k.GetLastRefreshBlockHeight(ctx, *vaultId); !exists {
+			continue
+		}
+
+		orphanedOrders, err := k.FindOrphanedVaultOrders(ctx, *vaultId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to find orphaned vault orders", err, "vaultId", *vaultId)
+			continue
+		}
+		orderIdsToCancel := make([]*clobtypes.OrderId, len(orphanedOrders))
+		for i := range orphanedOrders {
+			orderIdsToCancel[i] = &orphanedOrders[i].OrderId
+		}
+		k.cancelVaultClobOrders(ctx, *vaultId, orderIdsToCancel, params, true)
+	}
+}
+
+// isVaultOrderRenewalDue returns whether any of the given resting vault orders expires within
+// `renewBufferBlocks` blocks, where block duration is estimated as the duration of the last
+// block. Returns false if `renewBufferBlocks` is zero.
+func (k Keeper) isVaultOrderRenewalDue(
+	ctx sdk.Context,
+	orderIds []*clobtypes.OrderId,
+	renewBufferBlocks uint32,
+) bool {
+	if renewBufferBlocks == 0 {
+		return false
+	}
+
+	blockDuration := ctx.BlockTime().Sub(k.blockTimeKeeper.GetPreviousBlockInfo(ctx).Timestamp)
+	if blockDuration < 0 {
+		blockDuration = 0
+	}
+	renewBeforeTime := ctx.BlockTime().Add(blockDuration * time.Duration(renewBufferBlocks)).Unix()
+	for _, orderId := range orderIds {
+		placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+		if !exists {
+			continue
+		}
+		if int64(placement.Order.GetGoodTilBlockTime()) <= renewBeforeTime {
+			return true
+		}
+	}
+	return false
+}
+
+// getVaultOrderExpirationSeconds returns the number of seconds after which orders of a CLOB
+// vault expire, excluding the vault's expiration jitter. This is `expiration_oracle_ticks`
+// update intervals of the vault's price market if both are set and `order_expiration_seconds`
+// otherwise, including if the vault's price market can't be found.
+func (k Keeper) getVaultOrderExpirationSeconds(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+) uint64 {
+	if params.ExpirationOracleTicks == 0 {
+		return uint64(params.OrderExpirationSeconds)
+	}
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return uint64(params.OrderExpirationSeconds)
+	}
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return uint64(params.OrderExpirationSeconds)
+	}
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		return uint64(params.OrderExpirationSeconds)
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	marketParam, exists := k.pricesKeeper.GetMarketParam(
+		ctx,
+		getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId),
+	)
+	if !exists || marketParam.UpdateIntervalSeconds == 0 {
+		return uint64(params.OrderExpirationSeconds)
+	}
+	return uint64(params.ExpirationOracleTicks) * uint64(marketParam.UpdateIntervalSeconds)
+}
+
+// getVaultOrderGoodTilBlockTime returns the good-til-block-time of a vault order placed in
+// the current block, which includes the vault's expiration jitter. Good-til-block-time is
+// raised to at least current block time plus `min_order_lifetime_seconds` and then clamped
+// to the maximum that the clob accepts, i.e. previous block time plus `StatefulOrderTimeWindow`.
+func (k Keeper) getVaultOrderGoodTilBlockTime(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+) uint32 {
+	goodTilBlockTime := uint64(ctx.BlockTime().Unix()) +
+		k.getVaultOrderExpirationSeconds(ctx, vaultId, params) +
+		uint64(vaultId.GetOrderExpirationJitterSeconds())
+	minGoodTilBlockTime := uint64(ctx.BlockTime().Unix()) + uint64(params.MinOrderLifetimeSeconds)
+	if goodTilBlockTime < minGoodTilBlockTime {
+		log.InfoLog(
+			ctx,
+			"Raising vault order good-til-block-time to the minimum order lifetime",
+			"vaultId", vaultId,
+			"goodTilBlockTime", goodTilBlockTime,
+			"minGoodTilBlockTime", minGoodTilBlockTime,
+		)
+		goodTilBlockTime = minGoodTilBlockTime
+	}
+	maxGoodTilBlockTime := uint64(
+		k.blockTimeKeeper.GetPreviousBlockInfo(ctx).Timestamp.Add(clobtypes.StatefulOrderTimeWindow).Unix(),
+	)
+	if goodTilBlockTime > maxGoodTilBlockTime {
+		log.InfoLog(
+			ctx,
+			"Clamping vault order good-til-block-time to the maximum allowed",
+			"vaultId", vaultId,
+			"goodTilBlockTime", goodTilBlockTime,
+			"maxGoodTilBlockTime", maxGoodTilBlockTime,
+		)
+		goodTilBlockTime = maxGoodTilBlockTime
+	}
+	return lib.MustConvertIntegerToUint32(goodTilBlockTime)
+}
+
+// GetLastRefreshBlockTime returns the block time (in unix seconds) at which a vault last
+// refreshed its orders.
+func (k Keeper) GetLastRefreshBlockTime(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (blockTime uint32, exists bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastRefreshBlockTimeKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return 0, false
+	}
+
+	var value gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value, true
+}
+
+// SetLastRefreshBlockTime sets the block time (in unix seconds) at which a vault last
+// refreshed its orders.
+func (k Keeper) SetLastRefreshBlockTime(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	blockTime uint32,
+) {
+	value := gogotypes.UInt32Value{Value: blockTime}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastRefreshBlockTimeKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// GetVaultLastQuotePrice returns the market price that a vault's orders were based on when
+// it last refreshed its orders, i.e. the price of the market that the vault quotes at after
+// any manual reference price or price blend of the vault is applied.
+func (k Keeper) GetVaultLastQuotePrice(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (marketPrice pricestypes.MarketPrice, exists bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastQuotePriceKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return marketPrice, false
+	}
+
+	k.cdc.MustUnmarshal(b, &marketPrice)
+	return marketPrice, true
+}
+
+// setVaultLastQuotePrice sets the market price that a vault's orders were based on when it
+// last refreshed its orders.
+func (k Keeper) setVaultLastQuotePrice(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	marketPrice pricestypes.MarketPrice,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastQuotePriceKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&marketPrice))
+}
+
+// getVaultQuoteMarketPrice returns the market price that a CLOB vault's orders are based on,
+// i.e. the price of the vault's price market after any manual reference price or price blend
+// of the vault is applied.
+func (k Keeper) getVaultQuoteMarketPrice(
+	ctx sdk.Context,
+	vaultParams types.VaultParams,
+	clobPair clobtypes.ClobPair,
+) (pricestypes.MarketPrice, error) {
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return pricestypes.MarketPrice{}, err
+	}
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		return pricestypes.MarketPrice{}, err
+	}
+	return k.getVaultMarketPrice(ctx, vaultParams, getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId))
+}
+
+// deleteLastRefresh deletes the block height, block time, and quote price at which a vault
+// last refreshed its orders.
+func (k Keeper) deleteLastRefresh(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) {
+	lastRefreshBlockHeightStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.LastRefreshBlockHeightKeyPrefix),
+	)
+	lastRefreshBlockHeightStore.Delete(vaultId.ToStateKey())
+
+	lastRefreshBlockTimeStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.LastRefreshBlockTimeKeyPrefix),
+	)
+	lastRefreshBlockTimeStore.Delete(vaultId.ToStateKey())
+
+	lastQuotePriceStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.LastQuotePriceKeyPrefix),
+	)
+	lastQuotePriceStore.Delete(vaultId.ToStateKey())
+}
+
+// getRefreshCursor returns the vault from which `RefreshAllVaultOrders` starts refreshing
+// orders in round-robin order.
+func (k Keeper) getRefreshCursor(ctx sdk.Context) (vaultId types.VaultId, exists bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get([]byte(types.RefreshCursorKey))
+	if b == nil {
+		return vaultId, false
+	}
+
+	k.cdc.MustUnmarshal(b, &vaultId)
+	return vaultId, true
+}
+
+// setRefreshCursor sets the vault from which `RefreshAllVaultOrders` starts refreshing
+// orders in round-robin order.
+func (k Keeper) setRefreshCursor(ctx sdk.Context, vaultId types.VaultId) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.RefreshCursorKey), k.cdc.MustMarshal(&vaultId))
+}
+
+// deleteRefreshCursor deletes the refresh cursor such that `RefreshAllVaultOrders` starts
+// refreshing orders from the first vault.
+func (k Keeper) deleteRefreshCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.RefreshCursorKey))
+}
+
+// GetLastRefreshBlockHeight returns the block height at which a vault last refreshed its orders.
+func (k Keeper) GetLastRefreshBlockHeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (blockHeight uint32, exists bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastRefreshBlockHeightKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return 0, false
+	}
+
+	var value gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value, true
+}
+
+// SetLastRefreshBlockHeight sets the block height at which a vault last refreshed its orders.
+func (k Keeper) SetLastRefreshBlockHeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	blockHeight uint32,
+) {
+	value := gogotypes.UInt32Value{Value: blockHeight}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastRefreshBlockHeightKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// GetVaultClobOrders returns a list of long term orders for a given CLOB vault.
+// Let n be number of layers, then the function returns orders at [a_0, b_0, a_1, b_1, ..., a_{n-1}, b_{n-1}]
+// where a_i and b_i are the ask price and bid price at i-th layer. To compute a_i and b_i:
+// - a_i = oraclePrice * (1 + skew_i) * (1 + spread)^{i+1}
+// - b_i = oraclePrice * (1 + skew_i) / (1 + spread)^{i+1}
+// - skew_i = -leverage_i * spread * skew_factor (or 0 if skew is disabled), clamped to
+// [-max_skew, max_skew] if `max_skew_ppm` is positive
+// - leverage_i = leverage +/- i * order_size_pct\ (- for ask and + for bid)
+// - leverage = open notional / equity
+// - spread = max(spread_min, spread_buffer + min_price_change)
+// and size of each order is calculated as `order_size * equity / oraclePrice`.
+// If `min_equity_per_layer` is positive, n is reduced to `max(1, equity / min_equity_per_layer)`
+// when that is less than the number of layers in params.
+// If order size is positive but rounds down to zero and `min_lot` is positive, order size is rounded
+// up to `min_lot` and n is reduced to at most `equity / min_lot_notional` (no orders if that is zero).
+// If `spread_multiplier_ppm_by_layer` is non-empty, spread of i-th layer is `spread * multiplier_i`
+// instead of `spread * (i+1)`, where layers beyond the last multiplier use the last multiplier.
+// If `inventory_spread_scale` is positive, spread is widened to `spread * (1 + |leverage| * inventory_spread_scale)`.
+// If `max_position_delta_per_block` is positive, sizes of each side that increases exposure (or flips
+// position) are scaled down so that fully filling that side changes inventory by at most that amount.
+// If `soft_inventory_band` is positive and absolute inventory is beyond it, sizes of the side that
+// increases exposure are scaled by `soft_inventory_band / |inventory|` (and are at least step size).
+// If `price_market_id_override` of the vault is set, oraclePrice is the price of that market instead
+// of the price of the market of the vault's perpetual. If `price_blend` of the vault is set, oraclePrice
+// is the weighted average of prices of the markets in the blend. If `manual_reference_price` of the
+// vault is set and unexpired, oraclePrice is that price (and a vault_manual_price event is emitted
+// when the vault places such orders on refresh).
+// If `min_ticks_from_oracle_per_side` is positive, a_i (b_i) is at least that many ticks above (below)
+// oraclePrice.
+// If `subticks_jitter_enabled` is true, a_i (b_i) is moved up (down) by the vault's subticks jitter
+// (zero or one tick, derived from vault ID).
+// If `order_size_vol_scale` is positive, order size is divided by `1 + order_size_vol_scale * volatility`
+// where volatility is the EWMA of absolute per-block returns of the vault's price market.
+// If `include_fee_floor` is true, spread = max(spread_min, fee_floor, spread_buffer + min_price_change)
+// where fee_floor is the sum of taker and maker fees of the vault's fee tier.
+// If `spread_oracle_market_id` of the vault is set, spread_min is the price of that market
+// interpreted as basis points instead of `spread_min_ppm`.
+// If the vault has index constituents, leverage is computed from weighted notional of the vault's
+// positions in the constituent perpetuals instead of its position in the vault's perpetual.
+// If `inventory_mark_source` of the vault is TWAP, open notional and equity in leverage mark the
+// vault's positions at TWAP of the oracle price of each market instead of at the oracle price.
+// If `inventory_dead_band` is positive, leverage is computed from inventory moved towards zero by
+// the band (zero if absolute inventory is within the band).
+// If `round_to_mid` is true, a_i (b_i) is rounded down (up) to a multiple of subticks per tick
+// instead of up (down) as long as it stays above (below) oraclePrice.
+// If `order_step_base_quantums_override` of the vault is set, sizes are rounded to multiples of that
+// instead of the step size of the clob pair.
+// If `size_profile` is front loaded (back loaded), size of i-th layer is `order_size * 2(n-i)/(n+1)`
+// (`order_size * 2(i+1)/(n+1)`), rounded down to a multiple of step size and at least step size.
+// If a_i (b_i) equals a_{i-1} (b_{i-1}) after rounding, a_i (b_i) is moved one tick up (down).
+// Each order has `client_metadata` of params as its client metadata.
+// If subticks of an order on one side are non-positive or overflow before clamping, orders on that
+// side are dropped and only [a_0, ..., a_{n-1}] or [b_0, ..., b_{n-1}] is returned. Error is returned
+// if this happens on both sides.
+func (k Keeper) GetVaultClobOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (orders []*clobtypes.Order, err error) {
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return orders, errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	return k.getVaultClobOrders(ctx, vaultId, clobPair, k.GetParams(ctx))
+}
+
+// GetVaultClobOrdersSortedByPrice returns the same orders as `GetVaultClobOrders` but sorted by
+// price as on a book, i.e. asks in ascending order of subticks followed by bids in descending
+// order of subticks, instead of ask and bid at each layer. Orders at the same price keep their
+// layer order.
+func (k Keeper) GetVaultClobOrdersSortedByPrice(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (orders []*clobtypes.Order, err error) {
+	vaultOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+	if err != nil {
+		return orders, err
+	}
+
+	asks := make([]*clobtypes.Order, 0, len(vaultOrders))
+	bids := make([]*clobtypes.Order, 0, len(vaultOrders))
+	for _, order := range vaultOrders {
+		if order.Side == clobtypes.Order_SIDE_SELL {
+			asks = append(asks, order)
+		} else {
+			bids = append(bids, order)
+		}
+	}
+	sort.SliceStable(asks, func(i, j int) bool {
+		return asks[i].Subticks < asks[j].Subticks
+	})
+	sort.SliceStable(bids, func(i, j int) bool {
+		return bids[i].Subticks > bids[j].Subticks
+	})
+	return append(asks, bids...), nil
+}
+
+// getVaultClobOrders returns orders that a CLOB vault would place given its clob pair and params.
+// See `GetVaultClobOrders` for how orders are constructed.
+func (k Keeper) getVaultClobOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	clobPair clobtypes.ClobPair,
+	params types.Params,
+) (orders []*clobtypes.Order, err error) {
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	orders, _, err = k.getVaultClobOrdersWithExplanations(ctx, vaultId, clobPair, params, vaultParams)
+	return orders, err
+}
+
+// getVaultOpenNotional returns the open notional (in quote quantums) of a vault, which is
+// notional of its given inventory in the given perpetual at the given market price. If the vault
+// has index constituents, open notional is instead the sum of notional of its position in
+// each constituent perpetual at that perpetual's oracle price, weighted by the constituent's
+// weight. Prices are marked according to the vault's inventory mark source.
+func (k Keeper) getVaultOpenNotional(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	vaultParams types.VaultParams,
+	inventory *big.Int,
+	perpetual perptypes.Perpetual,
+	marketPrice pricestypes.MarketPrice,
+) (openNotional *big.Int, err error) {
+	if len(vaultParams.IndexConstituents) == 0 {
+		markPrice := k.getVaultInventoryMarkPrice(ctx, vaultParams, marketPrice)
+		return lib.BaseToQuoteQuantums(
+			inventory,
+			perpetual.Params.AtomicResolution,
+			markPrice.GetPrice(),
+			markPrice.GetExponent(),
+		), nil
+	}
+
+	openNotional = new(big.Int)
+	for _, constituent := range vaultParams.IndexConstituents {
+		constituentPerp, err := k.perpetualsKeeper.GetPerpetual(ctx, constituent.PerpetualId)
+		if err != nil {
+			return nil, err
+		}
+		constituentPrice, err := k.pricesKeeper.GetMarketPrice(ctx, constituentPerp.Params.MarketId)
+		if err != nil {
+			return nil, err
+		}
+		constituentPrice = k.getVaultInventoryMarkPrice(ctx, vaultParams, constituentPrice)
+		constituentNotional := lib.BaseToQuoteQuantums(
+			k.GetVaultInventoryInPerpetual(ctx, vaultId, constituent.PerpetualId),
+			constituentPerp.Params.AtomicResolution,
+			constituentPrice.GetPrice(),
+			constituentPrice.GetExponent(),
+		)
+		constituentNotional.Mul(constituentNotional, lib.BigU(constituent.WeightPpm))
+		constituentNotional.Quo(constituentNotional, lib.BigIntOneMillion())
+		openNotional.Add(openNotional, constituentNotional)
+	}
+	return openNotional, nil
+}
+
+// getVaultInventoryMarkPrice returns the price that a vault marks its inventory in the market of
+// the given oracle price at. This is the TWAP of the market if the vault's inventory mark source
+// is TWAP and the market has a TWAP in the same exponent, and the given oracle price otherwise.
+func (k Keeper) getVaultInventoryMarkPrice(
+	ctx sdk.Context,
+	vaultParams types.VaultParams,
+	oraclePrice pricestypes.MarketPrice,
+) pricestypes.MarketPrice {
+	if vaultParams.InventoryMarkSource != types.InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP {
+		return oraclePrice
+	}
+	twap := k.GetMarketTwap(ctx, oraclePrice.Id)
+	if twap.Price == 0 || twap.Exponent != oraclePrice.Exponent {
+		return oraclePrice
+	}
+	oraclePrice.Price = twap.Price
+	return oraclePrice
+}
+
+// getVaultMarkedEquity returns the given equity (in quote quantums) of a vault with its perpetual
+// positions re-marked from the oracle price to the price of the vault's inventory mark source,
+// i.e. `equity + sum(notional at mark price - notional at oracle price)` across positions.
+func (k Keeper) getVaultMarkedEquity(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	vaultParams types.VaultParams,
+	equity *big.Int,
+) (markedEquity *big.Int, err error) {
+	markedEquity = new(big.Int).Set(equity)
+	if vaultParams.InventoryMarkSource != types.InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP {
+		return markedEquity, nil
+	}
+
+	vaultSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+	for _, position := range vaultSubaccount.PerpetualPositions {
+		perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, position.PerpetualId)
+		if err != nil {
+			return nil, err
+		}
+		oraclePrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+		if err != nil {
+			return nil, err
+		}
+		markPrice := k.getVaultInventoryMarkPrice(ctx, vaultParams, oraclePrice)
+		markedEquity.Add(
+			markedEquity,
+			lib.BaseToQuoteQuantums(
+				position.GetBigQuantums(),
+				perpetual.Params.AtomicResolution,
+				markPrice.Price,
+				markPrice.Exponent,
+			),
+		)
+		markedEquity.Sub(
+			markedEquity,
+			lib.BaseToQuoteQuantums(
+				position.GetBigQuantums(),
+				perpetual.Params.AtomicResolution,
+				oraclePrice.Price,
+				oraclePrice.Exponent,
+			),
+		)
+	}
+	return markedEquity, nil
 }
 
-// GetVaultClobOrders returns a list of long term orders for a given CLOB vault.
-// Let n be number of layers, then the function returns orders at [a_0, b_0, a_1, b_1, ..., a_{n-1}, b_{n-1}]
-// where a_i and b_i are the ask price and bid price at i-th layer. To compute a_i and b_i:
-// - a_i = oraclePrice * (1 + skew_i) * (1 + spread)^{i+1}
-// - b_i = oraclePrice * (1 + skew_i) / (1 + spread)^{i+1}
-// - skew_i = -leverage_i * spread * skew_factor
-// - leverage_i = leverage +/- i * order_size_pct\ (- for ask and + for bid)
-// - leverage = open notional / equity
-// - spread = max(spread_min, spread_buffer + min_price_change)
-// and size of each order is calculated as `order_size * equity / oraclePrice`.
-func (k Keeper) GetVaultClobOrders(
+// getVaultClobOrdersWithExplanations returns orders that a CLOB vault would place given its
+// clob pair, params, and vault params, along with intermediate values of the computation of each order at
+// the same index.
+func (k Keeper) getVaultClobOrdersWithExplanations(
 	ctx sdk.Context,
 	vaultId types.VaultId,
-) (orders []*clobtypes.Order, err error) {
-	// Get clob pair, perpetual, market parameter, and market price that correspond to this vault.
-	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
-	if !exists {
-		return orders, errorsmod.Wrap(
-			types.ErrClobPairNotFound,
-			fmt.Sprintf("VaultId: %v", vaultId),
-		)
-	}
+	clobPair clobtypes.ClobPair,
+	params types.Params,
+	vaultParams types.VaultParams,
+) (orders []*clobtypes.Order, explanations []*types.VaultOrderExplanation, err error) {
+	// Get perpetual, market parameter, and market price that correspond to this vault.
 	perpId := clobPair.Metadata.(*clobtypes.ClobPair_PerpetualClobMetadata).PerpetualClobMetadata.PerpetualId
 	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
 	if err != nil {
-		return orders, errorsmod.Wrap(
+		return orders, nil, errorsmod.Wrap(
 			err,
 			fmt.Sprintf("VaultId: %v", vaultId),
 		)
 	}
-	marketParam, exists := k.pricesKeeper.GetMarketParam(ctx, perpetual.Params.MarketId)
+	marketId := getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId)
+	marketParam, exists := k.pricesKeeper.GetMarketParam(ctx, marketId)
 	if !exists {
-		return orders, errorsmod.Wrap(
+		return orders, nil, errorsmod.Wrap(
 			types.ErrMarketParamNotFound,
 			fmt.Sprintf("VaultId: %v", vaultId),
 		)
 	}
-	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	marketPrice, err := k.getVaultMarketPrice(ctx, vaultParams, marketId)
 	if err != nil {
-		return orders, errorsmod.Wrap(
+		return orders, nil, errorsmod.Wrap(
 			err,
 			fmt.Sprintf("VaultId: %v", vaultId),
 		)
 	}
 
+	// Don't quote if oracle price is outside of the vault's configured price range.
+	if !vaultParams.IsOraclePriceInRange(marketPrice.Price) {
+		return []*clobtypes.Order{}, nil, nil
+	}
+
 	// Calculate leverage = open notional / equity.
 	equity, err := k.GetVaultEquity(ctx, vaultId)
 	if err != nil {
-		return orders, err
+		return orders, nil, err
 	}
 	if equity.Sign() <= 0 {
-		return orders, errorsmod.Wrap(
+		return orders, nil, errorsmod.Wrap(
 			types.ErrNonPositiveEquity,
 			fmt.Sprintf("VaultId: %v", vaultId),
 		)
 	}
 	inventory := k.GetVaultInventoryInPerpetual(ctx, vaultId, perpId)
-	openNotional := lib.BaseToQuoteQuantums(
-		inventory,
-		perpetual.Params.AtomicResolution,
-		marketPrice.GetPrice(),
-		marketPrice.GetExponent(),
+	openNotional, err := k.getVaultOpenNotional(
+		ctx,
+		vaultId,
+		vaultParams,
+		getVaultSkewInventory(inventory, params.InventoryDeadBandBaseQuantums),
+		perpetual,
+		marketPrice,
 	)
+	if err != nil {
+		return orders, nil, errorsmod.Wrap(
+			err,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	leverageEquity, err := k.getVaultMarkedEquity(ctx, vaultId, vaultParams, equity)
+	if err != nil {
+		return orders, nil, errorsmod.Wrap(
+			err,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	if leverageEquity.Sign() <= 0 {
+		return orders, nil, errorsmod.Wrap(
+			types.ErrNonPositiveEquity,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
 	leveragePpm := new(big.Int).Mul(openNotional, lib.BigIntOneMillion())
-	leveragePpm.Quo(leveragePpm, equity)
-
-	// Get parameters.
-	params := k.GetParams(ctx)
+	leveragePpm.Quo(leveragePpm, leverageEquity)
 
 	// Calculate order size (in base quantums).
 	orderSizePctPpm := lib.BigU(params.OrderSizePctPpm)
@@ -226,47 +1499,121 @@ func (k Keeper) GetVaultClobOrders(
 	)
 	orderSize.Quo(orderSize, lib.BigIntOneMillion())
 
+	// Scale down order size as volatility of the vault's price market rises.
+	if params.OrderSizeVolScalePpm > 0 {
+		volatility := k.GetMarketVolatility(ctx, marketId)
+		volScalePpm := lib.BigU(params.OrderSizeVolScalePpm)
+		volScalePpm.Mul(volScalePpm, lib.BigU(volatility.EwmaAbsReturnPpm))
+		volScalePpm.Quo(volScalePpm, lib.BigIntOneMillion())
+		volScalePpm.Add(volScalePpm, lib.BigIntOneMillion())
+		orderSize.Mul(orderSize, lib.BigIntOneMillion())
+		orderSize.Quo(orderSize, volScalePpm)
+	}
+	isOrderSizePositive := orderSize.Sign() > 0
+
 	// Round (towards-zero) order size to the nearest multiple of step size.
-	stepSize := lib.BigU(clobPair.StepBaseQuantums)
+	stepSize := lib.BigU(getVaultOrderStepBaseQuantums(vaultParams, clobPair))
 	orderSize.Quo(orderSize, stepSize).Mul(orderSize, stepSize)
 
+	// If positive order size rounds down to zero, round it up to min lot (rounded up to
+	// a multiple of step size) and only place as many layers as equity can fund, i.e.
+	// `equity / min_lot_notional`. Layers that equity can't fund are dropped.
+	maxLayers := params.Layers
+	if orderSize.Sign() == 0 && isOrderSizePositive && params.MinLotBaseQuantums > 0 {
+		minLot := lib.BigIntRoundToMultiple(new(big.Int).SetUint64(params.MinLotBaseQuantums), stepSize, true)
+		minLotNotional := lib.BaseToQuoteQuantums(
+			minLot,
+			perpetual.Params.AtomicResolution,
+			marketPrice.GetPrice(),
+			marketPrice.GetExponent(),
+		)
+		fundedLayers := lib.BigU(maxLayers)
+		if minLotNotional.Sign() > 0 {
+			fundedLayers.Quo(equity, minLotNotional)
+		}
+		if fundedLayers.Sign() > 0 {
+			orderSize = minLot
+			if fundedLayers.Cmp(lib.BigU(maxLayers)) < 0 {
+				maxLayers = uint32(fundedLayers.Uint64())
+			}
+		}
+	}
+
 	// If order size is zero, return empty orders.
 	if orderSize.Sign() == 0 {
-		return []*clobtypes.Order{}, nil
+		return []*clobtypes.Order{}, nil, nil
 	}
 
 	// If order size is not a valid uint64, return error.
 	if !orderSize.IsUint64() {
-		return []*clobtypes.Order{}, errorsmod.Wrap(
+		return []*clobtypes.Order{}, nil, errorsmod.Wrap(
 			types.ErrInvalidOrderSize,
 			fmt.Sprintf("VaultId: %v", vaultId),
 		)
 	}
 
 	// Calculate spread.
-	spreadPpm := lib.BigU(lib.Max(
-		params.SpreadMinPpm,
-		params.SpreadBufferPpm+marketParam.MinPriceChangePpm,
-	))
-	// Get oracle price in subticks.
-	oracleSubticks := clobtypes.PriceToSubticks(
-		marketPrice,
-		clobPair,
-		perpetual.Params.AtomicResolution,
-		lib.QuoteCurrencyAtomicResolution,
+	spreadMinPpm, err := k.getVaultSpreadMinPpm(ctx, vaultParams, params)
+	if err != nil {
+		return orders, nil, errorsmod.Wrap(
+			err,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	spreadPpm := lib.BigMax(
+		spreadMinPpm,
+		lib.BigU(params.SpreadBufferPpm+marketParam.MinPriceChangePpm),
 	)
+	if params.IncludeFeeFloor {
+		spreadPpm = lib.BigMax(spreadPpm, k.getVaultFeeFloorPpm(ctx, vaultId))
+	}
+	// Widen spread by the multiplier of the vault's spread schedule at current block time.
+	if spreadMultiplierPpm := vaultParams.GetSpreadMultiplierPpm(ctx.BlockTime()); spreadMultiplierPpm != lib.OneMillion {
+		spreadPpm.Mul(spreadPpm, lib.BigU(spreadMultiplierPpm))
+		spreadPpm.Quo(spreadPpm, lib.BigIntOneMillion())
+	}
+	// Widen spread as inventory grows: spread = spread * (1 + |leverage| * inventory_spread_scale).
+	if params.InventorySpreadScalePpm > 0 {
+		scalePpm := new(big.Int).Abs(leveragePpm)
+		scalePpm.Mul(scalePpm, lib.BigU(params.InventorySpreadScalePpm))
+		scalePpm.Quo(scalePpm, lib.BigIntOneMillion())
+		scalePpm.Add(scalePpm, lib.BigIntOneMillion())
+		spreadPpm.Mul(spreadPpm, scalePpm)
+		spreadPpm.Quo(spreadPpm, lib.BigIntOneMillion())
+	}
+	// Get oracle price in subticks.
+	oracleSubticks, err := types.ComputeOracleSubticks(marketPrice, marketParam, perpetual, clobPair)
+	if err != nil {
+		return orders, nil, errorsmod.Wrap(
+			err,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
 	// Get order expiration time.
 	goodTilBlockTime := &clobtypes.Order_GoodTilBlockTime{
-		GoodTilBlockTime: uint32(ctx.BlockTime().Unix()) + params.OrderExpirationSeconds,
+		GoodTilBlockTime: k.getVaultOrderGoodTilBlockTime(ctx, vaultId, params),
 	}
+	// Skew is zero if skew is disabled.
 	skewFactorPpm := lib.BigU(params.SkewFactorPpm)
+	if !params.SkewEnabled {
+		skewFactorPpm.SetUint64(0)
+	}
+	// Get subticks jitter, which is zero if jitter is disabled.
+	subticksJitter := new(big.Int)
+	if params.SubticksJitterEnabled {
+		subticksJitter.SetUint64(vaultId.GetSubticksJitterTicks() * uint64(clobPair.SubticksPerTick))
+	}
+	// Get minimum and maximum subticks.
+	minSubticks := uint64(clobPair.SubticksPerTick)
+	maxSubticks := uint64(math.MaxUint64 - (uint64(math.MaxUint64) % uint64(clobPair.SubticksPerTick)))
 
 	// Construct one ask and one bid for each layer.
 	constructOrder := func(
 		side clobtypes.Order_Side,
 		layer uint32,
 		orderId *clobtypes.OrderId,
-	) *clobtypes.Order {
+		size *big.Int,
+	) (*clobtypes.Order, *types.VaultOrderExplanation, error) {
 		// Ask: leverage_i = leverage - i * order_size_pct
 		// Bid: leverage_i = leverage + i * order_size_pct
 		// skew_i = -leverage_i * spread * skew_factor
@@ -276,83 +1623,506 @@ func (k Keeper) GetVaultClobOrders(
 			leveragePpmI.Neg(leveragePpmI)
 		}
 		leveragePpmI.Add(leveragePpmI, leveragePpm)
+		explanation := &types.VaultOrderExplanation{
+			Side:        side,
+			Layer:       layer,
+			LeveragePpm: dtypes.NewIntFromBigInt(new(big.Int).Set(leveragePpmI)),
+		}
 		skewPpmI := leveragePpmI.
 			Mul(leveragePpmI, spreadPpm).
 			Mul(leveragePpmI, skewFactorPpm).
 			Quo(leveragePpmI, lib.BigIntOneMillion()).
 			Quo(leveragePpmI, lib.BigIntOneMillion()).
 			Neg(leveragePpmI)
+		// skew_i = clamp(skew_i, -max_skew, max_skew) if max skew is set
+		if params.MaxSkewPpm > 0 {
+			maxSkewPpm := lib.BigU(params.MaxSkewPpm)
+			skewPpmI = lib.BigMax(lib.BigMin(skewPpmI, maxSkewPpm), new(big.Int).Neg(maxSkewPpm))
+		}
 
-		// spread_i = spread * (layer+1)
+		// spread_i = spread * (layer+1), or spread * spread_multiplier_i if spread multipliers
+		// are specified, where layers beyond the last multiplier use the last multiplier.
 		// negated for buys
-		spreadPpmI := lib.BigU(layer + 1)
-		spreadPpmI.Mul(spreadPpmI, spreadPpm)
+		var spreadPpmI *big.Int
+		if numMultipliers := uint32(len(params.SpreadMultiplierPpmByLayer)); numMultipliers > 0 {
+			spreadPpmI = lib.BigU(params.SpreadMultiplierPpmByLayer[lib.Min(layer, numMultipliers-1)])
+			spreadPpmI.Mul(spreadPpmI, spreadPpm)
+			spreadPpmI.Quo(spreadPpmI, lib.BigIntOneMillion())
+		} else {
+			spreadPpmI = lib.BigU(layer + 1)
+			spreadPpmI.Mul(spreadPpmI, spreadPpm)
+		}
+		explanation.SpreadPpm = spreadPpmI.Uint64()
+		explanation.SkewPpm = dtypes.NewIntFromBigInt(skewPpmI)
 		if side == clobtypes.Order_SIDE_BUY {
 			spreadPpmI.Neg(spreadPpmI)
 		}
 
+		// Subticks of `oracleprice * (1 + ppm)`, rounded up for sells and down for buys.
+		toSubticks := func(ppm *big.Int) *big.Int {
+			orderSubticksNum := lib.BigMulPpm(
+				oracleSubticks.Num(),
+				new(big.Int).Add(ppm, lib.BigIntOneMillion()),
+				side == clobtypes.Order_SIDE_SELL,
+			)
+			if side == clobtypes.Order_SIDE_SELL {
+				return lib.BigDivCeil(orderSubticksNum, oracleSubticks.Denom())
+			}
+			return new(big.Int).Quo(orderSubticksNum, oracleSubticks.Denom())
+		}
+
 		// price = oracleprice * (1 + skew_i + spread_i)
 		// price <= oracleprice for buys
 		// price >= oracleprice for sells
 		spreadSkewPpm := new(big.Int).Add(spreadPpmI, skewPpmI)
+		explanation.RawSubticks = dtypes.NewIntFromBigInt(toSubticks(spreadSkewPpm))
 		if side == clobtypes.Order_SIDE_SELL && spreadSkewPpm.Sign() < 0 {
 			spreadSkewPpm.SetUint64(0)
 		} else if side == clobtypes.Order_SIDE_BUY && spreadSkewPpm.Sign() > 0 {
 			spreadSkewPpm.SetUint64(0)
 		}
-		spreadSkewPpm.Add(spreadSkewPpm, lib.BigIntOneMillion())
-		orderSubticksNum := lib.BigMulPpm(
-			oracleSubticks.Num(),
-			spreadSkewPpm,
-			side == clobtypes.Order_SIDE_SELL,
-		)
 
 		// Determine the subticks.
-		var subticks *big.Int
-		if side == clobtypes.Order_SIDE_SELL {
-			subticks = lib.BigDivCeil(orderSubticksNum, oracleSubticks.Denom())
-		} else {
-			subticks = new(big.Int).Quo(orderSubticksNum, oracleSubticks.Denom())
-		}
-		// Bound subticks between the minimum and maximum subticks.
+		subticks := toSubticks(spreadSkewPpm)
+		// Keep asks at least and bids at most `min_ticks_from_oracle_per_side` ticks away
+		// from oracle price.
 		subticksPerTick := lib.BigU(clobPair.SubticksPerTick)
-		subticks = lib.BigIntRoundToMultiple(
-			subticks,
-			subticksPerTick,
-			side == clobtypes.Order_SIDE_SELL,
-		)
+		var minTicksBoundSubticks *big.Int
+		if params.MinTicksFromOraclePerSide > 0 {
+			minOffset := lib.BigU(params.MinTicksFromOraclePerSide)
+			minOffset.Mul(minOffset, subticksPerTick)
+			if side == clobtypes.Order_SIDE_SELL {
+				minAskSubticks := lib.BigDivCeil(oracleSubticks.Num(), oracleSubticks.Denom())
+				minAskSubticks.Add(minAskSubticks, minOffset)
+				if subticks.Cmp(minAskSubticks) < 0 {
+					subticks = minAskSubticks
+				}
+				minTicksBoundSubticks = minAskSubticks
+			} else {
+				maxBidSubticks := new(big.Int).Quo(oracleSubticks.Num(), oracleSubticks.Denom())
+				maxBidSubticks.Sub(maxBidSubticks, minOffset)
+				if subticks.Cmp(maxBidSubticks) > 0 {
+					subticks = maxBidSubticks
+				}
+				minTicksBoundSubticks = maxBidSubticks
+			}
+		}
+		explanation.BoundedSubticks = dtypes.NewIntFromBigInt(subticks)
+		// Subticks that are non-positive or overflow uint64 before clamping indicate an
+		// arithmetic edge case (e.g. a bid skewed below zero price).
+		if subticks.Sign() <= 0 || !subticks.IsUint64() {
+			return nil, nil, errorsmod.Wrapf(
+				types.ErrInvalidOrderSubticks,
+				"VaultId: %v, side: %v, layer: %d, subticks: %v",
+				vaultId,
+				side,
+				layer,
+				subticks,
+			)
+		}
+		// Round subticks to a multiple of subticks per tick, away from oracle price by default.
+		// If `round_to_mid` is true, round towards oracle price instead unless that moves the
+		// order to or past oracle price or within `min_ticks_from_oracle_per_side` ticks of it.
+		roundedAway := lib.BigIntRoundToMultiple(subticks, subticksPerTick, side == clobtypes.Order_SIDE_SELL)
+		if params.RoundToMid {
+			roundedToMid := lib.BigIntRoundToMultiple(subticks, subticksPerTick, side == clobtypes.Order_SIDE_BUY)
+			cmpOracle := new(big.Rat).SetInt(roundedToMid).Cmp(oracleSubticks)
+			if side == clobtypes.Order_SIDE_SELL {
+				if cmpOracle > 0 && (minTicksBoundSubticks == nil || roundedToMid.Cmp(minTicksBoundSubticks) >= 0) {
+					roundedAway = roundedToMid
+				}
+			} else if cmpOracle < 0 && (minTicksBoundSubticks == nil || roundedToMid.Cmp(minTicksBoundSubticks) <= 0) {
+				roundedAway = roundedToMid
+			}
+		}
+		subticks = roundedAway
+		// Move order away from oracle price by the vault's jitter before bounding, so that
+		// orders at a bound stay at that bound.
+		if subticksJitter.Sign() > 0 {
+			if side == clobtypes.Order_SIDE_SELL {
+				subticks.Add(subticks, subticksJitter)
+			} else {
+				subticks.Sub(subticks, subticksJitter)
+			}
+		}
 
-		minSubticks := uint64(clobPair.SubticksPerTick)
-		maxSubticks := uint64(math.MaxUint64 - (uint64(math.MaxUint64) % uint64(clobPair.SubticksPerTick)))
 		subticksRounded := lib.BigUint64Clamp(
 			subticks,
 			minSubticks,
 			maxSubticks,
 		)
 
+		explanation.SizeBaseQuantums = size.Uint64()
 		return &clobtypes.Order{
-			OrderId:      *orderId,
-			Side:         side,
-			Quantums:     orderSize.Uint64(), // Validated to be a uint64 above.
-			Subticks:     subticksRounded,
-			GoodTilOneof: goodTilBlockTime,
+			OrderId:        *orderId,
+			Side:           side,
+			Quantums:       size.Uint64(), // Validated to be a uint64 above.
+			Subticks:       subticksRounded,
+			GoodTilOneof:   goodTilBlockTime,
+			ClientMetadata: params.ClientMetadata,
+		}, explanation, nil
+	}
+
+	orderIds := k.getVaultClobOrderIds(ctx, vaultId, clobPair, params)
+	// Reduce number of layers if equity can't fund all layers, keeping inner layers
+	// and always placing at least one layer.
+	numLayers := params.Layers
+	if params.MinEquityPerLayerQuoteQuantums.Sign() > 0 {
+		fundedLayers := new(big.Int).Quo(equity, params.MinEquityPerLayerQuoteQuantums.BigInt())
+		if fundedLayers.Cmp(lib.BigU(numLayers)) < 0 {
+			numLayers = lib.Max(uint32(fundedLayers.Uint64()), 1)
+		}
+	}
+	numLayers = lib.Min(numLayers, maxLayers)
+
+	// If fully filling all orders on a side would change inventory by more than
+	// `max_position_delta_per_block`, scale down order sizes on that side proportionally
+	// (rounded down to a multiple of step size). This only applies to a side that increases
+	// the vault's exposure or flips its position, i.e. a side that only reduces exposure
+	// isn't scaled down. If a scaled down size is zero, orders on that side aren't placed.
+	askSize, bidSize := orderSize, orderSize
+	if params.MaxPositionDeltaPerBlockBaseQuantums > 0 {
+		maxDelta := new(big.Int).SetUint64(params.MaxPositionDeltaPerBlockBaseQuantums)
+		sideDelta := new(big.Int).Mul(orderSize, lib.BigU(numLayers))
+		if sideDelta.Cmp(maxDelta) > 0 {
+			scaledSize := new(big.Int).Mul(orderSize, maxDelta)
+			scaledSize.Quo(scaledSize, sideDelta)
+			scaledSize.Quo(scaledSize, stepSize).Mul(scaledSize, stepSize)
+
+			// A side only reduces exposure if it moves inventory towards zero without crossing it.
+			onlyReducesExposure := func(delta *big.Int) bool {
+				return inventory.Sign()*delta.Sign() < 0 &&
+					new(big.Int).Abs(delta).Cmp(new(big.Int).Abs(inventory)) <= 0
+			}
+			// Asks decrease inventory and bids increase inventory.
+			if !onlyReducesExposure(new(big.Int).Neg(sideDelta)) {
+				askSize = scaledSize
+			}
+			if !onlyReducesExposure(sideDelta) {
+				bidSize = scaledSize
+			}
+			if askSize.Sign() == 0 && bidSize.Sign() == 0 {
+				return []*clobtypes.Order{}, nil, nil
+			}
+		}
+	}
+
+	// If absolute inventory is beyond `soft_inventory_band`, scale down sizes on the side that
+	// increases the vault's exposure (bids if long and asks if short) by `band / |inventory|`,
+	// such that sizes shrink the further inventory is beyond the band, rounded down to a
+	// multiple of step size but at least step size so that the side isn't suppressed.
+	if params.SoftInventoryBandBaseQuantums > 0 {
+		softBand := new(big.Int).SetUint64(params.SoftInventoryBandBaseQuantums)
+		absInventory := new(big.Int).Abs(inventory)
+		if absInventory.Cmp(softBand) > 0 {
+			scaleSize := func(size *big.Int) *big.Int {
+				if size.Sign() == 0 {
+					return size
+				}
+				scaledSize := new(big.Int).Mul(size, softBand)
+				scaledSize.Quo(scaledSize, absInventory)
+				scaledSize.Quo(scaledSize, stepSize).Mul(scaledSize, stepSize)
+				return lib.BigMax(scaledSize, stepSize)
+			}
+			if inventory.Sign() > 0 {
+				bidSize = scaleSize(bidSize)
+			} else {
+				askSize = scaleSize(askSize)
+			}
+		}
+	}
+
+	// Weight size of each layer according to size profile. With `n` layers, weight of
+	// layer `i` is `2(n-i)/(n+1)` if front loaded and `2(i+1)/(n+1)` if back loaded, such
+	// that weights average to one. Weighted size is rounded down to a multiple of step size
+	// and is at least step size.
+	weightedLayerSize := func(size *big.Int, layer uint32) *big.Int {
+		var weight uint32
+		switch params.SizeProfile {
+		case types.SizeProfile_SIZE_PROFILE_FRONT_LOADED:
+			weight = 2 * (numLayers - layer)
+		case types.SizeProfile_SIZE_PROFILE_BACK_LOADED:
+			weight = 2 * (layer + 1)
+		default:
+			return size
+		}
+		layerSize := new(big.Int).Mul(size, lib.BigU(weight))
+		layerSize.Quo(layerSize, lib.BigU(numLayers+1))
+		layerSize.Quo(layerSize, stepSize).Mul(layerSize, stepSize)
+		if layerSize.Sign() == 0 {
+			layerSize.Set(stepSize)
+		}
+		return layerSize
+	}
+
+	// Construct orders on one side for each layer. If two adjacent layers round to the same
+	// subticks, move the outer layer one tick away from oracle price (up for asks and down
+	// for bids) to avoid duplicate price levels.
+	subticksPerTick := uint64(clobPair.SubticksPerTick)
+	constructSide := func(
+		side clobtypes.Order_Side,
+		size *big.Int,
+	) (sideOrders []*clobtypes.Order, sideExplanations []*types.VaultOrderExplanation, err error) {
+		sideOffset := uint32(1)
+		if side == clobtypes.Order_SIDE_SELL {
+			sideOffset = 0
+		}
+		sideOrders = make([]*clobtypes.Order, numLayers)
+		sideExplanations = make([]*types.VaultOrderExplanation, numLayers)
+		for i := uint32(0); i < numLayers; i++ {
+			layerSize := weightedLayerSize(size, i)
+			if !layerSize.IsUint64() {
+				return nil, nil, errorsmod.Wrap(
+					types.ErrInvalidOrderSize,
+					fmt.Sprintf("VaultId: %v, Layer: %d", vaultId, i),
+				)
+			}
+			sideOrders[i], sideExplanations[i], err = constructOrder(side, i, orderIds[2*i+sideOffset], layerSize)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		for i := uint32(1); i < numLayers; i++ {
+			order, innerOrder := sideOrders[i], sideOrders[i-1]
+			if order.Subticks != innerOrder.Subticks {
+				continue
+			}
+			if side == clobtypes.Order_SIDE_SELL && order.Subticks <= maxSubticks-subticksPerTick {
+				order.Subticks += subticksPerTick
+			} else if side == clobtypes.Order_SIDE_BUY && order.Subticks >= minSubticks+subticksPerTick {
+				order.Subticks -= subticksPerTick
+			}
+		}
+		for i, order := range sideOrders {
+			sideExplanations[i].RoundedSubticks = order.Subticks
+		}
+		return sideOrders, sideExplanations, nil
+	}
+
+	// If orders on one side can't be constructed, only place orders on the other side.
+	// Return error only if neither side can be constructed. A side whose size is zero
+	// isn't constructed and only orders on the other side are placed.
+	var asks, bids []*clobtypes.Order
+	var askExplanations, bidExplanations []*types.VaultOrderExplanation
+	var askErr, bidErr error
+	if askSize.Sign() > 0 {
+		asks, askExplanations, askErr = constructSide(clobtypes.Order_SIDE_SELL, askSize)
+	}
+	if bidSize.Sign() > 0 {
+		bids, bidExplanations, bidErr = constructSide(clobtypes.Order_SIDE_BUY, bidSize)
+	}
+	if askErr != nil && bidErr != nil {
+		return []*clobtypes.Order{}, nil, errors.Join(askErr, bidErr)
+	} else if askErr != nil {
+		log.InfoLog(ctx, "Dropping vault asks that can't be constructed", "vaultId", vaultId, log.Error, askErr)
+		orders, explanations = bids, bidExplanations
+	} else if bidErr != nil {
+		log.InfoLog(ctx, "Dropping vault bids that can't be constructed", "vaultId", vaultId, log.Error, bidErr)
+		orders, explanations = asks, askExplanations
+	} else if askSize.Sign() == 0 {
+		orders, explanations = bids, bidExplanations
+	} else if bidSize.Sign() == 0 {
+		orders, explanations = asks, askExplanations
+	} else {
+		orders = make([]*clobtypes.Order, 2*numLayers)
+		explanations = make([]*types.VaultOrderExplanation, 2*numLayers)
+		for i := uint32(0); i < numLayers; i++ {
+			orders[2*i] = asks[i]
+			orders[2*i+1] = bids[i]
+			explanations[2*i] = askExplanations[i]
+			explanations[2*i+1] = bidExplanations[i]
+		}
+	}
+
+	// Alert on (and drop if strict) orders that deviate too much from oracle price.
+	orders, explanations = k.checkVaultOrdersSubticksDeviation(
+		ctx,
+		vaultId,
+		params,
+		oracleSubticks,
+		orders,
+		explanations,
+	)
+	return orders, explanations, nil
+}
+
+// sortVaultOrdersByPlacementPriority sorts vault orders by layer, inner layers first if
+// `priority` is `INNER_FIRST` and outer layers first if `priority` is `OUTER_FIRST`. Orders
+// of the same layer keep their relative order.
+func sortVaultOrdersByPlacementPriority(orders []*clobtypes.Order, priority types.PlacementPriority) {
+	layer := func(order *clobtypes.Order) uint8 {
+		_, _, layer, _ := types.DecodeVaultClientId(order.OrderId.ClientId)
+		return layer
+	}
+	sort.SliceStable(orders, func(i, j int) bool {
+		if priority == types.PlacementPriority_PLACEMENT_PRIORITY_OUTER_FIRST {
+			return layer(orders[i]) > layer(orders[j])
 		}
+		return layer(orders[i]) < layer(orders[j])
+	})
+}
+
+// getVaultPriceMarketId returns the id of the market whose price a vault quotes at, which is
+// the vault's price market override if set and the given market id of its perpetual otherwise.
+func getVaultPriceMarketId(vaultParams types.VaultParams, perpetualMarketId uint32) uint32 {
+	if vaultParams.PriceMarketIdOverride != nil {
+		return vaultParams.PriceMarketIdOverride.Value
 	}
+	return perpetualMarketId
+}
 
-	orderIds, err := k.GetVaultClobOrderIds(ctx, vaultId)
+// getVaultSpreadMinPpm returns the minimum spread (in ppm) of a vault, which is the price of
+// the vault's spread oracle market interpreted as basis points if set and `spread_min_ppm`
+// otherwise. As a basis point is 100 ppm, spread oracle price `p` with exponent `e` maps to
+// `p * 10^(e + 2)` ppm, rounded down.
+func (k Keeper) getVaultSpreadMinPpm(
+	ctx sdk.Context,
+	vaultParams types.VaultParams,
+	params types.Params,
+) (*big.Int, error) {
+	if vaultParams.SpreadOracleMarketId == nil {
+		return lib.BigU(params.SpreadMinPpm), nil
+	}
+	spreadOraclePrice, err := k.pricesKeeper.GetMarketPrice(ctx, vaultParams.SpreadOracleMarketId.Value)
 	if err != nil {
-		return orders, err
+		return nil, err
 	}
-	orders = make([]*clobtypes.Order, 2*params.Layers)
-	for i := uint32(0); i < params.Layers; i++ {
-		// Construct ask at this layer.
-		orders[2*i] = constructOrder(clobtypes.Order_SIDE_SELL, i, orderIds[2*i])
+	return lib.BigIntMulPow10(lib.BigU(spreadOraclePrice.Price), spreadOraclePrice.Exponent+2, false), nil
+}
 
-		// Construct bid at this layer.
-		orders[2*i+1] = constructOrder(clobtypes.Order_SIDE_BUY, i, orderIds[2*i+1])
+// getVaultSkewInventory returns the inventory (in base quantums) that leverage for skew is
+// computed from, which is zero if absolute inventory is within the given dead band and is
+// otherwise inventory moved towards zero by the dead band, i.e. measured from the band edge.
+func getVaultSkewInventory(inventory *big.Int, deadBandBaseQuantums uint64) *big.Int {
+	deadBand := new(big.Int).SetUint64(deadBandBaseQuantums)
+	if new(big.Int).Abs(inventory).Cmp(deadBand) <= 0 {
+		return big.NewInt(0)
+	}
+	if inventory.Sign() > 0 {
+		return new(big.Int).Sub(inventory, deadBand)
 	}
+	return new(big.Int).Add(inventory, deadBand)
+}
 
-	return orders, nil
+// getVaultOrderStepBaseQuantums returns the step size (in base quantums) that a vault rounds
+// its order sizes to, which is the vault's step size override if set and a multiple of the
+// clob pair's step size, and the clob pair's step size otherwise.
+func getVaultOrderStepBaseQuantums(vaultParams types.VaultParams, clobPair clobtypes.ClobPair) uint64 {
+	override := vaultParams.OrderStepBaseQuantumsOverride
+	if override != 0 && override%clobPair.StepBaseQuantums == 0 {
+		return override
+	}
+	return clobPair.StepBaseQuantums
+}
+
+// isVaultManualReferencePriceActive returns whether a vault has a manual reference price that
+// hasn't expired as of current block time.
+func isVaultManualReferencePriceActive(ctx sdk.Context, vaultParams types.VaultParams) bool {
+	manualPrice := vaultParams.ManualReferencePrice
+	return manualPrice != nil && ctx.BlockTime().Unix() < int64(manualPrice.ExpiryTime)
+}
+
+// getVaultMarketPrice returns the market price that a vault quotes at. This is the vault's
+// manual reference price if set and unexpired, the price of market `marketId` if the vault has
+// no price blend, and otherwise the weighted average of prices of markets in the blend, expressed
+// in the exponent of market `marketId`.
+func (k Keeper) getVaultMarketPrice(
+	ctx sdk.Context,
+	vaultParams types.VaultParams,
+	marketId uint32,
+) (pricestypes.MarketPrice, error) {
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, marketId)
+	if err != nil {
+		return marketPrice, err
+	}
+	if isVaultManualReferencePriceActive(ctx, vaultParams) {
+		marketPrice.Price = vaultParams.ManualReferencePrice.Price
+		return marketPrice, nil
+	}
+	if len(vaultParams.PriceBlend) == 0 {
+		return marketPrice, nil
+	}
+
+	// blended_price = sum(price_i * 10^(exponent_i - exponent) * weight_i) / 1_000_000
+	blendedPrice := new(big.Int)
+	for _, component := range vaultParams.PriceBlend {
+		componentPrice, err := k.pricesKeeper.GetMarketPrice(ctx, component.MarketId)
+		if err != nil {
+			return marketPrice, err
+		}
+		weightedPrice := new(big.Int).Mul(
+			new(big.Int).SetUint64(componentPrice.Price),
+			lib.BigU(component.WeightPpm),
+		)
+		blendedPrice.Add(
+			blendedPrice,
+			lib.BigIntMulPow10(weightedPrice, componentPrice.Exponent-marketPrice.Exponent, false),
+		)
+	}
+	blendedPrice.Quo(blendedPrice, lib.BigIntOneMillion())
+
+	// If blended price is not a valid uint64, e.g. due to components with much larger exponents
+	// than the vault's price market, return error.
+	if !blendedPrice.IsUint64() {
+		return marketPrice, errorsmod.Wrapf(
+			types.ErrInvalidPriceBlend,
+			"blended price %v of market %d is not a valid uint64",
+			blendedPrice,
+			marketId,
+		)
+	}
+	marketPrice.Price = blendedPrice.Uint64()
+
+	return marketPrice, nil
+}
+
+// GetVaultQuotedNotional returns the total notional (in quote quantums) of orders that a vault
+// would place on each side, i.e. `sum(size * subticks)` across layers converted to quote quantums.
+func (k Keeper) GetVaultQuotedNotional(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (bidNotional, askNotional *big.Int, err error) {
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	if err != nil {
+		return nil, nil, err
+	}
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return nil, nil, errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+
+	bidNotional, askNotional = new(big.Int), new(big.Int)
+	for _, order := range orders {
+		notional := clobtypes.FillAmountToQuoteQuantums(
+			order.GetOrderSubticks(),
+			order.GetBaseQuantums(),
+			clobPair.QuantumConversionExponent,
+		)
+		if order.IsBuy() {
+			bidNotional.Add(bidNotional, notional)
+		} else {
+			askNotional.Add(askNotional, notional)
+		}
+	}
+	return bidNotional, askNotional, nil
+}
+
+// getVaultFeeFloorPpm returns the round-trip fee of a vault in ppm, i.e. sum of taker
+// and maker fee ppm of the vault's fee tier, floored at zero.
+func (k Keeper) getVaultFeeFloorPpm(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) *big.Int {
+	vaultAddress := vaultId.ToSubaccountId().Owner
+	feeFloorPpm := big.NewInt(int64(k.feeTiersKeeper.GetPerpetualFeePpm(ctx, vaultAddress, true)))
+	feeFloorPpm.Add(
+		feeFloorPpm,
+		big.NewInt(int64(k.feeTiersKeeper.GetPerpetualFeePpm(ctx, vaultAddress, false))),
+	)
+	return lib.BigMax(feeFloorPpm, big.NewInt(0))
 }
 
 // GetVaultClobOrderIds returns a list of order IDs for a given CLOB vault.
@@ -370,6 +2140,29 @@ func (k Keeper) GetVaultClobOrderIds(
 			fmt.Sprintf("VaultId: %v", vaultId),
 		)
 	}
+	return k.getVaultClobOrderIds(ctx, vaultId, clobPair, k.GetParams(ctx)), nil
+}
+
+// GetNextBlockVaultClobOrderIds returns order IDs that a given CLOB vault will use in the
+// next block, i.e. order IDs as returned by `GetVaultClobOrderIds` at `blockHeight+1`.
+// As client IDs alternate on block height parity, these differ from current block's order
+// IDs only in the block height bit, which allows next block's orders to be placed without
+// cancelling current block's orders first.
+func (k Keeper) GetNextBlockVaultClobOrderIds(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (orderIds []*clobtypes.OrderId, err error) {
+	return k.GetVaultClobOrderIds(ctx.WithBlockHeight(ctx.BlockHeight()+1), vaultId)
+}
+
+// getVaultClobOrderIds returns order IDs of a CLOB vault given its clob pair and params.
+// See `GetVaultClobOrderIds` for how order IDs are ordered.
+func (k Keeper) getVaultClobOrderIds(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	clobPair clobtypes.ClobPair,
+	params types.Params,
+) (orderIds []*clobtypes.OrderId) {
 	vault := vaultId.ToSubaccountId()
 	constructOrderId := func(
 		side clobtypes.Order_Side,
@@ -383,9 +2176,8 @@ func (k Keeper) GetVaultClobOrderIds(
 		}
 	}
 
-	layers := k.GetParams(ctx).Layers
-	orderIds = make([]*clobtypes.OrderId, 2*layers)
-	for i := uint32(0); i < layers; i++ {
+	orderIds = make([]*clobtypes.OrderId, 2*params.Layers)
+	for i := uint32(0); i < params.Layers; i++ {
 		// Construct ask order ID at this layer.
 		orderIds[2*i] = constructOrderId(clobtypes.Order_SIDE_SELL, i)
 
@@ -393,16 +2185,106 @@ func (k Keeper) GetVaultClobOrderIds(
 		orderIds[2*i+1] = constructOrderId(clobtypes.Order_SIDE_BUY, i)
 	}
 
-	return orderIds, nil
+	return orderIds
+}
+
+// GetRestingVaultOrders returns the orders of a CLOB vault that are currently resting
+// in state. Only orders with IDs in the vault's long-term client ID space of current
+// or previous block are considered, ordered by previous block's IDs followed by current
+// block's IDs.
+func (k Keeper) GetRestingVaultOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (orders []clobtypes.Order, err error) {
+	previousOrderIds, err := k.GetVaultClobOrderIds(ctx.WithBlockHeight(ctx.BlockHeight()-1), vaultId)
+	if err != nil {
+		return orders, err
+	}
+	currentOrderIds, err := k.GetVaultClobOrderIds(ctx, vaultId)
+	if err != nil {
+		return orders, err
+	}
+
+	orders = make([]clobtypes.Order, 0)
+	for _, orderId := range append(previousOrderIds, currentOrderIds...) {
+		if placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId); exists {
+			orders = append(orders, placement.Order)
+		}
+	}
+
+	return orders, nil
+}
+
+// FindOrphanedVaultOrders returns resting orders of a CLOB vault in its client ID space, i.e.
+// of any layer and side with client IDs of either block height parity, that don't correspond
+// to any order that the vault currently generates, i.e. any order ID of the vault's last
+// refresh with current params. This includes orders at layers beyond current `layers` after
+// `layers` decreased and orders whose cancellation failed, but not orders kept by
+// `skip_unchanged_outer_layers`. All resting orders are orphaned if the vault hasn't refreshed
+// its orders. Orphaned orders are ordered by previous block's IDs followed by current block's IDs.
+func (k Keeper) FindOrphanedVaultOrders(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (orders []clobtypes.Order, err error) {
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return orders, errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	params := k.GetParams(ctx)
+
+	generatedOrderIds := make(map[clobtypes.OrderId]struct{})
+	if lastRefreshBlockHeight, exists := k.GetLastRefreshBlockHeight(ctx, vaultId); exists {
+		for _, orderId := range k.getVaultClobOrderIds(
+			ctx.WithBlockHeight(int64(lastRefreshBlockHeight)),
+			vaultId,
+			clobPair,
+			params,
+		) {
+			generatedOrderIds[*orderId] = struct{}{}
+			// An order kept by `skip_unchanged_outer_layers` has the order ID of the other block
+			// height parity and is generated if no order with the order ID of last refresh rests.
+			if !params.SkipUnchangedOuterLayers {
+				continue
+			}
+			if _, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId); !exists {
+				keptOrderId := *orderId
+				keptOrderId.ClientId ^= vaultOrderClientIdParityMask
+				generatedOrderIds[keptOrderId] = struct{}{}
+			}
+		}
+	}
+
+	allLayersParams := params
+	allLayersParams.Layers = math.MaxUint8
+	orderIds := append(
+		k.getVaultClobOrderIds(ctx.WithBlockHeight(ctx.BlockHeight()-1), vaultId, clobPair, allLayersParams),
+		k.getVaultClobOrderIds(ctx, vaultId, clobPair, allLayersParams)...,
+	)
+	orders = make([]clobtypes.Order, 0)
+	for _, orderId := range orderIds {
+		if _, generated := generatedOrderIds[*orderId]; generated {
+			continue
+		}
+		if placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, *orderId); exists {
+			orders = append(orders, placement.Order)
+		}
+	}
+
+	return orders, nil
 }
 
 // GetVaultClobOrderClientId returns the client ID for a CLOB order where
 // - 1st bit is `side-1` (subtract 1 as buy_side = 1, sell_side = 2)
 //
-// - 2nd bit is `block height % 2`
+// - 2nd bit is `|block height| % 2`
 //   - block height bit alternates between 0 and 1 to ensure that client IDs
 //     are different in two consecutive blocks (otherwise, order placement would
 //     fail because the same order IDs are already marked for cancellation)
+//   - a negative block height, which shouldn't happen, is taken by its absolute
+//     value so that its parity doesn't spill into the side bit
 //
 // - next 8 bits are `layer`
 func (k Keeper) GetVaultClobOrderClientId(
@@ -413,7 +2295,7 @@ func (k Keeper) GetVaultClobOrderClientId(
 	sideBit := uint32(side - 1)
 	sideBit <<= 31
 
-	blockHeightBit := uint32(ctx.BlockHeight() % 2)
+	blockHeightBit := k.GetCurrentBlockClientIdParity(ctx)
 	blockHeightBit <<= 30
 
 	layerBits := uint32(layer) << 22
@@ -421,12 +2303,32 @@ func (k Keeper) GetVaultClobOrderClientId(
 	return sideBit | blockHeightBit | layerBits
 }
 
+// GetCurrentBlockClientIdParity returns the block height parity that client IDs of vault orders
+// placed in the current block encode, i.e. `|block height| % 2`, which is 0 if block height is
+// even and 1 if odd. See `GetVaultClobOrderClientId`.
+func (k Keeper) GetCurrentBlockClientIdParity(ctx sdk.Context) uint32 {
+	blockHeight := ctx.BlockHeight()
+	if blockHeight < 0 {
+		blockHeight = -blockHeight
+	}
+	return uint32(blockHeight % 2)
+}
+
 // PlaceVaultClobOrder places a vault CLOB order as an order internal to the protocol,
-// skipping various logs, metrics, and validations.
+// skipping various logs, metrics, and validations. Placing an order that is already resting
+// with identical content is a no-op and returns no error, so that placement is idempotent.
 func (k Keeper) PlaceVaultClobOrder(
 	ctx sdk.Context,
 	order *clobtypes.Order,
 ) error {
 	// Place an internal clob order.
-	return k.clobKeeper.HandleMsgPlaceOrder(ctx, clobtypes.NewMsgPlaceOrder(*order), true)
+	err := k.clobKeeper.HandleMsgPlaceOrder(ctx, clobtypes.NewMsgPlaceOrder(*order), true)
+	if errors.Is(err, clobtypes.ErrStatefulOrderAlreadyExists) {
+		placement, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+		if exists && proto.Equal(&placement.Order, order) {
+			log.InfoLog(ctx, "Vault order is already resting", "orderId", order.OrderId)
+			return nil
+		}
+	}
+	return err
 }
@@ -1,24 +1,34 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"testing"
+	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	indexersharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetierstypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
 	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	"github.com/stretchr/testify/require"
 )
@@ -242,6 +252,508 @@ func TestRefreshAllVaultOrders(t *testing.T) {
 	}
 }
 
+func TestRefreshAllVaultOrders_RefreshStrategy(t *testing.T) {
+	tests := map[string]struct {
+		// Refresh strategy.
+		refreshStrategy vaulttypes.RefreshStrategy
+	}{
+		"Replace": {
+			refreshStrategy: vaulttypes.RefreshStrategy_REFRESH_STRATEGY_REPLACE,
+		},
+		"Cancel then place": {
+			refreshStrategy: vaulttypes.RefreshStrategy_REFRESH_STRATEGY_CANCEL_THEN_PLACE,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Enable testapp's indexer event manager
+			msgSender := msgsender.NewIndexerMessageSenderInMemoryCollector()
+			appOpts := map[string]interface{}{
+				indexer.MsgSenderInstanceForTest: msgSender,
+			}
+
+			// Initialize tApp and ctx (in deliverTx mode).
+			tApp := testapp.NewTestAppBuilder(t).WithAppOptions(appOpts).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.RefreshStrategy = tc.refreshStrategy
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
+
+			// Simulate vault orders placed in last block.
+			previousOrders, err := k.GetVaultClobOrders(ctx.WithBlockHeight(ctx.BlockHeight()-1), vaultId)
+			require.NoError(t, err)
+			require.NotEmpty(t, previousOrders)
+			for _, order := range previousOrders {
+				err := k.PlaceVaultClobOrder(ctx, order)
+				require.NoError(t, err)
+			}
+
+			// Refresh all vault orders.
+			k.RefreshAllVaultOrders(ctx)
+			expectedOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, expectedOrders, len(previousOrders))
+			require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), len(expectedOrders))
+
+			// Check that orders are replaced with a single replacement event per order with
+			// replace strategy and with a removal event per cancelled order followed by a
+			// placement event per placed order with cancel-then-place strategy.
+			expectedEventDataBytes := make([][]byte, 0)
+			switch tc.refreshStrategy {
+			case vaulttypes.RefreshStrategy_REFRESH_STRATEGY_REPLACE:
+				for i, order := range expectedOrders {
+					expectedEventDataBytes = append(expectedEventDataBytes, indexer_manager.GetBytes(
+						indexerevents.NewLongTermOrderReplacementEvent(previousOrders[i].OrderId, *order),
+					))
+				}
+			case vaulttypes.RefreshStrategy_REFRESH_STRATEGY_CANCEL_THEN_PLACE:
+				for _, order := range previousOrders {
+					expectedEventDataBytes = append(expectedEventDataBytes, indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							order.OrderId,
+							indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+						),
+					))
+				}
+				for _, order := range expectedOrders {
+					expectedEventDataBytes = append(expectedEventDataBytes, indexer_manager.GetBytes(
+						indexerevents.NewLongTermOrderPlacementEvent(*order),
+					))
+				}
+			}
+			block := k.GetIndexerEventManager().ProduceBlock(ctx)
+			require.Len(t, block.Events, len(expectedEventDataBytes))
+			for i, event := range block.Events {
+				require.Equal(t, indexerevents.SubtypeStatefulOrder, event.Subtype)
+				require.Equal(t, uint32(i), event.EventIndex)
+				require.Equal(t, expectedEventDataBytes[i], event.DataBytes)
+			}
+		})
+	}
+}
+
+func TestRefreshAllVaultOrders_ClientMetadata(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	// Enable testapp's indexer event manager
+	msgSender := msgsender.NewIndexerMessageSenderInMemoryCollector()
+	appOpts := map[string]interface{}{
+		indexer.MsgSenderInstanceForTest: msgSender,
+	}
+
+	// Initialize tApp and ctx (in deliverTx mode).
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(appOpts).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+	require.NoError(t, err)
+
+	// Simulate vault orders placed in last block with default client metadata of zero.
+	previousOrders, err := k.GetVaultClobOrders(ctx.WithBlockHeight(ctx.BlockHeight()-1), vaultId)
+	require.NoError(t, err)
+	require.NotEmpty(t, previousOrders)
+	for _, order := range previousOrders {
+		require.Zero(t, order.ClientMetadata)
+		err := k.PlaceVaultClobOrder(ctx, order)
+		require.NoError(t, err)
+	}
+
+	// Set client metadata and refresh all vault orders.
+	params := k.GetParams(ctx)
+	params.ClientMetadata = 7
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	k.RefreshAllVaultOrders(ctx)
+
+	// Check that generated and placed orders have the client metadata.
+	expectedOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, expectedOrders, len(previousOrders))
+	for _, order := range expectedOrders {
+		require.Equal(t, uint32(7), order.ClientMetadata)
+		placement, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+		require.True(t, exists)
+		require.Equal(t, uint32(7), placement.Order.ClientMetadata)
+	}
+
+	// Check that replacement events have the client metadata.
+	block := k.GetIndexerEventManager().ProduceBlock(ctx)
+	require.Len(t, block.Events, len(expectedOrders))
+	for i, event := range block.Events {
+		require.Equal(t, indexer_manager.GetBytes(
+			indexerevents.NewLongTermOrderReplacementEvent(previousOrders[i].OrderId, *expectedOrders[i]),
+		), event.DataBytes)
+		var statefulOrderEvent indexerevents.StatefulOrderEventV1
+		err := statefulOrderEvent.Unmarshal(event.DataBytes)
+		require.NoError(t, err)
+		require.Equal(t, uint32(7), statefulOrderEvent.GetOrderReplacement().Order.ClientMetadata)
+	}
+}
+
+// setUpManyVaults returns a test app with `numVaults` CLOB vaults, each with 1,000 USDC and
+// positive total shares. Vault `i` quotes on clob pair `i`, whose perpetual uses BTC market.
+func setUpManyVaults(tb testing.TB, numVaults uint32) (*testapp.TestApp, sdk.Context) {
+	tApp := testapp.NewTestAppBuilder(tb).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *perptypes.GenesisState) {
+				basePerpetual := genesisState.Perpetuals[0]
+				for i := uint32(len(genesisState.Perpetuals)); i < numVaults; i++ {
+					perpetual := basePerpetual
+					perpetual.Params.Id = i
+					perpetual.Params.Ticker = fmt.Sprintf("BTC-USD-%d", i)
+					genesisState.Perpetuals = append(genesisState.Perpetuals, perpetual)
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *clobtypes.GenesisState) {
+				baseClobPair := genesisState.ClobPairs[0]
+				for i := uint32(len(genesisState.ClobPairs)); i < numVaults; i++ {
+					clobPair := baseClobPair
+					clobPair.Id = i
+					clobPair.Metadata = &clobtypes.ClobPair_PerpetualClobMetadata{
+						PerpetualClobMetadata: &clobtypes.PerpetualClobMetadata{
+							PerpetualId: i,
+						},
+					}
+					genesisState.ClobPairs = append(genesisState.ClobPairs, clobPair)
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = make([]satypes.Subaccount, numVaults)
+				for i := uint32(0); i < numVaults; i++ {
+					vaultId := vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: i}
+					genesisState.Subaccounts[i] = satypes.Subaccount{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					}
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+
+	for i := uint32(0); i < numVaults; i++ {
+		vaultId := vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: i}
+		err := tApp.App.VaultKeeper.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+		require.NoError(tb, err)
+	}
+	return tApp, ctx
+}
+
+func TestGetMarketsWithActiveVaults(t *testing.T) {
+	tApp, ctx := setUpManyVaults(t, 5)
+	k := tApp.App.VaultKeeper
+
+	// All vaults are active and quoting.
+	require.Equal(t, []uint32{0, 1, 2, 3, 4}, k.GetMarketsWithActiveVaults(ctx))
+
+	// Vault 1 has no shares.
+	err := k.SetTotalShares(
+		ctx,
+		vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: 1},
+		vaulttypes.BigIntToNumShares(big.NewInt(0)),
+	)
+	require.NoError(t, err)
+	// Vault 2 is below activation threshold.
+	vault2Id := vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: 2}
+	tApp.App.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+		Id: vault2Id.ToSubaccountId(),
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(assettypes.AssetUsdc.Id, big.NewInt(999_999_999)),
+		},
+	})
+	// Vault 3 is active but places no orders as oracle price is above its max oracle price.
+	err = k.SetVaultParams(
+		ctx,
+		vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: 3},
+		vaulttypes.VaultParams{MaxOraclePrice: 1},
+	)
+	require.NoError(t, err)
+	// A vault on a non-existent clob pair has no equity.
+	err = k.SetTotalShares(
+		ctx,
+		vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: 99},
+		vaulttypes.BigIntToNumShares(big.NewInt(1_000)),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint32{0, 4}, k.GetMarketsWithActiveVaults(ctx))
+}
+
+func TestRefreshAllVaultOrders_ActivationInclusive(t *testing.T) {
+	tests := map[string]struct {
+		// Whether a vault at exactly activation threshold activates.
+		activationInclusive bool
+		// Asset quantums of the vault.
+		assetQuantums *big.Int
+		// Whether the vault is expected to be activated.
+		expectedActivated bool
+	}{
+		"Inclusive, Exactly at Activation Threshold": {
+			activationInclusive: true,
+			assetQuantums:       big.NewInt(1_000_000_000),
+			expectedActivated:   true,
+		},
+		"Exclusive, Exactly at Activation Threshold": {
+			activationInclusive: false,
+			assetQuantums:       big.NewInt(1_000_000_000),
+			expectedActivated:   false,
+		},
+		"Exclusive, Above Activation Threshold": {
+			activationInclusive: false,
+			assetQuantums:       big.NewInt(1_000_000_001),
+			expectedActivated:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.assetQuantums,
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.ActivationThresholdQuoteQuantums = dtypes.NewInt(1_000_000_000)
+						genesisState.Params.ActivationInclusive = tc.activationInclusive
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
+
+			// Check that activation and quoting status queries agree with refresh.
+			require.Equal(t, tc.expectedActivated, k.IsVaultActive(ctx, vaultId))
+			quotingStatus, err := k.VaultQuotingStatus(ctx, &vaulttypes.QueryVaultQuotingStatusRequest{
+				Type:   vaultId.Type,
+				Number: vaultId.Number,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedActivated, quotingStatus.Quoting)
+			if !tc.expectedActivated {
+				require.Equal(t, vaulttypes.QuotingStatusReasonBelowActivationThreshold, quotingStatus.Reason)
+			}
+
+			// Check that vault is activated and places orders only if expected.
+			k.RefreshAllVaultOrders(ctx)
+			require.Equal(t, tc.expectedActivated, k.GetVaultActivated(ctx, vaultId))
+			if tc.expectedActivated {
+				require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), int(2*k.GetParams(ctx).Layers))
+			} else {
+				require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+			}
+		})
+	}
+}
+
+func TestRefreshAllVaultOrders_ActivationThresholdMode(t *testing.T) {
+	tests := map[string]struct {
+		// Activation threshold mode.
+		activationThresholdMode vaulttypes.ActivationThresholdMode
+		// Asset quantums of the vault.
+		assetQuantums *big.Int
+		// Whether the vault is expected to be activated.
+		expectedActivated bool
+	}{
+		"Static, Exactly at Activation Threshold": {
+			activationThresholdMode: vaulttypes.ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_STATIC,
+			assetQuantums:           big.NewInt(1_000_000_000),
+			expectedActivated:       true,
+		},
+		"Static, Below Activation Threshold": {
+			activationThresholdMode: vaulttypes.ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_STATIC,
+			assetQuantums:           big.NewInt(999_999_999),
+			expectedActivated:       false,
+		},
+		"Static, Above Dynamic Threshold": {
+			activationThresholdMode: vaulttypes.ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_STATIC,
+			assetQuantums:           big.NewInt(4_000_000),
+			expectedActivated:       false,
+		},
+		"Dynamic, Exactly at Activation Threshold": {
+			activationThresholdMode: vaulttypes.ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_DYNAMIC,
+			assetQuantums:           big.NewInt(4_000_000),
+			expectedActivated:       true,
+		},
+		"Dynamic, Below Activation Threshold": {
+			activationThresholdMode: vaulttypes.ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_DYNAMIC,
+			assetQuantums:           big.NewInt(3_999_999),
+			expectedActivated:       false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.assetQuantums,
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.ActivationThresholdQuoteQuantums = dtypes.NewInt(1_000_000_000)
+						genesisState.Params.ActivationInclusive = true
+						genesisState.Params.ActivationThresholdMode = tc.activationThresholdMode
+						// Minimum order of 1_000_000 base quantums is 2 USDC at a BTC price of 20,000 USDC.
+						// With 2 layers, dynamic activation threshold is 4 USDC.
+						genesisState.Params.Layers = 2
+						genesisState.Params.MinLotBaseQuantums = 1_000_000
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
+
+			// Check that vault is activated only if expected.
+			k.RefreshAllVaultOrders(ctx)
+			require.Equal(t, tc.expectedActivated, k.GetVaultActivated(ctx, vaultId))
+			if !tc.expectedActivated {
+				require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+			}
+		})
+	}
+}
+
+func TestRefreshAllVaultOrders_MatchesRefreshVaultClobOrders(t *testing.T) {
+	numVaults := uint32(10)
+
+	// Refresh orders of all vaults at once.
+	tAppAll, ctxAll := setUpManyVaults(t, numVaults)
+	tAppAll.App.VaultKeeper.RefreshAllVaultOrders(ctxAll)
+
+	// Refresh orders of each vault individually.
+	tAppEach, ctxEach := setUpManyVaults(t, numVaults)
+	for i := uint32(0); i < numVaults; i++ {
+		vaultId := vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: i}
+		err := tAppEach.App.VaultKeeper.RefreshVaultClobOrders(ctxEach, vaultId)
+		require.NoError(t, err)
+	}
+
+	// Check that both ways place the same orders.
+	for i := uint32(0); i < numVaults; i++ {
+		vaultId := vaulttypes.VaultId{Type: vaulttypes.VaultType_VAULT_TYPE_CLOB, Number: i}
+		ordersAll, err := tAppAll.App.VaultKeeper.GetRestingVaultOrders(ctxAll, vaultId)
+		require.NoError(t, err)
+		ordersEach, err := tAppEach.App.VaultKeeper.GetRestingVaultOrders(ctxEach, vaultId)
+		require.NoError(t, err)
+		require.Len(t, ordersAll, int(2*vaulttypes.DefaultParams().Layers))
+		require.Equal(t, ordersEach, ordersAll)
+	}
+}
+
+func BenchmarkRefreshAllVaultOrders(b *testing.B) {
+	tApp, ctx := setUpManyVaults(b, 500)
+	k := tApp.App.VaultKeeper
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	gasConsumed := uint64(0)
+	for i := 0; i < b.N; i++ {
+		// Refresh on a cache context so that each iteration refreshes orders of all vaults
+		// from the same state.
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		k.RefreshAllVaultOrders(cacheCtx)
+		gasConsumed += cacheCtx.GasMeter().GasConsumed()
+	}
+	// Gas consumed reflects the number and size of store reads and writes.
+	b.ReportMetric(float64(gasConsumed)/float64(b.N), "gas/op")
+}
+
 func TestRefreshVaultClobOrders(t *testing.T) {
 	tests := map[string]struct {
 		/* --- Setup --- */
@@ -319,349 +831,3803 @@ func TestRefreshVaultClobOrders(t *testing.T) {
 	}
 }
 
-func TestGetVaultClobOrders(t *testing.T) {
-	tests := map[string]struct {
-		/* --- Setup --- */
-		// Vault params.
-		vaultParams vaulttypes.Params
-		// Vault ID.
-		vaultId vaulttypes.VaultId
-		// Vault asset.
-		vaultAssetQuoteQuantums *big.Int
-		// Vault inventory.
-		vaultInventoryBaseQuantums *big.Int
-		// Clob pair.
-		clobPair clobtypes.ClobPair
-		// Market param.
-		marketParam pricestypes.MarketParam
-		// Market price.
-		marketPrice pricestypes.MarketPrice
-		// Perpetual.
-		perpetual perptypes.Perpetual
+func TestRefreshVaultClobOrders_Liquidatable(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
 
-		/* --- Expectations --- */
-		expectedOrderSubticks []uint64
-		expectedOrderQuantums []uint64
-		expectedErr           error
+	// Vault places orders while it's healthy.
+	err := k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), int(params.Layers*2))
+
+	// Make vault subaccount liquidatable with a long position of 1 BTC ($20,000) and
+	// -19,900 USDC, which results in an equity of $100.
+	tApp.App.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+		Id: vaultId.ToSubaccountId(),
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(
+				assettypes.AssetUsdc.Id,
+				big.NewInt(-19_900_000_000),
+			),
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				0,
+				big.NewInt(10_000_000_000),
+				big.NewInt(0),
+			),
+		},
+	})
+	isLiquidatable, err := tApp.App.ClobKeeper.IsLiquidatable(ctx, *vaultId.ToSubaccountId())
+	require.NoError(t, err)
+	require.True(t, isLiquidatable)
+
+	// Check that resting orders are cancelled and no new orders are placed.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 0)
+
+	// Check that a vault_liquidatable event is emitted.
+	require.Contains(t, ctx.EventManager().Events(), vaulttypes.NewVaultLiquidatableEvent(vaultId))
+}
+
+func TestRefreshVaultClobOrders_MarketInactive(t *testing.T) {
+	tests := map[string]struct {
+		// Status of the vault's clob pair at genesis.
+		genesisStatus clobtypes.ClobPair_Status
+		// Status that the vault's clob pair is updated to after vault places orders.
+		updatedStatus clobtypes.ClobPair_Status
 	}{
-		"Success - Get orders from Vault for Clob Pair 0": {
-			vaultParams: vaulttypes.Params{
-				Layers:                           2,       // 2 layers
-				SpreadMinPpm:                     3_123,   // 31.23 bps
-				SpreadBufferPpm:                  1_500,   // 15 bps
-				SkewFactorPpm:                    554_321, // 0.554321
-				OrderSizePctPpm:                  100_000, // 10%
-				OrderExpirationSeconds:           2,       // 2 seconds
-				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
-			},
-			vaultId:                    constants.Vault_Clob0,
-			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
-			vaultInventoryBaseQuantums: big.NewInt(0),
-			clobPair:                   constants.ClobPair_Btc,
-			marketParam:                constants.TestMarketParams[0],
-			marketPrice: pricestypes.MarketPrice{
-				Id:       0,
-				Exponent: -5,
-				Price:    5_000_000, // $50
-			},
-			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
-			// To calculate order subticks:
-			// 1. spread = max(spread_min, spread_buffer + min_price_change)
-			// 2. leverage = open_notional / equity
-			// 3. leverage_i = leverage +/- i * order_size_pct (- for ask and + for bid)
-			// 4. skew_i = -leverage_i * spread * skew_factor
-			// 5. a_i = max(oracle_price * (1 + skew_i + spread * {i+1}), oracle_price)
-			//    b_i = min(oracle_price * (1 + skew_i - spread * {i+1}), oracle_price)
-			// 6. subticks needs to be a multiple of subticks_per_tick (round up for asks, round down for bids)
-			// To calculate size of each order
-			// 1. `order_size_pct_ppm * equity / oracle_price`.
-			expectedOrderSubticks: []uint64{
-				// spreadPpm = max(3_123, 1_500 + 50) = 3_123
-				// spread = 0.003123
-				// leverage = 0 / 1_000 = 0
-				// oracleSubticks = 5_000_000_000 * 10^(-5 - (-8) + (-10) - (-6)) = 5e8
-				// leverage_0 = leverage = 0
-				// skew_0 = -0 * 3_123 * 0.554321 = 0
-				// a_0 = 5e5 * (1 + 0 + 0.003123*1) = 501_561.5 = 501_565 (rounded up to 5)
-				501_565,
-				// b_0 = 5e5 * (1 + 0 - 0.003123*1) = 498_438.5 = 498435 (rounded down to 5)
-				498_435,
-				// leverage_1 = leverage - 0.1 = -0.1
-				// skew_1 = 0.1 * 0.003123 * 0.554321 ~= 0.000173
-				// a_1 = 5e5 * (1 + 0.000173 + 0.003123*2) = 503209.5 ~= 503_210 (rounded up to 5)
-				503_210,
-				// leverage_1 = leverage + 0.1 = 0.1
-				// skew_1 = -0.1 * 0.003123 * 0.554321 = -0.000173
-				// b_2 = 5e5 * (1 - 0.000173 - 0.003123*2) = 496790.5 ~= 496_790 (rounded down to 5)
-				496_790,
-			},
-			// order_size = 10% * $1_000 / $50 = 2
-			// order_size_base_quantums = 2 * 10^10 = 20_000_000_000
-			expectedOrderQuantums: []uint64{
-				20_000_000_000,
-				20_000_000_000,
-				20_000_000_000,
-				20_000_000_000,
-			},
+		"Initializing": {
+			genesisStatus: clobtypes.ClobPair_STATUS_INITIALIZING,
+			updatedStatus: clobtypes.ClobPair_STATUS_INITIALIZING,
 		},
-		"Success - Get orders from Vault for Clob Pair 1, bids bounded by oracle price.": {
-			vaultParams: vaulttypes.Params{
-				Layers:                           3,       // 3 layers
-				SpreadMinPpm:                     3_000,   // 30 bps
-				SpreadBufferPpm:                  8_500,   // 85 bps
-				SkewFactorPpm:                    900_000, // 0.9
-				OrderSizePctPpm:                  200_000, // 20%
-				OrderExpirationSeconds:           4,       // 4 seconds
-				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
-			},
-			vaultId:                    constants.Vault_Clob1,
-			vaultAssetQuoteQuantums:    big.NewInt(2_000_000_000), // 2,000 USDC
-			vaultInventoryBaseQuantums: big.NewInt(-500_000_000),  // -0.5 ETH
-			clobPair:                   constants.ClobPair_Eth,
-			marketParam:                constants.TestMarketParams[1],
-			marketPrice:                constants.TestMarketPrices[1],
-			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
-			// To calculate order subticks:
-			// 1. spread = max(spread_min, spread_buffer + min_price_change)
-			// 2. leverage = open_notional / equity
-			// 3. leverage_i = leverage +/- i * order_size_pct (- for ask and + for bid)
-			// 4. skew_i = -leverage_i * spread * skew_factor
-			// 5. a_i = max(oracle_price * (1 + skew_i + spread*{i+1}), oracle_price)
-			//    b_i = min(oracle_price * (1 + skew_i - spread*{i+1}), oracle_price)
-			// 6. subticks needs to be a multiple of subticks_per_tick (round up for asks, round down for bids)
-			// To calculate size of each order
-			// 1. `order_size_pct_ppm * equity / oracle_price`.
-			expectedOrderSubticks: []uint64{
-				// spreadPpm = max(3_000, 8_500 + 50) = 8_550
-				// spread = 0.00855
-				// open_notional = -500_000_000 * 10^-9 * 3_000 * 10^6 = -1_500_000_000
-				// leverage = -1_500_000_000 / (2_000_000_000 - 1_500_000_000) = -3
-				// oracleSubticks = 3_000_000_000 * 10^(-6 - (-9) + (-9) - (-6)) = 3e9
-				// leverage_0 = leverage - 0 * 0.2 = -3
-				// skew_0 = 3 * 0.00855 * 0.9
-				// a_0 = 3e9 * (1 + skew_0 + 0.00855*1) = 3_094_905_000
-				// a_0 = max(a_0, oracle_price) = 3_094_905_000
-				3_094_905_000,
-				// b_0 = 3e9 * (1 + skew_0 - 0.00855*1) = 3_043_605_000
-				// b_0 = min(b_0, oracle_price) = 3e9 (bound)
-				3_000_000_000,
-				// leverage_1 = leverage - 1 * 0.2
-				// skew_1 = -leverage_1 * 0.00855 * 0.9
-				// a_1 = 3e9 * (1 + skew_1 + 0.00855*2) = 3_125_172_000
-				// a_1 = max(a_1, oracle_price) = 3_125_172_000
-				3_125_172_000,
-				// leverage_1 = leverage + 1 * 0.2
-				// skew_1 = -leverage_1 * 0.00855 * 0.9
-				// b_1 = 3e9 * (1 + skew_1 - 0.00855*2) = 3_013_338_000
-				// b_1 = min(b_1, oracle_price) = 3e9 (bound)
-				3_000_000_000,
-				// leverage_2 = leverage - 2 * 0.2
-				// skew_2 = -leverage_2 * 0.00855 * 0.9
-				// a_2 = 3e9 * (1 + skew_2 + 0.00855*3) = 3_155_439_000
-				// a_2 = max(a_2, oracle_price) = 3_155_439_000
-				3_155_439_000,
-				// leverage_2 = leverage + 2 * 0.2
-				// skew_2 = -leverage_2 * 0.00855 * 0.9
-				// b_2 = 3e9 * (1 + skew_2 - 0.00855*3) = 2_983_071_000
-				// b_2 = min(b_2, oracle_price) = 2_983_071_000
-				2_983_071_000,
-			},
-			// order_size = 20% * 500 / 3000 ~= 0.0333333333
-			// order_size_base_quantums = 0.0333333333e9 ~= 33_333_333.33
-			// round down to nearest multiple of step_base_quantums=1_000.
-			expectedOrderQuantums: []uint64{
-				33_333_000,
-				33_333_000,
-				33_333_000,
-				33_333_000,
-				33_333_000,
-				33_333_000,
+		"Delisted": {
+			genesisStatus: clobtypes.ClobPair_STATUS_ACTIVE,
+			updatedStatus: clobtypes.ClobPair_STATUS_FINAL_SETTLEMENT,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs[0].Status = tc.genesisStatus
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+
+			// Vault places orders only if its clob pair is active.
+			err := k.RefreshVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			if tc.genesisStatus == clobtypes.ClobPair_STATUS_ACTIVE {
+				params := k.GetParams(ctx)
+				require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), int(params.Layers*2))
+			}
+
+			if tc.updatedStatus != tc.genesisStatus {
+				clobPair, exists := tApp.App.ClobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+				require.True(t, exists)
+				clobPair.Status = tc.updatedStatus
+				err = tApp.App.ClobKeeper.UpdateClobPair(ctx, clobPair)
+				require.NoError(t, err)
+			}
+
+			// Check that vault doesn't error, has no resting orders, and places no new orders.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err = k.RefreshVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 0)
+			refreshes := k.GetVaultRefreshHistory(ctx, vaultId).Refreshes
+			require.NotEmpty(t, refreshes)
+			require.Equal(t, vaulttypes.RefreshSkipReasonMarketInactive, refreshes[len(refreshes)-1].SkipReason)
+
+			// Check that a vault_market_inactive event is emitted.
+			require.Contains(
+				t,
+				ctx.EventManager().Events(),
+				vaulttypes.NewVaultMarketInactiveEvent(vaultId, tc.updatedStatus),
+			)
+		})
+	}
+}
+
+func TestRefreshVaultClobOrders_Undercollateralized(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
 			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	advanceBlock := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+			BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		})
+	}
+	getRestingOrderSides := func() (sides []clobtypes.Order_Side) {
+		for _, order := range tApp.App.ClobKeeper.GetAllStatefulOrders(ctx) {
+			sides = append(sides, order.Side)
+		}
+		return sides
+	}
+
+	// Vault places orders on both sides while it's healthy.
+	err := k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t,
+		[]clobtypes.Order_Side{
+			clobtypes.Order_SIDE_SELL,
+			clobtypes.Order_SIDE_BUY,
+			clobtypes.Order_SIDE_SELL,
+			clobtypes.Order_SIDE_BUY,
 		},
-		"Success - Get orders from Vault for Clob Pair 1, asks bounded by oracle price.": {
-			vaultParams: vaulttypes.Params{
-				Layers:                           2,         // 2 layers
-				SpreadMinPpm:                     3_000,     // 30 bps
-				SpreadBufferPpm:                  1_500,     // 15 bps
-				SkewFactorPpm:                    500_000,   // 0.5
-				OrderSizePctPpm:                  1_000_000, // 100%
-				OrderExpirationSeconds:           4,         // 4 seconds
-				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+		getRestingOrderSides(),
+	)
+
+	// Make vault subaccount undercollateralized but not liquidatable with a long position of
+	// 1 BTC ($20,000) and -19,200 USDC, which results in an equity of $800.
+	tApp.App.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+		Id: vaultId.ToSubaccountId(),
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(
+				assettypes.AssetUsdc.Id,
+				big.NewInt(-19_200_000_000),
+			),
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				0,
+				big.NewInt(10_000_000_000),
+				big.NewInt(0),
+			),
+		},
+	})
+	isLiquidatable, err := tApp.App.ClobKeeper.IsLiquidatable(ctx, *vaultId.ToSubaccountId())
+	require.NoError(t, err)
+	require.False(t, isLiquidatable)
+	_, _, freeCollateral, err := k.GetVaultMargin(ctx, vaultId)
+	require.NoError(t, err)
+	require.Negative(t, freeCollateral.Sign())
+
+	// Check that resting orders are cancelled, vault enters close-only mode, and a
+	// vault_close_only event is emitted.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Empty(t, getRestingOrderSides())
+	require.True(t, k.GetVaultCloseOnly(ctx, vaultId))
+	require.Contains(t, ctx.EventManager().Events(), vaulttypes.NewVaultCloseOnlyEvent(vaultId, freeCollateral))
+
+	// Check that vault only places asks, which reduce its long position, in next block.
+	advanceBlock()
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]clobtypes.Order_Side{clobtypes.Order_SIDE_SELL, clobtypes.Order_SIDE_SELL},
+		getRestingOrderSides(),
+	)
+	require.True(t, k.GetVaultCloseOnly(ctx, vaultId))
+
+	// Make vault subaccount healthy again with an equity of $10,000.
+	tApp.App.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+		Id: vaultId.ToSubaccountId(),
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(
+				assettypes.AssetUsdc.Id,
+				big.NewInt(-10_000_000_000),
+			),
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				0,
+				big.NewInt(10_000_000_000),
+				big.NewInt(0),
+			),
+		},
+	})
+
+	// Check that vault exits close-only mode and quotes both sides in next block.
+	advanceBlock()
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t,
+		[]clobtypes.Order_Side{
+			clobtypes.Order_SIDE_SELL,
+			clobtypes.Order_SIDE_BUY,
+			clobtypes.Order_SIDE_SELL,
+			clobtypes.Order_SIDE_BUY,
+		},
+		getRestingOrderSides(),
+	)
+	require.False(t, k.GetVaultCloseOnly(ctx, vaultId))
+}
+
+func TestPlaceVaultClobOrder_Duplicate(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
 			},
-			vaultId:                    constants.Vault_Clob1,
-			vaultAssetQuoteQuantums:    big.NewInt(-2_000_000_000), // -2,000 USDC
-			vaultInventoryBaseQuantums: big.NewInt(1_000_000_000),  // 1 ETH
-			clobPair:                   constants.ClobPair_Eth,
-			marketParam:                constants.TestMarketParams[1],
-			marketPrice:                constants.TestMarketPrices[1],
-			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
-			// To calculate order subticks:
-			// 1. spread = max(spread_min, spread_buffer + min_price_change)
-			// 2. leverage = open_notional / equity
-			// 3. leverage_i = leverage +/- i * order_size_pct (- for ask and + for bid)
-			// 4. skew_i = -leverage_i * spread * skew_factor
-			// 5. a_i = max(oracle_price * (1 + skew_i + spread*{i+1}), oracle_price)
-			//    b_i = min(oracle_price * (1 + skew_i - spread*{i+1}), oracle_price)
-			// 6. subticks needs to be a multiple of subticks_per_tick (round up for asks, round down for bids)
-			// To calculate size of each order
-			// 1. `order_size_pct_ppm * equity / oracle_price`.
-			expectedOrderSubticks: []uint64{
-				// spreadPpm = max(3_000, 1_500 + 50) = 3_000
-				// spread = 0.003
-				// open_notional = 1_000_000_000 * 10^-9 * 3_000 * 10^6 = 3_000_000_000
-				// leverage = 3_000_000_000 / (-2_000_000_000 + 3_000_000_000) = 3
-				// oracleSubticks = 3_000_000_000 * 10^(-6 - (-9) + (-9) - (-6)) = 3e9
-				// leverage_0 = leverage - 0 * 1 = 3
-				// skew_0 = -3 * 0.003 * 0.5
-				// a_0 = 3e9 * (1 + skew_0 + 0.003*1) = 2_995_500_000
-				// a_0 = max(a_0, oracle_price) = 3e9 (bound)
-				3_000_000_000,
-				// b_0 = 3e9 * (1 + skew_0 - 0.003*1) = 2_977_500_000
-				// b_0 = min(b_0, oracle_price) = 2_977_500_000
-				2_977_500_000,
-				// leverage_1 = leverage - 1 * 1 = 2
-				// skew_1 = -2 * 0.003 * .5
-				// a_1 = 3e9 * (1 + skew_1 + 0.003*2) = 3_009_000_000
-				// a_1 = max(a_1, oracle_price) = 3_009_000_000
-				3_009_000_000,
-				// leverage_1 = leverage + 1 * 1 = 4
-				// skew_1 = -4 * 0.003 * .5
-				// b_1 = 3e9 * (1 + skew_1 - 0.003*2) = 2_964_000_000
-				// b_1 = min(b_1, oracle_price) = 2_964_000_000
-				2_964_000_000,
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.NotEmpty(t, orders)
+	order := orders[0]
+
+	// Place an order.
+	err = k.PlaceVaultClobOrder(ctx, order)
+	require.NoError(t, err)
+	require.Equal(t, []clobtypes.Order{*order}, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+
+	// Placing the same order again is a no-op success.
+	err = k.PlaceVaultClobOrder(ctx, order)
+	require.NoError(t, err)
+	require.Equal(t, []clobtypes.Order{*order}, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+
+	// Placing an order with the same order ID but different content fails.
+	differentOrder := *order
+	differentOrder.Subticks += uint64(10_000)
+	err = k.PlaceVaultClobOrder(ctx, &differentOrder)
+	require.ErrorIs(t, err, clobtypes.ErrStatefulOrderAlreadyExists)
+	require.Equal(t, []clobtypes.Order{*order}, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+}
+
+func TestCancelAllOrdersForVaultSubaccount(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	// Enable testapp's indexer event manager
+	msgSender := msgsender.NewIndexerMessageSenderInMemoryCollector()
+	appOpts := map[string]interface{}{
+		indexer.MsgSenderInstanceForTest: msgSender,
+	}
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(appOpts).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
 			},
-			// order_size = 100% * 1000 / 3000 ~= 0.333333333
-			// order_size_base_quantums = 0.333333333e9 ~= 333_333_333.33
-			// round down to nearest multiple of step_base_quantums=1_000.
-			expectedOrderQuantums: []uint64{
-				333_333_000,
-				333_333_000,
-				333_333_000,
-				333_333_000,
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+
+	// Place vault orders with client IDs of last block and of current block.
+	previousOrders, err := k.GetVaultClobOrders(ctx.WithBlockHeight(ctx.BlockHeight()-1), vaultId)
+	require.NoError(t, err)
+	currentOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	restingOrders := append(previousOrders, currentOrders...)
+	for _, order := range restingOrders {
+		err := k.PlaceVaultClobOrder(ctx, order)
+		require.NoError(t, err)
+	}
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), len(restingOrders))
+	k.SetLastRefreshBlockHeight(ctx, vaultId, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+
+	// Cancel all vault orders.
+	numOrdersCancelled, err := k.CancelAllOrdersForVaultSubaccount(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(len(restingOrders)), numOrdersCancelled)
+	require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+	_, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+	require.False(t, exists)
+
+	// Check that an indexer order removal event is sent for each cancelled order.
+	block := tApp.App.VaultKeeper.GetIndexerEventManager().ProduceBlock(ctx)
+	require.Len(t, block.Events, len(restingOrders))
+	expectedEvents := make([]indexer_manager.IndexerTendermintEvent, len(restingOrders))
+	for i, order := range restingOrders {
+		expectedEvents[i] = indexer_manager.IndexerTendermintEvent{
+			Subtype: indexerevents.SubtypeStatefulOrder,
+			OrderingWithinBlock: &indexer_manager.IndexerTendermintEvent_TransactionIndex{
+				TransactionIndex: 0,
 			},
-		},
-		"Success - Get orders from Vault for Clob Pair 1, No Orders due to Zero Order Size": {
-			vaultParams: vaulttypes.Params{
-				Layers:                           2,       // 2 layers
-				SpreadMinPpm:                     3_000,   // 30 bps
-				SpreadBufferPpm:                  1_500,   // 15 bps
+			EventIndex: uint32(i),
+			Version:    indexerevents.StatefulOrderEventVersion,
+			DataBytes: indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					order.OrderId,
+					indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+				),
+			),
+		}
+	}
+	for _, event := range block.Events {
+		require.Contains(t, expectedEvents, *event)
+	}
+
+	// Check that cancelling again is a no-op.
+	numOrdersCancelled, err = k.CancelAllOrdersForVaultSubaccount(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), numOrdersCancelled)
+
+	// Check that cancelling orders of a vault without a clob pair fails.
+	_, err = k.CancelAllOrdersForVaultSubaccount(ctx, vaulttypes.VaultId{
+		Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+		Number: 999,
+	})
+	require.ErrorIs(t, err, vaulttypes.ErrClobPairNotFound)
+}
+
+func TestRefreshVaultClobOrders_ZeroLayers(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+
+	// Vault places orders with 3 layers.
+	params := k.GetParams(ctx)
+	params.Layers = 3
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 6)
+
+	// Set layers to zero.
+	params.Layers = 0
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// Check that no orders are constructed.
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Empty(t, orders)
+
+	// Check that resting orders are cancelled, no new orders are placed, and last refresh
+	// is cleared.
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 0)
+	_, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+	require.False(t, exists)
+	_, exists = k.GetLastRefreshBlockTime(ctx, vaultId)
+	require.False(t, exists)
+
+	// Check that refreshing again is a no-op without error.
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 0)
+}
+
+func TestRefreshVaultClobOrders_NoSelfCross(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+
+	// Vault places orders with 3 layers.
+	params := k.GetParams(ctx)
+	params.Layers = 3
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 6)
+
+	// Reduce layers to 1, such that orders at layers 1 and 2 are not replaced, and increase
+	// oracle price by 5%, such that new layer-0 bid is above stale layer-1 ask.
+	params.Layers = 1
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	marketPrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	err = tApp.App.PricesKeeper.UpdateMarketPrices(
+		ctx,
+		[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: marketPrice.Price * 21 / 20}},
+	)
+	require.NoError(t, err)
+
+	// Refresh vault orders in next block.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+		BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+	})
+	ordersToPlace, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, ordersToPlace, 2)
+	err = k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+
+	// Check that new orders are placed and that no resting bid of the vault is at or above
+	// a resting ask of the vault.
+	restingOrders := tApp.App.ClobKeeper.GetAllStatefulOrders(ctx)
+	for _, order := range ordersToPlace {
+		require.Contains(t, restingOrders, *order)
+	}
+	for _, bid := range restingOrders {
+		if bid.Side != clobtypes.Order_SIDE_BUY {
+			continue
+		}
+		for _, ask := range restingOrders {
+			if ask.Side == clobtypes.Order_SIDE_SELL {
+				require.Less(t, bid.Subticks, ask.Subticks, "bid %+v crosses ask %+v", bid, ask)
+			}
+		}
+	}
+}
+
+func TestRefreshVaultClobOrders_MinRefreshIntervalBlocks(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Minimum number of blocks between two refreshes.
+		minRefreshIntervalBlocks uint32
+
+		/* --- Expectations --- */
+		// Blocks at which vault orders are refreshed (among blocks 1 to 9).
+		expectedRefreshBlocks []uint32
+	}{
+		"Interval 0, Refresh Every Block": {
+			minRefreshIntervalBlocks: 0,
+			expectedRefreshBlocks:    []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		"Interval 1, Refresh Every Block": {
+			minRefreshIntervalBlocks: 1,
+			expectedRefreshBlocks:    []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		"Interval 3, Refresh Every 3 Blocks": {
+			minRefreshIntervalBlocks: 3,
+			expectedRefreshBlocks:    []uint32{1, 4, 7},
+		},
+		"Interval 5, Refresh Every 5 Blocks": {
+			minRefreshIntervalBlocks: 5,
+			expectedRefreshBlocks:    []uint32{1, 6},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Initialize tApp with a vault that refreshes its orders in EndBlocker.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.MinRefreshIntervalBlocks = tc.minRefreshIntervalBlocks
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			// Vault refreshes its orders for the first time at block 1.
+			tApp.InitChain()
+
+			expectedLastRefreshBlock := uint32(1)
+			for block := uint32(2); block <= 9; block++ {
+				ctx := tApp.AdvanceToBlock(block, testapp.AdvanceToBlockOptions{})
+				if slices.Contains(tc.expectedRefreshBlocks, block) {
+					expectedLastRefreshBlock = block
+				}
+
+				// Check that vault last refreshed at expected block.
+				lastRefreshBlock, exists := tApp.App.VaultKeeper.GetLastRefreshBlockHeight(ctx, vaultId)
+				require.True(t, exists)
+				require.Equal(t, expectedLastRefreshBlock, lastRefreshBlock, "block %d", block)
+
+				// Check that resting orders are the ones placed at last refresh.
+				expectedOrders, err := tApp.App.VaultKeeper.GetVaultClobOrders(
+					ctx.WithBlockHeight(int64(expectedLastRefreshBlock)),
+					vaultId,
+				)
+				require.NoError(t, err)
+				allStatefulOrders := tApp.App.ClobKeeper.GetAllStatefulOrders(ctx)
+				require.Len(t, allStatefulOrders, len(expectedOrders))
+				for i, order := range expectedOrders {
+					require.Equal(t, order.OrderId, allStatefulOrders[i].OrderId)
+				}
+			}
+		})
+	}
+}
+
+func TestRefreshVaultClobOrders_RenewBufferBlocks(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Number of blocks before expiry within which vault orders are renewed.
+		renewBufferBlocks uint32
+
+		/* --- Expectations --- */
+		// Blocks at which vault orders are refreshed (among blocks 2 to 9).
+		expectedRefreshBlocks []uint32
+	}{
+		"Buffer 0, Orders Are Not Renewed Before Expiry": {
+			renewBufferBlocks:     0,
+			expectedRefreshBlocks: []uint32{},
+		},
+		"Buffer 4, Orders Are Renewed at Block 8": {
+			renewBufferBlocks: 4,
+			// Orders expire at time 10 and block 7 (at time 6) is the first within buffer,
+			// but block 8 is the first of different parity from block 1.
+			expectedRefreshBlocks: []uint32{8},
+		},
+		"Buffer 6, Orders Are Renewed at Block 6": {
+			renewBufferBlocks: 6,
+			// Orders expire at time 10 and block 5 (at time 4) is the first within buffer,
+			// but block 6 is the first of different parity from block 1.
+			expectedRefreshBlocks: []uint32{6},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Vault 0 has an order expiration jitter of 4 seconds.
+			require.Equal(t, uint32(4), vaultId.GetOrderExpirationJitterSeconds())
+			// Initialize tApp with a vault that refreshes its orders in EndBlocker.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.MinRefreshIntervalBlocks = 21
+						genesisState.Params.OrderExpirationSeconds = 6
+						genesisState.Params.RenewBufferBlocks = tc.renewBufferBlocks
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			// Vault refreshes its orders for the first time at block 1 (at time 0), which
+			// expire at time 6 + 4 = 10.
+			genesisTime := tApp.InitChain().BlockTime()
+
+			expectedLastRefreshBlock := uint32(1)
+			for block := uint32(2); block <= 9; block++ {
+				// Block `b` is at time `b - 1`.
+				ctx := tApp.AdvanceToBlock(block, testapp.AdvanceToBlockOptions{
+					BlockTime: genesisTime.Add(time.Duration(block-1) * time.Second),
+				})
+				if slices.Contains(tc.expectedRefreshBlocks, block) {
+					expectedLastRefreshBlock = block
+				}
+
+				// Check that vault last refreshed at expected block.
+				lastRefreshBlock, exists := tApp.App.VaultKeeper.GetLastRefreshBlockHeight(ctx, vaultId)
+				require.True(t, exists)
+				require.Equal(t, expectedLastRefreshBlock, lastRefreshBlock, "block %d", block)
+			}
+		})
+	}
+}
+
+func TestRefreshAllVaultOrders_MaxVaultOrdersPerBlock(t *testing.T) {
+	vaultIds := []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1}
+	// Initialize tApp with two vaults that refresh their orders in EndBlocker and a limit
+	// of 4 orders per block, i.e. at most one vault (2 layers) refreshes per block.
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = make([]satypes.Subaccount, len(vaultIds))
+				for i, vaultId := range vaultIds {
+					genesisState.Subaccounts[i] = satypes.Subaccount{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					}
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.MaxVaultOrdersPerBlock = 4
+				genesisState.Vaults = make([]*vaulttypes.Vault, len(vaultIds))
+				for i := range vaultIds {
+					genesisState.Vaults[i] = &vaulttypes.Vault{
+						VaultId:     &vaultIds[i],
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+						OwnerShares: []*vaulttypes.OwnerShare{
+							{
+								Owner:  constants.AliceAccAddress.String(),
+								Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+							},
+						},
+					}
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	// Vault 0 refreshes its orders at block 1 and vault 1 is deferred.
+	ctx := tApp.InitChain()
+	lastRefreshBlock, exists := tApp.App.VaultKeeper.GetLastRefreshBlockHeight(ctx, vaultIds[0])
+	require.True(t, exists)
+	require.Equal(t, uint32(1), lastRefreshBlock)
+	_, exists = tApp.App.VaultKeeper.GetLastRefreshBlockHeight(ctx, vaultIds[1])
+	require.False(t, exists)
+
+	// Vaults take turns in round-robin order. A vault that refreshed in the last block
+	// only refreshes again if the other vault can't refresh in current block due to
+	// block parity, in which case the other vault stays first in order for next block.
+	expectedRefreshBlocks := map[vaulttypes.VaultId][]uint32{
+		vaultIds[0]: {1, 4, 5, 8, 9},
+		vaultIds[1]: {2, 3, 6, 7},
+	}
+	expectedLastRefreshBlocks := map[vaulttypes.VaultId]uint32{vaultIds[0]: 1}
+	for block := uint32(2); block <= 9; block++ {
+		ctx = tApp.AdvanceToBlock(block, testapp.AdvanceToBlockOptions{})
+
+		numRefreshedVaults := 0
+		for _, vaultId := range vaultIds {
+			if slices.Contains(expectedRefreshBlocks[vaultId], block) {
+				expectedLastRefreshBlocks[vaultId] = block
+				numRefreshedVaults++
+			}
+			lastRefreshBlock, exists := tApp.App.VaultKeeper.GetLastRefreshBlockHeight(ctx, vaultId)
+			require.True(t, exists, "block %d", block)
+			require.Equal(t, expectedLastRefreshBlocks[vaultId], lastRefreshBlock, "block %d", block)
+		}
+		// Check that at most one vault places orders in current block.
+		require.LessOrEqual(t, numRefreshedVaults, 1, "block %d", block)
+
+		// Check that resting orders are the ones placed at last refresh of each vault.
+		numExpectedOrders := 0
+		for _, vaultId := range vaultIds {
+			orderIds, err := tApp.App.VaultKeeper.GetVaultClobOrderIds(
+				ctx.WithBlockHeight(int64(expectedLastRefreshBlocks[vaultId])),
+				vaultId,
+			)
+			require.NoError(t, err)
+			for _, orderId := range orderIds {
+				_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+				require.True(t, exists, "block %d", block)
+			}
+			numExpectedOrders += len(orderIds)
+		}
+		require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), numExpectedOrders)
+	}
+}
+
+func TestRefreshAllVaultOrders_PlacementPriority(t *testing.T) {
+	tests := map[string]struct {
+		// Placement priority.
+		placementPriority vaulttypes.PlacementPriority
+		// Expected layer of resting orders of the vault that is capped.
+		expectedCappedLayer uint8
+	}{
+		"Inner first": {
+			placementPriority:   vaulttypes.PlacementPriority_PLACEMENT_PRIORITY_INNER_FIRST,
+			expectedCappedLayer: 0,
+		},
+		"Outer first": {
+			placementPriority:   vaulttypes.PlacementPriority_PLACEMENT_PRIORITY_OUTER_FIRST,
+			expectedCappedLayer: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultIds := []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1}
+			// Initialize tApp with two vaults that refresh their orders in EndBlocker and a limit
+			// of 6 orders per block, i.e. one vault (2 layers) places all of its orders and the
+			// other vault only places 2 of its orders.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = make([]satypes.Subaccount, len(vaultIds))
+						for i, vaultId := range vaultIds {
+							genesisState.Subaccounts[i] = satypes.Subaccount{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							}
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.MaxVaultOrdersPerBlock = 6
+						genesisState.Params.PlacementPriority = tc.placementPriority
+						genesisState.Vaults = make([]*vaulttypes.Vault, len(vaultIds))
+						for i := range vaultIds {
+							genesisState.Vaults[i] = &vaulttypes.Vault{
+								VaultId:     &vaultIds[i],
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							}
+						}
+					},
+				)
+				return genesis
+			}).Build()
+
+			// Check that the first vault places all of its orders and the second vault only places
+			// both orders of the layer with the highest placement priority.
+			checkRestingOrders := func(ctx sdk.Context, fullVaultId, cappedVaultId vaulttypes.VaultId) {
+				layers := make(map[vaulttypes.VaultId][]uint8)
+				for _, order := range tApp.App.ClobKeeper.GetAllStatefulOrders(ctx) {
+					vaultId := vaulttypes.VaultId{
+						Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+						Number: order.OrderId.ClobPairId,
+					}
+					_, _, layer, err := vaulttypes.DecodeVaultClientId(order.OrderId.ClientId)
+					require.NoError(t, err)
+					layers[vaultId] = append(layers[vaultId], layer)
+				}
+				require.ElementsMatch(t, []uint8{0, 0, 1, 1}, layers[fullVaultId])
+				require.Equal(
+					t,
+					[]uint8{tc.expectedCappedLayer, tc.expectedCappedLayer},
+					layers[cappedVaultId],
+				)
+			}
+
+			// Vault 0 places all of its orders at block 1 and vault 1 is capped.
+			ctx := tApp.InitChain()
+			checkRestingOrders(ctx, vaultIds[0], vaultIds[1])
+
+			// Capped vault 1 places all of its orders first at block 2 and vault 0 is capped.
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+			checkRestingOrders(ctx, vaultIds[1], vaultIds[0])
+		})
+	}
+}
+
+func TestSweepStaleVaultOrders(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Maximum age of a vault order.
+		hardMaxOrderAgeSeconds uint32
+
+		/* --- Expectations --- */
+		// Whether orders are still resting after vault misses refreshes.
+		expectedOrdersResting bool
+	}{
+		"Max Order Age 10 seconds, Stale Orders are Cancelled": {
+			hardMaxOrderAgeSeconds: 10,
+			expectedOrdersResting:  false,
+		},
+		"Max Order Age 0 (disabled), Stale Orders keep Resting": {
+			hardMaxOrderAgeSeconds: 0,
+			expectedOrdersResting:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Initialize tApp with a vault that refreshes its orders only once at block 1.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.MinRefreshIntervalBlocks = 1_001
+						genesisState.Params.OrderExpirationSeconds = 60
+						genesisState.Params.HardMaxOrderAgeSeconds = tc.hardMaxOrderAgeSeconds
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			genesisTime := ctx.BlockTime()
+			params := tApp.App.VaultKeeper.GetParams(ctx)
+			numOrders := int(params.Layers * 2)
+			require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), numOrders)
+
+			// Orders are not stale yet 5 seconds after placement.
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+				BlockTime: genesisTime.Add(5 * time.Second),
+			})
+			require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), numOrders)
+
+			// Orders are stale 11 seconds after placement and vault hasn't refreshed its orders.
+			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{
+				BlockTime: genesisTime.Add(11 * time.Second),
+			})
+			lastRefreshBlock, exists := tApp.App.VaultKeeper.GetLastRefreshBlockHeight(ctx, vaultId)
+			require.True(t, exists)
+			require.Equal(t, uint32(1), lastRefreshBlock)
+			if tc.expectedOrdersResting {
+				require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), numOrders)
+			} else {
+				require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), 0)
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault params.
+		vaultParams vaulttypes.Params
+		// Individual vault params. Nil if vault has no individual params.
+		individualVaultParams *vaulttypes.VaultParams
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Vault asset.
+		vaultAssetQuoteQuantums *big.Int
+		// Vault inventory.
+		vaultInventoryBaseQuantums *big.Int
+		// Clob pair.
+		clobPair clobtypes.ClobPair
+		// Market param.
+		marketParam pricestypes.MarketParam
+		// Market price.
+		marketPrice pricestypes.MarketPrice
+		// Perpetual.
+		perpetual perptypes.Perpetual
+
+		/* --- Expectations --- */
+		expectedOrderSubticks []uint64
+		expectedOrderQuantums []uint64
+		expectedErr           error
+	}{
+		"Success - Get orders from Vault for Clob Pair 0": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// To calculate order subticks:
+			// 1. spread = max(spread_min, spread_buffer + min_price_change)
+			// 2. leverage = open_notional / equity
+			// 3. leverage_i = leverage +/- i * order_size_pct (- for ask and + for bid)
+			// 4. skew_i = -leverage_i * spread * skew_factor
+			// 5. a_i = max(oracle_price * (1 + skew_i + spread * {i+1}), oracle_price)
+			//    b_i = min(oracle_price * (1 + skew_i - spread * {i+1}), oracle_price)
+			// 6. subticks needs to be a multiple of subticks_per_tick (round up for asks, round down for bids)
+			// To calculate size of each order
+			// 1. `order_size_pct_ppm * equity / oracle_price`.
+			expectedOrderSubticks: []uint64{
+				// spreadPpm = max(3_123, 1_500 + 50) = 3_123
+				// spread = 0.003123
+				// leverage = 0 / 1_000 = 0
+				// oracleSubticks = 5_000_000_000 * 10^(-5 - (-8) + (-10) - (-6)) = 5e8
+				// leverage_0 = leverage = 0
+				// skew_0 = -0 * 3_123 * 0.554321 = 0
+				// a_0 = 5e5 * (1 + 0 + 0.003123*1) = 501_561.5 = 501_565 (rounded up to 5)
+				501_565,
+				// b_0 = 5e5 * (1 + 0 - 0.003123*1) = 498_438.5 = 498435 (rounded down to 5)
+				498_435,
+				// leverage_1 = leverage - 0.1 = -0.1
+				// skew_1 = 0.1 * 0.003123 * 0.554321 ~= 0.000173
+				// a_1 = 5e5 * (1 + 0.000173 + 0.003123*2) = 503209.5 ~= 503_210 (rounded up to 5)
+				503_210,
+				// leverage_1 = leverage + 0.1 = 0.1
+				// skew_1 = -0.1 * 0.003123 * 0.554321 = -0.000173
+				// b_2 = 5e5 * (1 - 0.000173 - 0.003123*2) = 496790.5 ~= 496_790 (rounded down to 5)
+				496_790,
+			},
+			// order_size = 10% * $1_000 / $50 = 2
+			// order_size_base_quantums = 2 * 10^10 = 20_000_000_000
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, Oracle Price within Range": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			individualVaultParams: &vaulttypes.VaultParams{
+				MinOraclePrice: 4_000_000, // $40
+				MaxOraclePrice: 6_000_000, // $60
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// Same orders as when vault has no oracle price range.
+			expectedOrderSubticks: []uint64{
+				501_565,
+				498_435,
+				503_210,
+				496_790,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, No Orders as Oracle Price is above Range": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			individualVaultParams: &vaulttypes.VaultParams{
+				MinOraclePrice: 4_000_000, // $40
+				MaxOraclePrice: 4_999_999, // $49.99999
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual:             constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedOrderSubticks: []uint64{},
+			expectedOrderQuantums: []uint64{},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, No Orders as Oracle Price is below Range": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			individualVaultParams: &vaulttypes.VaultParams{
+				MinOraclePrice: 5_000_001, // $50.00001
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual:             constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedOrderSubticks: []uint64{},
+			expectedOrderQuantums: []uint64{},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, custom spread multipliers": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           3,       // 3 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      false,
+				SpreadMultiplierPpmByLayer:       []uint32{1_000_000, 2_500_000}, // 1x, 2.5x
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedOrderSubticks: []uint64{
+				// spreadPpm = max(3_000, 1_500 + 50) = 3_000
+				// spread = 0.003
+				// skew is disabled, so skew_i = 0
+				// a_0 = 5e5 * (1 + 0.003*1) = 501_500
+				501_500,
+				// b_0 = 5e5 * (1 - 0.003*1) = 498_500
+				498_500,
+				// a_1 = 5e5 * (1 + 0.003*2.5) = 503_750
+				503_750,
+				// b_1 = 5e5 * (1 - 0.003*2.5) = 496_250
+				496_250,
+				// layer 2 uses last multiplier 2.5
+				// a_2 = 5e5 * (1 + 0.003*2.5) = 503_750
+				// a_2 = a_1 = 503_750, so a_2 is moved one tick up to 503_755
+				503_755,
+				// b_2 = 5e5 * (1 - 0.003*2.5) = 496_250
+				// b_2 = b_1 = 496_250, so b_2 is moved one tick down to 496_245
+				496_245,
+			},
+			// order_size = 10% * $1_000 / $50 = 2
+			// order_size_base_quantums = 2 * 10^10 = 20_000_000_000
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, bids bounded by oracle price.": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           3,       // 3 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  8_500,   // 85 bps
+				SkewFactorPpm:                    900_000, // 0.9
+				OrderSizePctPpm:                  200_000, // 20%
+				OrderExpirationSeconds:           4,       // 4 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(2_000_000_000), // 2,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-500_000_000),  // -0.5 ETH
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// To calculate order subticks:
+			// 1. spread = max(spread_min, spread_buffer + min_price_change)
+			// 2. leverage = open_notional / equity
+			// 3. leverage_i = leverage +/- i * order_size_pct (- for ask and + for bid)
+			// 4. skew_i = -leverage_i * spread * skew_factor
+			// 5. a_i = max(oracle_price * (1 + skew_i + spread*{i+1}), oracle_price)
+			//    b_i = min(oracle_price * (1 + skew_i - spread*{i+1}), oracle_price)
+			// 6. subticks needs to be a multiple of subticks_per_tick (round up for asks, round down for bids)
+			// To calculate size of each order
+			// 1. `order_size_pct_ppm * equity / oracle_price`.
+			expectedOrderSubticks: []uint64{
+				// spreadPpm = max(3_000, 8_500 + 50) = 8_550
+				// spread = 0.00855
+				// open_notional = -500_000_000 * 10^-9 * 3_000 * 10^6 = -1_500_000_000
+				// leverage = -1_500_000_000 / (2_000_000_000 - 1_500_000_000) = -3
+				// oracleSubticks = 3_000_000_000 * 10^(-6 - (-9) + (-9) - (-6)) = 3e9
+				// leverage_0 = leverage - 0 * 0.2 = -3
+				// skew_0 = 3 * 0.00855 * 0.9
+				// a_0 = 3e9 * (1 + skew_0 + 0.00855*1) = 3_094_905_000
+				// a_0 = max(a_0, oracle_price) = 3_094_905_000
+				3_094_905_000,
+				// b_0 = 3e9 * (1 + skew_0 - 0.00855*1) = 3_043_605_000
+				// b_0 = min(b_0, oracle_price) = 3e9 (bound)
+				3_000_000_000,
+				// leverage_1 = leverage - 1 * 0.2
+				// skew_1 = -leverage_1 * 0.00855 * 0.9
+				// a_1 = 3e9 * (1 + skew_1 + 0.00855*2) = 3_125_172_000
+				// a_1 = max(a_1, oracle_price) = 3_125_172_000
+				3_125_172_000,
+				// leverage_1 = leverage + 1 * 0.2
+				// skew_1 = -leverage_1 * 0.00855 * 0.9
+				// b_1 = 3e9 * (1 + skew_1 - 0.00855*2) = 3_013_338_000
+				// b_1 = min(b_1, oracle_price) = 3e9 (bound)
+				// b_1 = b_0 - subticks_per_tick = 2_999_999_000 (separated from b_0)
+				2_999_999_000,
+				// leverage_2 = leverage - 2 * 0.2
+				// skew_2 = -leverage_2 * 0.00855 * 0.9
+				// a_2 = 3e9 * (1 + skew_2 + 0.00855*3) = 3_155_439_000
+				// a_2 = max(a_2, oracle_price) = 3_155_439_000
+				3_155_439_000,
+				// leverage_2 = leverage + 2 * 0.2
+				// skew_2 = -leverage_2 * 0.00855 * 0.9
+				// b_2 = 3e9 * (1 + skew_2 - 0.00855*3) = 2_983_071_000
+				// b_2 = min(b_2, oracle_price) = 2_983_071_000
+				2_983_071_000,
+			},
+			// order_size = 20% * 500 / 3000 ~= 0.0333333333
+			// order_size_base_quantums = 0.0333333333e9 ~= 33_333_333.33
+			// round down to nearest multiple of step_base_quantums=1_000.
+			expectedOrderQuantums: []uint64{
+				33_333_000,
+				33_333_000,
+				33_333_000,
+				33_333_000,
+				33_333_000,
+				33_333_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, skew disabled.": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           3,       // 3 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  8_500,   // 85 bps
+				SkewFactorPpm:                    900_000, // 0.9
+				OrderSizePctPpm:                  200_000, // 20%
+				OrderExpirationSeconds:           4,       // 4 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      false,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(2_000_000_000), // 2,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-500_000_000),  // -0.5 ETH
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// With skew disabled, skew_i = 0 regardless of inventory and thus
+			// a_i = oracle_price * (1 + spread*{i+1})
+			// b_i = oracle_price * (1 - spread*{i+1})
+			expectedOrderSubticks: []uint64{
+				// spreadPpm = max(3_000, 8_500 + 50) = 8_550
+				// spread = 0.00855
+				// oracleSubticks = 3e9
+				// a_0 = 3e9 * (1 + 0.00855*1) = 3_025_650_000
+				3_025_650_000,
+				// b_0 = 3e9 * (1 - 0.00855*1) = 2_974_350_000
+				2_974_350_000,
+				// a_1 = 3e9 * (1 + 0.00855*2) = 3_051_300_000
+				3_051_300_000,
+				// b_1 = 3e9 * (1 - 0.00855*2) = 2_948_700_000
+				2_948_700_000,
+				// a_2 = 3e9 * (1 + 0.00855*3) = 3_076_950_000
+				3_076_950_000,
+				// b_2 = 3e9 * (1 - 0.00855*3) = 2_923_050_000
+				2_923_050_000,
+			},
+			expectedOrderQuantums: []uint64{
+				33_333_000,
+				33_333_000,
+				33_333_000,
+				33_333_000,
+				33_333_000,
+				33_333_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, asks scaled down by max position delta per block": {
+			vaultParams: vaulttypes.Params{
+				Layers:                               3,       // 3 layers
+				SpreadMinPpm:                         3_000,   // 30 bps
+				SpreadBufferPpm:                      8_500,   // 85 bps
+				SkewFactorPpm:                        900_000, // 0.9
+				OrderSizePctPpm:                      200_000, // 20%
+				OrderExpirationSeconds:               4,       // 4 seconds
+				ActivationThresholdQuoteQuantums:     dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                          false,
+				MaxPositionDeltaPerBlockBaseQuantums: 60_000_000,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(2_000_000_000), // 2,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-500_000_000),  // -0.5 ETH
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// equity = 2_000 - 0.5 * 3_000 = 500 USDC
+			// order_size = 20% * 500 / 3_000 = 0.0333... ETH = 33_333_000 base quantums (rounded to step size)
+			// each side changes inventory by 3 * 33_333_000 = 99_999_000 > 60_000_000 if fully filled.
+			// bids only reduce exposure of short vault (-0.5 ETH + 0.099999 ETH < 0) and aren't scaled.
+			// asks increase exposure and are scaled down to 33_333_000 * 60_000_000 / 99_999_000 = 20_000_000
+			// Subticks are the same as in the case with skew disabled above.
+			expectedOrderSubticks: []uint64{
+				3_025_650_000,
+				2_974_350_000,
+				3_051_300_000,
+				2_948_700_000,
+				3_076_950_000,
+				2_923_050_000,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000,
+				33_333_000,
+				20_000_000,
+				33_333_000,
+				20_000_000,
+				33_333_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, both sides scaled down by max position delta per block": {
+			vaultParams: vaulttypes.Params{
+				Layers:                               3,       // 3 layers
+				SpreadMinPpm:                         3_000,   // 30 bps
+				SpreadBufferPpm:                      8_500,   // 85 bps
+				SkewFactorPpm:                        900_000, // 0.9
+				OrderSizePctPpm:                      200_000, // 20%
+				OrderExpirationSeconds:               4,       // 4 seconds
+				ActivationThresholdQuoteQuantums:     dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                          false,
+				MaxPositionDeltaPerBlockBaseQuantums: 60_000_000,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(2_000_000_000), // 2,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-50_000_000),   // -0.05 ETH
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// equity = 2_000 - 0.05 * 3_000 = 1_850 USDC
+			// order_size = 20% * 1_850 / 3_000 = 0.12333... ETH = 123_333_000 base quantums (rounded to step size)
+			// each side changes inventory by 3 * 123_333_000 = 369_999_000 > 60_000_000 if fully filled.
+			// bids would flip short vault to long (-0.05 ETH + 0.369999 ETH > 0) and asks increase exposure,
+			// so both are scaled down to 123_333_000 * 60_000_000 / 369_999_000 = 20_000_000
+			// Subticks are the same as in the case with skew disabled above.
+			expectedOrderSubticks: []uint64{
+				3_025_650_000,
+				2_974_350_000,
+				3_051_300_000,
+				2_948_700_000,
+				3_076_950_000,
+				2_923_050_000,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000,
+				20_000_000,
+				20_000_000,
+				20_000_000,
+				20_000_000,
+				20_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, no orders as max position delta per block scales both sides to zero": {
+			vaultParams: vaulttypes.Params{
+				Layers:                               3,       // 3 layers
+				SpreadMinPpm:                         3_000,   // 30 bps
+				SpreadBufferPpm:                      8_500,   // 85 bps
+				SkewFactorPpm:                        900_000, // 0.9
+				OrderSizePctPpm:                      200_000, // 20%
+				OrderExpirationSeconds:               4,       // 4 seconds
+				ActivationThresholdQuoteQuantums:     dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                          false,
+				MaxPositionDeltaPerBlockBaseQuantums: 1_000,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(2_000_000_000), // 2,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-50_000_000),   // -0.05 ETH
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// bids would flip short vault to long and asks increase exposure, so both are scaled down
+			// to 123_333_000 * 1_000 / 369_999_000 = 333, which rounds down to 0.
+			expectedOrderQuantums: []uint64{},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, asks bounded by oracle price.": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,         // 2 layers
+				SpreadMinPpm:                     3_000,     // 30 bps
+				SpreadBufferPpm:                  1_500,     // 15 bps
+				SkewFactorPpm:                    500_000,   // 0.5
+				OrderSizePctPpm:                  1_000_000, // 100%
+				OrderExpirationSeconds:           4,         // 4 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(-2_000_000_000), // -2,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(1_000_000_000),  // 1 ETH
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// To calculate order subticks:
+			// 1. spread = max(spread_min, spread_buffer + min_price_change)
+			// 2. leverage = open_notional / equity
+			// 3. leverage_i = leverage +/- i * order_size_pct (- for ask and + for bid)
+			// 4. skew_i = -leverage_i * spread * skew_factor
+			// 5. a_i = max(oracle_price * (1 + skew_i + spread*{i+1}), oracle_price)
+			//    b_i = min(oracle_price * (1 + skew_i - spread*{i+1}), oracle_price)
+			// 6. subticks needs to be a multiple of subticks_per_tick (round up for asks, round down for bids)
+			// To calculate size of each order
+			// 1. `order_size_pct_ppm * equity / oracle_price`.
+			expectedOrderSubticks: []uint64{
+				// spreadPpm = max(3_000, 1_500 + 50) = 3_000
+				// spread = 0.003
+				// open_notional = 1_000_000_000 * 10^-9 * 3_000 * 10^6 = 3_000_000_000
+				// leverage = 3_000_000_000 / (-2_000_000_000 + 3_000_000_000) = 3
+				// oracleSubticks = 3_000_000_000 * 10^(-6 - (-9) + (-9) - (-6)) = 3e9
+				// leverage_0 = leverage - 0 * 1 = 3
+				// skew_0 = -3 * 0.003 * 0.5
+				// a_0 = 3e9 * (1 + skew_0 + 0.003*1) = 2_995_500_000
+				// a_0 = max(a_0, oracle_price) = 3e9 (bound)
+				3_000_000_000,
+				// b_0 = 3e9 * (1 + skew_0 - 0.003*1) = 2_977_500_000
+				// b_0 = min(b_0, oracle_price) = 2_977_500_000
+				2_977_500_000,
+				// leverage_1 = leverage - 1 * 1 = 2
+				// skew_1 = -2 * 0.003 * .5
+				// a_1 = 3e9 * (1 + skew_1 + 0.003*2) = 3_009_000_000
+				// a_1 = max(a_1, oracle_price) = 3_009_000_000
+				3_009_000_000,
+				// leverage_1 = leverage + 1 * 1 = 4
+				// skew_1 = -4 * 0.003 * .5
+				// b_1 = 3e9 * (1 + skew_1 - 0.003*2) = 2_964_000_000
+				// b_1 = min(b_1, oracle_price) = 2_964_000_000
+				2_964_000_000,
+			},
+			// order_size = 100% * 1000 / 3000 ~= 0.333333333
+			// order_size_base_quantums = 0.333333333e9 ~= 333_333_333.33
+			// round down to nearest multiple of step_base_quantums=1_000.
+			expectedOrderQuantums: []uint64{
+				333_333_000,
+				333_333_000,
+				333_333_000,
+				333_333_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, Adjacent Layers with Same Subticks are Separated": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair: clobtypes.ClobPair{
+				Id: 0,
+				Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
+					PerpetualClobMetadata: &clobtypes.PerpetualClobMetadata{
+						PerpetualId: 0,
+					},
+				},
+				StepBaseQuantums:          5,
+				SubticksPerTick:           5_000,
+				QuantumConversionExponent: -8,
+				Status:                    clobtypes.ClobPair_STATUS_ACTIVE,
+			},
+			marketParam: constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedOrderSubticks: []uint64{
+				// a_0 = 501_561.5 = 505_000 (rounded up to 5_000)
+				505_000,
+				// b_0 = 498_438.5 = 495_000 (rounded down to 5_000)
+				495_000,
+				// a_1 = 503_209.5 = 505_000 (rounded up to 5_000)
+				// a_1 = a_0 + subticks_per_tick = 510_000 (separated from a_0)
+				510_000,
+				// b_1 = 496_790.5 = 495_000 (rounded down to 5_000)
+				// b_1 = b_0 - subticks_per_tick = 490_000 (separated from b_0)
+				490_000,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, Fewer Layers due to Low Equity": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(600_000_000), // 600 USDC
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// equity / min_equity_per_layer = 1_000 / 600 = 1 layer.
+			// Orders at layer 0 are the same as in the 2-layer case above.
+			expectedOrderSubticks: []uint64{
+				501_565,
+				498_435,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, At Least One Layer when Equity is Below Min Equity Per Layer": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(5_000_000_000), // 5,000 USDC
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// equity / min_equity_per_layer = 1_000 / 5_000 = 0 layers, floored at 1 layer.
+			expectedOrderSubticks: []uint64{
+				501_565,
+				498_435,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 0, All Layers when Equity is Sufficient": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_123,   // 31.23 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    554_321, // 0.554321
+				OrderSizePctPpm:                  100_000, // 10%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(500_000_000), // 500 USDC
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -5,
+				Price:    5_000_000, // $50
+			},
+			perpetual: constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			// equity / min_equity_per_layer = 1_000 / 500 = 2 layers.
+			expectedOrderSubticks: []uint64{
+				501_565,
+				498_435,
+				503_210,
+				496_790,
+			},
+			expectedOrderQuantums: []uint64{
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+				20_000_000_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, No Orders due to Zero Order Size": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    500_000, // 0.5
+				OrderSizePctPpm:                  1_000,   // 0.1%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000), // 1 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			expectedOrderSubticks:      []uint64{},
+			// order_size = 0.1% * 1 / 3_000 ~= 0.00000033333
+			// order_size_base_quantums = 0.000033333e9 = 333
+			// round down to nearest multiple of step_base_quantums=1_000.
+			// order size is 0.
+			expectedOrderQuantums: []uint64{},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, Zero Order Size Rounded up to Min Lot": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    500_000, // 0.5
+				OrderSizePctPpm:                  1_000,   // 0.1%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+				MinLotBaseQuantums:               1_500,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000), // 1 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// order_size_base_quantums = 333, which rounds down to 0.
+			// min_lot = 1_500, rounded up to nearest multiple of step_base_quantums=1_000 = 2_000
+			// min_lot_notional = 2_000 * 10^-9 * 3_000 = 0.006 USDC
+			// equity / min_lot_notional = 1 / 0.006 = 166 layers, all 2 layers are funded.
+			// spread = max(3_000, 1_500 + 50) = 3_000 ppm
+			// a_0 = 3e9 * (1 + 0.003) = 3_009_000_000
+			// b_0 = 3e9 * (1 - 0.003) = 2_991_000_000
+			// a_1 = 3e9 * (1 + 0.000001 + 0.006) = 3_018_003_000
+			// b_1 = 3e9 * (1 - 0.000001 - 0.006) = 2_981_997_000
+			expectedOrderSubticks: []uint64{
+				3_009_000_000,
+				2_991_000_000,
+				3_018_003_000,
+				2_981_997_000,
+			},
+			expectedOrderQuantums: []uint64{
+				2_000,
+				2_000,
+				2_000,
+				2_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, Layers that Equity can't Fund at Min Lot are Dropped": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
 				SkewFactorPpm:                    500_000, // 0.5
 				OrderSizePctPpm:                  1_000,   // 0.1%
 				OrderExpirationSeconds:           2,       // 2 seconds
 				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+				MinLotBaseQuantums:               200_000,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000), // 1 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// order_size_base_quantums = 333, which rounds down to 0.
+			// min_lot = 200_000 (a multiple of step_base_quantums=1_000)
+			// min_lot_notional = 200_000 * 10^-9 * 3_000 = 0.6 USDC
+			// equity / min_lot_notional = 1 / 0.6 = 1 layer, outer layer is dropped.
+			expectedOrderSubticks: []uint64{
+				3_009_000_000,
+				2_991_000_000,
+			},
+			expectedOrderQuantums: []uint64{
+				200_000,
+				200_000,
+			},
+		},
+		"Success - Get orders from Vault for Clob Pair 1, No Orders as Equity can't Fund Min Lot": {
+			vaultParams: vaulttypes.Params{
+				Layers:                           2,       // 2 layers
+				SpreadMinPpm:                     3_000,   // 30 bps
+				SpreadBufferPpm:                  1_500,   // 15 bps
+				SkewFactorPpm:                    500_000, // 0.5
+				OrderSizePctPpm:                  1_000,   // 0.1%
+				OrderExpirationSeconds:           2,       // 2 seconds
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+				SkewEnabled:                      true,
+				MinLotBaseQuantums:               1_000_000,
+			},
+			vaultId:                    constants.Vault_Clob1,
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000), // 1 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Eth,
+			marketParam:                constants.TestMarketParams[1],
+			marketPrice:                constants.TestMarketPrices[1],
+			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
+			// order_size_base_quantums = 333, which rounds down to 0.
+			// min_lot = 1_000_000 (a multiple of step_base_quantums=1_000)
+			// min_lot_notional = 1_000_000 * 10^-9 * 3_000 = 3 USDC
+			// equity / min_lot_notional = 1 / 3 = 0 layers, all layers are dropped.
+			expectedOrderSubticks: []uint64{},
+			expectedOrderQuantums: []uint64{},
+		},
+		"Error - Clob Pair doesn't exist": {
+			vaultParams: vaulttypes.DefaultParams(),
+			vaultId:     constants.Vault_Clob0,
+			clobPair:    constants.ClobPair_Eth,
+			marketParam: constants.TestMarketParams[1],
+			marketPrice: constants.TestMarketPrices[1],
+			perpetual:   constants.EthUsd_NoMarginRequirement,
+			expectedErr: vaulttypes.ErrClobPairNotFound,
+		},
+		"Error - Vault equity is zero": {
+			vaultParams:                vaulttypes.DefaultParams(),
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(0),
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice:                constants.TestMarketPrices[0],
+			perpetual:                  constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedErr:                vaulttypes.ErrNonPositiveEquity,
+		},
+		"Error - Vault equity is negative": {
+			vaultParams:                vaulttypes.DefaultParams(),
+			vaultId:                    constants.Vault_Clob0,
+			vaultAssetQuoteQuantums:    big.NewInt(5_000_000), // 5 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-10_000_000),
+			clobPair:                   constants.ClobPair_Btc,
+			marketParam:                constants.TestMarketParams[0],
+			marketPrice:                constants.TestMarketPrices[0],
+			perpetual:                  constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+			expectedErr:                vaulttypes.ErrNonPositiveEquity,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Initialize tApp and ctx.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				// Initialize prices module with test market param and market price.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *pricestypes.GenesisState) {
+						genesisState.MarketParams = []pricestypes.MarketParam{tc.marketParam}
+						genesisState.MarketPrices = []pricestypes.MarketPrice{tc.marketPrice}
+					},
+				)
+				// Initialize perpetuals module with test perpetual.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{tc.perpetual}
+					},
+				)
+				// Initialize clob module with test clob pair.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{tc.clobPair}
+					},
+				)
+				// Initialize vault module with test params.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params = tc.vaultParams
+					},
+				)
+				// Initialize subaccounts module with vault's equity and inventory.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						assetPositions := []*satypes.AssetPosition{}
+						if tc.vaultAssetQuoteQuantums != nil && tc.vaultAssetQuoteQuantums.Sign() != 0 {
+							assetPositions = append(
+								assetPositions,
+								&satypes.AssetPosition{
+									AssetId:  assettypes.AssetUsdc.Id,
+									Quantums: dtypes.NewIntFromBigInt(tc.vaultAssetQuoteQuantums),
+								},
+							)
+						}
+						perpPositions := []*satypes.PerpetualPosition{}
+						if tc.vaultInventoryBaseQuantums != nil && tc.vaultInventoryBaseQuantums.Sign() != 0 {
+							perpPositions = append(
+								perpPositions,
+								testutil.CreateSinglePerpetualPosition(
+									tc.perpetual.Params.Id,
+									tc.vaultInventoryBaseQuantums,
+									big.NewInt(0),
+								),
+							)
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id:                 tc.vaultId.ToSubaccountId(),
+								AssetPositions:     assetPositions,
+								PerpetualPositions: perpPositions,
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			if tc.individualVaultParams != nil {
+				err := tApp.App.VaultKeeper.SetVaultParams(ctx, tc.vaultId, *tc.individualVaultParams)
+				require.NoError(t, err)
+			}
+
+			// Get vault orders.
+			orders, err := tApp.App.VaultKeeper.GetVaultClobOrders(ctx, tc.vaultId)
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// Get expected orders.
+			params := tApp.App.VaultKeeper.GetParams(ctx)
+			buildVaultClobOrder := func(
+				layer uint8,
+				side clobtypes.Order_Side,
+				quantums uint64,
+				subticks uint64,
+			) *clobtypes.Order {
+				return &clobtypes.Order{
+					OrderId: clobtypes.OrderId{
+						SubaccountId: *tc.vaultId.ToSubaccountId(),
+						ClientId:     tApp.App.VaultKeeper.GetVaultClobOrderClientId(ctx, side, layer),
+						OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+						ClobPairId:   tc.vaultId.Number,
+					},
+					Side:     side,
+					Quantums: quantums,
+					Subticks: subticks,
+					GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(ctx.BlockTime().Unix()) + params.OrderExpirationSeconds +
+							tc.vaultId.GetOrderExpirationJitterSeconds(),
+					},
+				}
+			}
+			expectedOrders := make([]*clobtypes.Order, 0)
+			for i := 0; i < len(tc.expectedOrderQuantums); i += 2 {
+				expectedOrders = append(
+					expectedOrders,
+					// ask.
+					buildVaultClobOrder(
+						uint8(i/2),
+						clobtypes.Order_SIDE_SELL,
+						tc.expectedOrderQuantums[i],
+						tc.expectedOrderSubticks[i],
+					),
+					// bid.
+					buildVaultClobOrder(
+						uint8(i/2),
+						clobtypes.Order_SIDE_BUY,
+						tc.expectedOrderQuantums[i+1],
+						tc.expectedOrderSubticks[i+1],
+					),
+				)
+			}
+
+			// Compare expected orders with actual orders.
+			require.Equal(
+				t,
+				expectedOrders,
+				orders,
+			)
+		})
+	}
+}
+
+func TestGetVaultClobOrders_OneSideScaledToZero(t *testing.T) {
+	vaultId := constants.Vault_Clob1
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *perptypes.GenesisState) {
+				genesisState.LiquidityTiers = constants.LiquidityTiers
+				genesisState.Perpetuals = []perptypes.Perpetual{
+					constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+					constants.EthUsd_0DefaultFunding_9AtomicResolution,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *clobtypes.GenesisState) {
+				genesisState.ClobPairs = []clobtypes.ClobPair{constants.ClobPair_Btc, constants.ClobPair_Eth}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.Layers = 3
+				genesisState.Params.OrderSizePctPpm = 200_000
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(2_000_000_000), // 2,000 USDC
+							),
+						},
+						PerpetualPositions: []*satypes.PerpetualPosition{
+							testutil.CreateSinglePerpetualPosition(
+								constants.EthUsd_0DefaultFunding_9AtomicResolution.Params.Id,
+								big.NewInt(-500_000_000), // -0.5 ETH
+								big.NewInt(0),
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Get orders without a max position delta per block.
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, orders, 6)
+	expectedOrders := make([]*clobtypes.Order, 0)
+	for _, order := range orders {
+		if order.Side == clobtypes.Order_SIDE_BUY {
+			expectedOrders = append(expectedOrders, order)
+		}
+	}
+
+	// Asks increase exposure of the short vault and are scaled down to zero by a tiny max
+	// position delta per block. Bids only reduce exposure, aren't scaled down, and are still
+	// placed.
+	params := k.GetParams(ctx)
+	params.MaxPositionDeltaPerBlockBaseQuantums = 1_000
+	require.NoError(t, k.SetParams(ctx, params))
+	orders, err = k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(t, expectedOrders, orders)
+}
+
+func TestGetVaultClobOrders_ExpirationJitter(t *testing.T) {
+	vaultIds := []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1}
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{}
+				for _, vaultId := range vaultIds {
+					genesisState.Subaccounts = append(genesisState.Subaccounts, satypes.Subaccount{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					})
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	params := k.GetParams(ctx)
+	blockTime := uint32(ctx.BlockTime().Unix())
+
+	// Check that all orders of each vault expire at the same time within jitter window.
+	goodTilBlockTimes := make([]uint32, len(vaultIds))
+	for i, vaultId := range vaultIds {
+		orders, err := k.GetVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+		require.NotEmpty(t, orders)
+		goodTilBlockTimes[i] = orders[0].GetGoodTilBlockTime()
+		for _, order := range orders {
+			require.Equal(t, goodTilBlockTimes[i], order.GetGoodTilBlockTime())
+		}
+		require.GreaterOrEqual(t, goodTilBlockTimes[i], blockTime+params.OrderExpirationSeconds)
+		require.Less(
+			t,
+			goodTilBlockTimes[i],
+			blockTime+params.OrderExpirationSeconds+vaulttypes.OrderExpirationJitterWindowSeconds,
+		)
+	}
+
+	// Check that orders of different vaults expire at different times.
+	require.NotEqual(t, goodTilBlockTimes[0], goodTilBlockTimes[1])
+}
+
+func TestGetVaultClobOrders_GoodTilBlockTimeClamp(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+
+	// Set an order expiration that is way beyond the clob's stateful order time window.
+	params := vaulttypes.DefaultParams()
+	params.OrderExpirationSeconds = math.MaxUint32
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// Check that good-til-block-time is clamped to previous block time plus stateful order time window.
+	expectedGoodTilBlockTime := uint32(
+		tApp.App.BlockTimeKeeper.GetPreviousBlockInfo(ctx).Timestamp.Add(clobtypes.StatefulOrderTimeWindow).Unix(),
+	)
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.NotEmpty(t, orders)
+	for _, order := range orders {
+		require.Equal(t, expectedGoodTilBlockTime, order.GetGoodTilBlockTime())
+
+		// Check that clamped orders can be placed.
+		err := k.PlaceVaultClobOrder(ctx, order)
+		require.NoError(t, err)
+	}
+}
+
+func TestGetVaultClobOrders_MinOrderLifetimeSeconds(t *testing.T) {
+	tests := map[string]struct {
+		// Min order lifetime in seconds.
+		minOrderLifetimeSeconds uint32
+		// Expected lifetime (good-til-block-time minus block time) of vault orders.
+		expectedLifetimeSeconds uint32
+	}{
+		"Floor disabled": {
+			minOrderLifetimeSeconds: 0,
+			expectedLifetimeSeconds: 6, // 2 + 4 (jitter)
+		},
+		"Floor below expiration": {
+			minOrderLifetimeSeconds: 5,
+			expectedLifetimeSeconds: 6,
+		},
+		"Floor above expiration, expiration is raised to floor": {
+			minOrderLifetimeSeconds: 30,
+			expectedLifetimeSeconds: 30,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Vault 0 has an order expiration jitter of 4 seconds.
+			require.Equal(t, uint32(4), vaultId.GetOrderExpirationJitterSeconds())
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+
+			params := vaulttypes.DefaultParams()
+			params.OrderExpirationSeconds = 2
+			params.MinOrderLifetimeSeconds = tc.minOrderLifetimeSeconds
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			// Check that orders expire after expected lifetime and can be placed.
+			expectedGoodTilBlockTime := uint32(ctx.BlockTime().Unix()) + tc.expectedLifetimeSeconds
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.NotEmpty(t, orders)
+			for _, order := range orders {
+				require.Equal(t, expectedGoodTilBlockTime, order.GetGoodTilBlockTime())
+				err := k.PlaceVaultClobOrder(ctx, order)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders_ExpirationOracleTicks(t *testing.T) {
+	tests := map[string]struct {
+		// Number of oracle update intervals after which vault orders expire.
+		expirationOracleTicks uint32
+		// Update interval of BTC market in seconds.
+		btcUpdateIntervalSeconds uint32
+		// Update interval of ETH market in seconds.
+		ethUpdateIntervalSeconds uint32
+		// Whether vault quotes at ETH market instead of BTC market.
+		overridePriceMarket bool
+		// Expected lifetime (good-til-block-time minus block time) of vault orders.
+		expectedLifetimeSeconds uint32
+	}{
+		"Ticks unset, orders expire after order expiration seconds": {
+			expirationOracleTicks:    0,
+			btcUpdateIntervalSeconds: 3,
+			expectedLifetimeSeconds:  6, // 2 + 4 (jitter)
+		},
+		"Market has no update interval, orders expire after order expiration seconds": {
+			expirationOracleTicks:    5,
+			btcUpdateIntervalSeconds: 0,
+			expectedLifetimeSeconds:  6, // 2 + 4 (jitter)
+		},
+		"Orders expire after ticks of market update interval": {
+			expirationOracleTicks:    5,
+			btcUpdateIntervalSeconds: 3,
+			expectedLifetimeSeconds:  19, // 5 * 3 + 4 (jitter)
+		},
+		"Orders expire after ticks of price market update interval": {
+			expirationOracleTicks:    5,
+			btcUpdateIntervalSeconds: 3,
+			ethUpdateIntervalSeconds: 1,
+			overridePriceMarket:      true,
+			expectedLifetimeSeconds:  9, // 5 * 1 + 4 (jitter)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Vault 0 has an order expiration jitter of 4 seconds.
+			require.Equal(t, uint32(4), vaultId.GetOrderExpirationJitterSeconds())
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *pricestypes.GenesisState) {
+						genesisState.MarketParams[0].UpdateIntervalSeconds = tc.btcUpdateIntervalSeconds
+						genesisState.MarketParams[1].UpdateIntervalSeconds = tc.ethUpdateIntervalSeconds
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+			k := tApp.App.VaultKeeper
+
+			params := vaulttypes.DefaultParams()
+			params.OrderExpirationSeconds = 2
+			params.ExpirationOracleTicks = tc.expirationOracleTicks
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+			if tc.overridePriceMarket {
+				err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+					PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 1},
+				})
+				require.NoError(t, err)
+			}
+
+			// Check that orders expire after expected lifetime and can be placed.
+			expectedGoodTilBlockTime := uint32(ctx.BlockTime().Unix()) + tc.expectedLifetimeSeconds
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.NotEmpty(t, orders)
+			for _, order := range orders {
+				require.Equal(t, expectedGoodTilBlockTime, order.GetGoodTilBlockTime())
+				err := k.PlaceVaultClobOrder(ctx, order)
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders_PriceMarketIdOverride(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Override price market of BTC vault to ETH market, whose price is $1,500 in default genesis.
+	err := k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+		PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 1},
+	})
+	require.NoError(t, err)
+
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	// Orders center on oracle price of ETH ($1,500 = 15_000_000 subticks) with a spread of 1%.
+	// a_0 = 15_000_000 * 1.01 = 15_150_000, a_1 = 15_000_000 * (1 + 0.002 + 0.02) = 15_330_000.
+	// b_0 = 15_000_000 * 0.99 = 14_850_000, b_1 = 15_000_000 * (1 - 0.002 - 0.02) = 14_670_000.
+	expectedSubticks := []uint64{15_150_000, 14_850_000, 15_330_000, 14_670_000}
+	require.Len(t, orders, len(expectedSubticks))
+	for i, order := range orders {
+		require.Equal(t, expectedSubticks[i], order.Subticks)
+		// order_size = 10% * $1_000 / $1_500 ~= 0.0667 BTC = 666_666_660 base quantums (rounded
+		// down to step size).
+		require.Equal(t, uint64(666_666_660), order.Quantums)
+	}
+}
+
+func TestGetVaultClobOrders_SpreadOracleMarketId(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Source spread of BTC vault from ETH market, whose price is 1_500_000_000 with exponent -6
+	// in default genesis, i.e. 1_500 bps = 150_000 ppm.
+	err := k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+		SpreadOracleMarketId: &gogotypes.UInt32Value{Value: 1},
+	})
+	require.NoError(t, err)
+
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	// Orders center on oracle price of BTC ($20,000 = 200_000_000 subticks) with a spread of 15%.
+	// a_0 = 200_000_000 * 1.15 = 230_000_000, b_0 = 200_000_000 * 0.85 = 170_000_000.
+	require.Equal(t, uint64(230_000_000), orders[0].Subticks)
+	require.Equal(t, uint64(170_000_000), orders[1].Subticks)
+
+	// Spread oracle moves to 0.05 (500 bps = 50_000 ppm), which tightens the vault's spread.
+	err = tApp.App.PricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+		{MarketId: 1, Price: 500_000_000},
+	})
+	require.NoError(t, err)
+	orders, err = k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	// a_0 = 200_000_000 * 1.05 = 210_000_000, b_0 = 200_000_000 * 0.95 = 190_000_000.
+	require.Equal(t, uint64(210_000_000), orders[0].Subticks)
+	require.Equal(t, uint64(190_000_000), orders[1].Subticks)
+}
+
+func TestGetVaultClobOrders_PriceBlend(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+	require.NoError(t, err)
+
+	// Blend prices of BTC market ($20,000) and ETH market ($1,500) with weights 70% and 30%.
+	err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+		PriceBlend: []vaulttypes.PriceBlendComponent{
+			{MarketId: 0, WeightPpm: 700_000},
+			{MarketId: 1, WeightPpm: 300_000},
+		},
+	})
+	require.NoError(t, err)
+
+	// Check that quotes center on blended price = 0.7 * $20,000 + 0.3 * $1,500 = $14,450,
+	// which is 144_500_000 subticks.
+	quoteCurve, err := k.VaultQuoteCurve(ctx, &vaulttypes.QueryVaultQuoteCurveRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(144_500_000), quoteCurve.OracleSubticks)
+
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, orders, 4)
+	for _, order := range orders {
+		if order.IsBuy() {
+			require.Less(t, order.Subticks, quoteCurve.OracleSubticks)
+		} else {
+			require.Greater(t, order.Subticks, quoteCurve.OracleSubticks)
+		}
+	}
+	// a_0 = 144_500_000 * 1.01 = 145_945_000 and b_0 = 144_500_000 * 0.99 = 143_055_000,
+	// rounded to a multiple of subticks per tick (10_000).
+	require.Equal(t, uint64(145_950_000), orders[0].Subticks)
+	require.Equal(t, uint64(143_050_000), orders[1].Subticks)
+}
+
+func TestGetVaultClobOrders_PriceBlendOverflow(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		// ETH market has exponent 10, which is 15 more than exponent -5 of BTC market.
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *pricestypes.GenesisState) {
+				genesisState.MarketParams[1].Exponent = 10
+				genesisState.MarketPrices[1].Exponent = 10
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+	require.NoError(t, err)
+
+	// Blend prices of BTC market and ETH market with weights 70% and 30%, where ETH price in
+	// BTC market's exponent is 1_500_000_000 * 10^15, which overflows uint64.
+	err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+		PriceBlend: []vaulttypes.PriceBlendComponent{
+			{MarketId: 0, WeightPpm: 700_000},
+			{MarketId: 1, WeightPpm: 300_000},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = k.GetVaultClobOrders(ctx, vaultId)
+	require.ErrorIs(t, err, vaulttypes.ErrInvalidPriceBlend)
+	_, err = k.VaultQuoteCurve(ctx, &vaulttypes.QueryVaultQuoteCurveRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.ErrorContains(t, err, vaulttypes.ErrInvalidPriceBlend.Error())
+}
+
+func TestGetVaultClobOrders_IndexConstituents(t *testing.T) {
+	tests := map[string]struct {
+		// Index constituents of the vault.
+		indexConstituents []vaulttypes.IndexConstituent
+		// Expected leverage of the vault.
+		expectedLeveragePpm int64
+	}{
+		"No index constituents": {
+			// leverage = $20 / $1,005 = 0.019900
+			expectedLeveragePpm: 19_900,
+		},
+		"Two index constituents": {
+			indexConstituents: []vaulttypes.IndexConstituent{
+				{PerpetualId: 0, WeightPpm: 600_000},
+				{PerpetualId: 1, WeightPpm: 400_000},
+			},
+			// leverage = (0.6 * $20 + 0.4 * -$15) / $1,005 = 0.005970
+			expectedLeveragePpm: 5_970,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									// 0.001 BTC long at $20,000 = $20.
+									testutil.CreateSinglePerpetualPosition(0, big.NewInt(10_000_000), big.NewInt(0)),
+									// 0.01 ETH short at $1,500 = -$15.
+									testutil.CreateSinglePerpetualPosition(1, big.NewInt(-10_000_000), big.NewInt(0)),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+			err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+				IndexConstituents: tc.indexConstituents,
+			})
+			require.NoError(t, err)
+
+			// Check leverage of the vault's layer-0 ask.
+			response, err := k.ExplainVaultOrder(ctx, &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaultId.Type,
+				Number: vaultId.Number,
+				Side:   clobtypes.Order_SIDE_SELL,
+				Layer:  0,
+			})
+			require.NoError(t, err)
+			require.Equal(t, dtypes.NewInt(tc.expectedLeveragePpm), response.Explanation.LeveragePpm)
+
+			// Check that vault quotes both sides of both layers.
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, orders, 4)
+		})
+	}
+}
+
+func TestGetVaultClobOrders_SubticksJitter(t *testing.T) {
+	vaultIds := []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1}
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = make([]satypes.Subaccount, len(vaultIds))
+				for i, vaultId := range vaultIds {
+					genesisState.Subaccounts[i] = satypes.Subaccount{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					}
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Vaults 0 and 1 have jitter of 0 and 1 tick respectively.
+	expectedJitterTicks := []uint64{0, 1}
+	for i, vaultId := range vaultIds {
+		clobPair, exists := tApp.App.ClobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+		require.True(t, exists)
+
+		// Get orders with jitter disabled and enabled, where params are otherwise identical.
+		params := vaulttypes.DefaultParams()
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+		ordersWithoutJitter, err := k.GetVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+		params.SubticksJitterEnabled = true
+		err = k.SetParams(ctx, params)
+		require.NoError(t, err)
+		ordersWithJitter, err := k.GetVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+
+		// Check that asks (bids) move up (down) by the vault's jitter.
+		jitter := expectedJitterTicks[i] * uint64(clobPair.SubticksPerTick)
+		require.Len(t, ordersWithJitter, len(ordersWithoutJitter))
+		for j, order := range ordersWithoutJitter {
+			expectedOrder := *order
+			if order.Side == clobtypes.Order_SIDE_SELL {
+				expectedOrder.Subticks += jitter
+			} else {
+				expectedOrder.Subticks -= jitter
+			}
+			require.Equal(t, expectedOrder, *ordersWithJitter[j])
+		}
+
+		// Check that jitter is deterministic.
+		ordersWithJitterAgain, err := k.GetVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+		require.Equal(t, ordersWithJitter, ordersWithJitterAgain)
+	}
+}
+
+func TestGetVaultClobOrders_MinTicksFromOraclePerSide(t *testing.T) {
+	tests := map[string]struct {
+		// Minimum ticks from oracle price per side.
+		minTicksFromOraclePerSide uint32
+		// Expected subticks of a_0 and b_0.
+		expectedAsk0Subticks uint64
+		expectedBid0Subticks uint64
+	}{
+		"Disabled: a_0 is at oracle price": {
+			minTicksFromOraclePerSide: 0,
+			expectedAsk0Subticks:      200_000_000,
+			expectedBid0Subticks:      196_000_000,
+		},
+		"3 ticks: a_0 is pushed out to 3 ticks above oracle price": {
+			minTicksFromOraclePerSide: 3,
+			expectedAsk0Subticks:      200_030_000,
+			expectedBid0Subticks:      196_000_000,
+		},
+		"500 ticks: both a_0 and b_0 are pushed out to 500 ticks from oracle price": {
+			minTicksFromOraclePerSide: 500,
+			expectedAsk0Subticks:      205_000_000,
+			expectedBid0Subticks:      195_000_000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(500_000_000), // 500 USDC
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										big.NewInt(250_000_000), // 0.025 BTC ($500 notional)
+										big.NewInt(0),
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			params := vaulttypes.DefaultParams()
+			params.MinTicksFromOraclePerSide = tc.minTicksFromOraclePerSide
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			// With leverage 0.5 and skew factor 2, skew at layer 0 is -0.5 * 1% * 2 = -1%, which
+			// cancels out ask spread (placing a_0 at oracle price) and doubles bid spread.
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, orders, 2*int(params.Layers))
+			require.Equal(t, tc.expectedAsk0Subticks, orders[0].Subticks)
+			require.Equal(t, tc.expectedBid0Subticks, orders[1].Subticks)
+			// Outer layers are also at least the minimum number of ticks away from oracle price.
+			minOffset := uint64(tc.minTicksFromOraclePerSide) * 10_000
+			for _, order := range orders {
+				if order.IsBuy() {
+					require.LessOrEqual(t, order.Subticks, 200_000_000-minOffset)
+				} else {
+					require.GreaterOrEqual(t, order.Subticks, 200_000_000+minOffset)
+				}
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders_OneSideInvalid(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						PerpetualPositions: []*satypes.PerpetualPosition{
+							testutil.CreateSinglePerpetualPosition(
+								0,
+								big.NewInt(500_000_000), // 0.05 BTC ($1,000 notional)
+								big.NewInt(0),
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// With leverage 1 and a skew factor of 200, bid skew at layer 0 is -1 * 1% * 200 = -200%,
+	// which makes bid subticks negative. Ask skew is negative and thus floored at zero.
+	params := vaulttypes.DefaultParams()
+	params.SkewFactorPpm = 200_000_000
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	// Only asks are returned. a_0 = oracle price (200_000_000 subticks) and a_1 is moved one tick
+	// up as it rounds to the same subticks as a_0.
+	require.Len(t, orders, int(params.Layers))
+	expectedSubticks := []uint64{200_000_000, 200_010_000}
+	for i, order := range orders {
+		require.Equal(t, clobtypes.Order_SIDE_SELL, order.Side)
+		require.Equal(t, expectedSubticks[i], order.Subticks)
+	}
+
+	// Check that quote curve reports layers of the remaining side.
+	err = k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+	require.NoError(t, err)
+	quoteCurve, err := k.VaultQuoteCurve(ctx, &vaulttypes.QueryVaultQuoteCurveRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	for i, point := range quoteCurve.Points {
+		require.Equal(t, uint32(i), point.Layer)
+	}
+}
+
+func TestGetRestingVaultOrders(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Vault IDs whose orders are placed.
+		vaultIdsWithOrders []vaulttypes.VaultId
+
+		/* --- Expectations --- */
+		// Whether orders of `vaultId` are expected to be resting.
+		expectOrders bool
+		// Expected error, if any.
+		expectedErr error
+	}{
+		"Vault Clob 0, only orders of Vault Clob 0 are placed": {
+			vaultId:            constants.Vault_Clob0,
+			vaultIdsWithOrders: []vaulttypes.VaultId{constants.Vault_Clob0},
+			expectOrders:       true,
+		},
+		"Vault Clob 0, orders of Vault Clob 0 and 1 are placed": {
+			vaultId:            constants.Vault_Clob0,
+			vaultIdsWithOrders: []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1},
+			expectOrders:       true,
+		},
+		"Vault Clob 1, only orders of Vault Clob 0 are placed": {
+			vaultId:            constants.Vault_Clob1,
+			vaultIdsWithOrders: []vaulttypes.VaultId{constants.Vault_Clob0},
+			expectOrders:       false,
+		},
+		"Vault Clob 797 (non-existent clob pair)": {
+			vaultId: vaulttypes.VaultId{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 797,
+			},
+			vaultIdsWithOrders: []vaulttypes.VaultId{constants.Vault_Clob0},
+			expectedErr:        vaulttypes.ErrClobPairNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Initialize tApp and ctx (in deliverTx mode).
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				// Initialize vaults with quote quantums to be able to place orders.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						subaccounts := make([]satypes.Subaccount, 0)
+						for _, vaultId := range tc.vaultIdsWithOrders {
+							subaccounts = append(subaccounts, satypes.Subaccount{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							})
+						}
+						genesisState.Subaccounts = subaccounts
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithIsCheckTx(false)
+
+			// Place orders.
+			placedOrders := make(map[vaulttypes.VaultId][]*clobtypes.Order)
+			for _, vaultId := range tc.vaultIdsWithOrders {
+				orders, err := tApp.App.VaultKeeper.GetVaultClobOrders(ctx, vaultId)
+				require.NoError(t, err)
+				for _, order := range orders {
+					err := tApp.App.VaultKeeper.PlaceVaultClobOrder(ctx, order)
+					require.NoError(t, err)
+				}
+				placedOrders[vaultId] = orders
+			}
+
+			// Get resting orders.
+			restingOrders, err := tApp.App.VaultKeeper.GetRestingVaultOrders(ctx, tc.vaultId)
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// Check that resting orders are exactly the orders placed by the vault.
+			expectedOrders := make([]clobtypes.Order, 0)
+			if tc.expectOrders {
+				require.NotEmpty(t, placedOrders[tc.vaultId])
+				for _, order := range placedOrders[tc.vaultId] {
+					expectedOrders = append(expectedOrders, *order)
+				}
+			}
+			require.Equal(t, expectedOrders, restingOrders)
+		})
+	}
+}
+
+func TestGetVaultClobOrderIds(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Layers.
+		layers uint32
+
+		/* --- Expectations --- */
+		// Expected error, if any.
+		expectedErr error
+	}{
+		"Vault Clob 0, 2 layers": {
+			vaultId: constants.Vault_Clob0,
+			layers:  2,
+		},
+		"Vault Clob 1, 7 layers": {
+			vaultId: constants.Vault_Clob1,
+			layers:  7,
+		},
+		"Vault Clob 0, 0 layers": {
+			vaultId: constants.Vault_Clob0,
+			layers:  0,
+		},
+		"Vault Clob 797 (non-existent clob pair), 2 layers": {
+			vaultId: vaulttypes.VaultId{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 797,
+			},
+			layers:      2,
+			expectedErr: vaulttypes.ErrClobPairNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			k := tApp.App.VaultKeeper
+			ctx := tApp.InitChain()
+
+			// Set number of layers.
+			params := k.GetParams(ctx)
+			params.Layers = tc.layers
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			// Construct expected order IDs.
+			expectedOrderIds := make([]*clobtypes.OrderId, tc.layers*2)
+			for i := uint32(0); i < tc.layers; i++ {
+				expectedOrderIds[2*i] = &clobtypes.OrderId{
+					SubaccountId: *tc.vaultId.ToSubaccountId(),
+					ClientId:     tApp.App.VaultKeeper.GetVaultClobOrderClientId(ctx, clobtypes.Order_SIDE_SELL, uint8(i)),
+					OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+					ClobPairId:   tc.vaultId.Number,
+				}
+				expectedOrderIds[2*i+1] = &clobtypes.OrderId{
+					SubaccountId: *tc.vaultId.ToSubaccountId(),
+					ClientId:     tApp.App.VaultKeeper.GetVaultClobOrderClientId(ctx, clobtypes.Order_SIDE_BUY, uint8(i)),
+					OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+					ClobPairId:   tc.vaultId.Number,
+				}
+			}
+
+			// Verify order IDs.
+			orderIds, err := k.GetVaultClobOrderIds(ctx, tc.vaultId)
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				require.Empty(t, orderIds)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expectedOrderIds, orderIds)
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrderClientId(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// side.
+		side clobtypes.Order_Side
+		// block height.
+		blockHeight int64
+		// layer.
+		layer uint8
+
+		/* --- Expectations --- */
+		// Expected client ID.
+		expectedClientId uint32
+	}{
+		"Buy, Block Height Odd, Layer 1": {
+			side:             clobtypes.Order_SIDE_BUY, // 0<<31
+			blockHeight:      1,                        // 1<<30
+			layer:            1,                        // 1<<22
+			expectedClientId: 0<<31 | 1<<30 | 1<<22,
+		},
+		"Buy, Block Height Even, Layer 1": {
+			side:             clobtypes.Order_SIDE_BUY, // 0<<31
+			blockHeight:      2,                        // 0<<30
+			layer:            1,                        // 1<<22
+			expectedClientId: 0<<31 | 0<<30 | 1<<22,
+		},
+		"Sell, Block Height Odd, Layer 2": {
+			side:             clobtypes.Order_SIDE_SELL, // 1<<31
+			blockHeight:      1,                         // 1<<30
+			layer:            2,                         // 2<<22
+			expectedClientId: 1<<31 | 1<<30 | 2<<22,
+		},
+		"Sell, Block Height Even, Layer 2": {
+			side:             clobtypes.Order_SIDE_SELL, // 1<<31
+			blockHeight:      2,                         // 0<<30
+			layer:            2,                         // 2<<22
+			expectedClientId: 1<<31 | 0<<30 | 2<<22,
+		},
+		"Buy, Block Height Even, Layer Max Uint8": {
+			side:             clobtypes.Order_SIDE_BUY, // 0<<31
+			blockHeight:      123456,                   // 0<<30
+			layer:            math.MaxUint8,            // 255<<22
+			expectedClientId: 0<<31 | 0<<30 | 255<<22,
+		},
+		"Sell, Block Height Odd, Layer 0": {
+			side:             clobtypes.Order_SIDE_SELL, // 1<<31
+			blockHeight:      12345654321,               // 1<<30
+			layer:            0,                         // 0<<22
+			expectedClientId: 1<<31 | 1<<30 | 0<<22,
+		},
+		"Sell, Block Height Odd (negative), Layer 202": {
+			side: clobtypes.Order_SIDE_SELL, // 1<<31
+			// Negative block height shouldn't happen but blockHeight
+			// is represented as int64.
+			blockHeight:      -678987, // 1<<30
+			layer:            202,     // 202<<22
+			expectedClientId: 1<<31 | 1<<30 | 202<<22,
+		},
+		"Buy, Block Height Odd (negative), Layer 3": {
+			side:             clobtypes.Order_SIDE_BUY, // 0<<31
+			blockHeight:      -5,                       // 1<<30
+			layer:            3,                        // 3<<22
+			expectedClientId: 0<<31 | 1<<30 | 3<<22,
+		},
+		"Buy, Block Height Even (negative), Layer 3": {
+			side:             clobtypes.Order_SIDE_BUY, // 0<<31
+			blockHeight:      -6,                       // 0<<30
+			layer:            3,                        // 3<<22
+			expectedClientId: 0<<31 | 0<<30 | 3<<22,
+		},
+		"Buy, Block Height Even (min int64), Layer 3": {
+			side:             clobtypes.Order_SIDE_BUY, // 0<<31
+			blockHeight:      math.MinInt64,            // 0<<30
+			layer:            3,                        // 3<<22
+			expectedClientId: 0<<31 | 0<<30 | 3<<22,
+		},
+		"Buy, Block Height Even (zero), Layer 157": {
+			side:             clobtypes.Order_SIDE_SELL, // 1<<31
+			blockHeight:      0,                         // 0<<30
+			layer:            157,                       // 157<<22
+			expectedClientId: 1<<31 | 0<<30 | 157<<22,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+
+			clientId := tApp.App.VaultKeeper.GetVaultClobOrderClientId(
+				ctx.WithBlockHeight(tc.blockHeight),
+				tc.side,
+				tc.layer,
+			)
+			require.Equal(t, tc.expectedClientId, clientId)
+
+			// Check that decoding client ID returns side, block parity, and layer that it encodes.
+			side, blockParity, layer, err := vaulttypes.DecodeVaultClientId(clientId)
+			require.NoError(t, err)
+			require.Equal(t, tc.side, side)
+			require.Equal(t, uint32(tc.blockHeight%2)&1, blockParity)
+			require.Equal(t, tc.layer, layer)
+
+			// Check that client ID differs from that of an adjacent block height, which has
+			// the opposite parity, including when both block heights are negative.
+			adjacentClientId := tApp.App.VaultKeeper.GetVaultClobOrderClientId(
+				ctx.WithBlockHeight(tc.blockHeight+1),
+				tc.side,
+				tc.layer,
+			)
+			require.NotEqual(t, clientId, adjacentClientId)
+			adjacentSide, adjacentBlockParity, adjacentLayer, err := vaulttypes.DecodeVaultClientId(adjacentClientId)
+			require.NoError(t, err)
+			require.Equal(t, tc.side, adjacentSide)
+			require.Equal(t, 1-blockParity, adjacentBlockParity)
+			require.Equal(t, tc.layer, adjacentLayer)
+		})
+	}
+}
+
+func TestGetVaultClobOrders_SizeProfile(t *testing.T) {
+	tests := map[string]struct {
+		// Size profile.
+		sizeProfile vaulttypes.SizeProfile
+		// Expected size of each layer, from innermost to outermost.
+		expectedLayerSizes []uint64
+	}{
+		"Flat: all layers have the same size": {
+			sizeProfile:        vaulttypes.SizeProfile_SIZE_PROFILE_FLAT,
+			expectedLayerSizes: []uint64{50_000_000, 50_000_000, 50_000_000},
+		},
+		"Front loaded: inner layers have more size": {
+			sizeProfile:        vaulttypes.SizeProfile_SIZE_PROFILE_FRONT_LOADED,
+			expectedLayerSizes: []uint64{75_000_000, 50_000_000, 25_000_000},
+		},
+		"Back loaded: outer layers have more size": {
+			sizeProfile:        vaulttypes.SizeProfile_SIZE_PROFILE_BACK_LOADED,
+			expectedLayerSizes: []uint64{25_000_000, 50_000_000, 75_000_000},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			params := vaulttypes.DefaultParams()
+			params.Layers = 3
+			params.SizeProfile = tc.sizeProfile
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, orders, 2*len(tc.expectedLayerSizes))
+
+			// Check that asks and bids of each layer have the expected size and that
+			// total size across layers is the same as that of a flat profile.
+			totalSize := uint64(0)
+			for i, expectedSize := range tc.expectedLayerSizes {
+				require.Equal(t, expectedSize, orders[2*i].Quantums)
+				require.Equal(t, expectedSize, orders[2*i+1].Quantums)
+				totalSize += orders[2*i].Quantums
+			}
+			require.Equal(t, uint64(150_000_000), totalSize)
+		})
+	}
+}
+
+func TestGetVaultClobOrders_InventoryDeadBand(t *testing.T) {
+	tests := map[string]struct {
+		// Inventory dead band in base quantums.
+		inventoryDeadBand uint64
+		// Inventory of the vault in perpetual 0.
+		inventory *big.Int
+		// Inventory without a dead band at which vault is expected to quote the same prices.
+		expectedSkewInventory *big.Int
+	}{
+		"Dead band disabled": {
+			inventoryDeadBand:     0,
+			inventory:             big.NewInt(500_000_000),
+			expectedSkewInventory: big.NewInt(500_000_000),
+		},
+		"Long inside dead band, no skew applied": {
+			inventoryDeadBand:     1_000_000_000, // 0.1 BTC
+			inventory:             big.NewInt(500_000_000),
+			expectedSkewInventory: big.NewInt(0),
+		},
+		"Short at dead band edge, no skew applied": {
+			inventoryDeadBand:     1_000_000_000,
+			inventory:             big.NewInt(-1_000_000_000),
+			expectedSkewInventory: big.NewInt(0),
+		},
+		"Long outside dead band, skew measured from band edge": {
+			inventoryDeadBand:     1_000_000_000,
+			inventory:             big.NewInt(1_500_000_000),
+			expectedSkewInventory: big.NewInt(500_000_000),
+		},
+		"Short outside dead band, skew measured from band edge": {
+			inventoryDeadBand:     1_000_000_000,
+			inventory:             big.NewInt(-1_500_000_000),
+			expectedSkewInventory: big.NewInt(-500_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Sets vault inventory while keeping its equity at $10,000 when BTC is at $20,000.
+			setVaultInventory := func(inventory *big.Int) {
+				usdc := new(big.Int).Mul(inventory, big.NewInt(-2))
+				usdc.Add(usdc, big.NewInt(10_000_000_000))
+				subaccount := satypes.Subaccount{
+					Id: vaultId.ToSubaccountId(),
+					AssetPositions: []*satypes.AssetPosition{
+						testutil.CreateSingleAssetPosition(assettypes.AssetUsdc.Id, usdc),
+					},
+				}
+				if inventory.Sign() != 0 {
+					subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, inventory, big.NewInt(0)),
+					}
+				}
+				tApp.App.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+				equity, err := k.GetVaultEquity(ctx, vaultId)
+				require.NoError(t, err)
+				require.Equal(t, big.NewInt(10_000_000_000), equity)
+			}
+			getOrderSubticks := func() (subticks []uint64) {
+				orders, err := k.GetVaultClobOrders(ctx, vaultId)
+				require.NoError(t, err)
+				require.NotEmpty(t, orders)
+				for _, order := range orders {
+					subticks = append(subticks, order.Subticks)
+				}
+				return subticks
+			}
+
+			// Get prices that vault quotes at with the expected skew inventory and no dead band.
+			setVaultInventory(tc.expectedSkewInventory)
+			expectedSubticks := getOrderSubticks()
+
+			// Check that vault quotes the same prices with its inventory and the dead band.
+			params := k.GetParams(ctx)
+			params.InventoryDeadBandBaseQuantums = tc.inventoryDeadBand
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+			setVaultInventory(tc.inventory)
+			require.Equal(t, expectedSubticks, getOrderSubticks())
+		})
+	}
+}
+
+func TestGetVaultClobOrders_ManualReferencePrice(t *testing.T) {
+	tests := map[string]struct {
+		// Whether vault has a manual reference price.
+		hasManualPrice bool
+		// Seconds from current block time until manual reference price expires.
+		expirySecondsFromNow int64
+		// Whether vault is expected to quote around manual reference price.
+		expectedManualPrice bool
+	}{
+		"No manual reference price": {
+			hasManualPrice:      false,
+			expectedManualPrice: false,
+		},
+		"Manual reference price active": {
+			hasManualPrice:       true,
+			expirySecondsFromNow: 60,
+			expectedManualPrice:  true,
+		},
+		"Manual reference price expired at current block time": {
+			hasManualPrice:       true,
+			expirySecondsFromNow: 0,
+			expectedManualPrice:  false,
+		},
+		"Manual reference price expired before current block time": {
+			hasManualPrice:       true,
+			expirySecondsFromNow: -60,
+			expectedManualPrice:  false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithBlockTime(time.Unix(1_700_000_000, 0))
+			k := tApp.App.VaultKeeper
+
+			// Get orders that vault would place around oracle price and around manual price,
+			// which is 10% above oracle price.
+			marketPrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+			require.NoError(t, err)
+			oracleOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			manualPrice := marketPrice.Price * 11 / 10
+			err = tApp.App.PricesKeeper.UpdateMarketPrices(
+				ctx,
+				[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: manualPrice}},
+			)
+			require.NoError(t, err)
+			manualPriceOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.NotEqual(t, oracleOrders, manualPriceOrders)
+			err = tApp.App.PricesKeeper.UpdateMarketPrices(
+				ctx,
+				[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: marketPrice.Price}},
+			)
+			require.NoError(t, err)
+
+			// Set manual reference price.
+			if tc.hasManualPrice {
+				err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+					ManualReferencePrice: &vaulttypes.ManualReferencePrice{
+						Price:      manualPrice,
+						ExpiryTime: uint32(ctx.BlockTime().Unix() + tc.expirySecondsFromNow),
+					},
+				})
+				require.NoError(t, err)
+			}
+
+			// Check orders. Computing orders doesn't emit events.
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Empty(t, ctx.EventManager().Events())
+
+			// Check events emitted when vault places orders.
+			ctx = ctx.WithIsCheckTx(false)
+			err = k.RefreshVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			manualPriceEvents := []sdk.Event{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == vaulttypes.EventTypeVaultManualPrice {
+					manualPriceEvents = append(manualPriceEvents, event)
+				}
+			}
+			if tc.expectedManualPrice {
+				require.Equal(t, manualPriceOrders, orders)
+				vaultParams, exists := k.GetVaultParams(ctx, vaultId)
+				require.True(t, exists)
+				require.Equal(
+					t,
+					[]sdk.Event{vaulttypes.NewVaultManualPriceEvent(vaultId, *vaultParams.ManualReferencePrice)},
+					manualPriceEvents,
+				)
+			} else {
+				require.Equal(t, oracleOrders, orders)
+				require.Empty(t, manualPriceEvents)
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders_RoundToMid(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
 			},
-			vaultId:                    constants.Vault_Clob1,
-			vaultAssetQuoteQuantums:    big.NewInt(1_000_000), // 1 USDC
-			vaultInventoryBaseQuantums: big.NewInt(0),
-			clobPair:                   constants.ClobPair_Eth,
-			marketParam:                constants.TestMarketParams[1],
-			marketPrice:                constants.TestMarketPrices[1],
-			perpetual:                  constants.EthUsd_0DefaultFunding_9AtomicResolution,
-			expectedOrderSubticks:      []uint64{},
-			// order_size = 0.1% * 1 / 3_000 ~= 0.00000033333
-			// order_size_base_quantums = 0.000033333e9 = 333
-			// round down to nearest multiple of step_base_quantums=1_000.
-			// order size is 0.
-			expectedOrderQuantums: []uint64{},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	clobPair, exists := tApp.App.ClobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	require.True(t, exists)
+
+	// Use a spread such that order prices are not multiples of subticks per tick.
+	params := k.GetParams(ctx)
+	params.SpreadMinPpm = 12_345
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// Get orders with default rounding away from oracle price.
+	defaultOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.NotEmpty(t, defaultOrders)
+
+	// Get orders with rounding towards oracle price.
+	params.RoundToMid = true
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	midOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, midOrders, len(defaultOrders))
+
+	// Check that orders rounded to mid are at most one tick tighter and that at least
+	// one order is strictly tighter.
+	tighter := false
+	minAskSubticks, maxBidSubticks := uint64(math.MaxUint64), uint64(0)
+	for i, midOrder := range midOrders {
+		defaultOrder := defaultOrders[i]
+		require.Equal(t, defaultOrder.Side, midOrder.Side)
+		require.Equal(t, defaultOrder.Quantums, midOrder.Quantums)
+		require.Zero(t, midOrder.Subticks%uint64(clobPair.SubticksPerTick))
+		if midOrder.Side == clobtypes.Order_SIDE_SELL {
+			require.LessOrEqual(t, midOrder.Subticks, defaultOrder.Subticks)
+			require.LessOrEqual(t, defaultOrder.Subticks-midOrder.Subticks, uint64(clobPair.SubticksPerTick))
+			minAskSubticks = min(minAskSubticks, midOrder.Subticks)
+		} else {
+			require.GreaterOrEqual(t, midOrder.Subticks, defaultOrder.Subticks)
+			require.LessOrEqual(t, midOrder.Subticks-defaultOrder.Subticks, uint64(clobPair.SubticksPerTick))
+			maxBidSubticks = max(maxBidSubticks, midOrder.Subticks)
+		}
+		if midOrder.Subticks != defaultOrder.Subticks {
+			tighter = true
+		}
+	}
+	require.True(t, tighter)
+	// Check that orders rounded to mid don't cross.
+	require.Greater(t, minAskSubticks, maxBidSubticks)
+}
+
+func TestGetVaultClobOrders_OrderStepBaseQuantumsOverride(t *testing.T) {
+	tests := map[string]struct {
+		// Multiple of clob pair's step size that the vault's step size override is. Zero
+		// means no override.
+		stepMultiple uint64
+		// Expected size of each order.
+		expectedSize uint64
+	}{
+		"No override, size is rounded to clob pair's step size": {
+			stepMultiple: 0,
+			// 10% * 1,234.56789 USDC / $20,000 = 61_728_394.5 base quantums, rounded to 10.
+			expectedSize: 61_728_390,
 		},
-		"Error - Clob Pair doesn't exist": {
-			vaultParams: vaulttypes.DefaultParams(),
+		"10x override, size is rounded to 10x clob pair's step size": {
+			stepMultiple: 10,
+			expectedSize: 61_728_300,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_234_567_890), // 1,234.56789 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			clobPair, exists := tApp.App.ClobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+			require.True(t, exists)
+			require.Equal(t, uint64(10), clobPair.StepBaseQuantums)
+			err := k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+				OrderStepBaseQuantumsOverride: clobPair.StepBaseQuantums * tc.stepMultiple,
+			})
+			require.NoError(t, err)
+
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.NotEmpty(t, orders)
+			for _, order := range orders {
+				require.Equal(t, tc.expectedSize, order.Quantums)
+			}
+		})
+	}
+}
+
+func TestGetNextBlockVaultClobOrderIds(t *testing.T) {
+	tests := map[string]struct {
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Block height.
+		blockHeight int64
+		// Expected error, if any.
+		expectedErr error
+	}{
+		"Vault Clob 0, Block Height Even": {
 			vaultId:     constants.Vault_Clob0,
-			clobPair:    constants.ClobPair_Eth,
-			marketParam: constants.TestMarketParams[1],
-			marketPrice: constants.TestMarketPrices[1],
-			perpetual:   constants.EthUsd_NoMarginRequirement,
+			blockHeight: 2,
+		},
+		"Vault Clob 1, Block Height Odd": {
+			vaultId:     constants.Vault_Clob1,
+			blockHeight: 3,
+		},
+		"Vault Clob 797 (non-existent clob pair)": {
+			vaultId: vaulttypes.VaultId{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 797,
+			},
+			blockHeight: 2,
 			expectedErr: vaulttypes.ErrClobPairNotFound,
 		},
-		"Error - Vault equity is zero": {
-			vaultParams:                vaulttypes.DefaultParams(),
-			vaultId:                    constants.Vault_Clob0,
-			vaultAssetQuoteQuantums:    big.NewInt(0),
-			vaultInventoryBaseQuantums: big.NewInt(0),
-			clobPair:                   constants.ClobPair_Btc,
-			marketParam:                constants.TestMarketParams[0],
-			marketPrice:                constants.TestMarketPrices[0],
-			perpetual:                  constants.BtcUsd_0DefaultFunding_10AtomicResolution,
-			expectedErr:                vaulttypes.ErrNonPositiveEquity,
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			k := tApp.App.VaultKeeper
+			ctx := tApp.InitChain().WithBlockHeight(tc.blockHeight)
+
+			nextBlockOrderIds, err := k.GetNextBlockVaultClobOrderIds(ctx, tc.vaultId)
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				require.Empty(t, nextBlockOrderIds)
+				return
+			}
+			require.NoError(t, err)
+
+			// Next block's order IDs should be the same as order IDs at next block height.
+			expectedOrderIds, err := k.GetVaultClobOrderIds(ctx.WithBlockHeight(tc.blockHeight+1), tc.vaultId)
+			require.NoError(t, err)
+			require.Equal(t, expectedOrderIds, nextBlockOrderIds)
+
+			// Next block's order IDs should differ from current block's only in the block height bit.
+			currentOrderIds, err := k.GetVaultClobOrderIds(ctx, tc.vaultId)
+			require.NoError(t, err)
+			require.Len(t, nextBlockOrderIds, len(currentOrderIds))
+			for i, orderId := range currentOrderIds {
+				require.Equal(t, orderId.ClientId^(1<<30), nextBlockOrderIds[i].ClientId)
+				expectedOrderId := *orderId
+				expectedOrderId.ClientId = nextBlockOrderIds[i].ClientId
+				require.Equal(t, expectedOrderId, *nextBlockOrderIds[i])
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders_FeeFloor(t *testing.T) {
+	tests := map[string]struct {
+		// Whether to include fee floor in spread.
+		includeFeeFloor bool
+		// Maker and taker fee ppm of the vault's fee tier.
+		makerFeePpm int32
+		takerFeePpm int32
+		// Expected subticks of a_0 and b_0.
+		expectedAsk0Subticks uint64
+		expectedBid0Subticks uint64
+	}{
+		"Fee floor disabled: spread is spread_min": {
+			includeFeeFloor:      false,
+			makerFeePpm:          5_000,
+			takerFeePpm:          15_000,
+			expectedAsk0Subticks: 202_000_000, // 20_000 * (1 + 1%)
+			expectedBid0Subticks: 198_000_000, // 20_000 * (1 - 1%)
 		},
-		"Error - Vault equity is negative": {
-			vaultParams:                vaulttypes.DefaultParams(),
-			vaultId:                    constants.Vault_Clob0,
-			vaultAssetQuoteQuantums:    big.NewInt(5_000_000), // 5 USDC
-			vaultInventoryBaseQuantums: big.NewInt(-10_000_000),
-			clobPair:                   constants.ClobPair_Btc,
-			marketParam:                constants.TestMarketParams[0],
-			marketPrice:                constants.TestMarketPrices[0],
-			perpetual:                  constants.BtcUsd_0DefaultFunding_10AtomicResolution,
-			expectedErr:                vaulttypes.ErrNonPositiveEquity,
+		"Fee floor enabled but less than spread_min: spread is spread_min": {
+			includeFeeFloor:      true,
+			makerFeePpm:          -110,
+			takerFeePpm:          500,
+			expectedAsk0Subticks: 202_000_000,
+			expectedBid0Subticks: 198_000_000,
+		},
+		"Fee floor enabled and dominates: spread is maker fee + taker fee": {
+			includeFeeFloor:      true,
+			makerFeePpm:          5_000,
+			takerFeePpm:          15_000,
+			expectedAsk0Subticks: 204_000_000, // 20_000 * (1 + 2%)
+			expectedBid0Subticks: 196_000_000, // 20_000 * (1 - 2%)
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			// Initialize tApp and ctx.
+			vaultId := constants.Vault_Clob0
 			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
 				genesis = testapp.DefaultGenesis()
-				// Initialize prices module with test market param and market price.
-				testapp.UpdateGenesisDocWithAppStateForModule(
-					&genesis,
-					func(genesisState *pricestypes.GenesisState) {
-						genesisState.MarketParams = []pricestypes.MarketParam{tc.marketParam}
-						genesisState.MarketPrices = []pricestypes.MarketPrice{tc.marketPrice}
-					},
-				)
-				// Initialize perpetuals module with test perpetual.
-				testapp.UpdateGenesisDocWithAppStateForModule(
-					&genesis,
-					func(genesisState *perptypes.GenesisState) {
-						genesisState.LiquidityTiers = constants.LiquidityTiers
-						genesisState.Perpetuals = []perptypes.Perpetual{tc.perpetual}
-					},
-				)
-				// Initialize clob module with test clob pair.
 				testapp.UpdateGenesisDocWithAppStateForModule(
 					&genesis,
-					func(genesisState *clobtypes.GenesisState) {
-						genesisState.ClobPairs = []clobtypes.ClobPair{tc.clobPair}
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
 					},
 				)
-				// Initialize vault module with test params.
 				testapp.UpdateGenesisDocWithAppStateForModule(
 					&genesis,
-					func(genesisState *vaulttypes.GenesisState) {
-						genesisState.Params = tc.vaultParams
+					func(genesisState *feetierstypes.GenesisState) {
+						genesisState.Params = feetierstypes.PerpetualFeeParams{
+							Tiers: []*feetierstypes.PerpetualFeeTier{
+								{
+									Name:        "1",
+									MakerFeePpm: tc.makerFeePpm,
+									TakerFeePpm: tc.takerFeePpm,
+								},
+							},
+						}
 					},
 				)
-				// Initialize subaccounts module with vault's equity and inventory.
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			params := vaulttypes.DefaultParams()
+			params.IncludeFeeFloor = tc.includeFeeFloor
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, orders, 2*int(params.Layers))
+			require.Equal(t, tc.expectedAsk0Subticks, orders[0].Subticks)
+			require.Equal(t, tc.expectedBid0Subticks, orders[1].Subticks)
+		})
+	}
+}
+
+func TestGetVaultClobOrders_SoftInventoryBand(t *testing.T) {
+	tests := map[string]struct {
+		// Vault asset.
+		asset *big.Int
+		// Vault inventory in perpetual 0.
+		inventory *big.Int
+		// Soft inventory band.
+		softInventoryBandBaseQuantums uint64
+		// Expected sizes of asks and bids.
+		expectedAskSize uint64
+		expectedBidSize uint64
+	}{
+		"Disabled": {
+			asset:                         big.NewInt(500_000_000), // 500 USDC
+			inventory:                     big.NewInt(250_000_000), // 0.025 BTC ($500 notional)
+			softInventoryBandBaseQuantums: 0,
+			expectedAskSize:               50_000_000,
+			expectedBidSize:               50_000_000,
+		},
+		"Long inventory within soft band": {
+			asset:                         big.NewInt(500_000_000),
+			inventory:                     big.NewInt(250_000_000),
+			softInventoryBandBaseQuantums: 300_000_000,
+			expectedAskSize:               50_000_000,
+			expectedBidSize:               50_000_000,
+		},
+		"Long inventory at soft band": {
+			asset:                         big.NewInt(500_000_000),
+			inventory:                     big.NewInt(250_000_000),
+			softInventoryBandBaseQuantums: 250_000_000,
+			expectedAskSize:               50_000_000,
+			expectedBidSize:               50_000_000,
+		},
+		"Long inventory beyond soft band: bids are scaled down": {
+			asset:                         big.NewInt(500_000_000),
+			inventory:                     big.NewInt(250_000_000),
+			softInventoryBandBaseQuantums: 200_000_000,
+			// 50_000_000 * 200_000_000 / 250_000_000 = 40_000_000
+			expectedAskSize: 50_000_000,
+			expectedBidSize: 40_000_000,
+		},
+		"Long inventory at twice soft band: bids are halved": {
+			asset:                         big.NewInt(500_000_000),
+			inventory:                     big.NewInt(250_000_000),
+			softInventoryBandBaseQuantums: 125_000_000,
+			expectedAskSize:               50_000_000,
+			expectedBidSize:               25_000_000,
+		},
+		"Long inventory far beyond soft band: bids are step size": {
+			asset:                         big.NewInt(500_000_000),
+			inventory:                     big.NewInt(250_000_000),
+			softInventoryBandBaseQuantums: 1,
+			expectedAskSize:               50_000_000,
+			expectedBidSize:               10, // step size
+		},
+		"Short inventory beyond soft band: asks are scaled down": {
+			asset:                         big.NewInt(1_500_000_000), // 1,500 USDC
+			inventory:                     big.NewInt(-250_000_000),  // -0.025 BTC (-$500 notional)
+			softInventoryBandBaseQuantums: 200_000_000,
+			expectedAskSize:               40_000_000,
+			expectedBidSize:               50_000_000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
 				testapp.UpdateGenesisDocWithAppStateForModule(
 					&genesis,
 					func(genesisState *satypes.GenesisState) {
-						assetPositions := []*satypes.AssetPosition{}
-						if tc.vaultAssetQuoteQuantums != nil && tc.vaultAssetQuoteQuantums.Sign() != 0 {
-							assetPositions = append(
-								assetPositions,
-								&satypes.AssetPosition{
-									AssetId:  assettypes.AssetUsdc.Id,
-									Quantums: dtypes.NewIntFromBigInt(tc.vaultAssetQuoteQuantums),
-								},
-							)
-						}
-						perpPositions := []*satypes.PerpetualPosition{}
-						if tc.vaultInventoryBaseQuantums != nil && tc.vaultInventoryBaseQuantums.Sign() != 0 {
-							perpPositions = append(
-								perpPositions,
-								testutil.CreateSinglePerpetualPosition(
-									tc.perpetual.Params.Id,
-									tc.vaultInventoryBaseQuantums,
-									big.NewInt(0),
-								),
-							)
-						}
 						genesisState.Subaccounts = []satypes.Subaccount{
 							{
-								Id:                 tc.vaultId.ToSubaccountId(),
-								AssetPositions:     assetPositions,
-								PerpetualPositions: perpPositions,
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.asset,
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										tc.inventory,
+										big.NewInt(0),
+									),
+								},
 							},
 						}
 					},
@@ -669,222 +4635,641 @@ func TestGetVaultClobOrders(t *testing.T) {
 				return genesis
 			}).Build()
 			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
 
-			// Get vault orders.
-			orders, err := tApp.App.VaultKeeper.GetVaultClobOrders(ctx, tc.vaultId)
-			if tc.expectedErr != nil {
-				require.ErrorContains(t, err, tc.expectedErr.Error())
-				return
-			}
+			params := vaulttypes.DefaultParams()
+			params.SoftInventoryBandBaseQuantums = tc.softInventoryBandBaseQuantums
+			err := k.SetParams(ctx, params)
 			require.NoError(t, err)
 
-			// Get expected orders.
-			params := tApp.App.VaultKeeper.GetParams(ctx)
-			buildVaultClobOrder := func(
-				layer uint8,
-				side clobtypes.Order_Side,
-				quantums uint64,
-				subticks uint64,
-			) *clobtypes.Order {
-				return &clobtypes.Order{
-					OrderId: clobtypes.OrderId{
-						SubaccountId: *tc.vaultId.ToSubaccountId(),
-						ClientId:     tApp.App.VaultKeeper.GetVaultClobOrderClientId(ctx, side, layer),
-						OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
-						ClobPairId:   tc.vaultId.Number,
-					},
-					Side:     side,
-					Quantums: quantums,
-					Subticks: subticks,
-					GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{
-						GoodTilBlockTime: uint32(ctx.BlockTime().Unix()) + params.OrderExpirationSeconds,
-					},
+			// Equity is $1,000 and order size is 10% of equity, i.e. 0.005 BTC.
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, orders, 2*int(params.Layers))
+			for _, order := range orders {
+				if order.IsBuy() {
+					require.Equal(t, tc.expectedBidSize, order.Quantums)
+				} else {
+					require.Equal(t, tc.expectedAskSize, order.Quantums)
 				}
 			}
-			expectedOrders := make([]*clobtypes.Order, 0)
-			for i := 0; i < len(tc.expectedOrderQuantums); i += 2 {
-				expectedOrders = append(
-					expectedOrders,
-					// ask.
-					buildVaultClobOrder(
-						uint8(i/2),
-						clobtypes.Order_SIDE_SELL,
-						tc.expectedOrderQuantums[i],
-						tc.expectedOrderSubticks[i],
-					),
-					// bid.
-					buildVaultClobOrder(
-						uint8(i/2),
-						clobtypes.Order_SIDE_BUY,
-						tc.expectedOrderQuantums[i+1],
-						tc.expectedOrderSubticks[i+1],
-					),
+		})
+	}
+}
+
+func TestGetVaultClobOrders_MaxSubticksDeviation(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Max subticks deviation in ppm.
+		maxSubticksDeviationPpm uint32
+		// Whether orders that deviate too much are dropped.
+		strictSubticksDeviation bool
+
+		/* --- Expectations --- */
+		// Expected sides of orders.
+		expectedSides []clobtypes.Order_Side
+	}{
+		"Disabled: all orders are placed": {
+			maxSubticksDeviationPpm: 0,
+			strictSubticksDeviation: true,
+			expectedSides: []clobtypes.Order_Side{
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_BUY,
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_BUY,
+			},
+		},
+		"Not strict: bids that deviate too much are still placed": {
+			maxSubticksDeviationPpm: 50_000, // 5%
+			strictSubticksDeviation: false,
+			expectedSides: []clobtypes.Order_Side{
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_BUY,
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_BUY,
+			},
+		},
+		"Strict: bids that deviate too much are dropped": {
+			maxSubticksDeviationPpm: 50_000, // 5%
+			strictSubticksDeviation: true,
+			expectedSides: []clobtypes.Order_Side{
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_SELL,
+			},
+		},
+		"Strict: no order deviates too much": {
+			maxSubticksDeviationPpm: 500_000, // 50%
+			strictSubticksDeviation: true,
+			expectedSides: []clobtypes.Order_Side{
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_BUY,
+				clobtypes.Order_SIDE_SELL,
+				clobtypes.Order_SIDE_BUY,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										big.NewInt(1_000_000_000), // 0.1 BTC
+										big.NewInt(0),
+									),
+								},
+							},
+						}
+					},
 				)
-			}
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Use an extreme skew such that bids of the long vault are far below oracle price.
+			params := k.GetParams(ctx)
+			params.SkewFactorPpm = 20_000_000
+			params.MaxSubticksDeviationPpm = tc.maxSubticksDeviationPpm
+			params.StrictSubticksDeviation = tc.strictSubticksDeviation
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
 
-			// Compare expected orders with actual orders.
-			require.Equal(
-				t,
-				expectedOrders,
-				orders,
-			)
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			sides := make([]clobtypes.Order_Side, len(orders))
+			for i, order := range orders {
+				sides[i] = order.Side
+			}
+			require.Equal(t, tc.expectedSides, sides)
 		})
 	}
 }
 
-func TestGetVaultClobOrderIds(t *testing.T) {
+func TestGetVaultClobOrders_SpreadSchedule(t *testing.T) {
+	// 2023-11-14 22:13:20 UTC, i.e. 80,000 seconds since midnight.
+	blockTime := time.Unix(1_700_000_000, 0)
 	tests := map[string]struct {
-		/* --- Setup --- */
-		// Vault ID.
-		vaultId vaulttypes.VaultId
-		// Layers.
-		layers uint32
-
-		/* --- Expectations --- */
-		// Expected error, if any.
-		expectedErr error
+		// Spread schedule of the vault.
+		spreadSchedule []vaulttypes.SpreadScheduleWindow
+		// Whether orders are expected to be wider than orders without a schedule.
+		expectedWider bool
 	}{
-		"Vault Clob 0, 2 layers": {
-			vaultId: constants.Vault_Clob0,
-			layers:  2,
-		},
-		"Vault Clob 1, 7 layers": {
-			vaultId: constants.Vault_Clob1,
-			layers:  7,
+		"Window covers block time": {
+			spreadSchedule: []vaulttypes.SpreadScheduleWindow{
+				{StartSeconds: 79_200, EndSeconds: 82_800, SpreadMultiplierPpm: 2_000_000},
+			},
+			expectedWider: true,
 		},
-		"Vault Clob 0, 0 layers": {
-			vaultId: constants.Vault_Clob0,
-			layers:  0,
+		"Window wraps around midnight and covers block time": {
+			spreadSchedule: []vaulttypes.SpreadScheduleWindow{
+				{StartSeconds: 79_200, EndSeconds: 3_600, SpreadMultiplierPpm: 2_000_000},
+			},
+			expectedWider: true,
 		},
-		"Vault Clob 797 (non-existent clob pair), 2 layers": {
-			vaultId: vaulttypes.VaultId{
-				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
-				Number: 797,
+		"Window doesn't cover block time": {
+			spreadSchedule: []vaulttypes.SpreadScheduleWindow{
+				{StartSeconds: 0, EndSeconds: 3_600, SpreadMultiplierPpm: 2_000_000},
 			},
-			layers:      2,
-			expectedErr: vaulttypes.ErrClobPairNotFound,
+			expectedWider: false,
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			tApp := testapp.NewTestAppBuilder(t).Build()
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithBlockTime(blockTime)
 			k := tApp.App.VaultKeeper
-			ctx := tApp.InitChain()
 
-			// Set number of layers.
-			params := k.GetParams(ctx)
-			params.Layers = tc.layers
-			err := k.SetParams(ctx, params)
+			// Get orders without a schedule.
+			unscheduledOrders, err := k.GetVaultClobOrders(ctx, vaultId)
 			require.NoError(t, err)
 
-			// Construct expected order IDs.
-			expectedOrderIds := make([]*clobtypes.OrderId, tc.layers*2)
-			for i := uint32(0); i < tc.layers; i++ {
-				expectedOrderIds[2*i] = &clobtypes.OrderId{
-					SubaccountId: *tc.vaultId.ToSubaccountId(),
-					ClientId:     tApp.App.VaultKeeper.GetVaultClobOrderClientId(ctx, clobtypes.Order_SIDE_SELL, uint8(i)),
-					OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
-					ClobPairId:   tc.vaultId.Number,
+			err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+				SpreadSchedule: tc.spreadSchedule,
+			})
+			require.NoError(t, err)
+
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, orders, len(unscheduledOrders))
+			for i, order := range orders {
+				unscheduledOrder := unscheduledOrders[i]
+				require.Equal(t, unscheduledOrder.Side, order.Side)
+				if !tc.expectedWider {
+					require.Equal(t, unscheduledOrder.Subticks, order.Subticks)
+				} else if order.Side == clobtypes.Order_SIDE_SELL {
+					require.Greater(t, order.Subticks, unscheduledOrder.Subticks)
+				} else {
+					require.Less(t, order.Subticks, unscheduledOrder.Subticks)
 				}
-				expectedOrderIds[2*i+1] = &clobtypes.OrderId{
-					SubaccountId: *tc.vaultId.ToSubaccountId(),
-					ClientId:     tApp.App.VaultKeeper.GetVaultClobOrderClientId(ctx, clobtypes.Order_SIDE_BUY, uint8(i)),
-					OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
-					ClobPairId:   tc.vaultId.Number,
+			}
+		})
+	}
+}
+
+func TestGetVaultClobOrders_InventorySpreadScale(t *testing.T) {
+	tests := map[string]struct {
+		// Inventory spread scale.
+		inventorySpreadScalePpm uint32
+		// Perpetual position of the vault in base quantums.
+		positionBaseQuantums *big.Int
+		// Asset quantums of the vault.
+		assetQuantums *big.Int
+		// Expected spread (in ppm) of layer-0 orders.
+		expectedSpreadPpm uint64
+	}{
+		"Flat inventory": {
+			inventorySpreadScalePpm: 500_000, // 0.5
+			positionBaseQuantums:    big.NewInt(0),
+			assetQuantums:           big.NewInt(1_000_000_000), // 1,000 USDC
+			// leverage = 0, so spread is not widened.
+			expectedSpreadPpm: 10_000,
+		},
+		"Leveraged inventory": {
+			inventorySpreadScalePpm: 500_000, // 0.5
+			// 0.1 BTC ($2,000) and -1,000 USDC, i.e. equity of $1,000 and leverage of 2.
+			positionBaseQuantums: big.NewInt(1_000_000_000),
+			assetQuantums:        big.NewInt(-1_000_000_000),
+			// spread = 10_000 * (1 + 2 * 0.5) = 20_000.
+			expectedSpreadPpm: 20_000,
+		},
+		"Leveraged short inventory": {
+			inventorySpreadScalePpm: 500_000, // 0.5
+			// -0.1 BTC (-$2,000) and 3,000 USDC, i.e. equity of $1,000 and leverage of -2.
+			positionBaseQuantums: big.NewInt(-1_000_000_000),
+			assetQuantums:        big.NewInt(3_000_000_000),
+			// spread = 10_000 * (1 + |-2| * 0.5) = 20_000.
+			expectedSpreadPpm: 20_000,
+		},
+		"Leveraged inventory, scale disabled": {
+			inventorySpreadScalePpm: 0,
+			positionBaseQuantums:    big.NewInt(1_000_000_000),
+			assetQuantums:           big.NewInt(-1_000_000_000),
+			expectedSpreadPpm:       10_000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			vaultSubaccount := satypes.Subaccount{
+				Id: vaultId.ToSubaccountId(),
+				AssetPositions: []*satypes.AssetPosition{
+					testutil.CreateSingleAssetPosition(
+						assettypes.AssetUsdc.Id,
+						tc.assetQuantums,
+					),
+				},
+			}
+			if tc.positionBaseQuantums.Sign() != 0 {
+				vaultSubaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						tc.positionBaseQuantums,
+						big.NewInt(0),
+					),
 				}
 			}
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{vaultSubaccount}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.SpreadMinPpm = 10_000
+						genesisState.Params.InventorySpreadScalePpm = tc.inventorySpreadScalePpm
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
 
-			// Verify order IDs.
-			orderIds, err := k.GetVaultClobOrderIds(ctx, tc.vaultId)
-			if tc.expectedErr != nil {
-				require.ErrorContains(t, err, tc.expectedErr.Error())
-				require.Empty(t, orderIds)
-			} else {
+			// Check that spread of layer-0 orders on both sides is as expected.
+			for _, side := range []clobtypes.Order_Side{clobtypes.Order_SIDE_SELL, clobtypes.Order_SIDE_BUY} {
+				response, err := k.ExplainVaultOrder(ctx, &vaulttypes.QueryExplainVaultOrderRequest{
+					Type:   vaultId.Type,
+					Number: vaultId.Number,
+					Side:   side,
+					Layer:  0,
+				})
 				require.NoError(t, err)
-				require.Equal(t, expectedOrderIds, orderIds)
+				require.Equal(t, tc.expectedSpreadPpm, response.Explanation.SpreadPpm)
 			}
 		})
 	}
 }
 
-func TestGetVaultClobOrderClientId(t *testing.T) {
+func TestGetVaultClobOrders_MaxSkew(t *testing.T) {
 	tests := map[string]struct {
-		/* --- Setup --- */
-		// side.
+		// Max skew.
+		maxSkewPpm uint32
+		// Perpetual position of the vault in base quantums.
+		positionBaseQuantums *big.Int
+		// Asset quantums of the vault.
+		assetQuantums *big.Int
+		// Side of the layer-0 order to check.
 		side clobtypes.Order_Side
-		// block height.
-		blockHeight int64
-		// layer.
-		layer uint8
-
-		/* --- Expectations --- */
-		// Expected client ID.
-		expectedClientId uint32
+		// Expected skew (in ppm) of the layer-0 order.
+		expectedSkewPpm int64
+		// Expected subticks of the layer-0 order.
+		expectedSubticks uint64
 	}{
-		"Buy, Block Height Odd, Layer 1": {
-			side:             clobtypes.Order_SIDE_BUY, // 0<<31
-			blockHeight:      1,                        // 1<<30
-			layer:            1,                        // 1<<22
-			expectedClientId: 0<<31 | 1<<30 | 1<<22,
-		},
-		"Buy, Block Height Even, Layer 1": {
-			side:             clobtypes.Order_SIDE_BUY, // 0<<31
-			blockHeight:      2,                        // 0<<30
-			layer:            1,                        // 1<<22
-			expectedClientId: 0<<31 | 0<<30 | 1<<22,
-		},
-		"Sell, Block Height Odd, Layer 2": {
-			side:             clobtypes.Order_SIDE_SELL, // 1<<31
-			blockHeight:      1,                         // 1<<30
-			layer:            2,                         // 2<<22
-			expectedClientId: 1<<31 | 1<<30 | 2<<22,
-		},
-		"Sell, Block Height Even, Layer 2": {
-			side:             clobtypes.Order_SIDE_SELL, // 1<<31
-			blockHeight:      2,                         // 0<<30
-			layer:            2,                         // 2<<22
-			expectedClientId: 1<<31 | 0<<30 | 2<<22,
-		},
-		"Buy, Block Height Even, Layer Max Uint8": {
-			side:             clobtypes.Order_SIDE_BUY, // 0<<31
-			blockHeight:      123456,                   // 0<<30
-			layer:            math.MaxUint8,            // 255<<22
-			expectedClientId: 0<<31 | 0<<30 | 255<<22,
+		"Long inventory, unbounded skew": {
+			maxSkewPpm: 0,
+			// 0.1 BTC ($2,000) and -1,000 USDC, i.e. equity of $1,000 and leverage of 2.
+			positionBaseQuantums: big.NewInt(1_000_000_000),
+			assetQuantums:        big.NewInt(-1_000_000_000),
+			side:                 clobtypes.Order_SIDE_BUY,
+			// skew = -2 * 0.01 * 2 = -0.04
+			expectedSkewPpm: -40_000,
+			// oracleSubticks = 2e8
+			// bid = 2e8 * (1 - 0.01 - 0.04) = 190_000_000
+			expectedSubticks: 190_000_000,
 		},
-		"Sell, Block Height Odd, Layer 0": {
-			side:             clobtypes.Order_SIDE_SELL, // 1<<31
-			blockHeight:      12345654321,               // 1<<30
-			layer:            0,                         // 0<<22
-			expectedClientId: 1<<31 | 1<<30 | 0<<22,
+		"Long inventory, max skew caps skew": {
+			maxSkewPpm:           25_000, // 2.5%
+			positionBaseQuantums: big.NewInt(1_000_000_000),
+			assetQuantums:        big.NewInt(-1_000_000_000),
+			side:                 clobtypes.Order_SIDE_BUY,
+			// skew = max(-0.04, -0.025) = -0.025
+			expectedSkewPpm: -25_000,
+			// bid = 2e8 * (1 - 0.01 - 0.025) = 193_000_000
+			expectedSubticks: 193_000_000,
 		},
-		"Sell, Block Height Odd (negative), Layer 202": {
-			side: clobtypes.Order_SIDE_SELL, // 1<<31
-			// Negative block height shouldn't happen but blockHeight
-			// is represented as int64.
-			blockHeight:      -678987, // 1<<30
-			layer:            202,     // 202<<22
-			expectedClientId: 1<<31 | 1<<30 | 202<<22,
+		"Short inventory, max skew caps skew": {
+			maxSkewPpm: 25_000, // 2.5%
+			// -0.1 BTC (-$2,000) and 3,000 USDC, i.e. equity of $1,000 and leverage of -2.
+			positionBaseQuantums: big.NewInt(-1_000_000_000),
+			assetQuantums:        big.NewInt(3_000_000_000),
+			side:                 clobtypes.Order_SIDE_SELL,
+			// skew = min(0.04, 0.025) = 0.025
+			expectedSkewPpm: 25_000,
+			// ask = 2e8 * (1 + 0.01 + 0.025) = 207_000_000
+			expectedSubticks: 207_000_000,
 		},
-		"Buy, Block Height Even (zero), Layer 157": {
-			side:             clobtypes.Order_SIDE_SELL, // 1<<31
-			blockHeight:      0,                         // 0<<30
-			layer:            157,                       // 157<<22
-			expectedClientId: 1<<31 | 0<<30 | 157<<22,
+		"Long inventory, max skew above skew": {
+			maxSkewPpm:           50_000, // 5%
+			positionBaseQuantums: big.NewInt(1_000_000_000),
+			assetQuantums:        big.NewInt(-1_000_000_000),
+			side:                 clobtypes.Order_SIDE_BUY,
+			expectedSkewPpm:      -40_000,
+			expectedSubticks:     190_000_000,
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			tApp := testapp.NewTestAppBuilder(t).Build()
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										tc.assetQuantums,
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									testutil.CreateSinglePerpetualPosition(
+										0,
+										tc.positionBaseQuantums,
+										big.NewInt(0),
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.SpreadMinPpm = 10_000
+						genesisState.Params.SkewFactorPpm = 2_000_000
+						genesisState.Params.MaxSkewPpm = tc.maxSkewPpm
+					},
+				)
+				return genesis
+			}).Build()
 			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+			require.NoError(t, err)
 
-			clientId := tApp.App.VaultKeeper.GetVaultClobOrderClientId(
-				ctx.WithBlockHeight(tc.blockHeight),
-				tc.side,
-				tc.layer,
-			)
-			require.Equal(t, tc.expectedClientId, clientId)
+			// Check that skew and subticks of layer-0 order are as expected.
+			response, err := k.ExplainVaultOrder(ctx, &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaultId.Type,
+				Number: vaultId.Number,
+				Side:   tc.side,
+				Layer:  0,
+			})
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tc.expectedSkewPpm), response.Explanation.SkewPpm.BigInt())
+			require.Equal(t, tc.expectedSubticks, response.Explanation.RoundedSubticks)
 		})
 	}
 }
+
+func TestFindOrphanedVaultOrders(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.Layers = 3
+				genesisState.Vaults = []*vaulttypes.Vault{
+					{
+						VaultId:     &vaultId,
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+						OwnerShares: []*vaulttypes.OwnerShare{
+							{
+								Owner:  constants.AliceAccAddress.String(),
+								Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	// Vault places orders at 3 layers at block 1.
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// No order is orphaned right after vault refreshes its orders.
+	orphanedOrders, err := k.FindOrphanedVaultOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Empty(t, orphanedOrders)
+	restingOrders, err := k.GetRestingVaultOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, restingOrders, 6)
+
+	// Reduce layers to 1, which orphans orders at layers 1 and 2.
+	params := k.GetParams(ctx)
+	params.Layers = 1
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	orphanedOrders, err = k.FindOrphanedVaultOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, orphanedOrders, 4)
+	for i, order := range orphanedOrders {
+		side, _, layer, err := vaulttypes.DecodeVaultClientId(order.OrderId.ClientId)
+		require.NoError(t, err)
+		require.Equal(t, uint8(i/2+1), layer)
+		if i%2 == 0 {
+			require.Equal(t, clobtypes.Order_SIDE_SELL, side)
+		} else {
+			require.Equal(t, clobtypes.Order_SIDE_BUY, side)
+		}
+	}
+
+	// Query returns the same orphaned orders.
+	resp, err := k.OrphanedVaultOrders(ctx, &vaulttypes.QueryOrphanedVaultOrdersRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	require.Equal(t, orphanedOrders, resp.Orders)
+
+	// Query fails for a non-existent vault.
+	_, err = k.OrphanedVaultOrders(ctx, &vaulttypes.QueryOrphanedVaultOrdersRequest{
+		Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+		Number: 1,
+	})
+	require.ErrorContains(t, err, "vault not found")
+}
+
+func TestMsgUpdateParams_CancelsOrphanedVaultOrders(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.Layers = 3
+				genesisState.Vaults = []*vaulttypes.Vault{
+					{
+						VaultId:     &vaultId,
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+						OwnerShares: []*vaulttypes.OwnerShare{
+							{
+								Owner:  constants.AliceAccAddress.String(),
+								Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	// Vault places orders at 3 layers at block 1.
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	orderIds, err := k.GetVaultClobOrderIds(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, orderIds, 6)
+	for _, orderId := range orderIds {
+		_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+		require.True(t, exists)
+	}
+
+	// Lower layers from 3 to 1.
+	params := k.GetParams(ctx)
+	params.Layers = 1
+	_, err = keeper.NewMsgServerImpl(k).UpdateParams(ctx, &vaulttypes.MsgUpdateParams{
+		Authority: lib.GovModuleAddress.String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	// Check that orders at layer 0 are still resting and orders at layers 1 and 2 are cancelled.
+	for i, orderId := range orderIds {
+		_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+		require.Equal(t, i < 2, exists)
+	}
+	orphanedOrders, err := k.FindOrphanedVaultOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Empty(t, orphanedOrders)
+}
+
+func TestGetVaultClobOrdersSortedByPrice(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.Layers = 3
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Orders of BTC vault are [a_0, b_0, a_1, b_1, a_2, b_2].
+	orders, err := k.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, orders, 6)
+
+	// Sorted orders are [a_0, a_1, a_2, b_0, b_1, b_2] as outer layers are further from oracle price.
+	sortedOrders, err := k.GetVaultClobOrdersSortedByPrice(ctx, vaultId)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*clobtypes.Order{orders[0], orders[2], orders[4], orders[1], orders[3], orders[5]},
+		sortedOrders,
+	)
+	for i := 1; i < 3; i++ {
+		require.Less(t, sortedOrders[i-1].Subticks, sortedOrders[i].Subticks)
+		require.Greater(t, sortedOrders[i+2].Subticks, sortedOrders[i+3].Subticks)
+	}
+	require.Greater(t, sortedOrders[0].Subticks, sortedOrders[3].Subticks)
+
+	// Error is returned for a vault without a clob pair.
+	_, err = k.GetVaultClobOrdersSortedByPrice(ctx, vaulttypes.VaultId{
+		Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+		Number: 1_000,
+	})
+	require.ErrorIs(t, err, vaulttypes.ErrClobPairNotFound)
+}
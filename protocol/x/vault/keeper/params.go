@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
 
-// GetParams returns `Params` in state.
+// GetParams returns `Params` in state, which are `DefaultParams` if params have never been set.
 func (k Keeper) GetParams(
 	ctx sdk.Context,
 ) (
@@ -14,6 +17,9 @@ func (k Keeper) GetParams(
 ) {
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get([]byte(types.ParamsKey))
+	if b == nil {
+		return types.DefaultParams()
+	}
 	k.cdc.MustUnmarshal(b, &params)
 	return params
 }
@@ -54,8 +60,10 @@ func (k Keeper) GetVaultParams(
 	return vaultParams, true
 }
 
-// SetVaultParams sets `VaultParams` in state for a given vault.
-// Returns an error if validation fails.
+// SetVaultParams sets `VaultParams` in state for a given vault and appends the change
+// to the vault's activity log.
+// Returns an error if validation fails or if price market override, a price blend
+// market, or spread oracle market doesn't exist.
 func (k Keeper) SetVaultParams(
 	ctx sdk.Context,
 	vaultId types.VaultId,
@@ -64,10 +72,89 @@ func (k Keeper) SetVaultParams(
 	if err := vaultParams.Validate(); err != nil {
 		return err
 	}
+	if vaultParams.PriceMarketIdOverride != nil {
+		marketId := vaultParams.PriceMarketIdOverride.Value
+		if _, exists := k.pricesKeeper.GetMarketParam(ctx, marketId); !exists {
+			return errorsmod.Wrapf(types.ErrMarketParamNotFound, "price market override: %d", marketId)
+		}
+	}
+	if vaultParams.SpreadOracleMarketId != nil {
+		marketId := vaultParams.SpreadOracleMarketId.Value
+		if _, exists := k.pricesKeeper.GetMarketParam(ctx, marketId); !exists {
+			return errorsmod.Wrapf(types.ErrMarketParamNotFound, "spread oracle market: %d", marketId)
+		}
+	}
+	for _, component := range vaultParams.PriceBlend {
+		if _, exists := k.pricesKeeper.GetMarketParam(ctx, component.MarketId); !exists {
+			return errorsmod.Wrapf(types.ErrMarketParamNotFound, "price blend market: %d", component.MarketId)
+		}
+	}
+	if vaultParams.OrderStepBaseQuantumsOverride != 0 {
+		clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+		if !exists {
+			return errorsmod.Wrapf(types.ErrClobPairNotFound, "VaultId: %v", vaultId)
+		}
+		if vaultParams.OrderStepBaseQuantumsOverride%clobPair.StepBaseQuantums != 0 {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderStepBaseQuantumsOverride,
+				"override: %d, clob pair step base quantums: %d",
+				vaultParams.OrderStepBaseQuantumsOverride,
+				clobPair.StepBaseQuantums,
+			)
+		}
+	}
 
 	b := k.cdc.MustMarshal(&vaultParams)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.VaultParamsKeyPrefix))
 	store.Set(vaultId.ToStateKey(), b)
 
+	k.appendVaultActivity(ctx, vaultId, types.VaultActivity{
+		Type:        types.VaultActivityType_VAULT_ACTIVITY_TYPE_PARAMS_CHANGE,
+		VaultParams: &vaultParams,
+	})
+
 	return nil
 }
+
+// SetVaultLabel sets the label of a vault, keeping its other individual params unchanged.
+// Returns an error if the vault doesn't exist or the label is invalid.
+func (k Keeper) SetVaultLabel(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	label string,
+) error {
+	if _, exists := k.GetTotalShares(ctx, vaultId); !exists {
+		return errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", vaultId)
+	}
+
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	vaultParams.Label = label
+	return k.SetVaultParams(ctx, vaultId, vaultParams)
+}
+
+// GetLastOperatorUpdateBlockHeight returns the block height at which the vault operator
+// last updated params.
+func (k Keeper) GetLastOperatorUpdateBlockHeight(
+	ctx sdk.Context,
+) (blockHeight uint32, exists bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.LastOperatorUpdateBlockHeightKey))
+	if b == nil {
+		return 0, false
+	}
+
+	var value gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value, true
+}
+
+// SetLastOperatorUpdateBlockHeight sets the block height at which the vault operator
+// last updated params.
+func (k Keeper) SetLastOperatorUpdateBlockHeight(
+	ctx sdk.Context,
+	blockHeight uint32,
+) {
+	value := gogotypes.UInt32Value{Value: blockHeight}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.LastOperatorUpdateBlockHeightKey), k.cdc.MustMarshal(&value))
+}
@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	"github.com/stretchr/testify/require"
@@ -29,6 +31,8 @@ func TestGetSetParams(t *testing.T) {
 		OrderSizePctPpm:                  200_000,
 		OrderExpirationSeconds:           10,
 		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+		MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(100_000_000),
+		SkewEnabled:                      false,
 	}
 	err := k.SetParams(ctx, newParams)
 	require.NoError(t, err)
@@ -43,12 +47,28 @@ func TestGetSetParams(t *testing.T) {
 		OrderSizePctPpm:                  200_000,
 		OrderExpirationSeconds:           0, // invalid
 		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+		MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(100_000_000),
+		SkewEnabled:                      false,
 	}
 	err = k.SetParams(ctx, invalidParams)
 	require.Error(t, err)
 	require.Equal(t, newParams, k.GetParams(ctx))
 }
 
+func TestGetParams_Unset(t *testing.T) {
+	ctx, k, _ := keepertest.VaultKeepers(t)
+
+	// Params should have default values if they have never been set.
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// Invalid params aren't written.
+	invalidParams := types.DefaultParams()
+	invalidParams.OrderExpirationSeconds = 0
+	err := k.SetParams(ctx, invalidParams)
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
 func TestGetSetVaultParams(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 	ctx := tApp.InitChain()
@@ -65,6 +85,7 @@ func TestGetSetVaultParams(t *testing.T) {
 			Exponent: -5,
 			Price:    123_456_789,
 		},
+		Label: "BTC-USD Vault",
 	}
 	err := k.SetVaultParams(ctx, constants.Vault_Clob0, vaultClob0Params)
 	require.NoError(t, err)
@@ -77,4 +98,75 @@ func TestGetSetVaultParams(t *testing.T) {
 	// Get vault params of vault clob 1.
 	_, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
 	require.False(t, exists)
+
+	// Set vault params of vault clob 1 with a valid price market override.
+	vaultClob1Params := types.VaultParams{
+		PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 0},
+	}
+	err = k.SetVaultParams(ctx, constants.Vault_Clob1, vaultClob1Params)
+	require.NoError(t, err)
+	params, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
+	require.True(t, exists)
+	require.Equal(t, vaultClob1Params, params)
+
+	// Set vault params of vault clob 1 with a non-existent price market override.
+	err = k.SetVaultParams(ctx, constants.Vault_Clob1, types.VaultParams{
+		PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 4321},
+	})
+	require.ErrorIs(t, err, types.ErrMarketParamNotFound)
+	params, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
+	require.True(t, exists)
+	require.Equal(t, vaultClob1Params, params)
+
+	// Set vault params of vault clob 1 with a non-existent price blend market.
+	err = k.SetVaultParams(ctx, constants.Vault_Clob1, types.VaultParams{
+		PriceBlend: []types.PriceBlendComponent{
+			{MarketId: 0, WeightPpm: 500_000},
+			{MarketId: 4321, WeightPpm: 500_000},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrMarketParamNotFound)
+	params, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
+	require.True(t, exists)
+	require.Equal(t, vaultClob1Params, params)
+
+	// Set vault params of vault clob 1 with a non-existent spread oracle market.
+	err = k.SetVaultParams(ctx, constants.Vault_Clob1, types.VaultParams{
+		SpreadOracleMarketId: &gogotypes.UInt32Value{Value: 4321},
+	})
+	require.ErrorIs(t, err, types.ErrMarketParamNotFound)
+	params, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
+	require.True(t, exists)
+	require.Equal(t, vaultClob1Params, params)
+
+	// Set vault params of vault clob 1 with a step size override that is not a multiple of
+	// step size of clob pair 1.
+	clobPair, exists := tApp.App.ClobKeeper.GetClobPair(ctx, 1)
+	require.True(t, exists)
+	err = k.SetVaultParams(ctx, constants.Vault_Clob1, types.VaultParams{
+		OrderStepBaseQuantumsOverride: clobPair.StepBaseQuantums*10 + 1,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidOrderStepBaseQuantumsOverride)
+	params, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
+	require.True(t, exists)
+	require.Equal(t, vaultClob1Params, params)
+
+	// Set vault params of vault clob 1 with a step size override that is a multiple of step
+	// size of clob pair 1.
+	vaultClob1Params = types.VaultParams{
+		OrderStepBaseQuantumsOverride: clobPair.StepBaseQuantums * 10,
+	}
+	err = k.SetVaultParams(ctx, constants.Vault_Clob1, vaultClob1Params)
+	require.NoError(t, err)
+	params, exists = k.GetVaultParams(ctx, constants.Vault_Clob1)
+	require.True(t, exists)
+	require.Equal(t, vaultClob1Params, params)
+
+	// Set vault params of a vault whose clob pair doesn't exist with a step size override.
+	err = k.SetVaultParams(
+		ctx,
+		types.VaultId{Type: types.VaultType_VAULT_TYPE_CLOB, Number: 4321},
+		types.VaultParams{OrderStepBaseQuantumsOverride: 10},
+	)
+	require.ErrorIs(t, err, types.ErrClobPairNotFound)
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultRefreshHistory returns the most recent order refreshes of a vault, oldest first,
+// which is empty if the vault has never refreshed its orders.
+func (k Keeper) GetVaultRefreshHistory(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (history types.VaultRefreshHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RefreshHistoryKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return types.VaultRefreshHistory{Refreshes: []types.VaultRefresh{}}
+	}
+
+	k.cdc.MustUnmarshal(b, &history)
+	return history
+}
+
+// appendVaultRefresh appends a refresh at current block height to a vault's refresh history,
+// dropping the oldest refreshes beyond `MaxVaultRefreshHistoryLength`.
+func (k Keeper) appendVaultRefresh(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	refresh types.VaultRefresh,
+) {
+	history := k.GetVaultRefreshHistory(ctx, vaultId)
+	refresh.BlockHeight = lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	history.Refreshes = append(history.Refreshes, refresh)
+	if len(history.Refreshes) > types.MaxVaultRefreshHistoryLength {
+		history.Refreshes = history.Refreshes[len(history.Refreshes)-types.MaxVaultRefreshHistoryLength:]
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RefreshHistoryKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&history))
+}
+
+// deleteVaultRefreshHistory deletes the refresh history of a vault.
+func (k Keeper) deleteVaultRefreshHistory(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RefreshHistoryKeyPrefix))
+	store.Delete(vaultId.ToStateKey())
+}
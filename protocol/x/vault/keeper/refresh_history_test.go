@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultRefreshHistory(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1_000)))
+	require.NoError(t, err)
+	startBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	advanceBlock := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+			BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		})
+	}
+	refresh := func() {
+		err := k.RefreshVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+	}
+	setParams := func(update func(params *vaulttypes.Params)) {
+		params := k.GetParams(ctx)
+		update(&params)
+		err := k.SetParams(ctx, params)
+		require.NoError(t, err)
+	}
+
+	// Vault has no refresh history before it refreshes its orders.
+	response, err := k.VaultRefreshHistory(ctx, &vaulttypes.QueryVaultRefreshHistoryRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Refreshes)
+
+	// Vault places 4 orders (2 layers) in its first refresh.
+	refresh()
+	// Vault replaces its 4 orders in next block.
+	advanceBlock()
+	refresh()
+	// Vault skips refreshing in a block with the same parity as the block of last refresh.
+	advanceBlock()
+	advanceBlock()
+	refresh()
+	// Vault skips refreshing if it refreshed too recently.
+	setParams(func(params *vaulttypes.Params) { params.MinRefreshIntervalBlocks = 5 })
+	advanceBlock()
+	refresh()
+	// Vault with zero layers cancels its 4 orders.
+	setParams(func(params *vaulttypes.Params) { params.Layers = 0 })
+	advanceBlock()
+	refresh()
+
+	// Check that refresh history is in order of refreshes.
+	expectedRefreshes := []vaulttypes.VaultRefresh{
+		{
+			BlockHeight:     startBlockHeight,
+			NumOrdersPlaced: 4,
+		},
+		{
+			BlockHeight:        startBlockHeight + 1,
+			NumOrdersPlaced:    4,
+			NumOrdersCancelled: 4,
+		},
+		{
+			BlockHeight: startBlockHeight + 3,
+			SkipReason:  vaulttypes.RefreshSkipReasonSameParity,
+		},
+		{
+			BlockHeight: startBlockHeight + 4,
+			SkipReason:  vaulttypes.RefreshSkipReasonTooRecent,
+		},
+		{
+			BlockHeight:        startBlockHeight + 5,
+			NumOrdersCancelled: 4,
+			SkipReason:         vaulttypes.RefreshSkipReasonZeroLayers,
+		},
+	}
+	response, err = k.VaultRefreshHistory(ctx, &vaulttypes.QueryVaultRefreshHistoryRequest{
+		Type:   vaultId.Type,
+		Number: vaultId.Number,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRefreshes, response.Refreshes)
+	require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+
+	// Check that refresh history is capped and drops the oldest refreshes.
+	for i := 0; i < vaulttypes.MaxVaultRefreshHistoryLength; i++ {
+		advanceBlock()
+		refresh()
+	}
+	refreshes := k.GetVaultRefreshHistory(ctx, vaultId).Refreshes
+	require.Len(t, refreshes, vaulttypes.MaxVaultRefreshHistoryLength)
+	for i, refresh := range refreshes {
+		require.Equal(
+			t,
+			vaulttypes.VaultRefresh{
+				BlockHeight: startBlockHeight + 6 + uint32(i),
+				SkipReason:  vaulttypes.RefreshSkipReasonZeroLayers,
+			},
+			refresh,
+		)
+	}
+
+	// Check that refresh history is deleted when vault is decommissioned.
+	k.DecommissionVault(ctx, vaultId)
+	require.Empty(t, k.GetVaultRefreshHistory(ctx, vaultId).Refreshes)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// IsVaultRefreshLocked returns whether a vault is locked by a `MsgRefreshVaultOrders` in the
+// current block. While locked, deposits to and withdrawals from the vault are rejected so that
+// share math of every deposit and withdrawal in a block uses equity that is consistent with a
+// single set of vault orders.
+func (k Keeper) IsVaultRefreshLocked(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) bool {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), []byte(types.RefreshLockKeyPrefix))
+	return store.Has(vaultId.ToStateKey())
+}
+
+// lockVaultForRefresh locks a vault until the end of the current block. Locks are kept in
+// the transient store and so are never committed to state.
+func (k Keeper) lockVaultForRefresh(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), []byte(types.RefreshLockKeyPrefix))
+	store.Set(vaultId.ToStateKey(), []byte{1})
+}
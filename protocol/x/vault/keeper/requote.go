@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultPendingRequote returns whether a vault has a pending requote. Returns false
+// if the vault's pending requote has never been set.
+func (k Keeper) GetVaultPendingRequote(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (pending bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingRequoteKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return false
+	}
+
+	var value gogotypes.BoolValue
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value
+}
+
+// SetVaultPendingRequote sets whether a vault has a pending requote.
+func (k Keeper) SetVaultPendingRequote(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	pending bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingRequoteKeyPrefix))
+	if !pending {
+		store.Delete(vaultId.ToStateKey())
+		return
+	}
+	value := gogotypes.BoolValue{Value: pending}
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// RequoteVaultOnFill flags the CLOB vault of the given clob pair for a requote if the given
+// subaccount is the vault's subaccount and the fill is strictly more than
+// `requote_fill_threshold_pct_ppm` of the filled order's size, and is a no-op otherwise.
+// A flagged vault refreshes its orders in the end blocker of the same block regardless of
+// `min_refresh_interval_blocks`, which tightens its book after a sweep. Orders aren't placed
+// from this hook itself, as it runs while the clob is still processing the match, so the
+// requote happens once all matches of the block have settled. As orders of both sides share
+// client IDs derived from block height, orders of both sides are replaced, and if the block
+// has the same parity as the vault's last refresh, the requote happens in the next block.
+func (k Keeper) RequoteVaultOnFill(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId clobtypes.ClobPairId,
+	orderBaseQuantums *big.Int,
+	fillBaseQuantums *big.Int,
+) {
+	vaultId := types.VaultId{
+		Type:   types.VaultType_VAULT_TYPE_CLOB,
+		Number: clobPairId.ToUint32(),
+	}
+	if subaccountId != *vaultId.ToSubaccountId() {
+		return
+	}
+
+	thresholdPpm := k.GetParams(ctx).RequoteFillThresholdPctPpm
+	if thresholdPpm == 0 {
+		return
+	}
+
+	// Flag vault if `fill / order_size > threshold`.
+	fillPpm := new(big.Int).Mul(fillBaseQuantums, lib.BigIntOneMillion())
+	thresholdBaseQuantums := new(big.Int).Mul(orderBaseQuantums, lib.BigU(thresholdPpm))
+	if fillPpm.Cmp(thresholdBaseQuantums) > 0 {
+		k.SetVaultPendingRequote(ctx, vaultId, true)
+	}
+}
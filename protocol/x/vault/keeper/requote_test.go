@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequoteVaultOnFill(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Requote fill threshold.
+		requoteFillThresholdPctPpm uint32
+		// Subaccount of the filled order.
+		subaccountId satypes.SubaccountId
+		// Clob pair of the filled order.
+		clobPairId clobtypes.ClobPairId
+		// Size of the filled order in base quantums.
+		orderBaseQuantums *big.Int
+		// Fill amount in base quantums.
+		fillBaseQuantums *big.Int
+
+		/* --- Expectations --- */
+		// Whether vault 0 is expected to have a pending requote.
+		expectedPendingRequote bool
+	}{
+		"Fill above threshold": {
+			requoteFillThresholdPctPpm: 500_000, // 50%
+			subaccountId:               *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:                 0,
+			orderBaseQuantums:          big.NewInt(50_000_000),
+			fillBaseQuantums:           big.NewInt(25_000_001),
+			expectedPendingRequote:     true,
+		},
+		"Fill exactly at threshold": {
+			requoteFillThresholdPctPpm: 500_000, // 50%
+			subaccountId:               *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:                 0,
+			orderBaseQuantums:          big.NewInt(50_000_000),
+			fillBaseQuantums:           big.NewInt(25_000_000),
+			expectedPendingRequote:     false,
+		},
+		"Threshold is zero": {
+			requoteFillThresholdPctPpm: 0,
+			subaccountId:               *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:                 0,
+			orderBaseQuantums:          big.NewInt(50_000_000),
+			fillBaseQuantums:           big.NewInt(50_000_000),
+			expectedPendingRequote:     false,
+		},
+		"Non-vault subaccount": {
+			requoteFillThresholdPctPpm: 500_000, // 50%
+			subaccountId:               constants.Alice_Num0,
+			clobPairId:                 0,
+			orderBaseQuantums:          big.NewInt(50_000_000),
+			fillBaseQuantums:           big.NewInt(50_000_000),
+			expectedPendingRequote:     false,
+		},
+		"Vault subaccount on a different clob pair": {
+			requoteFillThresholdPctPpm: 500_000, // 50%
+			subaccountId:               *constants.Vault_Clob0.ToSubaccountId(),
+			clobPairId:                 1,
+			orderBaseQuantums:          big.NewInt(50_000_000),
+			fillBaseQuantums:           big.NewInt(50_000_000),
+			expectedPendingRequote:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			params := k.GetParams(ctx)
+			params.RequoteFillThresholdPctPpm = tc.requoteFillThresholdPctPpm
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			k.RequoteVaultOnFill(
+				ctx,
+				tc.subaccountId,
+				tc.clobPairId,
+				tc.orderBaseQuantums,
+				tc.fillBaseQuantums,
+			)
+			require.Equal(t, tc.expectedPendingRequote, k.GetVaultPendingRequote(ctx, constants.Vault_Clob0))
+			require.False(t, k.GetVaultPendingRequote(ctx, constants.Vault_Clob1))
+		})
+	}
+}
+
+func TestRefreshVaultClobOrders_RequoteOnFill(t *testing.T) {
+	tests := map[string]struct {
+		// Whether Alice fills the vault's layer-0 ask.
+		fillVaultOrder bool
+		// Block in which Alice's order is included.
+		fillBlock uint32
+		// Expected block at which vault last refreshed its orders after `fillBlock`.
+		expectedLastRefreshBlockAtFill uint32
+		// Expected block at which vault last refreshed its orders after the block after `fillBlock`.
+		expectedLastRefreshBlockAfterFill uint32
+	}{
+		"Large fill, Vault requotes in the same block": {
+			fillVaultOrder:                    true,
+			fillBlock:                         2,
+			expectedLastRefreshBlockAtFill:    2,
+			expectedLastRefreshBlockAfterFill: 2,
+		},
+		"Large fill in block of same parity as last refresh, Vault requotes in the next block": {
+			fillVaultOrder:                    true,
+			fillBlock:                         3,
+			expectedLastRefreshBlockAtFill:    1,
+			expectedLastRefreshBlockAfterFill: 4,
+		},
+		"No fill, Vault doesn't refresh until min refresh interval": {
+			fillVaultOrder:                    false,
+			fillBlock:                         2,
+			expectedLastRefreshBlockAtFill:    1,
+			expectedLastRefreshBlockAfterFill: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			// Initialize tApp with a vault that refreshes its orders in EndBlocker.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+							{
+								Id: &constants.Alice_Num0,
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(100_000_000_000), // 100,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.MinRefreshIntervalBlocks = 5
+						genesisState.Params.RequoteFillThresholdPctPpm = 500_000 // 50%
+						genesisState.Vaults = []*vaulttypes.Vault{
+							{
+								VaultId:     &vaultId,
+								TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+								OwnerShares: []*vaulttypes.OwnerShare{
+									{
+										Owner:  constants.AliceAccAddress.String(),
+										Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+									},
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			// Vault refreshes its orders for the first time at block 1.
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			if tc.fillBlock > 2 {
+				ctx = tApp.AdvanceToBlock(tc.fillBlock-1, testapp.AdvanceToBlockOptions{})
+			}
+
+			// Alice fully fills the vault's layer-0 ask.
+			vaultOrders, err := k.GetVaultClobOrders(ctx.WithBlockHeight(1), vaultId)
+			require.NoError(t, err)
+			vaultAsk := vaultOrders[0]
+			_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, vaultAsk.OrderId)
+			require.True(t, exists)
+			if tc.fillVaultOrder {
+				aliceBuy := *clobtypes.NewMsgPlaceOrder(clobtypes.Order{
+					OrderId: clobtypes.OrderId{
+						SubaccountId: constants.Alice_Num0,
+						ClientId:     0,
+						OrderFlags:   clobtypes.OrderIdFlags_ShortTerm,
+						ClobPairId:   vaultId.Number,
+					},
+					Side:         clobtypes.Order_SIDE_BUY,
+					Quantums:     vaultAsk.Quantums,
+					Subticks:     vaultAsk.Subticks,
+					GoodTilOneof: &clobtypes.Order_GoodTilBlock{GoodTilBlock: 5},
+					TimeInForce:  clobtypes.Order_TIME_IN_FORCE_IOC,
+				})
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, aliceBuy) {
+					resp := tApp.CheckTx(checkTx)
+					require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			// Check that vault requotes in the block of the fill despite min refresh interval, unless
+			// that block has the same parity as the block of last refresh.
+			ctx = tApp.AdvanceToBlock(tc.fillBlock, testapp.AdvanceToBlockOptions{})
+			lastRefreshBlock, exists := k.GetLastRefreshBlockHeight(ctx, vaultId)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedLastRefreshBlockAtFill, lastRefreshBlock)
+			require.Equal(
+				t,
+				tc.fillVaultOrder && tc.expectedLastRefreshBlockAtFill != tc.fillBlock,
+				k.GetVaultPendingRequote(ctx, vaultId),
+			)
+			vaultFillStats := k.GetVaultFillStats(ctx, vaultId)
+			if tc.fillVaultOrder {
+				require.Equal(t, uint64(1), vaultFillStats.NumFills)
+			} else {
+				require.Equal(t, uint64(0), vaultFillStats.NumFills)
+			}
+
+			// Check that a requote skipped due to parity happens in the next block, which is
+			// before min refresh interval elapses.
+			ctx = tApp.AdvanceToBlock(tc.fillBlock+1, testapp.AdvanceToBlockOptions{})
+			lastRefreshBlock, exists = k.GetLastRefreshBlockHeight(ctx, vaultId)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedLastRefreshBlockAfterFill, lastRefreshBlock)
+			require.False(t, k.GetVaultPendingRequote(ctx, vaultId))
+
+			// Check that resting orders are the ones placed at last refresh.
+			expectedOrderIds, err := k.GetVaultClobOrderIds(
+				ctx.WithBlockHeight(int64(tc.expectedLastRefreshBlockAfterFill)),
+				vaultId,
+			)
+			require.NoError(t, err)
+			for _, orderId := range expectedOrderIds {
+				_, exists := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, *orderId)
+				require.True(t, exists)
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
@@ -72,7 +76,8 @@ func (k Keeper) GetOwnerShares(
 	return val, true
 }
 
-// SetOwnerShares sets owner shares for an owner in a vault.
+// SetOwnerShares sets owner shares for an owner in a vault and accumulates the owner's
+// time-weighted share balance in the current epoch.
 func (k Keeper) SetOwnerShares(
 	ctx sdk.Context,
 	vaultId types.VaultId,
@@ -83,6 +88,12 @@ func (k Keeper) SetOwnerShares(
 		return types.ErrNegativeShares
 	}
 
+	oldShares := big.NewInt(0)
+	if existingShares, exists := k.GetOwnerShares(ctx, vaultId, owner); exists {
+		oldShares = existingShares.NumShares.BigInt()
+	}
+	k.updateOwnerEpochShareWeight(ctx, vaultId, owner, oldShares, ownerShares.NumShares.BigInt())
+
 	b := k.cdc.MustMarshal(&ownerShares)
 	store := k.getVaultOwnerSharesStore(ctx, vaultId)
 	store.Set([]byte(owner), b)
@@ -99,6 +110,50 @@ func (k Keeper) getVaultOwnerSharesStore(
 	return prefix.NewStore(store, vaultId.ToStateKeyPrefix())
 }
 
+// IsOwnerSharesFrozen returns whether shares of an owner in a vault are frozen.
+func (k Keeper) IsOwnerSharesFrozen(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+) bool {
+	store := k.getVaultFrozenOwnerSharesStore(ctx, vaultId)
+
+	b := store.Get([]byte(owner))
+	if b == nil {
+		return false
+	}
+
+	var value gogotypes.BoolValue
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value
+}
+
+// SetOwnerSharesFrozen freezes (unfreezes) shares of an owner in a vault if `frozen` is
+// true (false). Frozen shares can't be withdrawn.
+func (k Keeper) SetOwnerSharesFrozen(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+	frozen bool,
+) {
+	store := k.getVaultFrozenOwnerSharesStore(ctx, vaultId)
+	if frozen {
+		value := gogotypes.BoolValue{Value: true}
+		store.Set([]byte(owner), k.cdc.MustMarshal(&value))
+	} else {
+		store.Delete([]byte(owner))
+	}
+}
+
+// getVaultFrozenOwnerSharesStore returns the store for frozen owner shares of a given vault.
+func (k Keeper) getVaultFrozenOwnerSharesStore(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FrozenOwnerSharesKeyPrefix))
+	return prefix.NewStore(store, vaultId.ToStateKeyPrefix())
+}
+
 // GetAllOwnerShares gets all owner shares of a given vault.
 func (k Keeper) GetAllOwnerShares(
 	ctx sdk.Context,
@@ -115,7 +170,36 @@ func (k Keeper) GetAllOwnerShares(
 		allOwnerShares = append(allOwnerShares, &types.OwnerShare{
 			Owner:  owner,
 			Shares: &ownerShares,
+			Frozen: k.IsOwnerSharesFrozen(ctx, vaultId, owner),
 		})
 	}
 	return allOwnerShares
 }
+
+// RecomputeTotalShares sets TotalShares of a vault to the sum of all owner shares in the vault,
+// which repairs TotalShares if it has diverged from owner shares. Returns TotalShares before
+// and after the repair and emits an event with both.
+func (k Keeper) RecomputeTotalShares(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (totalSharesBefore, totalSharesAfter *big.Int, err error) {
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	if !exists {
+		return nil, nil, errorsmod.Wrapf(types.ErrVaultNotFound, "VaultId: %v", vaultId)
+	}
+	totalSharesBefore = totalShares.NumShares.BigInt()
+
+	totalSharesAfter = new(big.Int)
+	for _, ownerShare := range k.GetAllOwnerShares(ctx, vaultId) {
+		totalSharesAfter.Add(totalSharesAfter, ownerShare.Shares.NumShares.BigInt())
+	}
+	if err := k.SetTotalShares(ctx, vaultId, types.BigIntToNumShares(totalSharesAfter)); err != nil {
+		return nil, nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewVaultRepairSharesEvent(vaultId, totalSharesBefore, totalSharesAfter),
+	)
+
+	return totalSharesBefore, totalSharesAfter, nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// SimulateFillImpact returns the orders that a CLOB vault would place after a hypothetical fill
+// of `fillBaseQuantums` of its orders on the given side at oracle price, i.e. after its inventory
+// in the perpetual of its clob pair increases (buy) or decreases (sell) by `fillBaseQuantums` and
+// its USDC balance changes by the notional of the fill in the opposite direction. Orders are
+// computed on a cache context that is discarded, so state is not mutated.
+func (k Keeper) SimulateFillImpact(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	side clobtypes.Order_Side,
+	fillBaseQuantums *big.Int,
+) (orders []*clobtypes.Order, err error) {
+	if (side != clobtypes.Order_SIDE_BUY && side != clobtypes.Order_SIDE_SELL) || fillBaseQuantums.Sign() < 0 {
+		return orders, errorsmod.Wrapf(
+			types.ErrInvalidSimulatedFill,
+			"Side: %v, FillBaseQuantums: %v",
+			side,
+			fillBaseQuantums,
+		)
+	}
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return orders, errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	perpId := clobPair.Metadata.(*clobtypes.ClobPair_PerpetualClobMetadata).PerpetualClobMetadata.PerpetualId
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		return orders, errorsmod.Wrap(
+			err,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	oraclePrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	if err != nil {
+		return orders, errorsmod.Wrap(
+			err,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+
+	// Delta of inventory is positive for a buy and negative for a sell.
+	inventoryDelta := new(big.Int).Set(fillBaseQuantums)
+	if side == clobtypes.Order_SIDE_SELL {
+		inventoryDelta.Neg(inventoryDelta)
+	}
+	quoteDelta := lib.BaseToQuoteQuantums(
+		inventoryDelta,
+		perpetual.Params.AtomicResolution,
+		oraclePrice.Price,
+		oraclePrice.Exponent,
+	)
+
+	// Apply the fill to the vault's subaccount on a cache context.
+	cacheCtx, _ := ctx.CacheContext()
+	subaccount := k.subaccountsKeeper.GetSubaccount(cacheCtx, *vaultId.ToSubaccountId())
+	subaccount.SetUsdcAssetPosition(new(big.Int).Sub(subaccount.GetUsdcPosition(), quoteDelta))
+	positions := make([]*satypes.PerpetualPosition, 0, len(subaccount.PerpetualPositions)+1)
+	found := false
+	for _, position := range subaccount.PerpetualPositions {
+		if position.PerpetualId == perpId {
+			found = true
+			quantums := new(big.Int).Add(position.GetBigQuantums(), inventoryDelta)
+			if quantums.Sign() == 0 {
+				continue
+			}
+			position.Quantums = dtypes.NewIntFromBigInt(quantums)
+		}
+		positions = append(positions, position)
+	}
+	if !found && inventoryDelta.Sign() != 0 {
+		positions = append(positions, &satypes.PerpetualPosition{
+			PerpetualId:  perpId,
+			Quantums:     dtypes.NewIntFromBigInt(inventoryDelta),
+			FundingIndex: perpetual.FundingIndex,
+		})
+		sort.Slice(positions, func(i, j int) bool {
+			return positions[i].PerpetualId < positions[j].PerpetualId
+		})
+	}
+	subaccount.PerpetualPositions = positions
+	k.subaccountsKeeper.SetSubaccount(cacheCtx, subaccount)
+
+	return k.getVaultClobOrders(cacheCtx, vaultId, clobPair, k.GetParams(cacheCtx))
+}
+
+// SimulateSweepCost returns the cost of a hypothetical taker order on the given side that sweeps
+// `baseQuantums` against just the orders that a CLOB vault would place, i.e. a buy (sell) walks
+// the vault's asks (bids) from best to worst price. Returns an error if the vault's orders on
+// the opposite side are insufficient to fill `baseQuantums`. Orders of other makers are not
+// considered and state is not mutated.
+func (k Keeper) SimulateSweepCost(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	side clobtypes.Order_Side,
+	baseQuantums *big.Int,
+) (cost types.VaultSweepCost, err error) {
+	if (side != clobtypes.Order_SIDE_BUY && side != clobtypes.Order_SIDE_SELL) || baseQuantums.Sign() <= 0 {
+		return cost, errorsmod.Wrapf(
+			types.ErrInvalidSimulatedSweep,
+			"Side: %v, BaseQuantums: %v",
+			side,
+			baseQuantums,
+		)
+	}
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return cost, errorsmod.Wrap(
+			types.ErrClobPairNotFound,
+			fmt.Sprintf("VaultId: %v", vaultId),
+		)
+	}
+	orders, err := k.GetVaultClobOrdersSortedByPrice(ctx, vaultId)
+	if err != nil {
+		return cost, err
+	}
+
+	// Fill makers on the opposite side in price order until the sweep is filled.
+	remaining := new(big.Int).Set(baseQuantums)
+	quoteQuantums := big.NewInt(0)
+	for _, order := range orders {
+		if order.Side == side || remaining.Sign() == 0 {
+			continue
+		}
+		fillQuantums := lib.BigMin(remaining, lib.BigU(order.Quantums))
+		quoteQuantums.Add(quoteQuantums, clobtypes.FillAmountToQuoteQuantums(
+			clobtypes.Subticks(order.Subticks),
+			satypes.BaseQuantums(fillQuantums.Uint64()),
+			clobPair.QuantumConversionExponent,
+		))
+		remaining.Sub(remaining, fillQuantums)
+	}
+	if remaining.Sign() > 0 {
+		return cost, errorsmod.Wrapf(
+			types.ErrInsufficientSweepLiquidity,
+			"VaultId: %v, BaseQuantums: %v, Unfilled: %v",
+			vaultId,
+			baseQuantums,
+			remaining,
+		)
+	}
+
+	return types.VaultSweepCost{
+		AvgPriceSubticks: clobtypes.GetAveragePriceSubticks(
+			quoteQuantums,
+			baseQuantums,
+			clobPair.QuantumConversionExponent,
+		),
+		QuoteQuantums: quoteQuantums,
+	}, nil
+}
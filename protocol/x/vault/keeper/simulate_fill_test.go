@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateFillImpact(t *testing.T) {
+	tests := map[string]struct {
+		// Side of the simulated fill.
+		side clobtypes.Order_Side
+		// Size of the simulated fill in base quantums.
+		fillBaseQuantums *big.Int
+
+		/* --- Expectations --- */
+		// Expected sign of change of each order's subticks after the simulated fill.
+		expectedSubticksChangeSign int
+		// Expected error.
+		expectedErr error
+	}{
+		"Buy fill: skew increases and quotes move down": {
+			side:                       clobtypes.Order_SIDE_BUY,
+			fillBaseQuantums:           big.NewInt(100_000_000), // 0.01 BTC
+			expectedSubticksChangeSign: -1,
+		},
+		"Sell fill: skew decreases and quotes move up": {
+			side:                       clobtypes.Order_SIDE_SELL,
+			fillBaseQuantums:           big.NewInt(100_000_000), // 0.01 BTC
+			expectedSubticksChangeSign: 1,
+		},
+		"Zero fill: quotes are unchanged": {
+			side:                       clobtypes.Order_SIDE_BUY,
+			fillBaseQuantums:           big.NewInt(0),
+			expectedSubticksChangeSign: 0,
+		},
+		"Failure - Unspecified side": {
+			side:             clobtypes.Order_SIDE_UNSPECIFIED,
+			fillBaseQuantums: big.NewInt(100_000_000),
+			expectedErr:      vaulttypes.ErrInvalidSimulatedFill,
+		},
+		"Failure - Negative fill": {
+			side:             clobtypes.Order_SIDE_BUY,
+			fillBaseQuantums: big.NewInt(-1),
+			expectedErr:      vaulttypes.ErrInvalidSimulatedFill,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+
+			ordersBefore, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			subaccountBefore := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+
+			simulatedOrders, err := k.SimulateFillImpact(ctx, vaultId, tc.side, tc.fillBaseQuantums)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// Check that each order moves in the expected direction.
+			require.Len(t, simulatedOrders, len(ordersBefore))
+			for i, order := range simulatedOrders {
+				require.Equal(t, ordersBefore[i].Side, order.Side)
+				require.Equal(
+					t,
+					tc.expectedSubticksChangeSign,
+					new(big.Int).SetUint64(order.Subticks).Cmp(new(big.Int).SetUint64(ordersBefore[i].Subticks)),
+				)
+			}
+
+			// Check that state is not mutated.
+			require.Equal(
+				t,
+				subaccountBefore,
+				tApp.App.SubaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId()),
+			)
+			ordersAfter, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Equal(t, ordersBefore, ordersAfter)
+		})
+	}
+}
+
+func TestSimulateSweepCost(t *testing.T) {
+	tests := map[string]struct {
+		// Side of the simulated sweep.
+		side clobtypes.Order_Side
+		// Size of the simulated sweep in base quantums.
+		baseQuantums *big.Int
+
+		/* --- Expectations --- */
+		// Expected average price in subticks.
+		expectedAvgPriceSubticks *big.Rat
+		// Expected notional in quote quantums.
+		expectedQuoteQuantums *big.Int
+		// Expected error.
+		expectedErr error
+	}{
+		// Vault quotes 2 layers of 50_000_000 base quantums (0.005 BTC) around $20,000:
+		// asks at 202_000_000 and 204_400_000 subticks and bids at 198_000_000 and 195_600_000.
+		"Buy sweep within layer 0 ask": {
+			side:                     clobtypes.Order_SIDE_BUY,
+			baseQuantums:             big.NewInt(25_000_000),
+			expectedAvgPriceSubticks: big.NewRat(202_000_000, 1),
+			// 0.0025 BTC * $20,200 = $50.5.
+			expectedQuoteQuantums: big.NewInt(50_500_000),
+		},
+		"Buy sweep across two layers of asks": {
+			side:         clobtypes.Order_SIDE_BUY,
+			baseQuantums: big.NewInt(75_000_000),
+			// (50_000_000 * 202_000_000 + 25_000_000 * 204_400_000) / 75_000_000 = 202_800_000.
+			expectedAvgPriceSubticks: big.NewRat(202_800_000, 1),
+			// 0.005 BTC * $20,200 + 0.0025 BTC * $20,440 = $101 + $51.1 = $152.1.
+			expectedQuoteQuantums: big.NewInt(152_100_000),
+		},
+		"Sell sweep across two layers of bids": {
+			side:         clobtypes.Order_SIDE_SELL,
+			baseQuantums: big.NewInt(75_000_000),
+			// (50_000_000 * 198_000_000 + 25_000_000 * 195_600_000) / 75_000_000 = 197_200_000.
+			expectedAvgPriceSubticks: big.NewRat(197_200_000, 1),
+			// 0.005 BTC * $19,800 + 0.0025 BTC * $19,560 = $99 + $48.9 = $147.9.
+			expectedQuoteQuantums: big.NewInt(147_900_000),
+		},
+		"Failure - Sweep larger than vault's orders": {
+			side:         clobtypes.Order_SIDE_BUY,
+			baseQuantums: big.NewInt(100_000_001),
+			expectedErr:  vaulttypes.ErrInsufficientSweepLiquidity,
+		},
+		"Failure - Unspecified side": {
+			side:         clobtypes.Order_SIDE_UNSPECIFIED,
+			baseQuantums: big.NewInt(75_000_000),
+			expectedErr:  vaulttypes.ErrInvalidSimulatedSweep,
+		},
+		"Failure - Zero size": {
+			side:         clobtypes.Order_SIDE_BUY,
+			baseQuantums: big.NewInt(0),
+			expectedErr:  vaulttypes.ErrInvalidSimulatedSweep,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			cost, err := k.SimulateSweepCost(ctx, vaultId, tc.side, tc.baseQuantums)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedAvgPriceSubticks, cost.AvgPriceSubticks)
+			require.Equal(t, tc.expectedQuoteQuantums, cost.QuoteQuantums)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetMarketTwap returns the TWAP of a market's oracle price, which is zero if TWAP of the
+// market has never been updated.
+func (k Keeper) GetMarketTwap(
+	ctx sdk.Context,
+	marketId uint32,
+) (twap types.MarketTwap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketTwapKeyPrefix))
+
+	b := store.Get(lib.Uint32ToKey(marketId))
+	if b == nil {
+		return twap
+	}
+
+	k.cdc.MustUnmarshal(b, &twap)
+	return twap
+}
+
+// SetMarketTwap sets the TWAP of a market's oracle price.
+func (k Keeper) SetMarketTwap(
+	ctx sdk.Context,
+	marketId uint32,
+	twap types.MarketTwap,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketTwapKeyPrefix))
+	store.Set(lib.Uint32ToKey(marketId), k.cdc.MustMarshal(&twap))
+}
+
+// UpdateMarketTwaps updates TWAP of each market that a vault with TWAP inventory mark source
+// marks its inventory at, i.e. the market that the vault quotes at and markets of perpetuals
+// that the vault has positions in, with the market's current oracle price. Each market is
+// updated at most once per call even if multiple vaults mark inventory at it.
+func (k Keeper) UpdateMarketTwaps(ctx sdk.Context) {
+	updatedMarketIds := make(map[uint32]struct{})
+	updateMarketTwap := func(marketId uint32) {
+		if _, updated := updatedMarketIds[marketId]; updated {
+			return
+		}
+		updatedMarketIds[marketId] = struct{}{}
+
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, marketId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get market price", err, "marketId", marketId)
+			return
+		}
+		k.SetMarketTwap(ctx, marketId, k.GetMarketTwap(ctx, marketId).Update(marketPrice))
+	}
+
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+		if vaultId.Type != types.VaultType_VAULT_TYPE_CLOB {
+			continue
+		}
+		vaultParams, _ := k.GetVaultParams(ctx, *vaultId)
+		if vaultParams.InventoryMarkSource != types.InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP {
+			continue
+		}
+
+		// Update TWAP of the market that the vault quotes at.
+		clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+		if !exists {
+			continue
+		}
+		perpId := clobPair.Metadata.(*clobtypes.ClobPair_PerpetualClobMetadata).PerpetualClobMetadata.PerpetualId
+		perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault perpetual", err, "vaultId", *vaultId)
+			continue
+		}
+		updateMarketTwap(getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId))
+
+		// Update TWAP of markets of perpetuals that the vault has positions in.
+		vaultSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+		for _, position := range vaultSubaccount.PerpetualPositions {
+			positionPerp, err := k.perpetualsKeeper.GetPerpetual(ctx, position.PerpetualId)
+			if err != nil {
+				log.ErrorLogWithError(ctx, "Failed to get perpetual", err, "perpetualId", position.PerpetualId)
+				continue
+			}
+			updateMarketTwap(positionPerp.Params.MarketId)
+		}
+	}
+}
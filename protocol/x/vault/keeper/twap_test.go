@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMarketTwaps(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Vault 0 marks inventory at TWAP and vault 1 marks inventory at oracle price.
+	for _, vaultId := range []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1} {
+		err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+		require.NoError(t, err)
+	}
+	err := k.SetVaultParams(ctx, constants.Vault_Clob0, vaulttypes.VaultParams{
+		InventoryMarkSource: vaulttypes.InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP,
+	})
+	require.NoError(t, err)
+
+	// TWAP is initialized to oracle price.
+	initialPrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	k.UpdateMarketTwaps(ctx)
+	require.Equal(
+		t,
+		vaulttypes.MarketTwap{Price: initialPrice.Price, Exponent: initialPrice.Exponent},
+		k.GetMarketTwap(ctx, 0),
+	)
+
+	// A doubled price is weighted by alpha (10%).
+	err = tApp.App.PricesKeeper.UpdateMarketPrices(
+		ctx,
+		[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: initialPrice.Price * 2}},
+	)
+	require.NoError(t, err)
+	k.UpdateMarketTwaps(ctx)
+	require.Equal(
+		t,
+		vaulttypes.MarketTwap{Price: initialPrice.Price * 11 / 10, Exponent: initialPrice.Exponent},
+		k.GetMarketTwap(ctx, 0),
+	)
+
+	// Market 1 isn't marked at TWAP by any vault.
+	require.Equal(t, vaulttypes.MarketTwap{}, k.GetMarketTwap(ctx, 1))
+}
+
+func TestGetVaultClobOrders_InventoryMarkSource(t *testing.T) {
+	tests := map[string]struct {
+		// Inventory mark source of the vault.
+		inventoryMarkSource vaulttypes.InventoryMarkSource
+		// Expected leverage of the vault.
+		expectedLeveragePpm int64
+	}{
+		"Oracle": {
+			inventoryMarkSource: vaulttypes.InventoryMarkSource_INVENTORY_MARK_SOURCE_ORACLE,
+			// leverage = $20 / $1,020 = 0.019607
+			expectedLeveragePpm: 19_607,
+		},
+		"TWAP": {
+			inventoryMarkSource: vaulttypes.InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP,
+			// leverage = $10 / $1,010 = 0.009900
+			expectedLeveragePpm: 9_900,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob0
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									testutil.CreateSingleAssetPosition(
+										assettypes.AssetUsdc.Id,
+										big.NewInt(1_000_000_000), // 1,000 USDC
+									),
+								},
+								PerpetualPositions: []*satypes.PerpetualPosition{
+									// 0.001 BTC long at $20,000 = $20.
+									testutil.CreateSinglePerpetualPosition(0, big.NewInt(10_000_000), big.NewInt(0)),
+								},
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+			require.NoError(t, err)
+			err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+				InventoryMarkSource: tc.inventoryMarkSource,
+			})
+			require.NoError(t, err)
+
+			// TWAP of BTC is $10,000, half of its oracle price.
+			oraclePrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+			require.NoError(t, err)
+			k.SetMarketTwap(ctx, 0, vaulttypes.MarketTwap{
+				Price:    oraclePrice.Price / 2,
+				Exponent: oraclePrice.Exponent,
+			})
+
+			// Check leverage of the vault's layer-0 ask.
+			response, err := k.ExplainVaultOrder(ctx, &vaulttypes.QueryExplainVaultOrderRequest{
+				Type:   vaultId.Type,
+				Number: vaultId.Number,
+				Side:   clobtypes.Order_SIDE_SELL,
+				Layer:  0,
+			})
+			require.NoError(t, err)
+			require.Equal(t, dtypes.NewInt(tc.expectedLeveragePpm), response.Explanation.LeveragePpm)
+		})
+	}
+}
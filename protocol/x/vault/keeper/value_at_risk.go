@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultValueAtRisk returns the one-block value at risk (in quote quantums) of a CLOB vault's
+// position in its perpetual at the given confidence (in ppm), i.e. the loss that the position is
+// not expected to exceed in one block with that confidence, assuming normally-distributed returns.
+// Value at risk is `|notional| * sigma * z` where
+// - notional is the vault's inventory at oracle price
+// - sigma = sqrt(pi/2) * EWMA of absolute per-block returns of the vault's price market, i.e.
+// standard deviation of a normal distribution whose mean absolute value is the EWMA
+// - z is the standard normal quantile at the given confidence
+// Returns an error if confidence is not within [50%, 99.9%] or the vault's clob pair, perpetual,
+// or market price doesn't exist.
+func (k Keeper) GetVaultValueAtRisk(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	confidencePpm uint32,
+) (*big.Int, error) {
+	quantilePpm, ok := types.GetNormalQuantilePpm(confidencePpm)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidConfidencePpm, "confidence: %d", confidencePpm)
+	}
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrClobPairNotFound, "VaultId: %v", vaultId)
+	}
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return nil, err
+	}
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+	if err != nil {
+		return nil, err
+	}
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	vaultParams, _ := k.GetVaultParams(ctx, vaultId)
+	volatility := k.GetMarketVolatility(ctx, getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId))
+
+	return getVaultValueAtRisk(
+		k.GetVaultInventoryInPerpetual(ctx, vaultId, perpId),
+		perpetual,
+		marketPrice,
+		volatility,
+		quantilePpm,
+	), nil
+}
+
+// getVaultValueAtRisk returns the one-block value at risk (in quote quantums) of a position of
+// `inventory` in a perpetual given the perpetual's market price, volatility of the vault's price
+// market, and the standard normal quantile (in ppm) at the desired confidence.
+func getVaultValueAtRisk(
+	inventory *big.Int,
+	perpetual perptypes.Perpetual,
+	marketPrice pricestypes.MarketPrice,
+	volatility types.MarketVolatility,
+	quantilePpm uint64,
+) *big.Int {
+	// value_at_risk = |notional| * ewma_abs_return * sqrt(pi/2) * z
+	valueAtRisk := lib.BaseToQuoteQuantums(
+		new(big.Int).Abs(inventory),
+		perpetual.Params.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	)
+	valueAtRisk.Mul(valueAtRisk, new(big.Int).SetUint64(volatility.EwmaAbsReturnPpm))
+	valueAtRisk.Mul(valueAtRisk, lib.BigU(uint32(types.SqrtHalfPiPpm)))
+	valueAtRisk.Mul(valueAtRisk, new(big.Int).SetUint64(quantilePpm))
+	ppmCubed, _ := lib.BigPow10(18)
+	valueAtRisk.Quo(valueAtRisk, ppmCubed)
+	return valueAtRisk
+}
@@ -3,10 +3,12 @@ package keeper
 import (
 	"math/big"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
@@ -28,6 +30,53 @@ func (k Keeper) GetVaultEquity(
 	return risk.NC, nil
 }
 
+// GetVaultMargin returns the initial and maintenance margin requirements and free collateral
+// (net collateral minus initial margin requirement) of a vault (in quote quantums).
+func (k Keeper) GetVaultMargin(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (initialMargin, maintenanceMargin, freeCollateral *big.Int, err error) {
+	risk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{
+			SubaccountId: *vaultId.ToSubaccountId(),
+		},
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return risk.IMR, risk.MMR, new(big.Int).Sub(risk.NC, risk.IMR), nil
+}
+
+// settleVaultFunding settles pending funding of a vault's perpetual positions into its
+// subaccount by applying an empty update to the subaccount.
+func (k Keeper) settleVaultFunding(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) error {
+	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(
+		ctx,
+		[]satypes.Update{
+			{
+				SubaccountId: *vaultId.ToSubaccountId(),
+			},
+		},
+		satypes.UpdateTypeUnspecified,
+	)
+	if err != nil {
+		return err
+	}
+	if !success {
+		return errorsmod.Wrapf(
+			types.ErrSettleFundingFailed,
+			"VaultId: %v, Result: %v",
+			vaultId,
+			successPerUpdate,
+		)
+	}
+	return nil
+}
+
 // GetVaultInventory returns the inventory of a vault in a given perpeutal (in base quantums).
 func (k Keeper) GetVaultInventoryInPerpetual(
 	ctx sdk.Context,
@@ -82,7 +131,8 @@ func (k Keeper) DecommissionNonPositiveEquityVaults(
 	}
 }
 
-// DecommissionVault decommissions a vault by deleting its total shares and owner shares.
+// DecommissionVault decommissions a vault by deleting its total shares, owner shares,
+// and last refresh block height.
 func (k Keeper) DecommissionVault(
 	ctx sdk.Context,
 	vaultId types.VaultId,
@@ -98,10 +148,45 @@ func (k Keeper) DecommissionVault(
 	for ; ownerSharesIterator.Valid(); ownerSharesIterator.Next() {
 		ownerSharesStore.Delete(ownerSharesIterator.Key())
 	}
+
+	// Delete all frozen OwnerShares of the vault.
+	frozenOwnerSharesStore := k.getVaultFrozenOwnerSharesStore(ctx, vaultId)
+	frozenOwnerSharesIterator := storetypes.KVStorePrefixIterator(frozenOwnerSharesStore, []byte{})
+	defer frozenOwnerSharesIterator.Close()
+	for ; frozenOwnerSharesIterator.Valid(); frozenOwnerSharesIterator.Next() {
+		frozenOwnerSharesStore.Delete(frozenOwnerSharesIterator.Key())
+	}
+
+	// Delete cost basis of all OwnerShares of the vault.
+	costBasisStore := k.getVaultOwnerCostBasisStore(ctx, vaultId)
+	costBasisIterator := storetypes.KVStorePrefixIterator(costBasisStore, []byte{})
+	defer costBasisIterator.Close()
+	for ; costBasisIterator.Valid(); costBasisIterator.Next() {
+		costBasisStore.Delete(costBasisIterator.Key())
+	}
+
+	// Delete last refresh block height and block time of the vault.
+	k.deleteLastRefresh(ctx, vaultId)
+
+	// Delete activation status of the vault.
+	activatedStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ActivatedKeyPrefix))
+	activatedStore.Delete(vaultId.ToStateKey())
+
+	// Delete fill statistics of the vault.
+	fillStatsStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FillStatsKeyPrefix))
+	fillStatsStore.Delete(vaultId.ToStateKey())
+
+	// Delete pending requote of the vault.
+	k.SetVaultPendingRequote(ctx, vaultId, false)
+
+	// Delete close-only mode of the vault.
+	k.SetVaultCloseOnly(ctx, vaultId, false)
+
+	// Delete refresh history of the vault.
+	k.deleteVaultRefreshHistory(ctx, vaultId)
 }
 
 // GetAllVaults returns all vaults with their total shares, owner shares, and individual params.
-// Note: This function is only used for exporting module state.
 func (k Keeper) GetAllVaults(ctx sdk.Context) []*types.Vault {
 	vaults := []*types.Vault{}
 	totalSharesIterator := k.getTotalSharesIterator(ctx)
@@ -128,3 +213,64 @@ func (k Keeper) GetAllVaults(ctx sdk.Context) []*types.Vault {
 	}
 	return vaults
 }
+
+// GetTotalVaultTvl returns the total value locked across all vaults, i.e. sum of equity
+// of all vaults (in quote quantums), and the number of vaults.
+func (k Keeper) GetTotalVaultTvl(ctx sdk.Context) (totalEquity *big.Int, vaultCount uint32, err error) {
+	totalEquity = big.NewInt(0)
+	for _, vault := range k.GetAllVaults(ctx) {
+		equity, err := k.GetVaultEquity(ctx, *vault.VaultId)
+		if err != nil {
+			return nil, 0, err
+		}
+		totalEquity.Add(totalEquity, equity)
+		vaultCount++
+	}
+	return totalEquity, vaultCount, nil
+}
+
+// GetTotalVaultInventory returns the net inventory (in base quantums) of all vaults in the
+// perpetual of a given clob pair, i.e. sum of signed positions of all vaults in that perpetual,
+// which is zero if the clob pair doesn't exist.
+func (k Keeper) GetTotalVaultInventory(
+	ctx sdk.Context,
+	clobPairId clobtypes.ClobPairId,
+) *big.Int {
+	clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobPairId)
+	if !exists {
+		return big.NewInt(0)
+	}
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return big.NewInt(0)
+	}
+	if inventory, exists := k.getTotalVaultInventories(ctx)[perpId]; exists {
+		return inventory
+	}
+	return big.NewInt(0)
+}
+
+// getTotalVaultInventories returns the net inventory (in base quantums) of all vaults in each
+// perpetual that any vault has a position in, keyed by perpetual ID.
+func (k Keeper) getTotalVaultInventories(ctx sdk.Context) map[uint32]*big.Int {
+	inventories := make(map[uint32]*big.Int)
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+		subaccount := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
+		for _, p := range subaccount.PerpetualPositions {
+			inventory, exists := inventories[p.GetPerpetualId()]
+			if !exists {
+				inventory = big.NewInt(0)
+				inventories[p.GetPerpetualId()] = inventory
+			}
+			inventory.Add(inventory, p.GetBigQuantums())
+		}
+	}
+	return inventories
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
@@ -207,17 +208,38 @@ func TestDecommissionVault(t *testing.T) {
 				)
 				require.NoError(t, err)
 			}
+			k.SetLastRefreshBlockHeight(ctx, tc.vaultId, 5)
+			k.SetLastRefreshBlockTime(ctx, tc.vaultId, 100)
+			k.SetVaultActivated(ctx, tc.vaultId, true)
+			k.SetVaultFillStats(ctx, tc.vaultId, vaulttypes.VaultFillStats{
+				NumFills:                    1,
+				VolumeQuoteQuantums:         dtypes.NewInt(100),
+				RealizedSpreadQuoteQuantums: dtypes.NewInt(1),
+			})
+			for _, owner := range tc.owners {
+				k.SetOwnerSharesFrozen(ctx, tc.vaultId, owner, true)
+				k.SetOwnerCostBasis(ctx, tc.vaultId, owner, big.NewInt(7))
+			}
 
 			// Decommission vault.
 			k.DecommissionVault(ctx, tc.vaultId)
 
-			// Check that total shares and owner shares are deleted.
+			// Check that total shares, owner shares (and whether they're frozen), last refresh
+			// block height and time, and activation status are deleted.
 			_, exists := k.GetTotalShares(ctx, tc.vaultId)
 			require.Equal(t, false, exists)
 			for _, owner := range tc.owners {
 				_, exists = k.GetOwnerShares(ctx, tc.vaultId, owner)
 				require.Equal(t, false, exists)
+				require.Equal(t, false, k.IsOwnerSharesFrozen(ctx, tc.vaultId, owner))
+				require.Equal(t, 0, k.GetOwnerCostBasis(ctx, tc.vaultId, owner).Sign())
 			}
+			_, exists = k.GetLastRefreshBlockHeight(ctx, tc.vaultId)
+			require.Equal(t, false, exists)
+			_, exists = k.GetLastRefreshBlockTime(ctx, tc.vaultId)
+			require.Equal(t, false, exists)
+			require.Equal(t, false, k.GetVaultActivated(ctx, tc.vaultId))
+			require.Equal(t, uint64(0), k.GetVaultFillStats(ctx, tc.vaultId).NumFills)
 		})
 	}
 }
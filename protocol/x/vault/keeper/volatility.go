@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetMarketVolatility returns the realized volatility of a market, which is zero if
+// volatility of the market has never been updated.
+func (k Keeper) GetMarketVolatility(
+	ctx sdk.Context,
+	marketId uint32,
+) (volatility types.MarketVolatility) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketVolatilityKeyPrefix))
+
+	b := store.Get(lib.Uint32ToKey(marketId))
+	if b == nil {
+		return volatility
+	}
+
+	k.cdc.MustUnmarshal(b, &volatility)
+	return volatility
+}
+
+// GetMarketRealizedVolatility returns the EWMA of absolute per-block returns (in ppm) of a
+// market's oracle price and whether there's sufficient data for it, i.e. whether at least one
+// per-block return has been observed. The EWMA is zero if data is insufficient.
+func (k Keeper) GetMarketRealizedVolatility(
+	ctx sdk.Context,
+	marketId uint32,
+) (ewmaAbsReturnPpm uint64, sufficientData bool) {
+	volatility := k.GetMarketVolatility(ctx, marketId)
+	return volatility.EwmaAbsReturnPpm, volatility.HasSufficientData()
+}
+
+// SetMarketVolatility sets the realized volatility of a market.
+func (k Keeper) SetMarketVolatility(
+	ctx sdk.Context,
+	marketId uint32,
+	volatility types.MarketVolatility,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketVolatilityKeyPrefix))
+	store.Set(lib.Uint32ToKey(marketId), k.cdc.MustMarshal(&volatility))
+}
+
+// UpdateMarketVolatilities updates realized volatility of each market that a vault quotes
+// at with the market's current oracle price. Each market is updated at most once per call
+// even if multiple vaults quote at it.
+func (k Keeper) UpdateMarketVolatilities(ctx sdk.Context) {
+	updatedMarketIds := make(map[uint32]struct{})
+	totalSharesIterator := k.getTotalSharesIterator(ctx)
+	defer totalSharesIterator.Close()
+	for ; totalSharesIterator.Valid(); totalSharesIterator.Next() {
+		vaultId, err := types.GetVaultIdFromStateKey(totalSharesIterator.Key())
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault ID from state key", err)
+			continue
+		}
+		if vaultId.Type != types.VaultType_VAULT_TYPE_CLOB {
+			continue
+		}
+
+		// Get the market that the vault quotes at.
+		clobPair, exists := k.clobKeeper.GetClobPair(ctx, clobtypes.ClobPairId(vaultId.Number))
+		if !exists {
+			continue
+		}
+		perpId := clobPair.Metadata.(*clobtypes.ClobPair_PerpetualClobMetadata).PerpetualClobMetadata.PerpetualId
+		perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get vault perpetual", err, "vaultId", *vaultId)
+			continue
+		}
+		vaultParams, _ := k.GetVaultParams(ctx, *vaultId)
+		marketId := getVaultPriceMarketId(vaultParams, perpetual.Params.MarketId)
+		if _, updated := updatedMarketIds[marketId]; updated {
+			continue
+		}
+		updatedMarketIds[marketId] = struct{}{}
+
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, marketId)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to get market price", err, "marketId", marketId)
+			continue
+		}
+		k.SetMarketVolatility(ctx, marketId, k.GetMarketVolatility(ctx, marketId).Update(marketPrice))
+	}
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMarketVolatilities_OrderSizeShrinksAsVolatilityRises(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+	err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+	require.NoError(t, err)
+
+	unscaledParams := vaulttypes.DefaultParams()
+	scaledParams := vaulttypes.DefaultParams()
+	scaledParams.OrderSizeVolScalePpm = 1_000_000_000 // 1,000x
+
+	// Feed a price series whose absolute returns grow each block (0%, 1%, 2%, 4%, 8%).
+	initialPrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	price := initialPrice.Price
+	returnsPpm := []int64{0, 10_000, -20_000, 40_000, -80_000}
+	lastSizeRatio := new(big.Rat).SetInt64(1)
+	lastEwma := uint64(0)
+	for i, returnPpm := range returnsPpm {
+		price = uint64(int64(price) + int64(price)*returnPpm/1_000_000)
+		err := tApp.App.PricesKeeper.UpdateMarketPrices(
+			ctx,
+			[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: price}},
+		)
+		require.NoError(t, err)
+		k.UpdateMarketVolatilities(ctx)
+
+		volatility := k.GetMarketVolatility(ctx, 0)
+		require.Equal(t, price, volatility.LastPrice)
+		if i > 0 {
+			require.Greater(t, volatility.EwmaAbsReturnPpm, lastEwma)
+		}
+		lastEwma = volatility.EwmaAbsReturnPpm
+
+		// Get order size with and without volatility scaling at the same price.
+		err = k.SetParams(ctx, unscaledParams)
+		require.NoError(t, err)
+		unscaledOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+		err = k.SetParams(ctx, scaledParams)
+		require.NoError(t, err)
+		scaledOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+		require.Len(t, scaledOrders, len(unscaledOrders))
+
+		// Check that scaled sizes shrink relative to unscaled sizes as volatility rises.
+		sizeRatio := new(big.Rat).SetFrac(
+			new(big.Int).SetUint64(scaledOrders[0].Quantums),
+			new(big.Int).SetUint64(unscaledOrders[0].Quantums),
+		)
+		if i == 0 {
+			require.Equal(t, unscaledOrders, scaledOrders)
+		} else {
+			require.Equal(t, -1, sizeRatio.Cmp(lastSizeRatio))
+		}
+		lastSizeRatio = sizeRatio
+	}
+}
+
+func TestUpdateMarketVolatilities_OncePerMarket(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Both vaults quote at market 0 as vault 1 has a price market override.
+	for _, vaultId := range []vaulttypes.VaultId{constants.Vault_Clob0, constants.Vault_Clob1} {
+		err := k.SetTotalShares(ctx, vaultId, vaulttypes.BigIntToNumShares(big.NewInt(1)))
+		require.NoError(t, err)
+	}
+	err := k.SetVaultParams(ctx, constants.Vault_Clob1, vaulttypes.VaultParams{
+		PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 0},
+	})
+	require.NoError(t, err)
+
+	// Feed two prices for market 0. Volatility is only updated once per call.
+	initialPrice, err := tApp.App.PricesKeeper.GetMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	k.UpdateMarketVolatilities(ctx)
+	err = tApp.App.PricesKeeper.UpdateMarketPrices(
+		ctx,
+		[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: initialPrice.Price * 2}},
+	)
+	require.NoError(t, err)
+	k.UpdateMarketVolatilities(ctx)
+
+	require.Equal(
+		t,
+		initialPrice.Price*2,
+		k.GetMarketVolatility(ctx, 0).LastPrice,
+	)
+	// A 100% return is weighted by alpha (10%).
+	require.Equal(t, uint64(100_000), k.GetMarketVolatility(ctx, 0).EwmaAbsReturnPpm)
+	// Market 1 isn't quoted at by any vault.
+	require.Equal(t, vaulttypes.MarketVolatility{}, k.GetMarketVolatility(ctx, 1))
+}
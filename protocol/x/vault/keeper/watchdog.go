@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// GetVaultZeroOrdersSinceBlock returns the block height since which a vault has placed zero
+// orders at each refresh of its orders. Returns false if the vault placed orders at its last
+// refresh.
+func (k Keeper) GetVaultZeroOrdersSinceBlock(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (blockHeight uint32, exists bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ZeroOrdersSinceBlockKeyPrefix))
+
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return 0, false
+	}
+
+	var value gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &value)
+	return value.Value, true
+}
+
+// setVaultZeroOrdersSinceBlock sets the block height since which a vault has placed zero orders.
+func (k Keeper) setVaultZeroOrdersSinceBlock(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	blockHeight uint32,
+) {
+	value := gogotypes.UInt32Value{Value: blockHeight}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ZeroOrdersSinceBlockKeyPrefix))
+	store.Set(vaultId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// deleteVaultZeroOrdersSinceBlock deletes the block height since which a vault has placed zero orders.
+func (k Keeper) deleteVaultZeroOrdersSinceBlock(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ZeroOrdersSinceBlockKeyPrefix))
+	store.Delete(vaultId.ToStateKey())
+}
+
+// updateVaultWatchdog tracks consecutive blocks in which an active vault placed zero orders
+// when refreshing its orders, which catches silent quoting failures such as persistent zero
+// order sizes. Once the vault has placed zero orders for at least `watchdog_blocks` blocks, a
+// vault_watchdog event is emitted at each such refresh with level `zero_order_blocks /
+// watchdog_blocks`. Tracking is reset once the vault places orders or if the watchdog is disabled.
+func (k Keeper) updateVaultWatchdog(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	params types.Params,
+	numOrdersPlaced uint32,
+) {
+	if params.WatchdogBlocks == 0 || numOrdersPlaced > 0 {
+		k.deleteVaultZeroOrdersSinceBlock(ctx, vaultId)
+		return
+	}
+
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	sinceBlock, exists := k.GetVaultZeroOrdersSinceBlock(ctx, vaultId)
+	if !exists {
+		sinceBlock = blockHeight
+		k.setVaultZeroOrdersSinceBlock(ctx, vaultId, sinceBlock)
+	}
+
+	zeroOrderBlocks := blockHeight - sinceBlock + 1
+	level := zeroOrderBlocks / params.WatchdogBlocks
+	if level == 0 {
+		return
+	}
+	log.ErrorLog(
+		ctx,
+		"Vault has placed zero orders for at least watchdog blocks",
+		"vaultId", vaultId,
+		"zeroOrderBlocks", zeroOrderBlocks,
+		"watchdogBlocks", params.WatchdogBlocks,
+		"watchdogLevel", level,
+	)
+	ctx.EventManager().EmitEvent(types.NewVaultWatchdogEvent(vaultId, zeroOrderBlocks, level))
+	vaultId.IncrCounterWithLabels(metrics.VaultWatchdog)
+}
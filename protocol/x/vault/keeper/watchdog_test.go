@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshVaultClobOrders_Watchdog(t *testing.T) {
+	vaultId := constants.Vault_Clob0
+	// Initialize tApp with a funded vault that places zero orders as oracle price is above its
+	// max oracle price, and with a watchdog that trips after 4 blocks of zero orders.
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							testutil.CreateSingleAssetPosition(
+								assettypes.AssetUsdc.Id,
+								big.NewInt(1_000_000_000), // 1,000 USDC
+							),
+						},
+					},
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *vaulttypes.GenesisState) {
+				genesisState.Params.WatchdogBlocks = 4
+				genesisState.Vaults = []*vaulttypes.Vault{
+					{
+						VaultId:     &vaultId,
+						TotalShares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+						OwnerShares: []*vaulttypes.OwnerShare{
+							{
+								Owner:  constants.AliceAccAddress.String(),
+								Shares: &vaulttypes.NumShares{NumShares: dtypes.NewInt(1_000)},
+							},
+						},
+						VaultParams: &vaulttypes.VaultParams{MaxOraclePrice: 1},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	// Vault refreshes its orders for the first time at block 1 and places zero orders.
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+	k := tApp.App.VaultKeeper
+	sinceBlock, exists := k.GetVaultZeroOrdersSinceBlock(ctx, vaultId)
+	require.True(t, exists)
+	require.Equal(t, uint32(1), sinceBlock)
+
+	// Vault refreshes its orders at every block. Watchdog trips once the vault has placed zero
+	// orders for 4 blocks and escalates every 4 blocks after that.
+	for _, expected := range []struct {
+		blockHeight     int64
+		zeroOrderBlocks uint32
+		level           uint32
+	}{
+		{blockHeight: 2, zeroOrderBlocks: 2, level: 0},
+		{blockHeight: 3, zeroOrderBlocks: 3, level: 0},
+		{blockHeight: 4, zeroOrderBlocks: 4, level: 1},
+		{blockHeight: 5, zeroOrderBlocks: 5, level: 1},
+		{blockHeight: 6, zeroOrderBlocks: 6, level: 1},
+		{blockHeight: 7, zeroOrderBlocks: 7, level: 1},
+		{blockHeight: 8, zeroOrderBlocks: 8, level: 2},
+	} {
+		ctx = ctx.WithBlockHeight(expected.blockHeight).WithEventManager(sdk.NewEventManager())
+		tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+			BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		})
+		err := k.RefreshVaultClobOrders(ctx, vaultId)
+		require.NoError(t, err)
+		require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+
+		sinceBlock, exists := k.GetVaultZeroOrdersSinceBlock(ctx, vaultId)
+		require.True(t, exists)
+		require.Equal(t, uint32(1), sinceBlock)
+		watchdogEvent := vaulttypes.NewVaultWatchdogEvent(vaultId, expected.zeroOrderBlocks, expected.level)
+		if expected.level == 0 {
+			require.NotContains(t, ctx.EventManager().Events(), watchdogEvent)
+		} else {
+			require.Contains(t, ctx.EventManager().Events(), watchdogEvent)
+		}
+	}
+
+	// Watchdog resets once the vault places orders again.
+	k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{})
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, clobtypes.ProcessProposerMatchesEvents{
+		BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+	})
+	err := k.RefreshVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.NotEmpty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+	_, exists = k.GetVaultZeroOrdersSinceBlock(ctx, vaultId)
+	require.False(t, exists)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, vaulttypes.EventTypeVaultWatchdog, event.Type)
+	}
+}
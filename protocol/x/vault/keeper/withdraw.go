@@ -28,7 +28,17 @@ func (k Keeper) ValidateWithdrawFromVault(
 		)
 	}
 
-	// 3. Shares to withdraw cannot be greater than the owner shares.
+	// 3. Owner shares are not frozen.
+	if k.IsOwnerSharesFrozen(ctx, *msgWithdraw.GetVaultId(), msgWithdraw.SubaccountId.GetOwner()) {
+		return errors.Wrapf(
+			types.ErrOwnerSharesFrozen,
+			"VaultId: %v, Owner: %v",
+			*msgWithdraw.GetVaultId(),
+			msgWithdraw.SubaccountId.GetOwner(),
+		)
+	}
+
+	// 4. Shares to withdraw cannot be greater than the owner shares.
 	if ownerShares.NumShares.BigInt().Cmp(msgWithdraw.Shares.NumShares.BigInt()) < 0 {
 		return errors.Wrapf(
 			types.ErrInvalidWithdrawalAmount,
@@ -40,5 +50,10 @@ func (k Keeper) ValidateWithdrawFromVault(
 		)
 	}
 
+	// 5. Vault is not locked by a refresh in the current block.
+	if k.IsVaultRefreshLocked(ctx, *msgWithdraw.GetVaultId()) {
+		return errors.Wrapf(types.ErrVaultRefreshLocked, "VaultId: %v", *msgWithdraw.GetVaultId())
+	}
+
 	return nil
 }
@@ -40,6 +40,8 @@ func TestValidateWithdrawFromVault(t *testing.T) {
 	tests := map[string]struct {
 		/* --- Setup --- */
 		vaultState constants.VaultState
+		// Owners whose shares are frozen.
+		frozenOwners []string
 
 		/* --- Inputs --- */
 		msg vaulttypes.MsgWithdrawFromVault
@@ -83,6 +85,16 @@ func TestValidateWithdrawFromVault(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"Success: multiple owners, other owner's shares frozen": {
+			vaultState:   constants.Vault_Clob0_MultiOwner_Alice0_1000_Bob0_2500,
+			frozenOwners: []string{constants.Alice_Num0.Owner},
+			msg: vaulttypes.MsgWithdrawFromVault{
+				VaultId:      &constants.Vault_Clob0,
+				SubaccountId: &constants.Bob_Num0,
+				Shares:       &vaulttypes.NumShares{NumShares: dtypes.NewInt(1)},
+			},
+			expectedErr: nil,
+		},
 		"Failure: no vault": {
 			vaultState: constants.VaultState{}, // nil vault state.
 			msg: vaulttypes.MsgWithdrawFromVault{
@@ -110,6 +122,16 @@ func TestValidateWithdrawFromVault(t *testing.T) {
 			},
 			expectedErr: vaulttypes.ErrOwnerShareNotFound,
 		},
+		"Failure: multiple owners, owner shares frozen": {
+			vaultState:   constants.Vault_Clob0_MultiOwner_Alice0_1000_Bob0_2500,
+			frozenOwners: []string{constants.Alice_Num0.Owner},
+			msg: vaulttypes.MsgWithdrawFromVault{
+				VaultId:      &constants.Vault_Clob0,
+				SubaccountId: &constants.Alice_Num0,
+				Shares:       &vaulttypes.NumShares{NumShares: dtypes.NewInt(1)},
+			},
+			expectedErr: vaulttypes.ErrOwnerSharesFrozen,
+		},
 		"Failure: single owner, shares greater than owner shares": {
 			vaultState: constants.Vault_Clob0_SingleOwner_Alice0_1000,
 			msg: vaulttypes.MsgWithdrawFromVault{
@@ -142,6 +164,9 @@ func TestValidateWithdrawFromVault(t *testing.T) {
 			if tc.vaultState.VaultId != (vaulttypes.VaultId{}) {
 				setVaultState(t, tApp, ctx, tc.vaultState)
 			}
+			for _, owner := range tc.frozenOwners {
+				tApp.App.VaultKeeper.SetOwnerSharesFrozen(ctx, tc.vaultState.VaultId, owner, true)
+			}
 
 			// Run.
 			err := tApp.App.VaultKeeper.ValidateWithdrawFromVault(ctx, &tc.msg)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// DecodeVaultClientId returns the side, block height parity, and layer that the client ID
+// of a vault CLOB order encodes. This is the inverse of `GetVaultClobOrderClientId`, where
+// - 1st bit is `side-1`
+// - 2nd bit is `|block height| % 2`
+// - next 8 bits are `layer`
+// - remaining bits are zero
+func DecodeVaultClientId(clientId uint32) (
+	side clobtypes.Order_Side,
+	blockParity uint32,
+	layer uint8,
+	err error,
+) {
+	if clientId&(1<<22-1) != 0 {
+		return clobtypes.Order_SIDE_UNSPECIFIED, 0, 0, errorsmod.Wrapf(
+			ErrInvalidVaultClientId,
+			"client ID %d has non-zero lower 22 bits",
+			clientId,
+		)
+	}
+	side = clobtypes.Order_Side(clientId>>31 + 1)
+	blockParity = clientId >> 30 & 1
+	layer = uint8(clientId >> 22)
+	return side, blockParity, layer, nil
+}
@@ -0,0 +1,5 @@
+package types
+
+// OwnerShareEpochRetention is the number of epochs before the current epoch for which
+// time-weighted share balances of owners are kept, beyond which they expire.
+const OwnerShareEpochRetention = 30
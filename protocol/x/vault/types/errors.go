@@ -100,4 +100,209 @@ var (
 		19,
 		"Owner share not found",
 	)
+	ErrInvalidMinEquityPerLayerQuoteQuantums = errorsmod.Register(
+		ModuleName,
+		20,
+		"MinEquityPerLayerQuoteQuantums must be non-negative",
+	)
+	ErrInvalidVaultLabel = errorsmod.Register(
+		ModuleName,
+		21,
+		"Vault label is invalid",
+	)
+	ErrInvalidSpreadMultiplierPpmByLayer = errorsmod.Register(
+		ModuleName,
+		23,
+		"SpreadMultiplierPpmByLayer must have at most Layers multipliers, all of which are positive",
+	)
+	ErrOwnerSharesFrozen = errorsmod.Register(
+		ModuleName,
+		24,
+		"Owner shares are frozen",
+	)
+	ErrInvalidOraclePriceRange = errorsmod.Register(
+		ModuleName,
+		25,
+		"MinOraclePrice must not be greater than MaxOraclePrice",
+	)
+	ErrInvalidPriceBlend = errorsmod.Register(
+		ModuleName,
+		26,
+		"Invalid price blend",
+	)
+	ErrInvalidOrderSubticks = errorsmod.Register(
+		ModuleName,
+		27,
+		"Order subticks must be positive and fit in uint64",
+	)
+	ErrInvalidVaultClientId = errorsmod.Register(
+		ModuleName,
+		28,
+		"Invalid vault client ID",
+	)
+	ErrVaultNotDeactivated = errorsmod.Register(
+		ModuleName,
+		29,
+		"Vault is not deactivated",
+	)
+	ErrVaultHasRestingOrders = errorsmod.Register(
+		ModuleName,
+		30,
+		"Vault has resting orders",
+	)
+	ErrVaultHasOutstandingShares = errorsmod.Register(
+		ModuleName,
+		31,
+		"Vault has outstanding shares",
+	)
+	ErrVaultHasOpenPositions = errorsmod.Register(
+		ModuleName,
+		32,
+		"Vault has open perpetual positions",
+	)
+	ErrInvalidDestination = errorsmod.Register(
+		ModuleName,
+		33,
+		"Invalid destination subaccount",
+	)
+	ErrInvalidSizeProfile = errorsmod.Register(
+		ModuleName,
+		34,
+		"Invalid size profile",
+	)
+	ErrInvalidOperator = errorsmod.Register(
+		ModuleName,
+		35,
+		"Invalid vault operator",
+	)
+	ErrInvalidOperatorParamBounds = errorsmod.Register(
+		ModuleName,
+		36,
+		"Invalid operator param bounds",
+	)
+	ErrOperatorParamOutOfBounds = errorsmod.Register(
+		ModuleName,
+		37,
+		"Operator param is out of bounds",
+	)
+	ErrOperatorUpdateTooFrequent = errorsmod.Register(
+		ModuleName,
+		38,
+		"Operator param update is too frequent",
+	)
+	ErrInvalidRequoteFillThreshold = errorsmod.Register(
+		ModuleName,
+		39,
+		"RequoteFillThresholdPctPpm must be at most 1,000,000",
+	)
+	ErrInvalidMaxVaultOrdersPerBlock = errorsmod.Register(
+		ModuleName,
+		40,
+		"MaxVaultOrdersPerBlock must be zero or at least twice the number of layers",
+	)
+	ErrInvalidIndexConstituents = errorsmod.Register(
+		ModuleName,
+		41,
+		"Invalid index constituents",
+	)
+	ErrInvalidInventoryMarkSource = errorsmod.Register(
+		ModuleName,
+		42,
+		"Invalid inventory mark source",
+	)
+	ErrInvalidSimulatedFill = errorsmod.Register(
+		ModuleName,
+		43,
+		"Simulated fill must be on buy or sell side and of non-negative size",
+	)
+	ErrInvalidMinOrderLifetimeSeconds = errorsmod.Register(
+		ModuleName,
+		44,
+		"MinOrderLifetimeSeconds must be at most the stateful order time window",
+	)
+	ErrInvalidOrderStepBaseQuantumsOverride = errorsmod.Register(
+		ModuleName,
+		45,
+		"OrderStepBaseQuantumsOverride must be a multiple of the clob pair's step base quantums",
+	)
+	ErrInvalidManualReferencePrice = errorsmod.Register(
+		ModuleName,
+		46,
+		"ManualReferencePrice must have a positive price",
+	)
+	ErrInvalidConfidencePpm = errorsmod.Register(
+		ModuleName,
+		47,
+		"Confidence must be between 50% and 99.9%",
+	)
+	ErrInvalidPlacementPriority = errorsmod.Register(
+		ModuleName,
+		48,
+		"Invalid placement priority",
+	)
+	ErrInvalidSpreadSchedule = errorsmod.Register(
+		ModuleName,
+		49,
+		"Invalid spread schedule",
+	)
+	ErrVaultInactive = errorsmod.Register(
+		ModuleName,
+		50,
+		"Vault is inactive",
+	)
+	ErrVaultRefreshTooFrequent = errorsmod.Register(
+		ModuleName,
+		51,
+		"Vault order refresh is too frequent",
+	)
+	ErrInvalidActivationThresholdMode = errorsmod.Register(
+		ModuleName,
+		52,
+		"Invalid activation threshold mode",
+	)
+	ErrOwnerShareEpochsDisabled = errorsmod.Register(
+		ModuleName,
+		53,
+		"Owner share epochs are disabled",
+	)
+	ErrOwnerShareEpochExpired = errorsmod.Register(
+		ModuleName,
+		54,
+		"Owner share epoch has expired",
+	)
+	ErrVaultRefreshLocked = errorsmod.Register(
+		ModuleName,
+		55,
+		"Vault is locked by a refresh of its orders in the current block",
+	)
+	ErrInvalidOracleSubticksInputs = errorsmod.Register(
+		ModuleName,
+		56,
+		"Invalid inputs to compute oracle subticks",
+	)
+	ErrInvalidRefreshStrategy = errorsmod.Register(
+		ModuleName,
+		57,
+		"Invalid refresh strategy",
+	)
+	ErrSettleFundingFailed = errorsmod.Register(
+		ModuleName,
+		58,
+		"Failed to settle funding of vault",
+	)
+	ErrInvalidSimulatedSweep = errorsmod.Register(
+		ModuleName,
+		59,
+		"Simulated sweep must be on buy or sell side and of positive size",
+	)
+	ErrInsufficientSweepLiquidity = errorsmod.Register(
+		ModuleName,
+		60,
+		"Vault orders are insufficient to fill simulated sweep",
+	)
+	ErrInvalidMinRefreshIntervalBlocks = errorsmod.Register(
+		ModuleName,
+		61,
+		"MinRefreshIntervalBlocks must be zero or odd",
+	)
 )
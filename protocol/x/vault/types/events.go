@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+const (
+	EventTypeVaultActivated      = "vault_activated"
+	EventTypeVaultDeactivated    = "vault_deactivated"
+	EventTypeVaultLiquidatable   = "vault_liquidatable"
+	EventTypeVaultDeposit        = "vault_deposit"
+	EventTypeVaultRepairShares   = "vault_repair_shares"
+	EventTypeVaultClose          = "vault_close"
+	EventTypeVaultCloseOnly      = "vault_close_only"
+	EventTypeVaultManualPrice    = "vault_manual_price"
+	EventTypeVaultMarketInactive = "vault_market_inactive"
+	EventTypeVaultWatchdog       = "vault_watchdog"
+
+	AttributeKeyVaultType         = "vault_type"
+	AttributeKeyVaultNumber       = "vault_number"
+	AttributeKeyDepositor         = "depositor"
+	AttributeKeyQuoteQuantums     = "quote_quantums"
+	AttributeKeySharesMinted      = "shares_minted"
+	AttributeKeyTotalSharesAfter  = "total_shares_after"
+	AttributeKeyTotalSharesBefore = "total_shares_before"
+	AttributeKeyDestinationOwner  = "destination_owner"
+	AttributeKeyDestinationNumber = "destination_number"
+	AttributeKeyFreeCollateral    = "free_collateral"
+	AttributeKeyPrice             = "price"
+	AttributeKeyExpiryTime        = "expiry_time"
+	AttributeKeyClobPairStatus    = "clob_pair_status"
+	AttributeKeyZeroOrderBlocks   = "zero_order_blocks"
+	AttributeKeyWatchdogLevel     = "watchdog_level"
+)
+
+// NewVaultActivationEvent constructs a vault_activated sdk.Event if `activated` is true
+// and a vault_deactivated sdk.Event otherwise.
+func NewVaultActivationEvent(vaultId VaultId, activated bool) sdk.Event {
+	eventType := EventTypeVaultDeactivated
+	if activated {
+		eventType = EventTypeVaultActivated
+	}
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+	)
+}
+
+// NewVaultLiquidatableEvent constructs a vault_liquidatable sdk.Event, which is emitted
+// when a vault skips placing orders because its subaccount is liquidatable.
+func NewVaultLiquidatableEvent(vaultId VaultId) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultLiquidatable,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+	)
+}
+
+// NewVaultMarketInactiveEvent constructs a vault_market_inactive sdk.Event, which is emitted
+// when a vault skips placing orders because its clob pair has the given non-active `status`.
+func NewVaultMarketInactiveEvent(vaultId VaultId, status clobtypes.ClobPair_Status) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultMarketInactive,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyClobPairStatus, status.String()),
+	)
+}
+
+// NewVaultWatchdogEvent constructs a vault_watchdog sdk.Event, which is emitted when an active
+// vault has placed zero orders for `zeroOrderBlocks` consecutive blocks, where `level` is the
+// number of times that `watchdog_blocks` has elapsed since the vault last placed orders.
+func NewVaultWatchdogEvent(vaultId VaultId, zeroOrderBlocks uint32, level uint32) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultWatchdog,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyZeroOrderBlocks, fmt.Sprint(zeroOrderBlocks)),
+		sdk.NewAttribute(AttributeKeyWatchdogLevel, fmt.Sprint(level)),
+	)
+}
+
+// NewVaultCloseOnlyEvent constructs a vault_close_only sdk.Event, which is emitted when a
+// vault cancels its orders and enters close-only mode because its subaccount has negative
+// `freeCollateral` (in quote quantums).
+func NewVaultCloseOnlyEvent(vaultId VaultId, freeCollateral *big.Int) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultCloseOnly,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyFreeCollateral, freeCollateral.String()),
+	)
+}
+
+// NewVaultManualPriceEvent constructs a vault_manual_price sdk.Event, which is emitted when a
+// vault's orders are centered on its manual reference price instead of the oracle price.
+func NewVaultManualPriceEvent(vaultId VaultId, manualPrice ManualReferencePrice) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultManualPrice,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyPrice, fmt.Sprint(manualPrice.Price)),
+		sdk.NewAttribute(AttributeKeyExpiryTime, fmt.Sprint(manualPrice.ExpiryTime)),
+	)
+}
+
+// NewVaultDepositEvent constructs a vault_deposit sdk.Event, which is emitted when `depositor`
+// deposits `quoteQuantums` to a vault and is minted `sharesMinted` shares.
+func NewVaultDepositEvent(
+	vaultId VaultId,
+	depositor string,
+	quoteQuantums *big.Int,
+	sharesMinted *big.Int,
+	totalSharesAfter *big.Int,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultDeposit,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyDepositor, depositor),
+		sdk.NewAttribute(AttributeKeyQuoteQuantums, quoteQuantums.String()),
+		sdk.NewAttribute(AttributeKeySharesMinted, sharesMinted.String()),
+		sdk.NewAttribute(AttributeKeyTotalSharesAfter, totalSharesAfter.String()),
+	)
+}
+
+// NewVaultRepairSharesEvent constructs a vault_repair_shares sdk.Event, which is emitted when
+// total shares of a vault are recomputed from its owner shares.
+func NewVaultRepairSharesEvent(
+	vaultId VaultId,
+	totalSharesBefore *big.Int,
+	totalSharesAfter *big.Int,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultRepairShares,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyTotalSharesBefore, totalSharesBefore.String()),
+		sdk.NewAttribute(AttributeKeyTotalSharesAfter, totalSharesAfter.String()),
+	)
+}
+
+// NewVaultCloseEvent constructs a vault_close sdk.Event, which is emitted when a vault is
+// closed and `quoteQuantums` of its equity are transferred to `destination`.
+func NewVaultCloseEvent(
+	vaultId VaultId,
+	destination satypes.SubaccountId,
+	quoteQuantums *big.Int,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeVaultClose,
+		sdk.NewAttribute(AttributeKeyVaultType, vaultId.Type.String()),
+		sdk.NewAttribute(AttributeKeyVaultNumber, fmt.Sprint(vaultId.Number)),
+		sdk.NewAttribute(AttributeKeyDestinationOwner, destination.Owner),
+		sdk.NewAttribute(AttributeKeyDestinationNumber, fmt.Sprint(destination.Number)),
+		sdk.NewAttribute(AttributeKeyQuoteQuantums, quoteQuantums.String()),
+	)
+}
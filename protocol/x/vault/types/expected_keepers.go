@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/margin"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -10,6 +11,10 @@ import (
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
+type BlockTimeKeeper interface {
+	GetPreviousBlockInfo(ctx sdk.Context) blocktimetypes.BlockInfo
+}
+
 type ClobKeeper interface {
 	// Clob Pair.
 	GetClobPair(ctx sdk.Context, id clobtypes.ClobPairId) (val clobtypes.ClobPair, found bool)
@@ -29,6 +34,16 @@ type ClobKeeper interface {
 		msg *clobtypes.MsgPlaceOrder,
 		isInternalOrder bool,
 	) (err error)
+
+	// Liquidations.
+	IsLiquidatable(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+	) (bool, error)
+}
+
+type FeeTiersKeeper interface {
+	GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32
 }
 
 type PerpetualsKeeper interface {
@@ -68,4 +83,17 @@ type SubaccountsKeeper interface {
 		ctx sdk.Context,
 		id satypes.SubaccountId,
 	) satypes.Subaccount
+	SetSubaccount(
+		ctx sdk.Context,
+		subaccount satypes.Subaccount,
+	)
+	UpdateSubaccounts(
+		ctx sdk.Context,
+		updates []satypes.Update,
+		updateType satypes.UpdateType,
+	) (
+		success bool,
+		successPerUpdate []satypes.UpdateResult,
+		err error,
+	)
 }
@@ -7,6 +7,9 @@ const (
 
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
+
+	// TransientStoreKey defines the primary module transient store key.
+	TransientStoreKey = "tmp_" + ModuleName
 )
 
 // State.
@@ -23,4 +26,85 @@ const (
 
 	// VaultParamsKeyPrefix is the prefix to retrieve all VaultParams.
 	VaultParamsKeyPrefix = "VaultParams:"
+
+	// LastRefreshBlockHeightKeyPrefix is the prefix to retrieve the block height
+	// at which each vault last refreshed its orders.
+	LastRefreshBlockHeightKeyPrefix = "LastRefreshBlockHeight:"
+
+	// LastRefreshBlockTimeKeyPrefix is the prefix to retrieve the block time (in unix
+	// seconds) at which each vault last refreshed its orders, i.e. placed its resting orders.
+	LastRefreshBlockTimeKeyPrefix = "LastRefreshBlockTime:"
+
+	// LastQuotePriceKeyPrefix is the prefix to retrieve the market price that each vault's
+	// orders were based on when it last refreshed its orders.
+	LastQuotePriceKeyPrefix = "LastQuotePrice:"
+
+	// ActivatedKeyPrefix is the prefix to retrieve whether each vault was active,
+	// i.e. refreshed its orders, in the last block.
+	ActivatedKeyPrefix = "Activated:"
+
+	// FrozenOwnerSharesKeyPrefix is the prefix to retrieve all frozen owner shares.
+	// FrozenOwnerShares store: vaultId VaultId -> owner string -> frozen bool.
+	FrozenOwnerSharesKeyPrefix = "FrozenOwnerShares:"
+
+	// FillStatsKeyPrefix is the prefix to retrieve fill statistics of each vault.
+	FillStatsKeyPrefix = "FillStats:"
+
+	// MarketVolatilityKeyPrefix is the prefix to retrieve realized volatility of each
+	// market that a vault quotes at.
+	MarketVolatilityKeyPrefix = "MarketVolatility:"
+
+	// MarketTwapKeyPrefix is the prefix to retrieve TWAP of each market that a vault marks
+	// its inventory at.
+	MarketTwapKeyPrefix = "MarketTwap:"
+
+	// ActivityLogKeyPrefix is the prefix to retrieve the activity log of each vault.
+	// ActivityLog store: vaultId VaultId -> sequence uint64 -> activity VaultActivity.
+	ActivityLogKeyPrefix = "ActivityLog:"
+
+	// RefreshHistoryKeyPrefix is the prefix to retrieve the most recent order refreshes of
+	// each vault.
+	RefreshHistoryKeyPrefix = "RefreshHistory:"
+
+	// PendingRequoteKeyPrefix is the prefix to retrieve whether each vault has a pending
+	// requote, i.e. had an order filled beyond `requote_fill_threshold_pct_ppm` of its size
+	// since it last refreshed its orders.
+	PendingRequoteKeyPrefix = "PendingRequote:"
+
+	// CloseOnlyKeyPrefix is the prefix to retrieve whether each vault is in close-only
+	// mode, i.e. only quotes the side that reduces its position as its subaccount is
+	// undercollateralized.
+	CloseOnlyKeyPrefix = "CloseOnly:"
+
+	// OwnerCostBasisKeyPrefix is the prefix to retrieve cost basis of owner shares.
+	// OwnerCostBasis store: vaultId VaultId -> owner string -> costBasis OwnerCostBasis.
+	OwnerCostBasisKeyPrefix = "OwnerCostBasis:"
+
+	// OwnerEpochShareWeightKeyPrefix is the prefix to retrieve time-weighted share balance
+	// of owners per epoch.
+	// OwnerEpochShareWeight store: vaultId VaultId -> owner string -> epoch uint32 ->
+	// weight OwnerEpochShareWeight.
+	OwnerEpochShareWeightKeyPrefix = "OwnerEpochShareWeight:"
+
+	// ZeroOrdersSinceBlockKeyPrefix is the prefix to retrieve the block height since which
+	// each vault has placed zero orders at each refresh of its orders.
+	// ZeroOrdersSinceBlock store: vaultId VaultId -> blockHeight uint32.
+	ZeroOrdersSinceBlockKeyPrefix = "ZeroOrdersSinceBlock:"
+
+	// RefreshCursorKey is the key to retrieve the vault from which `RefreshAllVaultOrders`
+	// starts refreshing orders in round-robin order when `max_vault_orders_per_block` is set.
+	RefreshCursorKey = "RefreshCursor"
+
+	// LastOperatorUpdateBlockHeightKey is the key to retrieve the block height at which
+	// the vault operator last updated params.
+	LastOperatorUpdateBlockHeightKey = "LastOperatorUpdateBlockHeight"
+)
+
+// Transient state.
+const (
+	// RefreshLockKeyPrefix is the prefix to retrieve whether each vault was locked by a
+	// refresh of its orders in the current block, which blocks deposits and withdrawals
+	// until the end of that block.
+	// RefreshLock transient store: vaultId VaultId -> locked.
+	RefreshLockKeyPrefix = "RefreshLock:"
 )
@@ -10,6 +10,7 @@ import (
 func TestModuleKeys(t *testing.T) {
 	require.Equal(t, "vault", types.ModuleName)
 	require.Equal(t, "vault", types.StoreKey)
+	require.Equal(t, "tmp_vault", types.TransientStoreKey)
 }
 
 func TestStateKeys(t *testing.T) {
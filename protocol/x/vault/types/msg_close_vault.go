@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.Msg = &MsgCloseVault{}
+
+// ValidateBasic performs stateless validation on a MsgCloseVault.
+func (msg *MsgCloseVault) ValidateBasic() error {
+	// Note: msg signer must be a module authority. This is enforced by the msg server.
+	if err := msg.Destination.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDestination, "destination %v: %v", msg.Destination, err)
+	}
+	if msg.Destination == *msg.VaultId.ToSubaccountId() {
+		return errorsmod.Wrapf(ErrInvalidDestination, "destination %v is the vault itself", msg.Destination)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCloseVault_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgCloseVault
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: constants.Alice_Num0,
+			},
+		},
+		"Failure: invalid destination owner": {
+			msg: types.MsgCloseVault{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Destination: satypes.SubaccountId{
+					Owner:  "invalid_owner",
+					Number: 0,
+				},
+			},
+			expectedErr: types.ErrInvalidDestination.Error(),
+		},
+		"Failure: destination is the vault itself": {
+			msg: types.MsgCloseVault{
+				Authority:   lib.GovModuleAddress.String(),
+				VaultId:     constants.Vault_Clob0,
+				Destination: *constants.Vault_Clob0.ToSubaccountId(),
+			},
+			expectedErr: types.ErrInvalidDestination.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.Msg = &MsgFreezeVaultShares{}
+
+// ValidateBasic performs stateless validation on a MsgFreezeVaultShares.
+func (msg *MsgFreezeVaultShares) ValidateBasic() error {
+	// Note: msg signer must be a module authority. This is enforced by the msg server.
+	if _, err := types.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOwner, "owner '%s': %v", msg.Owner, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFreezeVaultShares_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgFreezeVaultShares
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgFreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     constants.AliceAccAddress.String(),
+			},
+		},
+		"Failure: empty owner": {
+			msg: types.MsgFreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     "",
+			},
+			expectedErr: types.ErrInvalidOwner.Error(),
+		},
+		"Failure: invalid owner": {
+			msg: types.MsgFreezeVaultShares{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob0,
+				Owner:     "invalid_owner",
+			},
+			expectedErr: types.ErrInvalidOwner.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.Msg = &MsgOperatorUpdateVaultParams{}
+
+// ValidateBasic performs stateless validation on a MsgOperatorUpdateVaultParams.
+func (msg *MsgOperatorUpdateVaultParams) ValidateBasic() error {
+	// Note: msg signer must be the operator in params. This is enforced by the msg server.
+	if _, err := types.AccAddressFromBech32(msg.Operator); err != nil {
+		return errorsmod.Wrap(ErrInvalidOperator, err.Error())
+	}
+	if msg.SpreadMinPpm == 0 {
+		return ErrInvalidSpreadMinPpm
+	}
+	if msg.OrderSizePctPpm == 0 {
+		return ErrInvalidOrderSizePctPpm
+	}
+
+	return nil
+}
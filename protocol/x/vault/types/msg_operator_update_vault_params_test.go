@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOperatorUpdateVaultParams_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgOperatorUpdateVaultParams
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 100_000,
+			},
+		},
+		"Failure: invalid operator": {
+			msg: types.MsgOperatorUpdateVaultParams{
+				Operator:        "invalid_operator",
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 100_000,
+			},
+			expectedErr: types.ErrInvalidOperator.Error(),
+		},
+		"Failure: zero spread min ppm": {
+			msg: types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    0,
+				OrderSizePctPpm: 100_000,
+			},
+			expectedErr: types.ErrInvalidSpreadMinPpm.Error(),
+		},
+		"Failure: zero order size pct ppm": {
+			msg: types.MsgOperatorUpdateVaultParams{
+				Operator:        constants.AliceAccAddress.String(),
+				SpreadMinPpm:    5_000,
+				OrderSizePctPpm: 0,
+			},
+			expectedErr: types.ErrInvalidOrderSizePctPpm.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.Msg = &MsgRefreshVaultOrders{}
+
+// ValidateBasic performs stateless validation on a MsgRefreshVaultOrders.
+func (msg *MsgRefreshVaultOrders) ValidateBasic() error {
+	// Note: msg signer must be the operator in params. This is enforced by the msg server.
+	if _, err := types.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(ErrInvalidOperator, err.Error())
+	}
+
+	return nil
+}
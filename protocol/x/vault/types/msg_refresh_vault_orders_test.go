@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRefreshVaultOrders_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgRefreshVaultOrders
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgRefreshVaultOrders{
+				Signer:  constants.AliceAccAddress.String(),
+				VaultId: constants.Vault_Clob0,
+			},
+		},
+		"Failure: invalid signer": {
+			msg: types.MsgRefreshVaultOrders{
+				Signer:  "invalid_signer",
+				VaultId: constants.Vault_Clob0,
+			},
+			expectedErr: types.ErrInvalidOperator.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.Msg = &MsgSetVaultLabel{}
+
+// ValidateBasic performs stateless validation on a MsgSetVaultLabel.
+func (msg *MsgSetVaultLabel) ValidateBasic() error {
+	// Note: msg signer must be the operator in params. This is enforced by the msg server.
+	if _, err := types.AccAddressFromBech32(msg.Operator); err != nil {
+		return errorsmod.Wrap(ErrInvalidOperator, err.Error())
+	}
+	return ValidateVaultLabel(msg.Label)
+}
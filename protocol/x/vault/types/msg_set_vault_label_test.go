@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetVaultLabel_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgSetVaultLabel
+		expectedErr string
+	}{
+		"Success": {
+			msg: types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD Vault",
+			},
+		},
+		"Success: empty label": {
+			msg: types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "",
+			},
+		},
+		"Success: label of max length": {
+			msg: types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    strings.Repeat("a", types.MaxVaultLabelLength),
+			},
+		},
+		"Failure: invalid operator": {
+			msg: types.MsgSetVaultLabel{
+				Operator: "invalid",
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD Vault",
+			},
+			expectedErr: types.ErrInvalidOperator.Error(),
+		},
+		"Failure: label exceeds max length": {
+			msg: types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    strings.Repeat("a", types.MaxVaultLabelLength+1),
+			},
+			expectedErr: "label length 65 exceeds max length 64: Vault label is invalid",
+		},
+		"Failure: label contains newline": {
+			msg: types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD\nVault",
+			},
+			expectedErr: "label must not contain control characters: Vault label is invalid",
+		},
+		"Failure: label contains null character": {
+			msg: types.MsgSetVaultLabel{
+				Operator: constants.AliceAccAddress.String(),
+				VaultId:  constants.Vault_Clob0,
+				Label:    "BTC-USD\x00",
+			},
+			expectedErr: "label must not contain control characters: Vault label is invalid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// ComputeOracleSubticks returns the price of the given market in subticks of the given clob
+// pair, i.e. `price * 10^(price_exponent - quantum_conversion_exponent + atomic_resolution -
+// quote_atomic_resolution)` (see `clobtypes.PriceToSubticks`). Returns an error if market price
+// and market param aren't of the same market or don't have the same exponent, or if the clob
+// pair isn't a perpetual clob pair of the given perpetual.
+func ComputeOracleSubticks(
+	marketPrice pricestypes.MarketPrice,
+	marketParam pricestypes.MarketParam,
+	perpetual perptypes.Perpetual,
+	clobPair clobtypes.ClobPair,
+) (*big.Rat, error) {
+	if marketPrice.Id != marketParam.Id {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidOracleSubticksInputs,
+			"market price of market %d doesn't match market param of market %d",
+			marketPrice.Id,
+			marketParam.Id,
+		)
+	}
+	if marketPrice.Exponent != marketParam.Exponent {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidOracleSubticksInputs,
+			"market price exponent %d doesn't match market param exponent %d",
+			marketPrice.Exponent,
+			marketParam.Exponent,
+		)
+	}
+	perpId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidOracleSubticksInputs, err.Error())
+	}
+	if perpId != perpetual.Params.Id {
+		return nil, errorsmod.Wrapf(
+			ErrInvalidOracleSubticksInputs,
+			"clob pair %d is of perpetual %d instead of perpetual %d",
+			clobPair.Id,
+			perpId,
+			perpetual.Params.Id,
+		)
+	}
+
+	return clobtypes.PriceToSubticks(
+		marketPrice,
+		clobPair,
+		perpetual.Params.AtomicResolution,
+		lib.QuoteCurrencyAtomicResolution,
+	), nil
+}
@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeOracleSubticks(t *testing.T) {
+	perpetualClobPair := func(perpId uint32, quantumConversionExponent int32) clobtypes.ClobPair {
+		return clobtypes.ClobPair{
+			Id: perpId,
+			Metadata: &clobtypes.ClobPair_PerpetualClobMetadata{
+				PerpetualClobMetadata: &clobtypes.PerpetualClobMetadata{
+					PerpetualId: perpId,
+				},
+			},
+			SubticksPerTick:           100,
+			QuantumConversionExponent: quantumConversionExponent,
+		}
+	}
+	perpetual := func(perpId uint32, atomicResolution int32) perptypes.Perpetual {
+		return perptypes.Perpetual{
+			Params: perptypes.PerpetualParams{
+				Id:               perpId,
+				MarketId:         perpId,
+				AtomicResolution: atomicResolution,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		marketPrice pricestypes.MarketPrice
+		marketParam pricestypes.MarketParam
+		perpetual   perptypes.Perpetual
+		clobPair    clobtypes.ClobPair
+
+		expectedSubticks *big.Rat
+		expectedErr      error
+	}{
+		"BTC: $50,000": {
+			// 5_000_000_000 * 10^(-5 - (-8) + (-10) - (-6)) = 5e8
+			marketPrice:      pricestypes.MarketPrice{Id: 0, Exponent: -5, Price: 5_000_000_000},
+			marketParam:      pricestypes.MarketParam{Id: 0, Exponent: -5},
+			perpetual:        perpetual(0, -10),
+			clobPair:         perpetualClobPair(0, -8),
+			expectedSubticks: big.NewRat(500_000_000, 1),
+		},
+		"ETH: $3,000": {
+			// 3_000_000_000 * 10^(-6 - (-9) + (-9) - (-6)) = 3e9
+			marketPrice:      pricestypes.MarketPrice{Id: 1, Exponent: -6, Price: 3_000_000_000},
+			marketParam:      pricestypes.MarketParam{Id: 1, Exponent: -6},
+			perpetual:        perpetual(1, -9),
+			clobPair:         perpetualClobPair(1, -9),
+			expectedSubticks: big.NewRat(3_000_000_000, 1),
+		},
+		"Positive exponent": {
+			// 123 * 10^(-2 - (-9) + (-6) - (-6)) = 1_230_000_000
+			marketPrice:      pricestypes.MarketPrice{Id: 2, Exponent: -2, Price: 123},
+			marketParam:      pricestypes.MarketParam{Id: 2, Exponent: -2},
+			perpetual:        perpetual(2, -6),
+			clobPair:         perpetualClobPair(2, -9),
+			expectedSubticks: big.NewRat(1_230_000_000, 1),
+		},
+		"Negative exponent with fractional subticks": {
+			// 123_456 * 10^(-9 - (-6) + (-5) - (-6)) = 1_234.56
+			marketPrice:      pricestypes.MarketPrice{Id: 3, Exponent: -9, Price: 123_456},
+			marketParam:      pricestypes.MarketParam{Id: 3, Exponent: -9},
+			perpetual:        perpetual(3, -5),
+			clobPair:         perpetualClobPair(3, -6),
+			expectedSubticks: big.NewRat(123_456, 100),
+		},
+		"Zero exponent": {
+			// 7_777 * 10^(-5 - (-5) + (-6) - (-6)) = 7_777
+			marketPrice:      pricestypes.MarketPrice{Id: 4, Exponent: -5, Price: 7_777},
+			marketParam:      pricestypes.MarketParam{Id: 4, Exponent: -5},
+			perpetual:        perpetual(4, -6),
+			clobPair:         perpetualClobPair(4, -5),
+			expectedSubticks: big.NewRat(7_777, 1),
+		},
+		"Error: market price and market param of different markets": {
+			marketPrice: pricestypes.MarketPrice{Id: 0, Exponent: -5, Price: 5_000_000_000},
+			marketParam: pricestypes.MarketParam{Id: 1, Exponent: -5},
+			perpetual:   perpetual(0, -10),
+			clobPair:    perpetualClobPair(0, -8),
+			expectedErr: types.ErrInvalidOracleSubticksInputs,
+		},
+		"Error: market price and market param with different exponents": {
+			marketPrice: pricestypes.MarketPrice{Id: 0, Exponent: -5, Price: 5_000_000_000},
+			marketParam: pricestypes.MarketParam{Id: 0, Exponent: -6},
+			perpetual:   perpetual(0, -10),
+			clobPair:    perpetualClobPair(0, -8),
+			expectedErr: types.ErrInvalidOracleSubticksInputs,
+		},
+		"Error: clob pair of a different perpetual": {
+			marketPrice: pricestypes.MarketPrice{Id: 0, Exponent: -5, Price: 5_000_000_000},
+			marketParam: pricestypes.MarketParam{Id: 0, Exponent: -5},
+			perpetual:   perpetual(0, -10),
+			clobPair:    perpetualClobPair(1, -8),
+			expectedErr: types.ErrInvalidOracleSubticksInputs,
+		},
+		"Error: clob pair isn't a perpetual clob pair": {
+			marketPrice: pricestypes.MarketPrice{Id: 0, Exponent: -5, Price: 5_000_000_000},
+			marketParam: pricestypes.MarketParam{Id: 0, Exponent: -5},
+			perpetual:   perpetual(0, -10),
+			clobPair:    clobtypes.ClobPair{Id: 0, SubticksPerTick: 100, QuantumConversionExponent: -8},
+			expectedErr: types.ErrInvalidOracleSubticksInputs,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subticks, err := types.ComputeOracleSubticks(tc.marketPrice, tc.marketParam, tc.perpetual, tc.clobPair)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Nil(t, subticks)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 0, tc.expectedSubticks.Cmp(subticks), "expected %s, got %s",
+					tc.expectedSubticks.RatString(), subticks.RatString())
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// VaultOrderDiff is the difference between orders that a CLOB vault generates and its
+// resting orders, where a generated order and a resting order correspond to each other if
+// they are at the same side and layer. Generated orders that are the same as their
+// corresponding resting orders aren't part of the diff.
+type VaultOrderDiff struct {
+	// Generated orders that have no corresponding resting order.
+	ToPlace []*clobtypes.Order
+	// Resting orders that have no corresponding generated order.
+	ToCancel []clobtypes.Order
+	// Generated orders that differ from their corresponding resting orders.
+	ToReplace []VaultOrderReplacement
+}
+
+// VaultOrderReplacement is a resting order of a vault and the generated order that replaces it.
+type VaultOrderReplacement struct {
+	Old clobtypes.Order
+	New *clobtypes.Order
+}
+
+// IsEmpty returns whether the diff has no orders to place, cancel, or replace.
+func (d VaultOrderDiff) IsEmpty() bool {
+	return len(d.ToPlace) == 0 && len(d.ToCancel) == 0 && len(d.ToReplace) == 0
+}
@@ -2,20 +2,70 @@ package types
 
 import (
 	"math"
+	"time"
+	"unicode"
 
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 )
 
+// MaxVaultLabelLength is the maximum length (in bytes) of a vault label.
+const MaxVaultLabelLength = 64
+
+// SecondsPerDay is the number of seconds in a day, which bounds spread schedule windows.
+const SecondsPerDay = 24 * 60 * 60
+
 // DefaultParams returns a default set of `x/vault` parameters.
 func DefaultParams() Params {
 	return Params{
-		Layers:                           2,                            // 2 layers
-		SpreadMinPpm:                     10_000,                       // 100 bps
-		SpreadBufferPpm:                  1_500,                        // 15 bps
-		SkewFactorPpm:                    2_000_000,                    // 2
-		OrderSizePctPpm:                  100_000,                      // 10%
-		OrderExpirationSeconds:           2,                            // 2 seconds
-		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000), // 1_000 USDC
+		Layers:                               2,                            // 2 layers
+		SpreadMinPpm:                         10_000,                       // 100 bps
+		SpreadBufferPpm:                      1_500,                        // 15 bps
+		SkewFactorPpm:                        2_000_000,                    // 2
+		OrderSizePctPpm:                      100_000,                      // 10%
+		OrderExpirationSeconds:               2,                            // 2 seconds
+		ActivationThresholdQuoteQuantums:     dtypes.NewInt(1_000_000_000), // 1_000 USDC
+		MinEquityPerLayerQuoteQuantums:       dtypes.NewInt(0),             // disabled
+		SkewEnabled:                          true,
+		MinRefreshIntervalBlocks:             0, // refresh every block
+		MinLotBaseQuantums:                   0, // disabled
+		MaxPositionDeltaPerBlockBaseQuantums: 0, // disabled
+		HardMaxOrderAgeSeconds:               0, // disabled
+		MinTicksFromOraclePerSide:            0, // disabled
+		OrderSizeVolScalePpm:                 0, // disabled
+		SubticksJitterEnabled:                false,
+		SizeProfile:                          SizeProfile_SIZE_PROFILE_FLAT,
+		IncludeFeeFloor:                      false,
+		SoftInventoryBandBaseQuantums:        0, // disabled
+		Operator:                             "",
+		OperatorUpdateIntervalBlocks:         0,
+		OperatorParamBounds:                  OperatorParamBounds{},
+		ActivationInclusive:                  true,
+		RequoteFillThresholdPctPpm:           0, // disabled
+		MaxVaultOrdersPerBlock:               0, // no limit
+		RenewBufferBlocks:                    0, // disabled
+		MinOrderLifetimeSeconds:              0, // disabled
+		InventoryDeadBandBaseQuantums:        0, // disabled
+		RoundToMid:                           false,
+		CrossVaultNetting:                    false,
+		MaxSubticksDeviationPpm:              0, // disabled
+		StrictSubticksDeviation:              false,
+		PlacementPriority:                    PlacementPriority_PLACEMENT_PRIORITY_INNER_FIRST,
+		ActivationThresholdMode:              ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_STATIC,
+		InventorySpreadScalePpm:              0, // disabled
+		SkipUnchangedOrders:                  false,
+		OwnerShareEpochSeconds:               0, // disabled
+		WatchdogBlocks:                       0, // disabled
+		RefreshStrategy:                      RefreshStrategy_REFRESH_STRATEGY_REPLACE,
+		MaxSkewPpm:                           0, // unbounded
+		SkipUnchangedOuterLayers:             false,
+		ClientMetadata:                       0,
+		SettleFundingBeforeShareMath:         false,
+		OperatorRefreshIntervalBlocks:        0, // only limited by block parity
+		ExpirationOracleTicks:                0, // use order expiration seconds
 	}
 }
 
@@ -41,11 +91,209 @@ func (p Params) Validate() error {
 	if p.ActivationThresholdQuoteQuantums.Sign() < 0 {
 		return ErrInvalidActivationThresholdQuoteQuantums
 	}
+	// Min equity per layer quote quantums must be non-negative.
+	if p.MinEquityPerLayerQuoteQuantums.Sign() < 0 {
+		return ErrInvalidMinEquityPerLayerQuoteQuantums
+	}
+	// Min refresh interval blocks must be zero or odd, as refreshes only happen on blocks of
+	// different parity from the last refresh.
+	if p.MinRefreshIntervalBlocks%2 == 0 && p.MinRefreshIntervalBlocks != 0 {
+		return ErrInvalidMinRefreshIntervalBlocks
+	}
+	// Spread multiplier ppm by layer must not be longer than layers and must be positive.
+	if len(p.SpreadMultiplierPpmByLayer) > int(p.Layers) {
+		return ErrInvalidSpreadMultiplierPpmByLayer
+	}
+	for _, multiplierPpm := range p.SpreadMultiplierPpmByLayer {
+		if multiplierPpm == 0 {
+			return ErrInvalidSpreadMultiplierPpmByLayer
+		}
+	}
+	// Size profile must be a known profile.
+	if _, exists := SizeProfile_name[int32(p.SizeProfile)]; !exists {
+		return ErrInvalidSizeProfile
+	}
+	// Placement priority must be a known priority.
+	if _, exists := PlacementPriority_name[int32(p.PlacementPriority)]; !exists {
+		return ErrInvalidPlacementPriority
+	}
+	// Activation threshold mode must be a known mode.
+	if _, exists := ActivationThresholdMode_name[int32(p.ActivationThresholdMode)]; !exists {
+		return ErrInvalidActivationThresholdMode
+	}
+	// Refresh strategy must be a known strategy.
+	if _, exists := RefreshStrategy_name[int32(p.RefreshStrategy)]; !exists {
+		return ErrInvalidRefreshStrategy
+	}
+	// Requote fill threshold must be at most 100%.
+	if p.RequoteFillThresholdPctPpm > lib.OneMillion {
+		return ErrInvalidRequoteFillThreshold
+	}
+	// Max vault orders per block, if set, must fit all orders of at least one vault.
+	if p.MaxVaultOrdersPerBlock != 0 && p.MaxVaultOrdersPerBlock < 2*p.Layers {
+		return ErrInvalidMaxVaultOrdersPerBlock
+	}
+	// Min order lifetime must be within the clob's stateful order time window.
+	if uint64(p.MinOrderLifetimeSeconds) > uint64(clobtypes.StatefulOrderTimeWindow.Seconds()) {
+		return ErrInvalidMinOrderLifetimeSeconds
+	}
+	// Operator, if set, must be a valid address.
+	if p.Operator != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Operator); err != nil {
+			return ErrInvalidOperator
+		}
+	}
 
+	return p.OperatorParamBounds.Validate()
+}
+
+// Validate validates operator param bounds, i.e. that each minimum is at most
+// its corresponding maximum.
+func (b OperatorParamBounds) Validate() error {
+	if b.SpreadMinPpmMin > b.SpreadMinPpmMax || b.OrderSizePctPpmMin > b.OrderSizePctPpmMax {
+		return ErrInvalidOperatorParamBounds
+	}
 	return nil
 }
 
 // Validate validates individual vault parameters.
 func (v VaultParams) Validate() error {
+	// Validate that oracle price range is non-empty if both bounds are set.
+	if v.MinOraclePrice != 0 && v.MaxOraclePrice != 0 && v.MinOraclePrice > v.MaxOraclePrice {
+		return ErrInvalidOraclePriceRange
+	}
+	// Validate that price blend, if set, has positive weights summing to 1_000_000 and
+	// is not combined with a price market override.
+	if len(v.PriceBlend) > 0 {
+		if v.PriceMarketIdOverride != nil {
+			return errorsmod.Wrap(ErrInvalidPriceBlend, "price blend and price market override are both set")
+		}
+		totalWeightPpm := uint64(0)
+		for _, component := range v.PriceBlend {
+			if component.WeightPpm == 0 {
+				return errorsmod.Wrapf(ErrInvalidPriceBlend, "weight of market %d is zero", component.MarketId)
+			}
+			totalWeightPpm += uint64(component.WeightPpm)
+		}
+		if totalWeightPpm != uint64(lib.OneMillion) {
+			return errorsmod.Wrapf(ErrInvalidPriceBlend, "weights sum to %d instead of 1_000_000", totalWeightPpm)
+		}
+	}
+	// Validate that index constituents, if set, are distinct perpetuals with positive
+	// weights summing to 1_000_000.
+	if len(v.IndexConstituents) > 0 {
+		perpIds := make(map[uint32]struct{}, len(v.IndexConstituents))
+		totalWeightPpm := uint64(0)
+		for _, constituent := range v.IndexConstituents {
+			if constituent.WeightPpm == 0 {
+				return errorsmod.Wrapf(
+					ErrInvalidIndexConstituents,
+					"weight of perpetual %d is zero",
+					constituent.PerpetualId,
+				)
+			}
+			if _, exists := perpIds[constituent.PerpetualId]; exists {
+				return errorsmod.Wrapf(
+					ErrInvalidIndexConstituents,
+					"perpetual %d is duplicated",
+					constituent.PerpetualId,
+				)
+			}
+			perpIds[constituent.PerpetualId] = struct{}{}
+			totalWeightPpm += uint64(constituent.WeightPpm)
+		}
+		if totalWeightPpm != uint64(lib.OneMillion) {
+			return errorsmod.Wrapf(
+				ErrInvalidIndexConstituents,
+				"weights sum to %d instead of 1_000_000",
+				totalWeightPpm,
+			)
+		}
+	}
+	// Validate that manual reference price, if set, is positive.
+	if v.ManualReferencePrice != nil && v.ManualReferencePrice.Price == 0 {
+		return ErrInvalidManualReferencePrice
+	}
+	// Validate that each spread schedule window is a non-empty window within a day whose
+	// multiplier doesn't tighten spread.
+	for i, window := range v.SpreadSchedule {
+		if window.StartSeconds >= SecondsPerDay || window.EndSeconds >= SecondsPerDay {
+			return errorsmod.Wrapf(ErrInvalidSpreadSchedule, "window %d is not within a day", i)
+		}
+		if window.StartSeconds == window.EndSeconds {
+			return errorsmod.Wrapf(ErrInvalidSpreadSchedule, "window %d is empty", i)
+		}
+		if window.SpreadMultiplierPpm < lib.OneMillion {
+			return errorsmod.Wrapf(
+				ErrInvalidSpreadSchedule,
+				"multiplier of window %d is %d, less than 1_000_000",
+				i,
+				window.SpreadMultiplierPpm,
+			)
+		}
+	}
+	// Validate that inventory mark source is known.
+	if _, exists := InventoryMarkSource_name[int32(v.InventoryMarkSource)]; !exists {
+		return errorsmod.Wrapf(
+			ErrInvalidInventoryMarkSource,
+			"inventory mark source %d is unknown",
+			v.InventoryMarkSource,
+		)
+	}
+
+	return ValidateVaultLabel(v.Label)
+}
+
+// IsOraclePriceInRange returns whether the given oracle price is within the vault's
+// configured oracle price range. A zero bound is unbounded.
+func (v VaultParams) IsOraclePriceInRange(oraclePrice uint64) bool {
+	if v.MinOraclePrice != 0 && oraclePrice < v.MinOraclePrice {
+		return false
+	}
+	if v.MaxOraclePrice != 0 && oraclePrice > v.MaxOraclePrice {
+		return false
+	}
+	return true
+}
+
+// GetSpreadMultiplierPpm returns the largest spread multiplier (in ppm) of the vault's spread
+// schedule windows that contain the time of day (in UTC) of the given block time, which is
+// 1_000_000 if no window contains it.
+func (v VaultParams) GetSpreadMultiplierPpm(blockTime time.Time) uint32 {
+	utc := blockTime.UTC()
+	secondOfDay := uint32(utc.Hour()*3600 + utc.Minute()*60 + utc.Second())
+	multiplierPpm := lib.OneMillion
+	for _, window := range v.SpreadSchedule {
+		var inWindow bool
+		if window.StartSeconds < window.EndSeconds {
+			inWindow = window.StartSeconds <= secondOfDay && secondOfDay < window.EndSeconds
+		} else {
+			// Window wraps around midnight.
+			inWindow = window.StartSeconds <= secondOfDay || secondOfDay < window.EndSeconds
+		}
+		if inWindow {
+			multiplierPpm = lib.Max(multiplierPpm, window.SpreadMultiplierPpm)
+		}
+	}
+	return multiplierPpm
+}
+
+// ValidateVaultLabel validates a vault label. A label must not exceed `MaxVaultLabelLength`
+// bytes and must not contain control characters.
+func ValidateVaultLabel(label string) error {
+	if len(label) > MaxVaultLabelLength {
+		return errorsmod.Wrapf(
+			ErrInvalidVaultLabel,
+			"label length %d exceeds max length %d",
+			len(label),
+			MaxVaultLabelLength,
+		)
+	}
+	for _, r := range label {
+		if unicode.IsControl(r) {
+			return errorsmod.Wrap(ErrInvalidVaultLabel, "label must not contain control characters")
+		}
+	}
+
 	return nil
 }
@@ -24,6 +24,132 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SizeProfile determines how order size is distributed across a vault's
+// layers.
+type SizeProfile int32
+
+const (
+	// All layers have the same size.
+	SizeProfile_SIZE_PROFILE_FLAT SizeProfile = 0
+	// Inner layers have more size than outer layers.
+	SizeProfile_SIZE_PROFILE_FRONT_LOADED SizeProfile = 1
+	// Outer layers have more size than inner layers.
+	SizeProfile_SIZE_PROFILE_BACK_LOADED SizeProfile = 2
+)
+
+var SizeProfile_name = map[int32]string{
+	0: "SIZE_PROFILE_FLAT",
+	1: "SIZE_PROFILE_FRONT_LOADED",
+	2: "SIZE_PROFILE_BACK_LOADED",
+}
+
+var SizeProfile_value = map[string]int32{
+	"SIZE_PROFILE_FLAT":         0,
+	"SIZE_PROFILE_FRONT_LOADED": 1,
+	"SIZE_PROFILE_BACK_LOADED":  2,
+}
+
+func (x SizeProfile) String() string {
+	return proto.EnumName(SizeProfile_name, int32(x))
+}
+
+func (SizeProfile) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{0}
+}
+
+// PlacementPriority determines the order in which a vault places orders of
+// its layers, which matters when `max_vault_orders_per_block` caps the number
+// of orders that a vault places.
+type PlacementPriority int32
+
+const (
+	// Inner layers are placed before outer layers.
+	PlacementPriority_PLACEMENT_PRIORITY_INNER_FIRST PlacementPriority = 0
+	// Outer layers are placed before inner layers.
+	PlacementPriority_PLACEMENT_PRIORITY_OUTER_FIRST PlacementPriority = 1
+)
+
+var PlacementPriority_name = map[int32]string{
+	0: "PLACEMENT_PRIORITY_INNER_FIRST",
+	1: "PLACEMENT_PRIORITY_OUTER_FIRST",
+}
+
+var PlacementPriority_value = map[string]int32{
+	"PLACEMENT_PRIORITY_INNER_FIRST": 0,
+	"PLACEMENT_PRIORITY_OUTER_FIRST": 1,
+}
+
+func (x PlacementPriority) String() string {
+	return proto.EnumName(PlacementPriority_name, int32(x))
+}
+
+func (PlacementPriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{1}
+}
+
+// ActivationThresholdMode determines how the quote quantums that a vault with
+// no perpetual positions must have to activate are computed.
+type ActivationThresholdMode int32
+
+const (
+	// The threshold is `activation_threshold_quote_quantums`.
+	ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_STATIC ActivationThresholdMode = 0
+	// The threshold is a vault's minimum order notional times `layers`, i.e.
+	// the quote quantums needed to fund a full book. Minimum order notional is
+	// the notional of the smallest order that a vault can place at oracle price.
+	ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_DYNAMIC ActivationThresholdMode = 1
+)
+
+var ActivationThresholdMode_name = map[int32]string{
+	0: "ACTIVATION_THRESHOLD_MODE_STATIC",
+	1: "ACTIVATION_THRESHOLD_MODE_DYNAMIC",
+}
+
+var ActivationThresholdMode_value = map[string]int32{
+	"ACTIVATION_THRESHOLD_MODE_STATIC":  0,
+	"ACTIVATION_THRESHOLD_MODE_DYNAMIC": 1,
+}
+
+func (x ActivationThresholdMode) String() string {
+	return proto.EnumName(ActivationThresholdMode_name, int32(x))
+}
+
+func (ActivationThresholdMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{2}
+}
+
+// RefreshStrategy determines how a vault replaces its resting orders with new
+// orders when refreshing its orders.
+type RefreshStrategy int32
+
+const (
+	// Each new order replaces the resting order of the same side and layer, for
+	// which a single long-term order replacement indexer event is sent.
+	RefreshStrategy_REFRESH_STRATEGY_REPLACE RefreshStrategy = 0
+	// Resting orders are cancelled before new orders are placed, for which an
+	// order removal indexer event is sent for each cancelled order followed by
+	// an order placement indexer event for each placed order.
+	RefreshStrategy_REFRESH_STRATEGY_CANCEL_THEN_PLACE RefreshStrategy = 1
+)
+
+var RefreshStrategy_name = map[int32]string{
+	0: "REFRESH_STRATEGY_REPLACE",
+	1: "REFRESH_STRATEGY_CANCEL_THEN_PLACE",
+}
+
+var RefreshStrategy_value = map[string]int32{
+	"REFRESH_STRATEGY_REPLACE":           0,
+	"REFRESH_STRATEGY_CANCEL_THEN_PLACE": 1,
+}
+
+func (x RefreshStrategy) String() string {
+	return proto.EnumName(RefreshStrategy_name, int32(x))
+}
+
+func (RefreshStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{3}
+}
+
 // Params stores `x/vault` parameters.
 type Params struct {
 	// The number of layers of orders a vault places. For example if
@@ -44,8 +170,189 @@ type Params struct {
 	// The number of quote quantums in quote asset that a vault with no perpetual
 	// positions must have to activate, i.e. if a vault has no perpetual positions
 	// and has strictly less than this amount of quote asset, it will not
-	// activate.
+	// activate. Whether a vault with exactly this amount activates depends on
+	// `activation_inclusive`. Only used if `activation_threshold_mode` is static.
 	ActivationThresholdQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,7,opt,name=activation_threshold_quote_quantums,json=activationThresholdQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"activation_threshold_quote_quantums"`
+	// The minimum amount of equity (in quote quantums) that a vault must have
+	// for each layer of orders it places. If a vault's equity can't fund all
+	// `layers`, it places fewer layers (inner layers first) but always at least
+	// one. A value of zero disables this reduction.
+	MinEquityPerLayerQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,8,opt,name=min_equity_per_layer_quote_quantums,json=minEquityPerLayerQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"min_equity_per_layer_quote_quantums"`
+	// Whether a vault skews its orders based on its inventory. If false, a
+	// vault's bids and asks are symmetric around the oracle price regardless of
+	// its inventory.
+	SkewEnabled bool `protobuf:"varint,9,opt,name=skew_enabled,json=skewEnabled,proto3" json:"skew_enabled,omitempty"`
+	// The minimum number of blocks between two consecutive order refreshes of a
+	// vault. Since client IDs of vault orders alternate between even and odd
+	// blocks, a refresh only happens on a block of different parity from the
+	// vault's last refresh, so this must be zero or odd. A value of zero or one
+	// refreshes every block.
+	MinRefreshIntervalBlocks uint32 `protobuf:"varint,11,opt,name=min_refresh_interval_blocks,json=minRefreshIntervalBlocks,proto3" json:"min_refresh_interval_blocks,omitempty"`
+	// The minimum size (in base quantums) of an order that a vault places. If
+	// order size is positive but rounds down to zero, it is rounded up to this
+	// size (and then up to a multiple of step base quantums) instead, in which
+	// case a vault places only as many layers as its equity can fund. A value of
+	// zero disables this rounding up.
+	MinLotBaseQuantums uint64 `protobuf:"varint,12,opt,name=min_lot_base_quantums,json=minLotBaseQuantums,proto3" json:"min_lot_base_quantums,omitempty"`
+	// The spread multiplier (in ppm) of each layer. If non-empty, the i-th
+	// layer is placed `spread * spread_multiplier_ppm_by_layer[i] / 1_000_000`
+	// away from the oracle price (before skew) instead of `spread * (i+1)`.
+	// Layers beyond the length of this list use its last multiplier. Length of
+	// this list must not exceed `layers`.
+	SpreadMultiplierPpmByLayer []uint32 `protobuf:"varint,13,rep,packed,name=spread_multiplier_ppm_by_layer,json=spreadMultiplierPpmByLayer,proto3" json:"spread_multiplier_ppm_by_layer,omitempty"`
+	// The maximum change (in base quantums) in a vault's inventory if all orders
+	// on one side that increase the vault's exposure or flip its position were
+	// filled in a block. Sizes of such orders are scaled down proportionally to
+	// respect this limit. A value of zero disables this limit.
+	MaxPositionDeltaPerBlockBaseQuantums uint64 `protobuf:"varint,14,opt,name=max_position_delta_per_block_base_quantums,json=maxPositionDeltaPerBlockBaseQuantums,proto3" json:"max_position_delta_per_block_base_quantums,omitempty"`
+	// The maximum age (in seconds) of a vault order, after which the order is
+	// cancelled regardless of whether the vault refreshes its orders. A value of
+	// zero disables this limit.
+	HardMaxOrderAgeSeconds uint32 `protobuf:"varint,15,opt,name=hard_max_order_age_seconds,json=hardMaxOrderAgeSeconds,proto3" json:"hard_max_order_age_seconds,omitempty"`
+	// The minimum number of ticks that a vault's orders must be away from oracle
+	// price on each side, i.e. bids are at most `oracle - n * tick` and asks are
+	// at least `oracle + n * tick`. A value of zero disables this floor.
+	MinTicksFromOraclePerSide uint32 `protobuf:"varint,16,opt,name=min_ticks_from_oracle_per_side,json=minTicksFromOraclePerSide,proto3" json:"min_ticks_from_oracle_per_side,omitempty"`
+	// How strongly (in ppm) order sizes shrink as volatility of a vault's market
+	// rises. Order sizes are scaled by
+	// `1 / (1 + order_size_vol_scale_ppm * volatility_ppm / 1_000_000^2)`, where
+	// `volatility_ppm` is the EWMA of absolute per-block oracle price returns of
+	// the market. A value of zero disables this scaling.
+	OrderSizeVolScalePpm uint32 `protobuf:"varint,17,opt,name=order_size_vol_scale_ppm,json=orderSizeVolScalePpm,proto3" json:"order_size_vol_scale_ppm,omitempty"`
+	// Whether to move orders of some vaults one tick away from oracle price, as
+	// deterministically derived from each vault's ID, so that vaults with
+	// identical params don't all quote at the same price levels.
+	SubticksJitterEnabled bool `protobuf:"varint,18,opt,name=subticks_jitter_enabled,json=subticksJitterEnabled,proto3" json:"subticks_jitter_enabled,omitempty"`
+	// How order size is distributed across layers. Size of each layer is order
+	// size weighted by the profile such that the average weight across layers
+	// is one.
+	SizeProfile SizeProfile `protobuf:"varint,19,opt,name=size_profile,json=sizeProfile,proto3,enum=dydxprotocol.vault.SizeProfile" json:"size_profile,omitempty"`
+	// Whether spread is at least the round-trip fee of vaults, i.e. sum of taker
+	// and maker fee ppm of a vault's fee tier, so that quotes are profitable
+	// after fees.
+	IncludeFeeFloor bool `protobuf:"varint,20,opt,name=include_fee_floor,json=includeFeeFloor,proto3" json:"include_fee_floor,omitempty"`
+	// The absolute inventory (in base quantums) beyond which sizes of orders on
+	// the side that increases a vault's exposure are scaled down by
+	// `soft_inventory_band_base_quantums / |inventory|`, i.e. the further
+	// inventory is beyond the band the smaller the orders (halved at twice the
+	// band), to slow down accumulation before hitting hard limits. Scaled sizes
+	// are at least step size so that the side isn't suppressed. A value of zero
+	// disables this band.
+	SoftInventoryBandBaseQuantums uint64 `protobuf:"varint,21,opt,name=soft_inventory_band_base_quantums,json=softInventoryBandBaseQuantums,proto3" json:"soft_inventory_band_base_quantums,omitempty"`
+	// Address of the vault operator, who can update a whitelisted subset of
+	// params within `operator_param_bounds` between gov proposals. Empty if
+	// there is no operator.
+	Operator string `protobuf:"bytes,22,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The minimum number of blocks between two param updates by the operator.
+	OperatorUpdateIntervalBlocks uint32 `protobuf:"varint,23,opt,name=operator_update_interval_blocks,json=operatorUpdateIntervalBlocks,proto3" json:"operator_update_interval_blocks,omitempty"`
+	// The bounds within which the operator can update params.
+	OperatorParamBounds OperatorParamBounds `protobuf:"bytes,24,opt,name=operator_param_bounds,json=operatorParamBounds,proto3" json:"operator_param_bounds"`
+	// Whether a vault with no perpetual positions and exactly
+	// `activation_threshold_quote_quantums` of quote asset activates. If true,
+	// a vault activates at the threshold (`>=`). If false, a vault must have
+	// strictly more than the threshold (`>`) to activate.
+	ActivationInclusive bool `protobuf:"varint,25,opt,name=activation_inclusive,json=activationInclusive,proto3" json:"activation_inclusive,omitempty"`
+	// Fraction (in parts-per-million) of a vault order's size that a single fill
+	// must exceed to requote the vault within the same block, bypassing
+	// `min_refresh_interval_blocks`. Zero disables requoting on fills.
+	RequoteFillThresholdPctPpm uint32 `protobuf:"varint,26,opt,name=requote_fill_threshold_pct_ppm,json=requoteFillThresholdPctPpm,proto3" json:"requote_fill_threshold_pct_ppm,omitempty"`
+	// The maximum number of orders that all vaults place in a block, which bounds
+	// block size. Once this is reached, the last refreshed vault only places some
+	// of its orders in order of `placement_priority`, and it and remaining vaults
+	// are deferred to later blocks in round-robin order. Zero means no limit.
+	MaxVaultOrdersPerBlock uint32 `protobuf:"varint,27,opt,name=max_vault_orders_per_block,json=maxVaultOrdersPerBlock,proto3" json:"max_vault_orders_per_block,omitempty"`
+	// The number of blocks before a vault's resting orders expire within which
+	// the vault replaces them, bypassing `min_refresh_interval_blocks`. Block
+	// duration is estimated as the duration of the last block. Since refreshes
+	// only happen on blocks of different parity from the last refresh, this
+	// should be at least two. Zero disables early renewal.
+	RenewBufferBlocks uint32 `protobuf:"varint,28,opt,name=renew_buffer_blocks,json=renewBufferBlocks,proto3" json:"renew_buffer_blocks,omitempty"`
+	// Minimum lifetime (in seconds) of vault orders. Good-til-block-time of a vault
+	// order is raised to at least current block time plus this, such that orders
+	// don't expire within the block of placement under clock skew. Must be at most
+	// the clob's stateful order time window. Zero disables the floor.
+	MinOrderLifetimeSeconds uint32 `protobuf:"varint,29,opt,name=min_order_lifetime_seconds,json=minOrderLifetimeSeconds,proto3" json:"min_order_lifetime_seconds,omitempty"`
+	// The absolute inventory (in base quantums) within which a vault's inventory
+	// is treated as zero when computing skew, such that small inventory
+	// fluctuations near flat don't reprice quotes. Beyond the band, skew is
+	// computed from inventory measured from the edge of the band. Doesn't apply
+	// to vaults with index constituents. A value of zero disables this band.
+	InventoryDeadBandBaseQuantums uint64 `protobuf:"varint,30,opt,name=inventory_dead_band_base_quantums,json=inventoryDeadBandBaseQuantums,proto3" json:"inventory_dead_band_base_quantums,omitempty"`
+	// Whether to round order subticks to a multiple of subticks per tick towards
+	// oracle price (asks down and bids up) instead of away from it, as long as
+	// orders stay on their side of oracle price, so that quotes are slightly
+	// tighter.
+	RoundToMid bool `protobuf:"varint,31,opt,name=round_to_mid,json=roundToMid,proto3" json:"round_to_mid,omitempty"`
+	// Whether to net exposure of vaults that quote at the same price market. If
+	// true, a vault whose position is fully offset by opposing positions of other
+	// such vaults doesn't place orders that reduce its position, as those orders
+	// would increase net exposure of the vaults.
+	CrossVaultNetting bool `protobuf:"varint,32,opt,name=cross_vault_netting,json=crossVaultNetting,proto3" json:"cross_vault_netting,omitempty"`
+	// Maximum deviation (in ppm) of an order's subticks from oracle price, beyond
+	// which a warning is logged and telemetry is emitted, as such an order likely
+	// indicates a misconfiguration. 0 means disabled.
+	MaxSubticksDeviationPpm uint32 `protobuf:"varint,33,opt,name=max_subticks_deviation_ppm,json=maxSubticksDeviationPpm,proto3" json:"max_subticks_deviation_ppm,omitempty"`
+	// Whether orders whose subticks deviate from oracle price by more than
+	// `max_subticks_deviation_ppm` are not placed.
+	StrictSubticksDeviation bool `protobuf:"varint,34,opt,name=strict_subticks_deviation,json=strictSubticksDeviation,proto3" json:"strict_subticks_deviation,omitempty"`
+	// The order in which a vault places orders of its layers.
+	PlacementPriority PlacementPriority `protobuf:"varint,35,opt,name=placement_priority,json=placementPriority,proto3,enum=dydxprotocol.vault.PlacementPriority" json:"placement_priority,omitempty"`
+	// How the activation threshold of a vault is computed.
+	ActivationThresholdMode ActivationThresholdMode `protobuf:"varint,36,opt,name=activation_threshold_mode,json=activationThresholdMode,proto3,enum=dydxprotocol.vault.ActivationThresholdMode" json:"activation_threshold_mode,omitempty"`
+	// The factor (in ppm) by which a vault widens its spread as its inventory
+	// grows, i.e. `spread = spread * (1 + |leverage| * inventory_spread_scale)`.
+	// Unlike skew, which shifts orders of both sides in the same direction, this
+	// widens the spread on both sides so that an exposed vault charges more for
+	// liquidity. A value of zero disables this scaling.
+	InventorySpreadScalePpm uint32 `protobuf:"varint,37,opt,name=inventory_spread_scale_ppm,json=inventorySpreadScalePpm,proto3" json:"inventory_spread_scale_ppm,omitempty"`
+	// Whether a vault skips refreshing its orders if orders to place are the
+	// same as its resting orders, i.e. have the same side, size, and subticks at
+	// each side and layer, and none of its resting orders expires within
+	// `max(renew_buffer_blocks, 1)` blocks, which saves cancelling and placing
+	// the same orders.
+	SkipUnchangedOrders bool `protobuf:"varint,38,opt,name=skip_unchanged_orders,json=skipUnchangedOrders,proto3" json:"skip_unchanged_orders,omitempty"`
+	// The duration (in seconds) of an epoch over which time-weighted share
+	// balance of each owner is tracked, where epoch `e` starts at unix time
+	// `e * owner_share_epoch_seconds`. Changing this value invalidates tracked
+	// weights of past epochs. A value of zero disables tracking.
+	OwnerShareEpochSeconds uint32 `protobuf:"varint,39,opt,name=owner_share_epoch_seconds,json=ownerShareEpochSeconds,proto3" json:"owner_share_epoch_seconds,omitempty"`
+	// The number of consecutive blocks for which an active vault places zero
+	// orders when refreshing its orders before a vault_watchdog event is
+	// emitted, which is emitted again at each such refresh with an escalating
+	// level until the vault places orders again. A value of zero disables the
+	// watchdog.
+	WatchdogBlocks uint32 `protobuf:"varint,40,opt,name=watchdog_blocks,json=watchdogBlocks,proto3" json:"watchdog_blocks,omitempty"`
+	// How a vault replaces its resting orders when refreshing its orders.
+	RefreshStrategy RefreshStrategy `protobuf:"varint,41,opt,name=refresh_strategy,json=refreshStrategy,proto3,enum=dydxprotocol.vault.RefreshStrategy" json:"refresh_strategy,omitempty"`
+	// The maximum magnitude (in ppm) of skew of each layer, i.e. skew is clamped
+	// to `[-max_skew_ppm, max_skew_ppm]`, which bounds how far a highly
+	// leveraged vault shifts its quotes. A value of zero means skew is unbounded.
+	MaxSkewPpm uint32 `protobuf:"varint,42,opt,name=max_skew_ppm,json=maxSkewPpm,proto3" json:"max_skew_ppm,omitempty"`
+	// Whether a vault keeps its resting orders of outer layers (all layers but
+	// layer 0) that are the same as the orders it would place, instead of
+	// replacing them, which reduces churn of a vault's book when prices haven't
+	// moved. A kept order is still replaced once it's due for renewal.
+	SkipUnchangedOuterLayers bool `protobuf:"varint,43,opt,name=skip_unchanged_outer_layers,json=skipUnchangedOuterLayers,proto3" json:"skip_unchanged_outer_layers,omitempty"`
+	// Client metadata that is set on all vault orders, which allows fills of
+	// vault orders to be attributed to a version of the vault strategy.
+	ClientMetadata uint32 `protobuf:"varint,44,opt,name=client_metadata,json=clientMetadata,proto3" json:"client_metadata,omitempty"`
+	// Whether pending funding of a vault is settled into its subaccount before
+	// shares of a deposit are computed. Vault equity already accounts for pending
+	// funding, so share math is the same either way, but settling first persists
+	// funding owed to or by the vault before its shares change.
+	SettleFundingBeforeShareMath bool `protobuf:"varint,45,opt,name=settle_funding_before_share_math,json=settleFundingBeforeShareMath,proto3" json:"settle_funding_before_share_math,omitempty"`
+	// Minimum number of blocks between a vault's last refresh and a refresh of
+	// its orders triggered by the vault operator (via `MsgRefreshVaultOrders`),
+	// which limits how often the operator can refresh a vault's orders. This is a
+	// single interval that applies to every vault. A value of zero means operator
+	// refreshes are only limited to blocks with a different parity from the block
+	// of last refresh.
+	OperatorRefreshIntervalBlocks uint32 `protobuf:"varint,46,opt,name=operator_refresh_interval_blocks,json=operatorRefreshIntervalBlocks,proto3" json:"operator_refresh_interval_blocks,omitempty"`
+	// Number of update intervals of a vault's price market after which vault
+	// orders expire, such that orders don't outlive a few oracle updates. If zero
+	// or if the market has no `update_interval_seconds`, orders expire after
+	// `order_expiration_seconds` instead.
+	ExpirationOracleTicks uint32 `protobuf:"varint,47,opt,name=expiration_oracle_ticks,json=expirationOracleTicks,proto3" json:"expiration_oracle_ticks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -123,222 +430,1872 @@ func (m *Params) GetOrderExpirationSeconds() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "dydxprotocol.vault.Params")
+func (m *Params) GetSkewEnabled() bool {
+	if m != nil {
+		return m.SkewEnabled
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("dydxprotocol/vault/params.proto", fileDescriptor_6043e0b8bfdbca9f) }
+func (m *Params) GetMinRefreshIntervalBlocks() uint32 {
+	if m != nil {
+		return m.MinRefreshIntervalBlocks
+	}
+	return 0
+}
 
-var fileDescriptor_6043e0b8bfdbca9f = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0x13, 0x0a, 0x41, 0xb2, 0x5a, 0x2a, 0x22, 0x54, 0x45, 0x0c, 0x69, 0x05, 0x08, 0x55,
-	0x20, 0x92, 0x01, 0x24, 0x18, 0x51, 0x25, 0x10, 0x0c, 0x48, 0xfd, 0xc3, 0xc4, 0x62, 0x39, 0x8e,
-	0xdb, 0x58, 0x24, 0xb1, 0x6b, 0x3b, 0xa5, 0xed, 0xa7, 0x60, 0x63, 0xe0, 0x0b, 0x75, 0xec, 0x88,
-	0x18, 0xaa, 0x53, 0xfb, 0x45, 0x4e, 0x79, 0x9d, 0xeb, 0xdd, 0x6d, 0xb7, 0xd9, 0xbf, 0xe7, 0xf7,
-	0xfa, 0xb1, 0x64, 0xa3, 0x7e, 0xba, 0x49, 0xd7, 0x52, 0x09, 0x23, 0xa8, 0xc8, 0xe3, 0x15, 0xa9,
-	0x72, 0x13, 0x4b, 0xa2, 0x48, 0xa1, 0x23, 0xa0, 0xbe, 0x7f, 0x53, 0x88, 0x40, 0x78, 0xfa, 0x64,
-	0x21, 0x16, 0x02, 0x58, 0x5c, 0xaf, 0xac, 0xf9, 0xec, 0x6f, 0x0b, 0x79, 0x63, 0x18, 0xf5, 0x7b,
-	0xc8, 0xcb, 0xc9, 0x86, 0x29, 0x1d, 0xb8, 0x03, 0x77, 0xd8, 0x99, 0x36, 0x3b, 0xff, 0x05, 0x7a,
-	0xa4, 0xa5, 0x62, 0x24, 0xc5, 0x05, 0x2f, 0xb1, 0x94, 0x45, 0x70, 0x0f, 0xf2, 0xb6, 0xa5, 0xdf,
-	0x78, 0x39, 0x96, 0x85, 0xff, 0x0a, 0x3d, 0x6e, 0xac, 0xa4, 0x9a, 0xcf, 0x99, 0x02, 0xb1, 0x05,
-	0x62, 0xd7, 0x06, 0x23, 0xe0, 0xb5, 0xfb, 0x12, 0x75, 0xf5, 0x4f, 0xf6, 0x0b, 0xcf, 0x09, 0x35,
-	0xc2, 0x9a, 0xf7, 0xc1, 0xec, 0xd4, 0xf8, 0x33, 0xd0, 0xda, 0x7b, 0x8d, 0x7c, 0xa1, 0x52, 0xa6,
-	0xb0, 0xe6, 0x5b, 0x86, 0x25, 0x35, 0xa0, 0x3e, 0xb0, 0x87, 0x42, 0x32, 0xe3, 0x5b, 0x36, 0xa6,
-	0xa6, 0x96, 0x3f, 0xa0, 0xc0, 0xca, 0x6c, 0x2d, 0xb9, 0x22, 0x86, 0x8b, 0x12, 0x6b, 0x46, 0x45,
-	0x99, 0xea, 0xc0, 0x83, 0x91, 0x1e, 0xe4, 0x9f, 0xce, 0xf1, 0xcc, 0xa6, 0xfe, 0x1f, 0x17, 0x3d,
-	0x27, 0xd4, 0xf0, 0x95, 0x1d, 0x32, 0x99, 0x62, 0x3a, 0x13, 0x79, 0x8a, 0x97, 0x95, 0x30, 0x0c,
-	0x2f, 0x2b, 0x52, 0x9a, 0xaa, 0xd0, 0xc1, 0xc3, 0x81, 0x3b, 0x6c, 0x8f, 0xbe, 0xec, 0x0e, 0x7d,
-	0xe7, 0xff, 0xa1, 0xff, 0x71, 0xc1, 0x4d, 0x56, 0x25, 0x11, 0x15, 0x45, 0x7c, 0xfb, 0x3d, 0xde,
-	0xbd, 0xa1, 0x19, 0xe1, 0x65, 0x7c, 0x26, 0xa9, 0xd9, 0x48, 0xa6, 0xa3, 0x19, 0x53, 0x9c, 0xe4,
-	0x7c, 0x4b, 0x92, 0x9c, 0x7d, 0x2d, 0xcd, 0x74, 0x70, 0x5d, 0xfa, 0xfd, 0xaa, 0x73, 0x52, 0x57,
-	0x4e, 0x9a, 0xc6, 0xd1, 0x64, 0x77, 0x0c, 0xdd, 0xfd, 0x31, 0x74, 0x2f, 0x8e, 0xa1, 0xfb, 0xfb,
-	0x14, 0x3a, 0xfb, 0x53, 0xe8, 0xfc, 0x3b, 0x85, 0xce, 0x8f, 0xf7, 0x77, 0x6f, 0x5f, 0x37, 0x3f,
-	0x04, 0x2e, 0x91, 0x78, 0xc0, 0xdf, 0x5e, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x00, 0xa7, 0x50,
-	0x44, 0x02, 0x00, 0x00,
+func (m *Params) GetMinLotBaseQuantums() uint64 {
+	if m != nil {
+		return m.MinLotBaseQuantums
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetSpreadMultiplierPpmByLayer() []uint32 {
+	if m != nil {
+		return m.SpreadMultiplierPpmByLayer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMaxPositionDeltaPerBlockBaseQuantums() uint64 {
+	if m != nil {
+		return m.MaxPositionDeltaPerBlockBaseQuantums
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ActivationThresholdQuoteQuantums.Size()
-		i -= size
-		if _, err := m.ActivationThresholdQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+func (m *Params) GetHardMaxOrderAgeSeconds() uint32 {
+	if m != nil {
+		return m.HardMaxOrderAgeSeconds
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.OrderExpirationSeconds != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.OrderExpirationSeconds))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *Params) GetMinTicksFromOraclePerSide() uint32 {
+	if m != nil {
+		return m.MinTicksFromOraclePerSide
 	}
-	if m.OrderSizePctPpm != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.OrderSizePctPpm))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *Params) GetOrderSizeVolScalePpm() uint32 {
+	if m != nil {
+		return m.OrderSizeVolScalePpm
 	}
-	if m.SkewFactorPpm != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.SkewFactorPpm))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *Params) GetSubticksJitterEnabled() bool {
+	if m != nil {
+		return m.SubticksJitterEnabled
 	}
-	if m.SpreadBufferPpm != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.SpreadBufferPpm))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *Params) GetSizeProfile() SizeProfile {
+	if m != nil {
+		return m.SizeProfile
 	}
-	if m.SpreadMinPpm != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.SpreadMinPpm))
-		i--
-		dAtA[i] = 0x10
+	return SizeProfile_SIZE_PROFILE_FLAT
+}
+
+func (m *Params) GetIncludeFeeFloor() bool {
+	if m != nil {
+		return m.IncludeFeeFloor
 	}
-	if m.Layers != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.Layers))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *Params) GetSoftInventoryBandBaseQuantums() uint64 {
+	if m != nil {
+		return m.SoftInventoryBandBaseQuantums
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetOperatorUpdateIntervalBlocks() uint32 {
+	if m != nil {
+		return m.OperatorUpdateIntervalBlocks
 	}
-	var l int
-	_ = l
-	if m.Layers != 0 {
-		n += 1 + sovParams(uint64(m.Layers))
+	return 0
+}
+
+func (m *Params) GetOperatorParamBounds() OperatorParamBounds {
+	if m != nil {
+		return m.OperatorParamBounds
 	}
-	if m.SpreadMinPpm != 0 {
-		n += 1 + sovParams(uint64(m.SpreadMinPpm))
+	return OperatorParamBounds{}
+}
+
+func (m *Params) GetActivationInclusive() bool {
+	if m != nil {
+		return m.ActivationInclusive
 	}
-	if m.SpreadBufferPpm != 0 {
-		n += 1 + sovParams(uint64(m.SpreadBufferPpm))
+	return false
+}
+
+func (m *Params) GetRequoteFillThresholdPctPpm() uint32 {
+	if m != nil {
+		return m.RequoteFillThresholdPctPpm
 	}
-	if m.SkewFactorPpm != 0 {
-		n += 1 + sovParams(uint64(m.SkewFactorPpm))
+	return 0
+}
+
+func (m *Params) GetMaxVaultOrdersPerBlock() uint32 {
+	if m != nil {
+		return m.MaxVaultOrdersPerBlock
 	}
-	if m.OrderSizePctPpm != 0 {
-		n += 1 + sovParams(uint64(m.OrderSizePctPpm))
+	return 0
+}
+
+func (m *Params) GetRenewBufferBlocks() uint32 {
+	if m != nil {
+		return m.RenewBufferBlocks
 	}
-	if m.OrderExpirationSeconds != 0 {
-		n += 1 + sovParams(uint64(m.OrderExpirationSeconds))
+	return 0
+}
+
+func (m *Params) GetMinOrderLifetimeSeconds() uint32 {
+	if m != nil {
+		return m.MinOrderLifetimeSeconds
 	}
-	l = m.ActivationThresholdQuoteQuantums.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	return 0
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Params) GetInventoryDeadBandBaseQuantums() uint64 {
+	if m != nil {
+		return m.InventoryDeadBandBaseQuantums
+	}
+	return 0
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Params) GetRoundToMid() bool {
+	if m != nil {
+		return m.RoundToMid
+	}
+	return false
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layers", wireType)
-			}
-			m.Layers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Layers |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMinPpm", wireType)
-			}
-			m.SpreadMinPpm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
+
+func (m *Params) GetCrossVaultNetting() bool {
+	if m != nil {
+		return m.CrossVaultNetting
+	}
+	return false
+}
+
+func (m *Params) GetMaxSubticksDeviationPpm() uint32 {
+	if m != nil {
+		return m.MaxSubticksDeviationPpm
+	}
+	return 0
+}
+
+func (m *Params) GetStrictSubticksDeviation() bool {
+	if m != nil {
+		return m.StrictSubticksDeviation
+	}
+	return false
+}
+
+func (m *Params) GetPlacementPriority() PlacementPriority {
+	if m != nil {
+		return m.PlacementPriority
+	}
+	return PlacementPriority_PLACEMENT_PRIORITY_INNER_FIRST
+}
+
+func (m *Params) GetActivationThresholdMode() ActivationThresholdMode {
+	if m != nil {
+		return m.ActivationThresholdMode
+	}
+	return ActivationThresholdMode_ACTIVATION_THRESHOLD_MODE_STATIC
+}
+
+func (m *Params) GetInventorySpreadScalePpm() uint32 {
+	if m != nil {
+		return m.InventorySpreadScalePpm
+	}
+	return 0
+}
+
+func (m *Params) GetSkipUnchangedOrders() bool {
+	if m != nil {
+		return m.SkipUnchangedOrders
+	}
+	return false
+}
+
+func (m *Params) GetOwnerShareEpochSeconds() uint32 {
+	if m != nil {
+		return m.OwnerShareEpochSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetWatchdogBlocks() uint32 {
+	if m != nil {
+		return m.WatchdogBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRefreshStrategy() RefreshStrategy {
+	if m != nil {
+		return m.RefreshStrategy
+	}
+	return RefreshStrategy_REFRESH_STRATEGY_REPLACE
+}
+
+func (m *Params) GetMaxSkewPpm() uint32 {
+	if m != nil {
+		return m.MaxSkewPpm
+	}
+	return 0
+}
+
+func (m *Params) GetSkipUnchangedOuterLayers() bool {
+	if m != nil {
+		return m.SkipUnchangedOuterLayers
+	}
+	return false
+}
+
+func (m *Params) GetClientMetadata() uint32 {
+	if m != nil {
+		return m.ClientMetadata
+	}
+	return 0
+}
+
+func (m *Params) GetSettleFundingBeforeShareMath() bool {
+	if m != nil {
+		return m.SettleFundingBeforeShareMath
+	}
+	return false
+}
+
+func (m *Params) GetOperatorRefreshIntervalBlocks() uint32 {
+	if m != nil {
+		return m.OperatorRefreshIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetExpirationOracleTicks() uint32 {
+	if m != nil {
+		return m.ExpirationOracleTicks
+	}
+	return 0
+}
+
+// OperatorParamBounds are the inclusive bounds within which the vault operator
+// can update params.
+type OperatorParamBounds struct {
+	// Minimum and maximum `spread_min_ppm` that the operator can set.
+	SpreadMinPpmMin uint32 `protobuf:"varint,1,opt,name=spread_min_ppm_min,json=spreadMinPpmMin,proto3" json:"spread_min_ppm_min,omitempty"`
+	SpreadMinPpmMax uint32 `protobuf:"varint,2,opt,name=spread_min_ppm_max,json=spreadMinPpmMax,proto3" json:"spread_min_ppm_max,omitempty"`
+	// Minimum and maximum `order_size_pct_ppm` that the operator can set.
+	OrderSizePctPpmMin uint32 `protobuf:"varint,3,opt,name=order_size_pct_ppm_min,json=orderSizePctPpmMin,proto3" json:"order_size_pct_ppm_min,omitempty"`
+	OrderSizePctPpmMax uint32 `protobuf:"varint,4,opt,name=order_size_pct_ppm_max,json=orderSizePctPpmMax,proto3" json:"order_size_pct_ppm_max,omitempty"`
+}
+
+func (m *OperatorParamBounds) Reset()         { *m = OperatorParamBounds{} }
+func (m *OperatorParamBounds) String() string { return proto.CompactTextString(m) }
+func (*OperatorParamBounds) ProtoMessage()    {}
+func (*OperatorParamBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{1}
+}
+func (m *OperatorParamBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorParamBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorParamBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorParamBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorParamBounds.Merge(m, src)
+}
+func (m *OperatorParamBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorParamBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorParamBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorParamBounds proto.InternalMessageInfo
+
+func (m *OperatorParamBounds) GetSpreadMinPpmMin() uint32 {
+	if m != nil {
+		return m.SpreadMinPpmMin
+	}
+	return 0
+}
+
+func (m *OperatorParamBounds) GetSpreadMinPpmMax() uint32 {
+	if m != nil {
+		return m.SpreadMinPpmMax
+	}
+	return 0
+}
+
+func (m *OperatorParamBounds) GetOrderSizePctPpmMin() uint32 {
+	if m != nil {
+		return m.OrderSizePctPpmMin
+	}
+	return 0
+}
+
+func (m *OperatorParamBounds) GetOrderSizePctPpmMax() uint32 {
+	if m != nil {
+		return m.OrderSizePctPpmMax
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dydxprotocol.vault.SizeProfile", SizeProfile_name, SizeProfile_value)
+	proto.RegisterEnum("dydxprotocol.vault.PlacementPriority", PlacementPriority_name, PlacementPriority_value)
+	proto.RegisterEnum("dydxprotocol.vault.ActivationThresholdMode", ActivationThresholdMode_name, ActivationThresholdMode_value)
+	proto.RegisterEnum("dydxprotocol.vault.RefreshStrategy", RefreshStrategy_name, RefreshStrategy_value)
+	proto.RegisterType((*Params)(nil), "dydxprotocol.vault.Params")
+	proto.RegisterType((*OperatorParamBounds)(nil), "dydxprotocol.vault.OperatorParamBounds")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/vault/params.proto", fileDescriptor_6043e0b8bfdbca9f) }
+
+var fileDescriptor_6043e0b8bfdbca9f = []byte{
+	// 1686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5b, 0x53, 0x1b, 0xc9,
+	0x15, 0x66, 0xbc, 0xc4, 0xf1, 0x36, 0xd8, 0xc0, 0x60, 0xa0, 0xc1, 0xb6, 0x10, 0xf8, 0xa6, 0xe0,
+	0x2c, 0xd4, 0x3a, 0x29, 0x27, 0xd9, 0xd4, 0x56, 0x45, 0x82, 0xd1, 0xa2, 0x8d, 0x6e, 0x1e, 0xc9,
+	0x4e, 0xbc, 0x79, 0xe8, 0x6a, 0xcd, 0xb4, 0xa4, 0x0e, 0x33, 0xd3, 0xe3, 0x9e, 0x16, 0x48, 0xfe,
+	0x15, 0x79, 0x49, 0xe5, 0x27, 0x65, 0x1f, 0xf7, 0x2d, 0xa9, 0x3c, 0x6c, 0xa5, 0xec, 0x3f, 0x92,
+	0xea, 0xd3, 0x33, 0x12, 0xba, 0x50, 0x95, 0x87, 0x7d, 0x32, 0x3a, 0xdf, 0x77, 0xa6, 0xbb, 0xcf,
+	0xf9, 0xce, 0xc5, 0x68, 0xdf, 0x1f, 0xf9, 0xc3, 0x58, 0x0a, 0x25, 0x3c, 0x11, 0x9c, 0x5c, 0xd2,
+	0x41, 0xa0, 0x4e, 0x62, 0x2a, 0x69, 0x98, 0x1c, 0x83, 0xd5, 0xb6, 0xaf, 0x13, 0x8e, 0x81, 0xb0,
+	0x77, 0xbf, 0x27, 0x7a, 0x02, 0x6c, 0x27, 0xfa, 0x2f, 0xc3, 0x3c, 0xfc, 0xe7, 0x36, 0xba, 0xdd,
+	0x04, 0x57, 0x7b, 0x1b, 0xdd, 0x0e, 0xe8, 0x88, 0xc9, 0x04, 0x5b, 0x79, 0xab, 0x70, 0xd7, 0x4d,
+	0x7f, 0xd9, 0x4f, 0xd0, 0xbd, 0x24, 0x96, 0x8c, 0xfa, 0x24, 0xe4, 0x11, 0x89, 0xe3, 0x10, 0xdf,
+	0x02, 0x7c, 0xd5, 0x58, 0x6b, 0x3c, 0x6a, 0xc6, 0xa1, 0x7d, 0x84, 0x36, 0x52, 0x56, 0x67, 0xd0,
+	0xed, 0x32, 0x09, 0xc4, 0xcf, 0x80, 0xb8, 0x66, 0x80, 0x12, 0xd8, 0x35, 0xf7, 0x19, 0x5a, 0x4b,
+	0x2e, 0xd8, 0x15, 0xe9, 0x52, 0x4f, 0x09, 0xc3, 0x5c, 0x06, 0xe6, 0x5d, 0x6d, 0x2e, 0x83, 0x55,
+	0xf3, 0x5e, 0x20, 0x5b, 0x48, 0x9f, 0x49, 0x92, 0xf0, 0x0f, 0x8c, 0xc4, 0x9e, 0x02, 0xea, 0xcf,
+	0xcc, 0x47, 0x01, 0x69, 0xf1, 0x0f, 0xac, 0xe9, 0x29, 0x4d, 0xfe, 0x2d, 0xc2, 0x86, 0xcc, 0x86,
+	0x31, 0x97, 0x54, 0x71, 0x11, 0x91, 0x84, 0x79, 0x22, 0xf2, 0x13, 0x7c, 0x1b, 0x5c, 0xb6, 0x01,
+	0x77, 0xc6, 0x70, 0xcb, 0xa0, 0xf6, 0x3f, 0x2c, 0xf4, 0x98, 0x7a, 0x8a, 0x5f, 0x1a, 0x27, 0xd5,
+	0x97, 0x2c, 0xe9, 0x8b, 0xc0, 0x27, 0xef, 0x07, 0x42, 0x31, 0xf2, 0x7e, 0x40, 0x23, 0x35, 0x08,
+	0x13, 0xfc, 0xf3, 0xbc, 0x55, 0x58, 0x2d, 0x9d, 0x7f, 0xff, 0xe3, 0xfe, 0xd2, 0x7f, 0x7e, 0xdc,
+	0xff, 0x43, 0x8f, 0xab, 0xfe, 0xa0, 0x73, 0xec, 0x89, 0xf0, 0x64, 0x3a, 0x1f, 0xbf, 0xfe, 0xc2,
+	0xeb, 0x53, 0x1e, 0x9d, 0x8c, 0x2d, 0xbe, 0x1a, 0xc5, 0x2c, 0x39, 0x6e, 0x31, 0xc9, 0x69, 0xc0,
+	0x3f, 0xd0, 0x4e, 0xc0, 0x2a, 0x91, 0x72, 0xf3, 0x93, 0x43, 0xdb, 0xd9, 0x99, 0xaf, 0xf5, 0x91,
+	0xaf, 0xd3, 0x13, 0xed, 0xbf, 0x5b, 0xe8, 0xb1, 0x0e, 0x3a, 0x7b, 0x3f, 0xe0, 0x6a, 0x44, 0x62,
+	0x26, 0x09, 0x24, 0x65, 0xf6, 0x66, 0x77, 0x7e, 0xe2, 0x9b, 0xe5, 0x42, 0x1e, 0x39, 0x70, 0x66,
+	0x93, 0xc9, 0xaa, 0x3e, 0x71, 0xfa, 0x5e, 0x07, 0x68, 0x15, 0x12, 0xc8, 0x22, 0xed, 0xe1, 0xe3,
+	0xcf, 0xf3, 0x56, 0xe1, 0x8e, 0xbb, 0xa2, 0x6d, 0x8e, 0x31, 0xd9, 0x5f, 0xa3, 0x07, 0xfa, 0xe6,
+	0x92, 0x75, 0xf5, 0xcb, 0x08, 0x8f, 0x14, 0x93, 0x97, 0x34, 0x20, 0x9d, 0x40, 0x78, 0x17, 0x09,
+	0x5e, 0x81, 0x8c, 0xe0, 0x90, 0x47, 0xae, 0x61, 0x54, 0x52, 0x42, 0x09, 0x70, 0xfb, 0x4b, 0xb4,
+	0xa5, 0xdd, 0x03, 0xa1, 0x48, 0x87, 0x26, 0xd7, 0x9e, 0xba, 0x9a, 0xb7, 0x0a, 0xcb, 0xae, 0x1d,
+	0xf2, 0xa8, 0x2a, 0x54, 0x89, 0x26, 0x93, 0x4b, 0x95, 0x50, 0x2e, 0xd3, 0xe9, 0x20, 0x50, 0x3c,
+	0x0e, 0xb8, 0x51, 0x21, 0xe9, 0x8c, 0x4c, 0xd4, 0xf0, 0xdd, 0xfc, 0x67, 0x85, 0xbb, 0xee, 0x5e,
+	0xaa, 0xdb, 0x31, 0xa9, 0x19, 0x87, 0xa5, 0x11, 0xbc, 0xd2, 0xfe, 0x33, 0x3a, 0x0a, 0xe9, 0x90,
+	0xc4, 0x22, 0xe1, 0xa0, 0x05, 0x9f, 0x05, 0x8a, 0x42, 0xdc, 0xe1, 0xde, 0x33, 0x77, 0xb9, 0x07,
+	0x77, 0x79, 0x12, 0xd2, 0x61, 0x33, 0x75, 0x38, 0xd3, 0xfc, 0x26, 0x93, 0xf0, 0x8a, 0xa9, 0xdb,
+	0x7d, 0x85, 0xf6, 0xfa, 0x54, 0xfa, 0x44, 0x7f, 0xde, 0xe8, 0x94, 0xf6, 0xd8, 0x58, 0xa0, 0x6b,
+	0x46, 0xa0, 0x9a, 0x51, 0xa3, 0xc3, 0x86, 0xc6, 0x8b, 0x3d, 0x96, 0x09, 0xb4, 0x88, 0x74, 0x42,
+	0x88, 0xe2, 0xde, 0x45, 0x42, 0xba, 0x52, 0x84, 0x44, 0x48, 0xea, 0x05, 0x0c, 0x2e, 0x96, 0x70,
+	0x9f, 0xe1, 0x75, 0xf0, 0xdf, 0x0d, 0x79, 0xd4, 0xd6, 0xa4, 0xb2, 0x14, 0x61, 0x03, 0x28, 0x4d,
+	0x5d, 0x23, 0x3e, 0xb3, 0x5f, 0x21, 0x7c, 0xad, 0x94, 0x2e, 0x45, 0x40, 0x12, 0x8f, 0xea, 0x2f,
+	0xc4, 0x21, 0xde, 0x00, 0xe7, 0xfb, 0xe3, 0x82, 0x7a, 0x2b, 0x82, 0x96, 0x06, 0x75, 0x55, 0xbd,
+	0x42, 0x3b, 0xc9, 0xa0, 0x63, 0x4e, 0xfe, 0x2b, 0x57, 0x4a, 0xd7, 0x57, 0x9a, 0x74, 0x1b, 0x92,
+	0xbe, 0x95, 0xc1, 0xdf, 0x02, 0x9a, 0xa5, 0xbf, 0x84, 0x56, 0x4d, 0xd1, 0x4a, 0xd1, 0xe5, 0x01,
+	0xc3, 0x9b, 0x79, 0xab, 0x70, 0xef, 0xe5, 0xfe, 0xf1, 0x7c, 0x63, 0x3a, 0x86, 0x1a, 0x36, 0x34,
+	0x77, 0x25, 0x99, 0xfc, 0xd0, 0x2d, 0x85, 0x47, 0x5e, 0x30, 0xf0, 0x19, 0xe9, 0x32, 0x46, 0xba,
+	0x81, 0x10, 0x12, 0xdf, 0x87, 0x53, 0xd7, 0x52, 0xa0, 0xcc, 0x58, 0x59, 0x9b, 0xed, 0x73, 0x74,
+	0x90, 0x88, 0xae, 0x22, 0x3c, 0xba, 0x64, 0x91, 0x12, 0x72, 0x44, 0x3a, 0x34, 0xf2, 0x67, 0xf2,
+	0xb5, 0x05, 0xf9, 0x7a, 0xa4, 0x89, 0x95, 0x8c, 0x57, 0xa2, 0x91, 0x3f, 0x95, 0xa8, 0x3d, 0x74,
+	0x47, 0xc4, 0x4c, 0x52, 0x25, 0x24, 0xde, 0xce, 0x5b, 0x85, 0xcf, 0xdd, 0xf1, 0x6f, 0xdb, 0x41,
+	0xfb, 0xd9, 0xdf, 0x64, 0x10, 0xfb, 0x54, 0xb1, 0x39, 0x61, 0xef, 0x40, 0x30, 0x1f, 0x66, 0xb4,
+	0x37, 0xc0, 0x9a, 0x11, 0x37, 0x45, 0x5b, 0xe3, 0xcf, 0x40, 0xdf, 0x26, 0x1d, 0x31, 0xd0, 0x32,
+	0xc0, 0x79, 0xab, 0xb0, 0xf2, 0xf2, 0xf9, 0xa2, 0x28, 0x35, 0x52, 0x07, 0x68, 0xd6, 0x25, 0xa0,
+	0x97, 0x96, 0x75, 0xc1, 0xbb, 0x9b, 0x62, 0x1e, 0xb2, 0xbf, 0x44, 0xf7, 0xaf, 0xb5, 0x34, 0x88,
+	0x56, 0xc2, 0x2f, 0x19, 0xde, 0x85, 0xf0, 0x6d, 0x4e, 0xb0, 0x4a, 0x06, 0xe9, 0xfa, 0x91, 0xcc,
+	0x34, 0x96, 0x2e, 0x0f, 0x82, 0x6b, 0x7d, 0x30, 0xeb, 0xbc, 0x7b, 0xf0, 0xb6, 0xbd, 0x94, 0x55,
+	0xe6, 0x41, 0x30, 0xee, 0x5b, 0x69, 0x13, 0xfe, 0x0a, 0xed, 0x69, 0x81, 0xc3, 0x95, 0x8d, 0xcc,
+	0x93, 0x49, 0xf5, 0xe0, 0x07, 0x46, 0xe5, 0x21, 0x1d, 0xbe, 0xd5, 0x04, 0x90, 0x79, 0x92, 0x55,
+	0x8b, 0x7d, 0x8c, 0x36, 0x25, 0x8b, 0xd8, 0x55, 0x36, 0x40, 0xd2, 0x80, 0x3e, 0x04, 0xa7, 0x0d,
+	0x80, 0xcc, 0x08, 0x49, 0xa3, 0xf8, 0x7b, 0xb4, 0xa7, 0xab, 0xc2, 0xc8, 0x3a, 0xe0, 0x5d, 0xa6,
+	0x78, 0x38, 0xa9, 0xa8, 0x47, 0xe0, 0xb6, 0x13, 0xf2, 0x08, 0x8e, 0xa9, 0xa6, 0x78, 0x56, 0x52,
+	0xe7, 0xe8, 0x60, 0x22, 0x15, 0x1f, 0xc6, 0xd6, 0xbc, 0x5e, 0x72, 0x46, 0x2f, 0x63, 0xe2, 0x99,
+	0x9e, 0x62, 0xb3, 0x7a, 0xc9, 0xa3, 0x55, 0xa9, 0x63, 0x4e, 0x94, 0x20, 0x21, 0xf7, 0xf1, 0x3e,
+	0x44, 0x18, 0x81, 0xad, 0x2d, 0x6a, 0xdc, 0xd7, 0x0f, 0xf3, 0xa4, 0x48, 0x92, 0x34, 0x2c, 0x11,
+	0x53, 0x8a, 0x47, 0x3d, 0x9c, 0x07, 0xe2, 0x06, 0x40, 0x10, 0x8f, 0xba, 0x01, 0xe0, 0x61, 0x74,
+	0x48, 0xc6, 0x75, 0xe7, 0xb3, 0x4b, 0x6e, 0xf2, 0xa8, 0x93, 0x70, 0x90, 0x3e, 0x8c, 0x0e, 0x5b,
+	0x29, 0xe1, 0x2c, 0xc3, 0x4d, 0x06, 0x76, 0x13, 0x25, 0xb9, 0xa7, 0x16, 0xf8, 0xe3, 0x43, 0x38,
+	0x72, 0xc7, 0x10, 0xe6, 0xdc, 0xed, 0x36, 0xb2, 0xe3, 0x80, 0x7a, 0x2c, 0x64, 0x91, 0x22, 0xb1,
+	0xe4, 0x42, 0x72, 0x35, 0xc2, 0x8f, 0xa1, 0x74, 0x9f, 0x2e, 0x12, 0x65, 0x33, 0x63, 0x37, 0x53,
+	0xb2, 0xbb, 0x11, 0xcf, 0x9a, 0xec, 0x1e, 0xda, 0x5d, 0x38, 0x5d, 0x43, 0xe1, 0x33, 0xfc, 0x04,
+	0x3e, 0xfe, 0x62, 0xd1, 0xc7, 0x8b, 0xf3, 0xd3, 0xb1, 0x26, 0x7c, 0xe6, 0xee, 0xd0, 0xc5, 0x80,
+	0x8e, 0xdb, 0x24, 0xa7, 0xe9, 0x28, 0x98, 0x74, 0xb9, 0xa7, 0x26, 0x6e, 0x63, 0x46, 0x0b, 0x08,
+	0xe3, 0x46, 0xf7, 0x12, 0x6d, 0x25, 0x17, 0x3c, 0x26, 0x83, 0xc8, 0xeb, 0xd3, 0xa8, 0xc7, 0xfc,
+	0x54, 0xbe, 0xf8, 0x99, 0xa9, 0x18, 0x0d, 0xbe, 0xc9, 0x30, 0xa3, 0x5c, 0xfb, 0x77, 0x68, 0x57,
+	0x5c, 0x45, 0xba, 0xa9, 0xf6, 0xa9, 0x64, 0x84, 0xc5, 0xc2, 0xeb, 0x8f, 0x05, 0xf8, 0x3c, 0xdd,
+	0x39, 0x34, 0xa1, 0xa5, 0x71, 0x47, 0xc3, 0x99, 0xfe, 0x9e, 0xa3, 0xb5, 0x2b, 0xaa, 0xbc, 0xbe,
+	0x2f, 0x7a, 0x99, 0xd0, 0x0b, 0xe0, 0x70, 0x2f, 0x33, 0xa7, 0x2a, 0xaf, 0xa3, 0xf5, 0x6c, 0x86,
+	0x26, 0x4a, 0x52, 0xc5, 0x7a, 0x23, 0xfc, 0x0b, 0x08, 0xda, 0xe3, 0x45, 0x41, 0x4b, 0xa7, 0x69,
+	0x2b, 0xa5, 0xba, 0x6b, 0x72, 0xda, 0xa0, 0xe5, 0x0a, 0xe2, 0xd2, 0xe3, 0x5b, 0x87, 0xe5, 0x08,
+	0x4e, 0x45, 0x5a, 0x4e, 0x17, 0xec, 0x4a, 0x47, 0xe2, 0x6b, 0xf4, 0x60, 0x36, 0x12, 0x03, 0x95,
+	0x6d, 0x1e, 0x09, 0x7e, 0x01, 0xf1, 0xc0, 0xd3, 0xf1, 0xd0, 0x04, 0x98, 0xa0, 0xf0, 0x32, 0x2f,
+	0xe0, 0x5a, 0x41, 0x21, 0x53, 0xd4, 0xa7, 0x8a, 0xe2, 0x5f, 0x9a, 0x97, 0x19, 0x73, 0x2d, 0xb5,
+	0xda, 0x65, 0x94, 0x4f, 0x98, 0x52, 0x01, 0x23, 0xdd, 0x41, 0xe4, 0xf3, 0xa8, 0x47, 0x3a, 0xac,
+	0x2b, 0x24, 0x4b, 0xa3, 0x19, 0x52, 0xd5, 0xc7, 0x5f, 0xc0, 0x61, 0x0f, 0x0d, 0xaf, 0x6c, 0x68,
+	0x25, 0x60, 0x41, 0x48, 0x6b, 0x54, 0xf5, 0xed, 0x6f, 0x50, 0x7e, 0xdc, 0x4d, 0x6f, 0x5a, 0x37,
+	0x8e, 0xe1, 0x06, 0x8f, 0x32, 0xde, 0xe2, 0x9d, 0xe3, 0x15, 0xda, 0xb9, 0xb6, 0x3b, 0xa6, 0x23,
+	0x16, 0x4a, 0x04, 0x9f, 0x80, 0xff, 0xd6, 0x04, 0x36, 0xd3, 0x15, 0x86, 0xed, 0xb7, 0xcb, 0x77,
+	0xd0, 0xfa, 0xca, 0xe1, 0xbf, 0x2c, 0xb4, 0xb9, 0xa0, 0x49, 0xeb, 0x25, 0x76, 0x7a, 0x7d, 0xd6,
+	0xff, 0x62, 0xeb, 0xfa, 0x66, 0x6c, 0x56, 0xe8, 0x1a, 0x8f, 0x16, 0x91, 0xe9, 0x10, 0xdf, 0x5a,
+	0x40, 0xa6, 0x43, 0xfb, 0x25, 0xda, 0x9e, 0x5f, 0x8f, 0xe1, 0xeb, 0x66, 0xef, 0xb6, 0x67, 0x56,
+	0x64, 0x7d, 0xc0, 0x0d, 0x3e, 0x74, 0x88, 0x97, 0x17, 0xfb, 0xd0, 0xe1, 0x11, 0x45, 0x2b, 0xd7,
+	0x66, 0xb4, 0xbd, 0x85, 0x36, 0x5a, 0x95, 0xef, 0x1c, 0xd2, 0x74, 0x1b, 0xe5, 0x4a, 0xd5, 0x21,
+	0xe5, 0x6a, 0xb1, 0xbd, 0xbe, 0x64, 0x3f, 0x42, 0xbb, 0xd3, 0x66, 0xb7, 0x51, 0x6f, 0x93, 0x6a,
+	0xa3, 0x78, 0xe6, 0x9c, 0xad, 0x5b, 0xf6, 0x43, 0x84, 0xa7, 0xe0, 0x52, 0xf1, 0xf4, 0x8f, 0x19,
+	0x7a, 0xeb, 0xe8, 0x2f, 0x68, 0x63, 0xae, 0x97, 0xd8, 0x87, 0x28, 0xd7, 0xac, 0x16, 0x4f, 0x9d,
+	0x9a, 0x53, 0x6f, 0x93, 0xa6, 0x5b, 0x69, 0xb8, 0x95, 0xf6, 0x3b, 0x52, 0xa9, 0xd7, 0x1d, 0x97,
+	0x94, 0x2b, 0x6e, 0x4b, 0x9f, 0xba, 0x98, 0xd3, 0x78, 0xd3, 0x1e, 0x73, 0xac, 0xa3, 0x2e, 0xda,
+	0xb9, 0xa1, 0x97, 0xd8, 0x4f, 0x50, 0xbe, 0x78, 0xda, 0xae, 0xbc, 0x2d, 0xb6, 0x2b, 0x8d, 0x3a,
+	0x69, 0x9f, 0xbb, 0x4e, 0xeb, 0xbc, 0x51, 0x3d, 0x23, 0xb5, 0xc6, 0x99, 0x43, 0x5a, 0xed, 0x62,
+	0xbb, 0x72, 0xba, 0xbe, 0x64, 0x3f, 0x45, 0x07, 0x37, 0xb3, 0xce, 0xde, 0xd5, 0x8b, 0xb5, 0xca,
+	0xe9, 0xba, 0x75, 0xf4, 0x27, 0xb4, 0x36, 0x53, 0x7e, 0xfa, 0xd5, 0xae, 0x53, 0xd6, 0x7c, 0xd2,
+	0x6a, 0xbb, 0xc5, 0xb6, 0xf3, 0xcd, 0x3b, 0xe2, 0x3a, 0x70, 0xe3, 0xf5, 0x25, 0xfb, 0x19, 0x3a,
+	0x9c, 0x43, 0x4f, 0x8b, 0xf5, 0x53, 0xa7, 0x4a, 0xda, 0xe7, 0x4e, 0x9d, 0x18, 0x9e, 0x55, 0x7a,
+	0xfd, 0xfd, 0xc7, 0x9c, 0xf5, 0xc3, 0xc7, 0x9c, 0xf5, 0xdf, 0x8f, 0x39, 0xeb, 0x6f, 0x9f, 0x72,
+	0x4b, 0x3f, 0x7c, 0xca, 0x2d, 0xfd, 0xfb, 0x53, 0x6e, 0xe9, 0xbb, 0xdf, 0xfc, 0xff, 0xab, 0xfe,
+	0x30, 0xfd, 0x8f, 0x22, 0x6c, 0xfc, 0x9d, 0xdb, 0x60, 0xff, 0xd5, 0xff, 0x06, 0x00, 0x60, 0x76,
+	0x01, 0x84, 0x4b, 0x0e, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationOracleTicks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpirationOracleTicks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.OperatorRefreshIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OperatorRefreshIntervalBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.SettleFundingBeforeShareMath {
+		i--
+		if m.SettleFundingBeforeShareMath {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ClientMetadata != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClientMetadata))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.SkipUnchangedOuterLayers {
+		i--
+		if m.SkipUnchangedOuterLayers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxSkewPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSkewPpm))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.RefreshStrategy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RefreshStrategy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.WatchdogBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WatchdogBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.OwnerShareEpochSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OwnerShareEpochSeconds))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SkipUnchangedOrders {
+		i--
+		if m.SkipUnchangedOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.InventorySpreadScalePpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InventorySpreadScalePpm))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ActivationThresholdMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationThresholdMode))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.PlacementPriority != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PlacementPriority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.StrictSubticksDeviation {
+		i--
+		if m.StrictSubticksDeviation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxSubticksDeviationPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSubticksDeviationPpm))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.CrossVaultNetting {
+		i--
+		if m.CrossVaultNetting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RoundToMid {
+		i--
+		if m.RoundToMid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.InventoryDeadBandBaseQuantums != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InventoryDeadBandBaseQuantums))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MinOrderLifetimeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinOrderLifetimeSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.RenewBufferBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RenewBufferBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxVaultOrdersPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVaultOrdersPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.RequoteFillThresholdPctPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RequoteFillThresholdPctPpm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.ActivationInclusive {
+		i--
+		if m.ActivationInclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size, err := m.OperatorParamBounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.OperatorUpdateIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OperatorUpdateIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.SoftInventoryBandBaseQuantums != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SoftInventoryBandBaseQuantums))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.IncludeFeeFloor {
+		i--
+		if m.IncludeFeeFloor {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.SizeProfile != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SizeProfile))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.SubticksJitterEnabled {
+		i--
+		if m.SubticksJitterEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.OrderSizeVolScalePpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderSizeVolScalePpm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MinTicksFromOraclePerSide != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTicksFromOraclePerSide))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HardMaxOrderAgeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HardMaxOrderAgeSeconds))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxPositionDeltaPerBlockBaseQuantums != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPositionDeltaPerBlockBaseQuantums))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SpreadMultiplierPpmByLayer) > 0 {
+		dAtA3 := make([]byte, len(m.SpreadMultiplierPpmByLayer)*10)
+		var j2 int
+		for _, num := range m.SpreadMultiplierPpmByLayer {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintParams(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MinLotBaseQuantums != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinLotBaseQuantums))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinRefreshIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinRefreshIntervalBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SkewEnabled {
+		i--
+		if m.SkewEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinEquityPerLayerQuoteQuantums.Size()
+		i -= size
+		if _, err := m.MinEquityPerLayerQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ActivationThresholdQuoteQuantums.Size()
+		i -= size
+		if _, err := m.ActivationThresholdQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OrderExpirationSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderExpirationSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OrderSizePctPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderSizePctPpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SkewFactorPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SkewFactorPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SpreadBufferPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadBufferPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpreadMinPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadMinPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Layers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Layers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorParamBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorParamBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorParamBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderSizePctPpmMax != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderSizePctPpmMax))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OrderSizePctPpmMin != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderSizePctPpmMin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpreadMinPpmMax != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadMinPpmMax))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpreadMinPpmMin != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadMinPpmMin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Layers != 0 {
+		n += 1 + sovParams(uint64(m.Layers))
+	}
+	if m.SpreadMinPpm != 0 {
+		n += 1 + sovParams(uint64(m.SpreadMinPpm))
+	}
+	if m.SpreadBufferPpm != 0 {
+		n += 1 + sovParams(uint64(m.SpreadBufferPpm))
+	}
+	if m.SkewFactorPpm != 0 {
+		n += 1 + sovParams(uint64(m.SkewFactorPpm))
+	}
+	if m.OrderSizePctPpm != 0 {
+		n += 1 + sovParams(uint64(m.OrderSizePctPpm))
+	}
+	if m.OrderExpirationSeconds != 0 {
+		n += 1 + sovParams(uint64(m.OrderExpirationSeconds))
+	}
+	l = m.ActivationThresholdQuoteQuantums.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinEquityPerLayerQuoteQuantums.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SkewEnabled {
+		n += 2
+	}
+	if m.MinRefreshIntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinRefreshIntervalBlocks))
+	}
+	if m.MinLotBaseQuantums != 0 {
+		n += 1 + sovParams(uint64(m.MinLotBaseQuantums))
+	}
+	if len(m.SpreadMultiplierPpmByLayer) > 0 {
+		l = 0
+		for _, e := range m.SpreadMultiplierPpmByLayer {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.MaxPositionDeltaPerBlockBaseQuantums != 0 {
+		n += 1 + sovParams(uint64(m.MaxPositionDeltaPerBlockBaseQuantums))
+	}
+	if m.HardMaxOrderAgeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.HardMaxOrderAgeSeconds))
+	}
+	if m.MinTicksFromOraclePerSide != 0 {
+		n += 2 + sovParams(uint64(m.MinTicksFromOraclePerSide))
+	}
+	if m.OrderSizeVolScalePpm != 0 {
+		n += 2 + sovParams(uint64(m.OrderSizeVolScalePpm))
+	}
+	if m.SubticksJitterEnabled {
+		n += 3
+	}
+	if m.SizeProfile != 0 {
+		n += 2 + sovParams(uint64(m.SizeProfile))
+	}
+	if m.IncludeFeeFloor {
+		n += 3
+	}
+	if m.SoftInventoryBandBaseQuantums != 0 {
+		n += 2 + sovParams(uint64(m.SoftInventoryBandBaseQuantums))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.OperatorUpdateIntervalBlocks != 0 {
+		n += 2 + sovParams(uint64(m.OperatorUpdateIntervalBlocks))
+	}
+	l = m.OperatorParamBounds.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ActivationInclusive {
+		n += 3
+	}
+	if m.RequoteFillThresholdPctPpm != 0 {
+		n += 2 + sovParams(uint64(m.RequoteFillThresholdPctPpm))
+	}
+	if m.MaxVaultOrdersPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxVaultOrdersPerBlock))
+	}
+	if m.RenewBufferBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RenewBufferBlocks))
+	}
+	if m.MinOrderLifetimeSeconds != 0 {
+		n += 2 + sovParams(uint64(m.MinOrderLifetimeSeconds))
+	}
+	if m.InventoryDeadBandBaseQuantums != 0 {
+		n += 2 + sovParams(uint64(m.InventoryDeadBandBaseQuantums))
+	}
+	if m.RoundToMid {
+		n += 3
+	}
+	if m.CrossVaultNetting {
+		n += 3
+	}
+	if m.MaxSubticksDeviationPpm != 0 {
+		n += 2 + sovParams(uint64(m.MaxSubticksDeviationPpm))
+	}
+	if m.StrictSubticksDeviation {
+		n += 3
+	}
+	if m.PlacementPriority != 0 {
+		n += 2 + sovParams(uint64(m.PlacementPriority))
+	}
+	if m.ActivationThresholdMode != 0 {
+		n += 2 + sovParams(uint64(m.ActivationThresholdMode))
+	}
+	if m.InventorySpreadScalePpm != 0 {
+		n += 2 + sovParams(uint64(m.InventorySpreadScalePpm))
+	}
+	if m.SkipUnchangedOrders {
+		n += 3
+	}
+	if m.OwnerShareEpochSeconds != 0 {
+		n += 2 + sovParams(uint64(m.OwnerShareEpochSeconds))
+	}
+	if m.WatchdogBlocks != 0 {
+		n += 2 + sovParams(uint64(m.WatchdogBlocks))
+	}
+	if m.RefreshStrategy != 0 {
+		n += 2 + sovParams(uint64(m.RefreshStrategy))
+	}
+	if m.MaxSkewPpm != 0 {
+		n += 2 + sovParams(uint64(m.MaxSkewPpm))
+	}
+	if m.SkipUnchangedOuterLayers {
+		n += 3
+	}
+	if m.ClientMetadata != 0 {
+		n += 2 + sovParams(uint64(m.ClientMetadata))
+	}
+	if m.SettleFundingBeforeShareMath {
+		n += 3
+	}
+	if m.OperatorRefreshIntervalBlocks != 0 {
+		n += 2 + sovParams(uint64(m.OperatorRefreshIntervalBlocks))
+	}
+	if m.ExpirationOracleTicks != 0 {
+		n += 2 + sovParams(uint64(m.ExpirationOracleTicks))
+	}
+	return n
+}
+
+func (m *OperatorParamBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpreadMinPpmMin != 0 {
+		n += 1 + sovParams(uint64(m.SpreadMinPpmMin))
+	}
+	if m.SpreadMinPpmMax != 0 {
+		n += 1 + sovParams(uint64(m.SpreadMinPpmMax))
+	}
+	if m.OrderSizePctPpmMin != 0 {
+		n += 1 + sovParams(uint64(m.OrderSizePctPpmMin))
+	}
+	if m.OrderSizePctPpmMax != 0 {
+		n += 1 + sovParams(uint64(m.OrderSizePctPpmMax))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layers", wireType)
+			}
+			m.Layers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMinPpm", wireType)
+			}
+			m.SpreadMinPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadMinPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadBufferPpm", wireType)
+			}
+			m.SpreadBufferPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadBufferPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkewFactorPpm", wireType)
+			}
+			m.SkewFactorPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkewFactorPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizePctPpm", wireType)
+			}
+			m.OrderSizePctPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSizePctPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpirationSeconds", wireType)
+			}
+			m.OrderExpirationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExpirationSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThresholdQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActivationThresholdQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEquityPerLayerQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinEquityPerLayerQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkewEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkewEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRefreshIntervalBlocks", wireType)
+			}
+			m.MinRefreshIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRefreshIntervalBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLotBaseQuantums", wireType)
+			}
+			m.MinLotBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLotBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SpreadMultiplierPpmByLayer = append(m.SpreadMultiplierPpmByLayer, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SpreadMultiplierPpmByLayer) == 0 {
+					m.SpreadMultiplierPpmByLayer = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpreadMultiplierPpmByLayer = append(m.SpreadMultiplierPpmByLayer, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMultiplierPpmByLayer", wireType)
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionDeltaPerBlockBaseQuantums", wireType)
+			}
+			m.MaxPositionDeltaPerBlockBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPositionDeltaPerBlockBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardMaxOrderAgeSeconds", wireType)
+			}
+			m.HardMaxOrderAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HardMaxOrderAgeSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTicksFromOraclePerSide", wireType)
+			}
+			m.MinTicksFromOraclePerSide = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTicksFromOraclePerSide |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizeVolScalePpm", wireType)
+			}
+			m.OrderSizeVolScalePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSizeVolScalePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubticksJitterEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SubticksJitterEnabled = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeProfile", wireType)
+			}
+			m.SizeProfile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeProfile |= SizeProfile(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFeeFloor", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFeeFloor = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftInventoryBandBaseQuantums", wireType)
+			}
+			m.SoftInventoryBandBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoftInventoryBandBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorUpdateIntervalBlocks", wireType)
+			}
+			m.OperatorUpdateIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorUpdateIntervalBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorParamBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorParamBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationInclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActivationInclusive = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequoteFillThresholdPctPpm", wireType)
+			}
+			m.RequoteFillThresholdPctPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequoteFillThresholdPctPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVaultOrdersPerBlock", wireType)
+			}
+			m.MaxVaultOrdersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVaultOrdersPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewBufferBlocks", wireType)
+			}
+			m.RenewBufferBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RenewBufferBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderLifetimeSeconds", wireType)
+			}
+			m.MinOrderLifetimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOrderLifetimeSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InventoryDeadBandBaseQuantums", wireType)
+			}
+			m.InventoryDeadBandBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InventoryDeadBandBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundToMid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoundToMid = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossVaultNetting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CrossVaultNetting = bool(v != 0)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubticksDeviationPpm", wireType)
+			}
+			m.MaxSubticksDeviationPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubticksDeviationPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSubticksDeviation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpreadMinPpm |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.StrictSubticksDeviation = bool(v != 0)
+		case 35:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadBufferPpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementPriority", wireType)
 			}
-			m.SpreadBufferPpm = 0
+			m.PlacementPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -348,16 +2305,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpreadBufferPpm |= uint32(b&0x7F) << shift
+				m.PlacementPriority |= PlacementPriority(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 36:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkewFactorPpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThresholdMode", wireType)
 			}
-			m.SkewFactorPpm = 0
+			m.ActivationThresholdMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -367,16 +2324,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SkewFactorPpm |= uint32(b&0x7F) << shift
+				m.ActivationThresholdMode |= ActivationThresholdMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 37:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizePctPpm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InventorySpreadScalePpm", wireType)
 			}
-			m.OrderSizePctPpm = 0
+			m.InventorySpreadScalePpm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -386,16 +2343,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderSizePctPpm |= uint32(b&0x7F) << shift
+				m.InventorySpreadScalePpm |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpirationSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipUnchangedOrders", wireType)
 			}
-			m.OrderExpirationSeconds = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -405,16 +2362,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderExpirationSeconds |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThresholdQuoteQuantums", wireType)
+			m.SkipUnchangedOrders = bool(v != 0)
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerShareEpochSeconds", wireType)
 			}
-			var byteLen int
+			m.OwnerShareEpochSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -424,25 +2382,291 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OwnerShareEpochSeconds |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthParams
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchdogBlocks", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			m.WatchdogBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchdogBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshStrategy", wireType)
+			}
+			m.RefreshStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshStrategy |= RefreshStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSkewPpm", wireType)
+			}
+			m.MaxSkewPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSkewPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipUnchangedOuterLayers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipUnchangedOuterLayers = bool(v != 0)
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMetadata", wireType)
+			}
+			m.ClientMetadata = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientMetadata |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleFundingBeforeShareMath", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettleFundingBeforeShareMath = bool(v != 0)
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRefreshIntervalBlocks", wireType)
+			}
+			m.OperatorRefreshIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorRefreshIntervalBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationOracleTicks", wireType)
+			}
+			m.ExpirationOracleTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationOracleTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActivationThresholdQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorParamBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorParamBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorParamBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMinPpmMin", wireType)
+			}
+			m.SpreadMinPpmMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadMinPpmMin |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMinPpmMax", wireType)
+			}
+			m.SpreadMinPpmMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadMinPpmMax |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizePctPpmMin", wireType)
+			}
+			m.OrderSizePctPpmMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSizePctPpmMin |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizePctPpmMax", wireType)
+			}
+			m.OrderSizePctPpmMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSizePctPpmMax |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
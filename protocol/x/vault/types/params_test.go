@@ -3,7 +3,9 @@ package types_test
 import (
 	"testing"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +21,34 @@ func TestValidate(t *testing.T) {
 			params:      types.DefaultParams(),
 			expectedErr: nil,
 		},
+		"Success - SpreadMultiplierPpmByLayer shorter than Layers": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				SpreadMultiplierPpmByLayer:       []uint32{1_000_000},
+			},
+			expectedErr: nil,
+		},
+		"Success - SpreadMultiplierPpmByLayer as long as Layers": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				SpreadMultiplierPpmByLayer:       []uint32{1_000_000, 3_000_000},
+			},
+			expectedErr: nil,
+		},
 		"Failure - Layer is greater than MaxUint8": {
 			params: types.Params{
 				Layers:                           256,
@@ -79,6 +109,204 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidActivationThresholdQuoteQuantums,
 		},
+		"Failure - MinEquityPerLayerQuoteQuantums is negative": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(-1),
+			},
+			expectedErr: types.ErrInvalidMinEquityPerLayerQuoteQuantums,
+		},
+		"Success - MinRefreshIntervalBlocks is odd": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				MinRefreshIntervalBlocks:         3,
+			},
+			expectedErr: nil,
+		},
+		"Failure - MinRefreshIntervalBlocks is even": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				MinRefreshIntervalBlocks:         2,
+			},
+			expectedErr: types.ErrInvalidMinRefreshIntervalBlocks,
+		},
+		"Failure - SpreadMultiplierPpmByLayer longer than Layers": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				SpreadMultiplierPpmByLayer:       []uint32{1_000_000, 2_000_000, 3_000_000},
+			},
+			expectedErr: types.ErrInvalidSpreadMultiplierPpmByLayer,
+		},
+		"Failure - SpreadMultiplierPpmByLayer contains zero": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				SpreadMultiplierPpmByLayer:       []uint32{1_000_000, 0},
+			},
+			expectedErr: types.ErrInvalidSpreadMultiplierPpmByLayer,
+		},
+		"Failure - Unknown SizeProfile": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				SizeProfile:                      types.SizeProfile(3),
+			},
+			expectedErr: types.ErrInvalidSizeProfile,
+		},
+		"Failure - Unknown PlacementPriority": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				PlacementPriority:                types.PlacementPriority(2),
+			},
+			expectedErr: types.ErrInvalidPlacementPriority,
+		},
+		"Failure - Unknown ActivationThresholdMode": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				ActivationThresholdMode:          types.ActivationThresholdMode(2),
+			},
+			expectedErr: types.ErrInvalidActivationThresholdMode,
+		},
+		"Failure - Unknown RefreshStrategy": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				RefreshStrategy:                  types.RefreshStrategy(2),
+			},
+			expectedErr: types.ErrInvalidRefreshStrategy,
+		},
+		"Failure - RequoteFillThresholdPctPpm Greater Than 1,000,000": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				RequoteFillThresholdPctPpm:       1_000_001,
+			},
+			expectedErr: types.ErrInvalidRequoteFillThreshold,
+		},
+		"Failure - MaxVaultOrdersPerBlock Less Than Orders of One Vault": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				MaxVaultOrdersPerBlock:           3,
+			},
+			expectedErr: types.ErrInvalidMaxVaultOrdersPerBlock,
+		},
+		"Failure - MinOrderLifetimeSeconds Exceeds Stateful Order Time Window": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				MinOrderLifetimeSeconds:          uint32(clobtypes.StatefulOrderTimeWindow.Seconds()) + 1,
+			},
+			expectedErr: types.ErrInvalidMinOrderLifetimeSeconds,
+		},
+		"Failure - Invalid Operator": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				Operator:                         "invalid_operator",
+			},
+			expectedErr: types.ErrInvalidOperator,
+		},
+		"Failure - Operator Param Bounds Min Greater Than Max": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				MinEquityPerLayerQuoteQuantums:   dtypes.NewInt(0),
+				OperatorParamBounds: types.OperatorParamBounds{
+					SpreadMinPpmMin: 2,
+					SpreadMinPpmMax: 1,
+				},
+			},
+			expectedErr: types.ErrInvalidOperatorParamBounds,
+		},
 	}
 
 	for name, tc := range tests {
@@ -88,3 +316,179 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateVaultParams(t *testing.T) {
+	tests := map[string]struct {
+		// Vault params to validate.
+		vaultParams types.VaultParams
+		// Expected error
+		expectedErr error
+	}{
+		"Success - Empty Vault Params": {
+			vaultParams: types.VaultParams{},
+			expectedErr: nil,
+		},
+		"Success - Oracle Price Range": {
+			vaultParams: types.VaultParams{
+				MinOraclePrice: 1_000,
+				MaxOraclePrice: 2_000,
+			},
+			expectedErr: nil,
+		},
+		"Success - Only Max Oracle Price": {
+			vaultParams: types.VaultParams{
+				MaxOraclePrice: 2_000,
+			},
+			expectedErr: nil,
+		},
+		"Failure - Min Oracle Price greater than Max Oracle Price": {
+			vaultParams: types.VaultParams{
+				MinOraclePrice: 2_001,
+				MaxOraclePrice: 2_000,
+			},
+			expectedErr: types.ErrInvalidOraclePriceRange,
+		},
+		"Success - Price Blend": {
+			vaultParams: types.VaultParams{
+				PriceBlend: []types.PriceBlendComponent{
+					{MarketId: 0, WeightPpm: 700_000},
+					{MarketId: 1, WeightPpm: 300_000},
+				},
+			},
+			expectedErr: nil,
+		},
+		"Failure - Price Blend weights don't sum to 1_000_000": {
+			vaultParams: types.VaultParams{
+				PriceBlend: []types.PriceBlendComponent{
+					{MarketId: 0, WeightPpm: 700_000},
+					{MarketId: 1, WeightPpm: 300_001},
+				},
+			},
+			expectedErr: types.ErrInvalidPriceBlend,
+		},
+		"Failure - Price Blend has a zero weight": {
+			vaultParams: types.VaultParams{
+				PriceBlend: []types.PriceBlendComponent{
+					{MarketId: 0, WeightPpm: 1_000_000},
+					{MarketId: 1, WeightPpm: 0},
+				},
+			},
+			expectedErr: types.ErrInvalidPriceBlend,
+		},
+		"Failure - Price Blend and Price Market Override both set": {
+			vaultParams: types.VaultParams{
+				PriceMarketIdOverride: &gogotypes.UInt32Value{Value: 1},
+				PriceBlend: []types.PriceBlendComponent{
+					{MarketId: 0, WeightPpm: 1_000_000},
+				},
+			},
+			expectedErr: types.ErrInvalidPriceBlend,
+		},
+		"Success - Index Constituents": {
+			vaultParams: types.VaultParams{
+				IndexConstituents: []types.IndexConstituent{
+					{PerpetualId: 0, WeightPpm: 600_000},
+					{PerpetualId: 1, WeightPpm: 400_000},
+				},
+			},
+			expectedErr: nil,
+		},
+		"Failure - Index Constituents weights don't sum to 1_000_000": {
+			vaultParams: types.VaultParams{
+				IndexConstituents: []types.IndexConstituent{
+					{PerpetualId: 0, WeightPpm: 600_000},
+					{PerpetualId: 1, WeightPpm: 399_999},
+				},
+			},
+			expectedErr: types.ErrInvalidIndexConstituents,
+		},
+		"Failure - Index Constituents has a zero weight": {
+			vaultParams: types.VaultParams{
+				IndexConstituents: []types.IndexConstituent{
+					{PerpetualId: 0, WeightPpm: 1_000_000},
+					{PerpetualId: 1, WeightPpm: 0},
+				},
+			},
+			expectedErr: types.ErrInvalidIndexConstituents,
+		},
+		"Failure - Index Constituents has a duplicate perpetual": {
+			vaultParams: types.VaultParams{
+				IndexConstituents: []types.IndexConstituent{
+					{PerpetualId: 0, WeightPpm: 500_000},
+					{PerpetualId: 0, WeightPpm: 500_000},
+				},
+			},
+			expectedErr: types.ErrInvalidIndexConstituents,
+		},
+		"Success - TWAP Inventory Mark Source": {
+			vaultParams: types.VaultParams{
+				InventoryMarkSource: types.InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP,
+			},
+			expectedErr: nil,
+		},
+		"Failure - Unknown Inventory Mark Source": {
+			vaultParams: types.VaultParams{
+				InventoryMarkSource: types.InventoryMarkSource(2),
+			},
+			expectedErr: types.ErrInvalidInventoryMarkSource,
+		},
+		"Success - Manual Reference Price": {
+			vaultParams: types.VaultParams{
+				ManualReferencePrice: &types.ManualReferencePrice{
+					Price:      5_000_000_000,
+					ExpiryTime: 1_700_000_000,
+				},
+			},
+			expectedErr: nil,
+		},
+		"Failure - Zero Manual Reference Price": {
+			vaultParams: types.VaultParams{
+				ManualReferencePrice: &types.ManualReferencePrice{
+					Price:      0,
+					ExpiryTime: 1_700_000_000,
+				},
+			},
+			expectedErr: types.ErrInvalidManualReferencePrice,
+		},
+		"Success - Spread Schedule": {
+			vaultParams: types.VaultParams{
+				SpreadSchedule: []types.SpreadScheduleWindow{
+					{StartSeconds: 0, EndSeconds: 3_600, SpreadMultiplierPpm: 2_000_000},
+					{StartSeconds: 82_800, EndSeconds: 1_800, SpreadMultiplierPpm: 1_000_000},
+				},
+			},
+			expectedErr: nil,
+		},
+		"Failure - Spread Schedule Window Beyond A Day": {
+			vaultParams: types.VaultParams{
+				SpreadSchedule: []types.SpreadScheduleWindow{
+					{StartSeconds: 0, EndSeconds: 86_400, SpreadMultiplierPpm: 2_000_000},
+				},
+			},
+			expectedErr: types.ErrInvalidSpreadSchedule,
+		},
+		"Failure - Empty Spread Schedule Window": {
+			vaultParams: types.VaultParams{
+				SpreadSchedule: []types.SpreadScheduleWindow{
+					{StartSeconds: 3_600, EndSeconds: 3_600, SpreadMultiplierPpm: 2_000_000},
+				},
+			},
+			expectedErr: types.ErrInvalidSpreadSchedule,
+		},
+		"Failure - Spread Schedule Multiplier Less Than One": {
+			vaultParams: types.VaultParams{
+				SpreadSchedule: []types.SpreadScheduleWindow{
+					{StartSeconds: 0, EndSeconds: 3_600, SpreadMultiplierPpm: 999_999},
+				},
+			},
+			expectedErr: types.ErrInvalidSpreadSchedule,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.vaultParams.Validate()
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -174,6 +175,7 @@ type QueryVaultResponse struct {
 	Equity       github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=equity,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"equity"`
 	Inventory    github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=inventory,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"inventory"`
 	TotalShares  NumShares                                                        `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares"`
+	Label        string                                                           `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
@@ -230,6 +232,13 @@ func (m *QueryVaultResponse) GetTotalShares() NumShares {
 	return NumShares{}
 }
 
+func (m *QueryVaultResponse) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 // QueryAllVaultsRequest is a request type for the AllVaults RPC method.
 type QueryAllVaultsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -442,749 +451,9983 @@ func (m *QueryOwnerSharesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.vault.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.vault.QueryParamsResponse")
-	proto.RegisterType((*QueryVaultRequest)(nil), "dydxprotocol.vault.QueryVaultRequest")
-	proto.RegisterType((*QueryVaultResponse)(nil), "dydxprotocol.vault.QueryVaultResponse")
-	proto.RegisterType((*QueryAllVaultsRequest)(nil), "dydxprotocol.vault.QueryAllVaultsRequest")
-	proto.RegisterType((*QueryAllVaultsResponse)(nil), "dydxprotocol.vault.QueryAllVaultsResponse")
-	proto.RegisterType((*QueryOwnerSharesRequest)(nil), "dydxprotocol.vault.QueryOwnerSharesRequest")
-	proto.RegisterType((*QueryOwnerSharesResponse)(nil), "dydxprotocol.vault.QueryOwnerSharesResponse")
+// QueryVaultQuotingStatusRequest is a request type for the VaultQuotingStatus
+// RPC method.
+type QueryVaultQuotingStatusRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/vault/query.proto", fileDescriptor_478fb8dc0ff21ea6) }
-
-var fileDescriptor_478fb8dc0ff21ea6 = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4b, 0x6f, 0xd3, 0x4a,
-	0x18, 0x8d, 0x9b, 0x34, 0xf7, 0x76, 0x92, 0x5e, 0xe9, 0xce, 0xed, 0x2d, 0xc1, 0x2d, 0x6e, 0xb1,
-	0x44, 0x9f, 0xe0, 0x21, 0x01, 0x89, 0x2e, 0x10, 0xa2, 0x5d, 0x14, 0xba, 0xa1, 0xad, 0x8b, 0x58,
-	0xb0, 0xa0, 0x8c, 0x93, 0xc1, 0xb5, 0xe4, 0x78, 0x5c, 0x7b, 0x1c, 0x1a, 0xaa, 0x6e, 0x90, 0x58,
-	0xb0, 0x43, 0xe2, 0x17, 0xc0, 0x82, 0x15, 0x7b, 0x96, 0x6c, 0xbb, 0xac, 0xc4, 0x06, 0xb1, 0xa8,
-	0x50, 0xcb, 0x0f, 0x41, 0x9e, 0x99, 0x26, 0xce, 0xc3, 0x6a, 0x84, 0xba, 0x89, 0x26, 0xdf, 0x9c,
-	0xef, 0x9c, 0xe3, 0xef, 0x31, 0x40, 0xab, 0x35, 0x6b, 0x7b, 0x7e, 0x40, 0x19, 0xad, 0x52, 0x17,
-	0x35, 0x70, 0xe4, 0x32, 0xb4, 0x1b, 0x91, 0xa0, 0x69, 0xf0, 0x20, 0x84, 0xc9, 0x7b, 0x83, 0xdf,
-	0xab, 0x63, 0x36, 0xb5, 0x29, 0x8f, 0xa1, 0xf8, 0x24, 0x90, 0xea, 0xa4, 0x4d, 0xa9, 0xed, 0x12,
-	0x84, 0x7d, 0x07, 0x61, 0xcf, 0xa3, 0x0c, 0x33, 0x87, 0x7a, 0xa1, 0xbc, 0x5d, 0xa8, 0xd2, 0xb0,
-	0x4e, 0x43, 0x64, 0xe1, 0x90, 0x08, 0x01, 0xd4, 0x28, 0x5b, 0x84, 0xe1, 0x32, 0xf2, 0xb1, 0xed,
-	0x78, 0x1c, 0x2c, 0xb1, 0xf3, 0x1d, 0x9e, 0xc2, 0xc8, 0xc2, 0xd5, 0x2a, 0x8d, 0x3c, 0x16, 0x26,
-	0xce, 0x12, 0x3a, 0xd5, 0xc7, 0xbe, 0x8f, 0x03, 0x5c, 0x3f, 0xd3, 0xed, 0xf7, 0x7d, 0xfc, 0x57,
-	0xdc, 0xeb, 0x63, 0x00, 0x6e, 0xc6, 0x6e, 0x36, 0x78, 0x92, 0x49, 0x76, 0x23, 0x12, 0x32, 0x7d,
-	0x1d, 0xfc, 0xd7, 0x11, 0x0d, 0x7d, 0xea, 0x85, 0x04, 0x2e, 0x81, 0xbc, 0x20, 0x2f, 0x29, 0xd3,
-	0xca, 0x5c, 0xa1, 0xa2, 0x1a, 0xbd, 0xd5, 0x31, 0x44, 0xce, 0x4a, 0xee, 0xf0, 0x78, 0x2a, 0x63,
-	0x4a, 0xbc, 0xfe, 0x0c, 0xfc, 0xcb, 0x09, 0x9f, 0xc4, 0x10, 0xa9, 0x02, 0xcb, 0x20, 0xc7, 0x9a,
-	0x3e, 0xe1, 0x64, 0xff, 0x54, 0xae, 0xf4, 0x23, 0xe3, 0xf8, 0xc7, 0x4d, 0x9f, 0x98, 0x1c, 0x0a,
-	0xc7, 0x41, 0xde, 0x8b, 0xea, 0x16, 0x09, 0x4a, 0x43, 0xd3, 0xca, 0xdc, 0xa8, 0x29, 0xff, 0xe9,
-	0x5f, 0xb2, 0xf2, 0x3b, 0xa4, 0x80, 0x34, 0x7c, 0x17, 0xfc, 0xcd, 0x79, 0xb6, 0x9d, 0x9a, 0xb4,
-	0x3c, 0x91, 0xaa, 0xb2, 0x56, 0x93, 0x9e, 0xff, 0x6a, 0x88, 0xbf, 0x70, 0x13, 0x8c, 0xb6, 0x0b,
-	0x1e, 0x53, 0x0c, 0x71, 0x8a, 0x99, 0x4e, 0x8a, 0x44, 0x7f, 0x8c, 0xad, 0xd6, 0xb9, 0xc5, 0x56,
-	0x0c, 0x13, 0x31, 0xf8, 0x1c, 0xe4, 0xc9, 0x6e, 0xe4, 0xb0, 0x66, 0x29, 0x3b, 0xad, 0xcc, 0x15,
-	0x57, 0x1e, 0xc6, 0x98, 0x1f, 0xc7, 0x53, 0xf7, 0x6d, 0x87, 0xed, 0x44, 0x96, 0x51, 0xa5, 0x75,
-	0xd4, 0xd9, 0xb1, 0xdb, 0x37, 0xaa, 0x3b, 0xd8, 0xf1, 0x50, 0x2b, 0x52, 0x8b, 0x0b, 0x11, 0x1a,
-	0x5b, 0x24, 0x70, 0xb0, 0xeb, 0xbc, 0xc2, 0x96, 0x4b, 0xd6, 0x3c, 0x66, 0x4a, 0x5e, 0xf8, 0x02,
-	0x8c, 0x38, 0x5e, 0x83, 0x78, 0x8c, 0x06, 0xcd, 0x52, 0xee, 0x82, 0x45, 0xda, 0xd4, 0x70, 0x15,
-	0x14, 0x19, 0x65, 0xd8, 0xdd, 0x0e, 0x77, 0x70, 0x40, 0xc2, 0xd2, 0x30, 0xaf, 0x4d, 0xdf, 0x26,
-	0x3e, 0x8a, 0xea, 0x5b, 0x1c, 0x24, 0x4b, 0x52, 0xe0, 0x89, 0x22, 0xa4, 0x6f, 0x83, 0xff, 0x79,
-	0xe3, 0x96, 0x5d, 0x97, 0xb7, 0xe1, 0x6c, 0x06, 0xe1, 0x2a, 0x00, 0xed, 0xcd, 0x90, 0xdd, 0x9b,
-	0x31, 0xc4, 0x1a, 0x19, 0xf1, 0x1a, 0x19, 0x62, 0x4f, 0xe5, 0x1a, 0x19, 0x1b, 0xd8, 0x26, 0x32,
-	0xd7, 0x4c, 0x64, 0xea, 0x1f, 0x14, 0x30, 0xde, 0xad, 0x20, 0xc7, 0xe3, 0x1e, 0xc8, 0x73, 0x87,
-	0xf1, 0x3c, 0x67, 0x7b, 0x3b, 0x2b, 0xdc, 0xf7, 0x8e, 0x95, 0x29, 0xb3, 0xe0, 0x83, 0x0e, 0x8b,
-	0x62, 0x3a, 0x66, 0xcf, 0xb5, 0x28, 0x49, 0x92, 0x1e, 0x3f, 0x2b, 0xe0, 0x12, 0xd7, 0x59, 0x7f,
-	0xe9, 0x91, 0x40, 0x54, 0xe6, 0xe2, 0xb7, 0xa4, 0xab, 0xa4, 0xd9, 0x3f, 0x2e, 0xe9, 0x27, 0x05,
-	0x94, 0x7a, 0xed, 0xca, 0xa2, 0x2e, 0x83, 0x22, 0x8d, 0xc3, 0x67, 0x83, 0x21, 0x4a, 0xab, 0xf5,
-	0xf3, 0xdd, 0x4e, 0x37, 0x0b, 0xb4, 0x4d, 0x75, 0x61, 0x75, 0xad, 0x7c, 0xcd, 0x81, 0x61, 0x6e,
-	0x14, 0x1e, 0x80, 0xbc, 0x78, 0x98, 0x60, 0x7a, 0x93, 0x3b, 0xde, 0x40, 0x75, 0xf6, 0x5c, 0x9c,
-	0x10, 0xd4, 0xf5, 0xd7, 0xdf, 0x7e, 0xbd, 0x1f, 0x9a, 0x84, 0x2a, 0x4a, 0x7d, 0x8c, 0xe1, 0x5b,
-	0x05, 0x0c, 0xf3, 0x2e, 0xc1, 0x6b, 0xe7, 0xcd, 0x98, 0x50, 0x1f, 0x70, 0x14, 0xf5, 0x32, 0x17,
-	0x5f, 0x84, 0xf3, 0x28, 0xed, 0xa1, 0x47, 0xfb, 0xf1, 0x4c, 0x1c, 0xa0, 0x7d, 0x31, 0x04, 0x07,
-	0xf0, 0x8d, 0x02, 0x46, 0x5a, 0xbb, 0x00, 0xe7, 0x53, 0x85, 0xba, 0x37, 0x52, 0x5d, 0x18, 0x04,
-	0x2a, 0x7d, 0x5d, 0xe5, 0xbe, 0x26, 0xe0, 0xe5, 0x54, 0x5f, 0xf0, 0xa3, 0x02, 0x0a, 0x89, 0x01,
-	0x82, 0x8b, 0xa9, 0xf4, 0xbd, 0x5b, 0xa1, 0x5e, 0x1f, 0x0c, 0x2c, 0xdd, 0x2c, 0x71, 0x37, 0x15,
-	0x78, 0xb3, 0x9f, 0x9b, 0xe4, 0xb4, 0x76, 0x17, 0x6b, 0x65, 0xf3, 0xf0, 0x44, 0x53, 0x8e, 0x4e,
-	0x34, 0xe5, 0xe7, 0x89, 0xa6, 0xbc, 0x3b, 0xd5, 0x32, 0x47, 0xa7, 0x5a, 0xe6, 0xfb, 0xa9, 0x96,
-	0x79, 0x7a, 0x67, 0xf0, 0xd7, 0x74, 0x4f, 0x2a, 0xf1, 0x47, 0xd5, 0xca, 0xf3, 0xf8, 0xad, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x91, 0x1e, 0x58, 0x7b, 0x08, 0x00, 0x00,
+func (m *QueryVaultQuotingStatusRequest) Reset()         { *m = QueryVaultQuotingStatusRequest{} }
+func (m *QueryVaultQuotingStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultQuotingStatusRequest) ProtoMessage()    {}
+func (*QueryVaultQuotingStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{8}
+}
+func (m *QueryVaultQuotingStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultQuotingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultQuotingStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultQuotingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultQuotingStatusRequest.Merge(m, src)
+}
+func (m *QueryVaultQuotingStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultQuotingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultQuotingStatusRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryVaultQuotingStatusRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries the Params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Vault by type and number.
-	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
-	// Queries all vaults.
-	AllVaults(ctx context.Context, in *QueryAllVaultsRequest, opts ...grpc.CallOption) (*QueryAllVaultsResponse, error)
-	// Queries owner shares of a vault.
-	OwnerShares(ctx context.Context, in *QueryOwnerSharesRequest, opts ...grpc.CallOption) (*QueryOwnerSharesResponse, error)
+func (m *QueryVaultQuotingStatusRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVaultQuotingStatusRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryVaultQuotingStatusResponse is a response type for the
+// VaultQuotingStatus RPC method.
+type QueryVaultQuotingStatusResponse struct {
+	// Whether the vault places orders.
+	Quoting bool `protobuf:"varint,1,opt,name=quoting,proto3" json:"quoting,omitempty"`
+	// Reason why the vault doesn't place orders. Empty if the vault is quoting.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVaultQuotingStatusResponse) Reset()         { *m = QueryVaultQuotingStatusResponse{} }
+func (m *QueryVaultQuotingStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultQuotingStatusResponse) ProtoMessage()    {}
+func (*QueryVaultQuotingStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{9}
 }
-
-func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
-	out := new(QueryVaultResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/Vault", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVaultQuotingStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultQuotingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultQuotingStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVaultQuotingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultQuotingStatusResponse.Merge(m, src)
+}
+func (m *QueryVaultQuotingStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultQuotingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultQuotingStatusResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllVaults(ctx context.Context, in *QueryAllVaultsRequest, opts ...grpc.CallOption) (*QueryAllVaultsResponse, error) {
-	out := new(QueryAllVaultsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/AllVaults", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultQuotingStatusResponse proto.InternalMessageInfo
+
+func (m *QueryVaultQuotingStatusResponse) GetQuoting() bool {
+	if m != nil {
+		return m.Quoting
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) OwnerShares(ctx context.Context, in *QueryOwnerSharesRequest, opts ...grpc.CallOption) (*QueryOwnerSharesResponse, error) {
-	out := new(QueryOwnerSharesResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/OwnerShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVaultQuotingStatusResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries the Params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Vault by type and number.
-	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
-	// Queries all vaults.
-	AllVaults(context.Context, *QueryAllVaultsRequest) (*QueryAllVaultsResponse, error)
-	// Queries owner shares of a vault.
-	OwnerShares(context.Context, *QueryOwnerSharesRequest) (*QueryOwnerSharesResponse, error)
+// QueryQuoteDepositRequest is a request type for the QuoteDeposit RPC method.
+type QueryQuoteDepositRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Amount of quote quantums to deposit.
+	QuoteQuantums uint64 `protobuf:"varint,3,opt,name=quote_quantums,json=quoteQuantums,proto3" json:"quote_quantums,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryQuoteDepositRequest) Reset()         { *m = QueryQuoteDepositRequest{} }
+func (m *QueryQuoteDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteDepositRequest) ProtoMessage()    {}
+func (*QueryQuoteDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{10}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryQuoteDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+func (m *QueryQuoteDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllVaults(ctx context.Context, req *QueryAllVaultsRequest) (*QueryAllVaultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllVaults not implemented")
+func (m *QueryQuoteDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteDepositRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OwnerShares(ctx context.Context, req *QueryOwnerSharesRequest) (*QueryOwnerSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OwnerShares not implemented")
+func (m *QueryQuoteDepositRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryQuoteDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteDepositRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryQuoteDepositRequest proto.InternalMessageInfo
 
-func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVaultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Query/Vault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+func (m *QueryQuoteDepositRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
 	}
-	return interceptor(ctx, in, info, handler)
+	return VaultType_VAULT_TYPE_UNSPECIFIED
 }
 
-func _Query_AllVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVaultsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllVaults(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Query/AllVaults",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllVaults(ctx, req.(*QueryAllVaultsRequest))
+func (m *QueryQuoteDepositRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_OwnerShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOwnerSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OwnerShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Query/OwnerShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OwnerShares(ctx, req.(*QueryOwnerSharesRequest))
+func (m *QueryQuoteDepositRequest) GetQuoteQuantums() uint64 {
+	if m != nil {
+		return m.QuoteQuantums
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.vault.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Vault",
-			Handler:    _Query_Vault_Handler,
-		},
-		{
-			MethodName: "AllVaults",
-			Handler:    _Query_AllVaults_Handler,
-		},
-		{
-			MethodName: "OwnerShares",
-			Handler:    _Query_OwnerShares_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/vault/query.proto",
+// QueryQuoteDepositResponse is a response type for the QuoteDeposit RPC
+// method.
+type QueryQuoteDepositResponse struct {
+	// Number of shares that the deposit would mint.
+	SharesOut NumShares `protobuf:"bytes,1,opt,name=shares_out,json=sharesOut,proto3" json:"shares_out"`
+	// Price of one share in quote quantums, in parts per million, i.e.
+	// `equity * 1_000_000 / total_shares`, or 1_000_000 if the vault has no
+	// shares yet.
+	SharePricePpm github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=share_price_ppm,json=sharePricePpm,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"share_price_ppm"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryQuoteDepositResponse) Reset()         { *m = QueryQuoteDepositResponse{} }
+func (m *QueryQuoteDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteDepositResponse) ProtoMessage()    {}
+func (*QueryQuoteDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{11}
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQuoteDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryQuoteDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteDepositResponse.Merge(m, src)
+}
+func (m *QueryQuoteDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteDepositResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuoteDepositResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteDepositResponse) GetSharesOut() NumShares {
+	if m != nil {
+		return m.SharesOut
 	}
-	return dAtA[:n], nil
+	return NumShares{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultQuoteCurveRequest is a request type for the VaultQuoteCurve RPC
+// method.
+type QueryVaultQuoteCurveRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVaultQuoteCurveRequest) Reset()         { *m = QueryVaultQuoteCurveRequest{} }
+func (m *QueryVaultQuoteCurveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultQuoteCurveRequest) ProtoMessage()    {}
+func (*QueryVaultQuoteCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{12}
+}
+func (m *QueryVaultQuoteCurveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultQuoteCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultQuoteCurveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVaultQuoteCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultQuoteCurveRequest.Merge(m, src)
 }
-
-func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultQuoteCurveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultQuoteCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultQuoteCurveRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Number != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryVaultQuoteCurveRequest proto.InternalMessageInfo
+
+func (m *QueryVaultQuoteCurveRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return VaultType_VAULT_TYPE_UNSPECIFIED
 }
 
-func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultQuoteCurveRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultQuoteCurveResponse is a response type for the VaultQuoteCurve RPC
+// method.
+type QueryVaultQuoteCurveResponse struct {
+	// Points of the quote curve, one for each order that the vault would place,
+	// in the same order as the vault's orders (ask then bid at each layer).
+	Points []QuoteCurvePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+	// Oracle price in subticks (rounded down) for reference.
+	OracleSubticks uint64 `protobuf:"varint,2,opt,name=oracle_subticks,json=oracleSubticks,proto3" json:"oracle_subticks,omitempty"`
 }
 
-func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVaultQuoteCurveResponse) Reset()         { *m = QueryVaultQuoteCurveResponse{} }
+func (m *QueryVaultQuoteCurveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultQuoteCurveResponse) ProtoMessage()    {}
+func (*QueryVaultQuoteCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{13}
+}
+func (m *QueryVaultQuoteCurveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultQuoteCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultQuoteCurveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Inventory.Size()
-		i -= size
-		if _, err := m.Inventory.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Equity.Size()
-		i -= size
-		if _, err := m.Equity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryVaultQuoteCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultQuoteCurveResponse.Merge(m, src)
+}
+func (m *QueryVaultQuoteCurveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultQuoteCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultQuoteCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultQuoteCurveResponse proto.InternalMessageInfo
+
+func (m *QueryVaultQuoteCurveResponse) GetPoints() []QuoteCurvePoint {
+	if m != nil {
+		return m.Points
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *QueryVaultQuoteCurveResponse) GetOracleSubticks() uint64 {
+	if m != nil {
+		return m.OracleSubticks
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// QuoteCurvePoint is a point on a vault's quote curve.
+type QuoteCurvePoint struct {
+	// Side of the order.
+	Side types1.Order_Side `protobuf:"varint,1,opt,name=side,proto3,enum=dydxprotocol.clob.Order_Side" json:"side,omitempty"`
+	// Price of the order in subticks.
+	Subticks uint64 `protobuf:"varint,2,opt,name=subticks,proto3" json:"subticks,omitempty"`
+	// Size of the order in base quantums.
+	Quantums uint64 `protobuf:"varint,3,opt,name=quantums,proto3" json:"quantums,omitempty"`
+	// Layer of the order, starting from 0 for the innermost layer.
+	Layer uint32 `protobuf:"varint,4,opt,name=layer,proto3" json:"layer,omitempty"`
+}
+
+func (m *QuoteCurvePoint) Reset()         { *m = QuoteCurvePoint{} }
+func (m *QuoteCurvePoint) String() string { return proto.CompactTextString(m) }
+func (*QuoteCurvePoint) ProtoMessage()    {}
+func (*QuoteCurvePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{14}
+}
+func (m *QuoteCurvePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteCurvePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteCurvePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuoteCurvePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteCurvePoint.Merge(m, src)
+}
+func (m *QuoteCurvePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteCurvePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteCurvePoint.DiscardUnknown(m)
 }
 
-func (m *QueryAllVaultsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuoteCurvePoint proto.InternalMessageInfo
+
+func (m *QuoteCurvePoint) GetSide() types1.Order_Side {
+	if m != nil {
+		return m.Side
 	}
-	return dAtA[:n], nil
+	return types1.Order_SIDE_UNSPECIFIED
 }
 
-func (m *QueryAllVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuoteCurvePoint) GetSubticks() uint64 {
+	if m != nil {
+		return m.Subticks
+	}
+	return 0
 }
 
-func (m *QueryAllVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuoteCurvePoint) GetQuantums() uint64 {
+	if m != nil {
+		return m.Quantums
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllVaultsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuoteCurvePoint) GetLayer() uint32 {
+	if m != nil {
+		return m.Layer
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultQuotedNotionalRequest is a request type for the VaultQuotedNotional
+// RPC method.
+type QueryVaultQuotedNotionalRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *QueryAllVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVaultQuotedNotionalRequest) Reset()         { *m = QueryVaultQuotedNotionalRequest{} }
+func (m *QueryVaultQuotedNotionalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultQuotedNotionalRequest) ProtoMessage()    {}
+func (*QueryVaultQuotedNotionalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{15}
+}
+func (m *QueryVaultQuotedNotionalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultQuotedNotionalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultQuotedNotionalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryVaultQuotedNotionalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultQuotedNotionalRequest.Merge(m, src)
+}
+func (m *QueryVaultQuotedNotionalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultQuotedNotionalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultQuotedNotionalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultQuotedNotionalRequest proto.InternalMessageInfo
+
+func (m *QueryVaultQuotedNotionalRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return VaultType_VAULT_TYPE_UNSPECIFIED
 }
 
-func (m *QueryOwnerSharesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultQuotedNotionalRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOwnerSharesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVaultQuotedNotionalResponse is a response type for the
+// VaultQuotedNotional RPC method.
+type QueryVaultQuotedNotionalResponse struct {
+	// Total notional (in quote quantums) of the vault's bids.
+	BidNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=bid_notional,json=bidNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"bid_notional"`
+	// Total notional (in quote quantums) of the vault's asks.
+	AskNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=ask_notional,json=askNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"ask_notional"`
 }
 
-func (m *QueryOwnerSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVaultQuotedNotionalResponse) Reset()         { *m = QueryVaultQuotedNotionalResponse{} }
+func (m *QueryVaultQuotedNotionalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultQuotedNotionalResponse) ProtoMessage()    {}
+func (*QueryVaultQuotedNotionalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{16}
+}
+func (m *QueryVaultQuotedNotionalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultQuotedNotionalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultQuotedNotionalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Number != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryVaultQuotedNotionalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultQuotedNotionalResponse.Merge(m, src)
+}
+func (m *QueryVaultQuotedNotionalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultQuotedNotionalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultQuotedNotionalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultQuotedNotionalResponse proto.InternalMessageInfo
+
+// QueryDecodeVaultClientIdRequest is a request type for the DecodeVaultClientId
+// RPC method.
+type QueryDecodeVaultClientIdRequest struct {
+	ClientId uint32 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryDecodeVaultClientIdRequest) Reset()         { *m = QueryDecodeVaultClientIdRequest{} }
+func (m *QueryDecodeVaultClientIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodeVaultClientIdRequest) ProtoMessage()    {}
+func (*QueryDecodeVaultClientIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{17}
+}
+func (m *QueryDecodeVaultClientIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodeVaultClientIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodeVaultClientIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDecodeVaultClientIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodeVaultClientIdRequest.Merge(m, src)
+}
+func (m *QueryDecodeVaultClientIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodeVaultClientIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodeVaultClientIdRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOwnerSharesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDecodeVaultClientIdRequest proto.InternalMessageInfo
+
+func (m *QueryDecodeVaultClientIdRequest) GetClientId() uint32 {
+	if m != nil {
+		return m.ClientId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOwnerSharesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDecodeVaultClientIdResponse is a response type for the
+// DecodeVaultClientId RPC method.
+type QueryDecodeVaultClientIdResponse struct {
+	// Side of the order.
+	Side types1.Order_Side `protobuf:"varint,1,opt,name=side,proto3,enum=dydxprotocol.clob.Order_Side" json:"side,omitempty"`
+	// Parity of the block height at which the order was placed.
+	BlockParity uint32 `protobuf:"varint,2,opt,name=block_parity,json=blockParity,proto3" json:"block_parity,omitempty"`
+	// Layer of the order.
+	Layer uint32 `protobuf:"varint,3,opt,name=layer,proto3" json:"layer,omitempty"`
 }
 
-func (m *QueryOwnerSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OwnerShares) > 0 {
-		for iNdEx := len(m.OwnerShares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OwnerShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDecodeVaultClientIdResponse) Reset()         { *m = QueryDecodeVaultClientIdResponse{} }
+func (m *QueryDecodeVaultClientIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodeVaultClientIdResponse) ProtoMessage()    {}
+func (*QueryDecodeVaultClientIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{18}
+}
+func (m *QueryDecodeVaultClientIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodeVaultClientIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodeVaultClientIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDecodeVaultClientIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodeVaultClientIdResponse.Merge(m, src)
+}
+func (m *QueryDecodeVaultClientIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodeVaultClientIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodeVaultClientIdResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDecodeVaultClientIdResponse proto.InternalMessageInfo
+
+func (m *QueryDecodeVaultClientIdResponse) GetSide() types1.Order_Side {
+	if m != nil {
+		return m.Side
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return types1.Order_SIDE_UNSPECIFIED
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryDecodeVaultClientIdResponse) GetBlockParity() uint32 {
+	if m != nil {
+		return m.BlockParity
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDecodeVaultClientIdResponse) GetLayer() uint32 {
+	if m != nil {
+		return m.Layer
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryVaultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	if m.Number != 0 {
-		n += 1 + sovQuery(uint64(m.Number))
-	}
-	return n
+// QueryVaultFillStatsRequest is a request type for the VaultFillStats RPC
+// method.
+type QueryVaultFillStatsRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *QueryVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVaultFillStatsRequest) Reset()         { *m = QueryVaultFillStatsRequest{} }
+func (m *QueryVaultFillStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultFillStatsRequest) ProtoMessage()    {}
+func (*QueryVaultFillStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{19}
+}
+func (m *QueryVaultFillStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultFillStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultFillStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.VaultId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.SubaccountId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Equity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Inventory.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalShares.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryVaultFillStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultFillStatsRequest.Merge(m, src)
+}
+func (m *QueryVaultFillStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultFillStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultFillStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllVaultsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryVaultFillStatsRequest proto.InternalMessageInfo
+
+func (m *QueryVaultFillStatsRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
 	}
-	return n
+	return VaultType_VAULT_TYPE_UNSPECIFIED
 }
 
-func (m *QueryAllVaultsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVaultFillStatsRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
 	}
-	var l int
-	_ = l
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
-			l = e.Size()
+	return 0
+}
+
+// QueryVaultFillStatsResponse is a response type for the VaultFillStats RPC
+// method.
+type QueryVaultFillStatsResponse struct {
+	Stats VaultFillStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryVaultFillStatsResponse) Reset()         { *m = QueryVaultFillStatsResponse{} }
+func (m *QueryVaultFillStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultFillStatsResponse) ProtoMessage()    {}
+func (*QueryVaultFillStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{20}
+}
+func (m *QueryVaultFillStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultFillStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultFillStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultFillStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultFillStatsResponse.Merge(m, src)
+}
+func (m *QueryVaultFillStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultFillStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultFillStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultFillStatsResponse proto.InternalMessageInfo
+
+func (m *QueryVaultFillStatsResponse) GetStats() VaultFillStats {
+	if m != nil {
+		return m.Stats
+	}
+	return VaultFillStats{}
+}
+
+// QueryVaultMarginRequest is a request type for the VaultMargin RPC method.
+type QueryVaultMarginRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryVaultMarginRequest) Reset()         { *m = QueryVaultMarginRequest{} }
+func (m *QueryVaultMarginRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultMarginRequest) ProtoMessage()    {}
+func (*QueryVaultMarginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{21}
+}
+func (m *QueryVaultMarginRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultMarginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultMarginRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultMarginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultMarginRequest.Merge(m, src)
+}
+func (m *QueryVaultMarginRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultMarginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultMarginRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultMarginRequest proto.InternalMessageInfo
+
+func (m *QueryVaultMarginRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryVaultMarginRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryVaultMarginResponse is a response type for the VaultMargin RPC method.
+type QueryVaultMarginResponse struct {
+	// Initial margin requirement (in quote quantums) of the vault.
+	InitialMarginQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=initial_margin_quote_quantums,json=initialMarginQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin_quote_quantums"`
+	// Maintenance margin requirement (in quote quantums) of the vault.
+	MaintenanceMarginQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=maintenance_margin_quote_quantums,json=maintenanceMarginQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin_quote_quantums"`
+	// Free collateral (in quote quantums) of the vault, i.e. net collateral minus
+	// initial margin requirement.
+	FreeCollateralQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=free_collateral_quote_quantums,json=freeCollateralQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"free_collateral_quote_quantums"`
+}
+
+func (m *QueryVaultMarginResponse) Reset()         { *m = QueryVaultMarginResponse{} }
+func (m *QueryVaultMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultMarginResponse) ProtoMessage()    {}
+func (*QueryVaultMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{22}
+}
+func (m *QueryVaultMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultMarginResponse.Merge(m, src)
+}
+func (m *QueryVaultMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultMarginResponse proto.InternalMessageInfo
+
+// QueryVaultActivityLogRequest is a request type for the VaultActivityLog RPC
+// method.
+type QueryVaultActivityLogRequest struct {
+	Type       VaultType          `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number     uint32             `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVaultActivityLogRequest) Reset()         { *m = QueryVaultActivityLogRequest{} }
+func (m *QueryVaultActivityLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultActivityLogRequest) ProtoMessage()    {}
+func (*QueryVaultActivityLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{23}
+}
+func (m *QueryVaultActivityLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultActivityLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultActivityLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultActivityLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultActivityLogRequest.Merge(m, src)
+}
+func (m *QueryVaultActivityLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultActivityLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultActivityLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultActivityLogRequest proto.InternalMessageInfo
+
+func (m *QueryVaultActivityLogRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryVaultActivityLogRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryVaultActivityLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVaultActivityLogResponse is a response type for the VaultActivityLog RPC
+// method.
+type QueryVaultActivityLogResponse struct {
+	Activities []*VaultActivity    `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVaultActivityLogResponse) Reset()         { *m = QueryVaultActivityLogResponse{} }
+func (m *QueryVaultActivityLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultActivityLogResponse) ProtoMessage()    {}
+func (*QueryVaultActivityLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{24}
+}
+func (m *QueryVaultActivityLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultActivityLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultActivityLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultActivityLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultActivityLogResponse.Merge(m, src)
+}
+func (m *QueryVaultActivityLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultActivityLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultActivityLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultActivityLogResponse proto.InternalMessageInfo
+
+func (m *QueryVaultActivityLogResponse) GetActivities() []*VaultActivity {
+	if m != nil {
+		return m.Activities
+	}
+	return nil
+}
+
+func (m *QueryVaultActivityLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVaultRefreshHistoryRequest is a request type for the VaultRefreshHistory
+// RPC method.
+type QueryVaultRefreshHistoryRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryVaultRefreshHistoryRequest) Reset()         { *m = QueryVaultRefreshHistoryRequest{} }
+func (m *QueryVaultRefreshHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRefreshHistoryRequest) ProtoMessage()    {}
+func (*QueryVaultRefreshHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{25}
+}
+func (m *QueryVaultRefreshHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRefreshHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRefreshHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultRefreshHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRefreshHistoryRequest.Merge(m, src)
+}
+func (m *QueryVaultRefreshHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRefreshHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRefreshHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultRefreshHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryVaultRefreshHistoryRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryVaultRefreshHistoryRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryVaultRefreshHistoryResponse is a response type for the
+// VaultRefreshHistory RPC method.
+type QueryVaultRefreshHistoryResponse struct {
+	Refreshes []VaultRefresh `protobuf:"bytes,1,rep,name=refreshes,proto3" json:"refreshes"`
+}
+
+func (m *QueryVaultRefreshHistoryResponse) Reset()         { *m = QueryVaultRefreshHistoryResponse{} }
+func (m *QueryVaultRefreshHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRefreshHistoryResponse) ProtoMessage()    {}
+func (*QueryVaultRefreshHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{26}
+}
+func (m *QueryVaultRefreshHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRefreshHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRefreshHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultRefreshHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRefreshHistoryResponse.Merge(m, src)
+}
+func (m *QueryVaultRefreshHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRefreshHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRefreshHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultRefreshHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryVaultRefreshHistoryResponse) GetRefreshes() []VaultRefresh {
+	if m != nil {
+		return m.Refreshes
+	}
+	return nil
+}
+
+// QueryVaultValueAtRiskRequest is a request type for the VaultValueAtRisk RPC
+// method.
+type QueryVaultValueAtRiskRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Confidence (in ppm) of the value at risk.
+	ConfidencePpm uint32 `protobuf:"varint,3,opt,name=confidence_ppm,json=confidencePpm,proto3" json:"confidence_ppm,omitempty"`
+}
+
+func (m *QueryVaultValueAtRiskRequest) Reset()         { *m = QueryVaultValueAtRiskRequest{} }
+func (m *QueryVaultValueAtRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultValueAtRiskRequest) ProtoMessage()    {}
+func (*QueryVaultValueAtRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{27}
+}
+func (m *QueryVaultValueAtRiskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultValueAtRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultValueAtRiskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultValueAtRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultValueAtRiskRequest.Merge(m, src)
+}
+func (m *QueryVaultValueAtRiskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultValueAtRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultValueAtRiskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultValueAtRiskRequest proto.InternalMessageInfo
+
+func (m *QueryVaultValueAtRiskRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryVaultValueAtRiskRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryVaultValueAtRiskRequest) GetConfidencePpm() uint32 {
+	if m != nil {
+		return m.ConfidencePpm
+	}
+	return 0
+}
+
+// QueryVaultValueAtRiskResponse is a response type for the VaultValueAtRisk RPC
+// method.
+type QueryVaultValueAtRiskResponse struct {
+	// Value at risk (in quote quantums) of the vault's position.
+	ValueAtRiskQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=value_at_risk_quote_quantums,json=valueAtRiskQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"value_at_risk_quote_quantums"`
+}
+
+func (m *QueryVaultValueAtRiskResponse) Reset()         { *m = QueryVaultValueAtRiskResponse{} }
+func (m *QueryVaultValueAtRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultValueAtRiskResponse) ProtoMessage()    {}
+func (*QueryVaultValueAtRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{28}
+}
+func (m *QueryVaultValueAtRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultValueAtRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultValueAtRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultValueAtRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultValueAtRiskResponse.Merge(m, src)
+}
+func (m *QueryVaultValueAtRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultValueAtRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultValueAtRiskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultValueAtRiskResponse proto.InternalMessageInfo
+
+// QueryOwnerUnrealizedPnlRequest is a request type for the OwnerUnrealizedPnl
+// RPC method.
+type QueryOwnerUnrealizedPnlRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Owner  string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) Reset()         { *m = QueryOwnerUnrealizedPnlRequest{} }
+func (m *QueryOwnerUnrealizedPnlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerUnrealizedPnlRequest) ProtoMessage()    {}
+func (*QueryOwnerUnrealizedPnlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{29}
+}
+func (m *QueryOwnerUnrealizedPnlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerUnrealizedPnlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerUnrealizedPnlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnerUnrealizedPnlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerUnrealizedPnlRequest.Merge(m, src)
+}
+func (m *QueryOwnerUnrealizedPnlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerUnrealizedPnlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerUnrealizedPnlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerUnrealizedPnlRequest proto.InternalMessageInfo
+
+func (m *QueryOwnerUnrealizedPnlRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryOwnerUnrealizedPnlResponse is a response type for the OwnerUnrealizedPnl
+// RPC method.
+type QueryOwnerUnrealizedPnlResponse struct {
+	// Unrealized PnL (in quote quantums) of the owner's shares, i.e. current value
+	// of the shares minus their cost basis.
+	UnrealizedPnlQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=unrealized_pnl_quote_quantums,json=unrealizedPnlQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"unrealized_pnl_quote_quantums"`
+	// Cost basis (in quote quantums) of the owner's shares.
+	CostBasisQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=cost_basis_quote_quantums,json=costBasisQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"cost_basis_quote_quantums"`
+}
+
+func (m *QueryOwnerUnrealizedPnlResponse) Reset()         { *m = QueryOwnerUnrealizedPnlResponse{} }
+func (m *QueryOwnerUnrealizedPnlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerUnrealizedPnlResponse) ProtoMessage()    {}
+func (*QueryOwnerUnrealizedPnlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{30}
+}
+func (m *QueryOwnerUnrealizedPnlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerUnrealizedPnlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerUnrealizedPnlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnerUnrealizedPnlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerUnrealizedPnlResponse.Merge(m, src)
+}
+func (m *QueryOwnerUnrealizedPnlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerUnrealizedPnlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerUnrealizedPnlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerUnrealizedPnlResponse proto.InternalMessageInfo
+
+// QueryTotalVaultTvlRequest is a request type for the TotalVaultTvl RPC method.
+type QueryTotalVaultTvlRequest struct {
+}
+
+func (m *QueryTotalVaultTvlRequest) Reset()         { *m = QueryTotalVaultTvlRequest{} }
+func (m *QueryTotalVaultTvlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVaultTvlRequest) ProtoMessage()    {}
+func (*QueryTotalVaultTvlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{31}
+}
+func (m *QueryTotalVaultTvlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVaultTvlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVaultTvlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalVaultTvlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVaultTvlRequest.Merge(m, src)
+}
+func (m *QueryTotalVaultTvlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVaultTvlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVaultTvlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalVaultTvlRequest proto.InternalMessageInfo
+
+// QueryTotalVaultTvlResponse is a response type for the TotalVaultTvl RPC
+// method.
+type QueryTotalVaultTvlResponse struct {
+	// Sum of equity (in quote quantums) of all vaults.
+	TotalEquityQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=total_equity_quote_quantums,json=totalEquityQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_equity_quote_quantums"`
+	// Number of vaults.
+	VaultCount uint32 `protobuf:"varint,2,opt,name=vault_count,json=vaultCount,proto3" json:"vault_count,omitempty"`
+}
+
+func (m *QueryTotalVaultTvlResponse) Reset()         { *m = QueryTotalVaultTvlResponse{} }
+func (m *QueryTotalVaultTvlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVaultTvlResponse) ProtoMessage()    {}
+func (*QueryTotalVaultTvlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{32}
+}
+func (m *QueryTotalVaultTvlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVaultTvlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVaultTvlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalVaultTvlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVaultTvlResponse.Merge(m, src)
+}
+func (m *QueryTotalVaultTvlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVaultTvlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVaultTvlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalVaultTvlResponse proto.InternalMessageInfo
+
+func (m *QueryTotalVaultTvlResponse) GetVaultCount() uint32 {
+	if m != nil {
+		return m.VaultCount
+	}
+	return 0
+}
+
+// QueryTotalVaultInventoryRequest is a request type for the TotalVaultInventory
+// RPC method.
+type QueryTotalVaultInventoryRequest struct {
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+}
+
+func (m *QueryTotalVaultInventoryRequest) Reset()         { *m = QueryTotalVaultInventoryRequest{} }
+func (m *QueryTotalVaultInventoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVaultInventoryRequest) ProtoMessage()    {}
+func (*QueryTotalVaultInventoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{33}
+}
+func (m *QueryTotalVaultInventoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVaultInventoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVaultInventoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalVaultInventoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVaultInventoryRequest.Merge(m, src)
+}
+func (m *QueryTotalVaultInventoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVaultInventoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVaultInventoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalVaultInventoryRequest proto.InternalMessageInfo
+
+func (m *QueryTotalVaultInventoryRequest) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
+}
+
+// QueryTotalVaultInventoryResponse is a response type for the
+// TotalVaultInventory RPC method.
+type QueryTotalVaultInventoryResponse struct {
+	// Sum of signed positions (in base quantums) of all vaults in the perpetual
+	// of the clob pair.
+	InventoryBaseQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=inventory_base_quantums,json=inventoryBaseQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"inventory_base_quantums"`
+}
+
+func (m *QueryTotalVaultInventoryResponse) Reset()         { *m = QueryTotalVaultInventoryResponse{} }
+func (m *QueryTotalVaultInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVaultInventoryResponse) ProtoMessage()    {}
+func (*QueryTotalVaultInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{34}
+}
+func (m *QueryTotalVaultInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVaultInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVaultInventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalVaultInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVaultInventoryResponse.Merge(m, src)
+}
+func (m *QueryTotalVaultInventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVaultInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVaultInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalVaultInventoryResponse proto.InternalMessageInfo
+
+// QueryExplainVaultOrderRequest is a request type for the ExplainVaultOrder RPC
+// method.
+type QueryExplainVaultOrderRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Side of the order.
+	Side types1.Order_Side `protobuf:"varint,3,opt,name=side,proto3,enum=dydxprotocol.clob.Order_Side" json:"side,omitempty"`
+	// Layer of the order, starting from 0 for the innermost layer.
+	Layer uint32 `protobuf:"varint,4,opt,name=layer,proto3" json:"layer,omitempty"`
+}
+
+func (m *QueryExplainVaultOrderRequest) Reset()         { *m = QueryExplainVaultOrderRequest{} }
+func (m *QueryExplainVaultOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainVaultOrderRequest) ProtoMessage()    {}
+func (*QueryExplainVaultOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{35}
+}
+func (m *QueryExplainVaultOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExplainVaultOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExplainVaultOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExplainVaultOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainVaultOrderRequest.Merge(m, src)
+}
+func (m *QueryExplainVaultOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExplainVaultOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainVaultOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainVaultOrderRequest proto.InternalMessageInfo
+
+func (m *QueryExplainVaultOrderRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryExplainVaultOrderRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryExplainVaultOrderRequest) GetSide() types1.Order_Side {
+	if m != nil {
+		return m.Side
+	}
+	return types1.Order_SIDE_UNSPECIFIED
+}
+
+func (m *QueryExplainVaultOrderRequest) GetLayer() uint32 {
+	if m != nil {
+		return m.Layer
+	}
+	return 0
+}
+
+// QueryExplainVaultOrderResponse is a response type for the ExplainVaultOrder
+// RPC method.
+type QueryExplainVaultOrderResponse struct {
+	Explanation VaultOrderExplanation `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation"`
+}
+
+func (m *QueryExplainVaultOrderResponse) Reset()         { *m = QueryExplainVaultOrderResponse{} }
+func (m *QueryExplainVaultOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainVaultOrderResponse) ProtoMessage()    {}
+func (*QueryExplainVaultOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{36}
+}
+func (m *QueryExplainVaultOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExplainVaultOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExplainVaultOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExplainVaultOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainVaultOrderResponse.Merge(m, src)
+}
+func (m *QueryExplainVaultOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExplainVaultOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainVaultOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExplainVaultOrderResponse proto.InternalMessageInfo
+
+func (m *QueryExplainVaultOrderResponse) GetExplanation() VaultOrderExplanation {
+	if m != nil {
+		return m.Explanation
+	}
+	return VaultOrderExplanation{}
+}
+
+// VaultOrderExplanation contains intermediate values of the quoting computation
+// of a vault order.
+type VaultOrderExplanation struct {
+	// Side of the order.
+	Side types1.Order_Side `protobuf:"varint,1,opt,name=side,proto3,enum=dydxprotocol.clob.Order_Side" json:"side,omitempty"`
+	// Layer of the order, starting from 0 for the innermost layer.
+	Layer uint32 `protobuf:"varint,2,opt,name=layer,proto3" json:"layer,omitempty"`
+	// Spread (in ppm) of the order's layer, i.e. `spread * (layer + 1)` or
+	// `spread * spread_multiplier` if spread multipliers are set.
+	SpreadPpm uint64 `protobuf:"varint,3,opt,name=spread_ppm,json=spreadPpm,proto3" json:"spread_ppm,omitempty"`
+	// Leverage (in ppm) of the order's layer, i.e. `leverage - layer *
+	// order_size_pct` for asks and `leverage + layer * order_size_pct` for bids.
+	LeveragePpm github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=leverage_ppm,json=leveragePpm,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"leverage_ppm"`
+	// Skew (in ppm) of the order's layer, i.e. `-leverage * spread *
+	// skew_factor`.
+	SkewPpm github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=skew_ppm,json=skewPpm,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"skew_ppm"`
+	// Price in subticks of `oracle_price * (1 + skew +/- spread)` (+ for asks
+	// and - for bids), rounded up for asks and down for bids.
+	RawSubticks github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,6,opt,name=raw_subticks,json=rawSubticks,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"raw_subticks"`
+	// Raw price in subticks bounded to be at least (for asks) or at most (for
+	// bids) oracle price and `min_ticks_from_oracle_per_side` ticks away from it.
+	BoundedSubticks github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,7,opt,name=bounded_subticks,json=boundedSubticks,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"bounded_subticks"`
+	// Price of the order in subticks, i.e. bounded price rounded to a multiple
+	// of subticks per tick (up for asks and down for bids) after jitter,
+	// clamping, and de-duplication of price levels across layers.
+	RoundedSubticks uint64 `protobuf:"varint,8,opt,name=rounded_subticks,json=roundedSubticks,proto3" json:"rounded_subticks,omitempty"`
+	// Size of the order in base quantums.
+	SizeBaseQuantums uint64 `protobuf:"varint,9,opt,name=size_base_quantums,json=sizeBaseQuantums,proto3" json:"size_base_quantums,omitempty"`
+}
+
+func (m *VaultOrderExplanation) Reset()         { *m = VaultOrderExplanation{} }
+func (m *VaultOrderExplanation) String() string { return proto.CompactTextString(m) }
+func (*VaultOrderExplanation) ProtoMessage()    {}
+func (*VaultOrderExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{37}
+}
+func (m *VaultOrderExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultOrderExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultOrderExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultOrderExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultOrderExplanation.Merge(m, src)
+}
+func (m *VaultOrderExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultOrderExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultOrderExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultOrderExplanation proto.InternalMessageInfo
+
+func (m *VaultOrderExplanation) GetSide() types1.Order_Side {
+	if m != nil {
+		return m.Side
+	}
+	return types1.Order_SIDE_UNSPECIFIED
+}
+
+func (m *VaultOrderExplanation) GetLayer() uint32 {
+	if m != nil {
+		return m.Layer
+	}
+	return 0
+}
+
+func (m *VaultOrderExplanation) GetSpreadPpm() uint64 {
+	if m != nil {
+		return m.SpreadPpm
+	}
+	return 0
+}
+
+func (m *VaultOrderExplanation) GetRoundedSubticks() uint64 {
+	if m != nil {
+		return m.RoundedSubticks
+	}
+	return 0
+}
+
+func (m *VaultOrderExplanation) GetSizeBaseQuantums() uint64 {
+	if m != nil {
+		return m.SizeBaseQuantums
+	}
+	return 0
+}
+
+// QueryOrphanedVaultOrdersRequest is a request type for the OrphanedVaultOrders
+// RPC method.
+type QueryOrphanedVaultOrdersRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryOrphanedVaultOrdersRequest) Reset()         { *m = QueryOrphanedVaultOrdersRequest{} }
+func (m *QueryOrphanedVaultOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrphanedVaultOrdersRequest) ProtoMessage()    {}
+func (*QueryOrphanedVaultOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{38}
+}
+func (m *QueryOrphanedVaultOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrphanedVaultOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrphanedVaultOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrphanedVaultOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrphanedVaultOrdersRequest.Merge(m, src)
+}
+func (m *QueryOrphanedVaultOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrphanedVaultOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrphanedVaultOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrphanedVaultOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryOrphanedVaultOrdersRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryOrphanedVaultOrdersRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryOrphanedVaultOrdersResponse is a response type for the
+// OrphanedVaultOrders RPC method.
+type QueryOrphanedVaultOrdersResponse struct {
+	// Resting orders of the vault that are orphaned.
+	Orders []types1.Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+}
+
+func (m *QueryOrphanedVaultOrdersResponse) Reset()         { *m = QueryOrphanedVaultOrdersResponse{} }
+func (m *QueryOrphanedVaultOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrphanedVaultOrdersResponse) ProtoMessage()    {}
+func (*QueryOrphanedVaultOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{39}
+}
+func (m *QueryOrphanedVaultOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrphanedVaultOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrphanedVaultOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrphanedVaultOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrphanedVaultOrdersResponse.Merge(m, src)
+}
+func (m *QueryOrphanedVaultOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrphanedVaultOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrphanedVaultOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrphanedVaultOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryOrphanedVaultOrdersResponse) GetOrders() []types1.Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+// QueryVaultMakerEdgeRequest is a request type for the VaultMakerEdge RPC
+// method.
+type QueryVaultMakerEdgeRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryVaultMakerEdgeRequest) Reset()         { *m = QueryVaultMakerEdgeRequest{} }
+func (m *QueryVaultMakerEdgeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultMakerEdgeRequest) ProtoMessage()    {}
+func (*QueryVaultMakerEdgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{40}
+}
+func (m *QueryVaultMakerEdgeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultMakerEdgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultMakerEdgeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultMakerEdgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultMakerEdgeRequest.Merge(m, src)
+}
+func (m *QueryVaultMakerEdgeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultMakerEdgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultMakerEdgeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultMakerEdgeRequest proto.InternalMessageInfo
+
+func (m *QueryVaultMakerEdgeRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryVaultMakerEdgeRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryVaultMakerEdgeResponse is a response type for the VaultMakerEdge RPC
+// method.
+type QueryVaultMakerEdgeResponse struct {
+	// Maker edge (in ppm) of the vault, which is negative if estimated adverse
+	// selection exceeds effective spread.
+	MakerEdgePpm int64 `protobuf:"zigzag64,1,opt,name=maker_edge_ppm,json=makerEdgePpm,proto3" json:"maker_edge_ppm,omitempty"`
+}
+
+func (m *QueryVaultMakerEdgeResponse) Reset()         { *m = QueryVaultMakerEdgeResponse{} }
+func (m *QueryVaultMakerEdgeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultMakerEdgeResponse) ProtoMessage()    {}
+func (*QueryVaultMakerEdgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{41}
+}
+func (m *QueryVaultMakerEdgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultMakerEdgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultMakerEdgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultMakerEdgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultMakerEdgeResponse.Merge(m, src)
+}
+func (m *QueryVaultMakerEdgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultMakerEdgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultMakerEdgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultMakerEdgeResponse proto.InternalMessageInfo
+
+func (m *QueryVaultMakerEdgeResponse) GetMakerEdgePpm() int64 {
+	if m != nil {
+		return m.MakerEdgePpm
+	}
+	return 0
+}
+
+// QueryCurrentBlockClientIdParityRequest is a request type for the
+// CurrentBlockClientIdParity RPC method.
+type QueryCurrentBlockClientIdParityRequest struct {
+}
+
+func (m *QueryCurrentBlockClientIdParityRequest) Reset() {
+	*m = QueryCurrentBlockClientIdParityRequest{}
+}
+func (m *QueryCurrentBlockClientIdParityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBlockClientIdParityRequest) ProtoMessage()    {}
+func (*QueryCurrentBlockClientIdParityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{42}
+}
+func (m *QueryCurrentBlockClientIdParityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBlockClientIdParityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBlockClientIdParityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBlockClientIdParityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBlockClientIdParityRequest.Merge(m, src)
+}
+func (m *QueryCurrentBlockClientIdParityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBlockClientIdParityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBlockClientIdParityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBlockClientIdParityRequest proto.InternalMessageInfo
+
+// QueryCurrentBlockClientIdParityResponse is a response type for the
+// CurrentBlockClientIdParity RPC method.
+type QueryCurrentBlockClientIdParityResponse struct {
+	// Parity of the current block height, i.e. 0 if even and 1 if odd.
+	BlockParity uint32 `protobuf:"varint,1,opt,name=block_parity,json=blockParity,proto3" json:"block_parity,omitempty"`
+}
+
+func (m *QueryCurrentBlockClientIdParityResponse) Reset() {
+	*m = QueryCurrentBlockClientIdParityResponse{}
+}
+func (m *QueryCurrentBlockClientIdParityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentBlockClientIdParityResponse) ProtoMessage()    {}
+func (*QueryCurrentBlockClientIdParityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{43}
+}
+func (m *QueryCurrentBlockClientIdParityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentBlockClientIdParityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentBlockClientIdParityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentBlockClientIdParityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentBlockClientIdParityResponse.Merge(m, src)
+}
+func (m *QueryCurrentBlockClientIdParityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentBlockClientIdParityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentBlockClientIdParityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentBlockClientIdParityResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentBlockClientIdParityResponse) GetBlockParity() uint32 {
+	if m != nil {
+		return m.BlockParity
+	}
+	return 0
+}
+
+// QueryVaultLastQuotePriceRequest is a request type for the
+// VaultLastQuotePrice RPC method.
+type QueryVaultLastQuotePriceRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QueryVaultLastQuotePriceRequest) Reset()         { *m = QueryVaultLastQuotePriceRequest{} }
+func (m *QueryVaultLastQuotePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultLastQuotePriceRequest) ProtoMessage()    {}
+func (*QueryVaultLastQuotePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{44}
+}
+func (m *QueryVaultLastQuotePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultLastQuotePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultLastQuotePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultLastQuotePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultLastQuotePriceRequest.Merge(m, src)
+}
+func (m *QueryVaultLastQuotePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultLastQuotePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultLastQuotePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultLastQuotePriceRequest proto.InternalMessageInfo
+
+func (m *QueryVaultLastQuotePriceRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryVaultLastQuotePriceRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QueryVaultLastQuotePriceResponse is a response type for the
+// VaultLastQuotePrice RPC method.
+type QueryVaultLastQuotePriceResponse struct {
+	// Market whose price the vault quoted at.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Exponent of the market's prices.
+	Exponent int32 `protobuf:"zigzag32,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// Price that the vault's orders were based on at its last refresh.
+	LastQuotePrice uint64 `protobuf:"varint,3,opt,name=last_quote_price,json=lastQuotePrice,proto3" json:"last_quote_price,omitempty"`
+	// Current oracle price of the market.
+	OraclePrice uint64 `protobuf:"varint,4,opt,name=oracle_price,json=oraclePrice,proto3" json:"oracle_price,omitempty"`
+}
+
+func (m *QueryVaultLastQuotePriceResponse) Reset()         { *m = QueryVaultLastQuotePriceResponse{} }
+func (m *QueryVaultLastQuotePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultLastQuotePriceResponse) ProtoMessage()    {}
+func (*QueryVaultLastQuotePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{45}
+}
+func (m *QueryVaultLastQuotePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultLastQuotePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultLastQuotePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultLastQuotePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultLastQuotePriceResponse.Merge(m, src)
+}
+func (m *QueryVaultLastQuotePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultLastQuotePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultLastQuotePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultLastQuotePriceResponse proto.InternalMessageInfo
+
+func (m *QueryVaultLastQuotePriceResponse) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryVaultLastQuotePriceResponse) GetExponent() int32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *QueryVaultLastQuotePriceResponse) GetLastQuotePrice() uint64 {
+	if m != nil {
+		return m.LastQuotePrice
+	}
+	return 0
+}
+
+func (m *QueryVaultLastQuotePriceResponse) GetOraclePrice() uint64 {
+	if m != nil {
+		return m.OraclePrice
+	}
+	return 0
+}
+
+// QueryMarketVolatilityRequest is a request type for the MarketVolatility RPC
+// method.
+type QueryMarketVolatilityRequest struct {
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryMarketVolatilityRequest) Reset()         { *m = QueryMarketVolatilityRequest{} }
+func (m *QueryMarketVolatilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketVolatilityRequest) ProtoMessage()    {}
+func (*QueryMarketVolatilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{46}
+}
+func (m *QueryMarketVolatilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketVolatilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketVolatilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketVolatilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketVolatilityRequest.Merge(m, src)
+}
+func (m *QueryMarketVolatilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketVolatilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketVolatilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketVolatilityRequest proto.InternalMessageInfo
+
+func (m *QueryMarketVolatilityRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+// QueryMarketVolatilityResponse is a response type for the MarketVolatility RPC
+// method.
+type QueryMarketVolatilityResponse struct {
+	// Realized volatility of the market.
+	Volatility MarketVolatility `protobuf:"bytes,1,opt,name=volatility,proto3" json:"volatility"`
+	// Whether no per-block return of the market's oracle price has been observed
+	// yet, in which case volatility is zero.
+	InsufficientData bool `protobuf:"varint,2,opt,name=insufficient_data,json=insufficientData,proto3" json:"insufficient_data,omitempty"`
+}
+
+func (m *QueryMarketVolatilityResponse) Reset()         { *m = QueryMarketVolatilityResponse{} }
+func (m *QueryMarketVolatilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketVolatilityResponse) ProtoMessage()    {}
+func (*QueryMarketVolatilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{47}
+}
+func (m *QueryMarketVolatilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketVolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketVolatilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketVolatilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketVolatilityResponse.Merge(m, src)
+}
+func (m *QueryMarketVolatilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketVolatilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketVolatilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketVolatilityResponse proto.InternalMessageInfo
+
+func (m *QueryMarketVolatilityResponse) GetVolatility() MarketVolatility {
+	if m != nil {
+		return m.Volatility
+	}
+	return MarketVolatility{}
+}
+
+func (m *QueryMarketVolatilityResponse) GetInsufficientData() bool {
+	if m != nil {
+		return m.InsufficientData
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.vault.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.vault.QueryParamsResponse")
+	proto.RegisterType((*QueryVaultRequest)(nil), "dydxprotocol.vault.QueryVaultRequest")
+	proto.RegisterType((*QueryVaultResponse)(nil), "dydxprotocol.vault.QueryVaultResponse")
+	proto.RegisterType((*QueryAllVaultsRequest)(nil), "dydxprotocol.vault.QueryAllVaultsRequest")
+	proto.RegisterType((*QueryAllVaultsResponse)(nil), "dydxprotocol.vault.QueryAllVaultsResponse")
+	proto.RegisterType((*QueryOwnerSharesRequest)(nil), "dydxprotocol.vault.QueryOwnerSharesRequest")
+	proto.RegisterType((*QueryOwnerSharesResponse)(nil), "dydxprotocol.vault.QueryOwnerSharesResponse")
+	proto.RegisterType((*QueryVaultQuotingStatusRequest)(nil), "dydxprotocol.vault.QueryVaultQuotingStatusRequest")
+	proto.RegisterType((*QueryVaultQuotingStatusResponse)(nil), "dydxprotocol.vault.QueryVaultQuotingStatusResponse")
+	proto.RegisterType((*QueryQuoteDepositRequest)(nil), "dydxprotocol.vault.QueryQuoteDepositRequest")
+	proto.RegisterType((*QueryQuoteDepositResponse)(nil), "dydxprotocol.vault.QueryQuoteDepositResponse")
+	proto.RegisterType((*QueryVaultQuoteCurveRequest)(nil), "dydxprotocol.vault.QueryVaultQuoteCurveRequest")
+	proto.RegisterType((*QueryVaultQuoteCurveResponse)(nil), "dydxprotocol.vault.QueryVaultQuoteCurveResponse")
+	proto.RegisterType((*QuoteCurvePoint)(nil), "dydxprotocol.vault.QuoteCurvePoint")
+	proto.RegisterType((*QueryVaultQuotedNotionalRequest)(nil), "dydxprotocol.vault.QueryVaultQuotedNotionalRequest")
+	proto.RegisterType((*QueryVaultQuotedNotionalResponse)(nil), "dydxprotocol.vault.QueryVaultQuotedNotionalResponse")
+	proto.RegisterType((*QueryDecodeVaultClientIdRequest)(nil), "dydxprotocol.vault.QueryDecodeVaultClientIdRequest")
+	proto.RegisterType((*QueryDecodeVaultClientIdResponse)(nil), "dydxprotocol.vault.QueryDecodeVaultClientIdResponse")
+	proto.RegisterType((*QueryVaultFillStatsRequest)(nil), "dydxprotocol.vault.QueryVaultFillStatsRequest")
+	proto.RegisterType((*QueryVaultFillStatsResponse)(nil), "dydxprotocol.vault.QueryVaultFillStatsResponse")
+	proto.RegisterType((*QueryVaultMarginRequest)(nil), "dydxprotocol.vault.QueryVaultMarginRequest")
+	proto.RegisterType((*QueryVaultMarginResponse)(nil), "dydxprotocol.vault.QueryVaultMarginResponse")
+	proto.RegisterType((*QueryVaultActivityLogRequest)(nil), "dydxprotocol.vault.QueryVaultActivityLogRequest")
+	proto.RegisterType((*QueryVaultActivityLogResponse)(nil), "dydxprotocol.vault.QueryVaultActivityLogResponse")
+	proto.RegisterType((*QueryVaultRefreshHistoryRequest)(nil), "dydxprotocol.vault.QueryVaultRefreshHistoryRequest")
+	proto.RegisterType((*QueryVaultRefreshHistoryResponse)(nil), "dydxprotocol.vault.QueryVaultRefreshHistoryResponse")
+	proto.RegisterType((*QueryVaultValueAtRiskRequest)(nil), "dydxprotocol.vault.QueryVaultValueAtRiskRequest")
+	proto.RegisterType((*QueryVaultValueAtRiskResponse)(nil), "dydxprotocol.vault.QueryVaultValueAtRiskResponse")
+	proto.RegisterType((*QueryOwnerUnrealizedPnlRequest)(nil), "dydxprotocol.vault.QueryOwnerUnrealizedPnlRequest")
+	proto.RegisterType((*QueryOwnerUnrealizedPnlResponse)(nil), "dydxprotocol.vault.QueryOwnerUnrealizedPnlResponse")
+	proto.RegisterType((*QueryTotalVaultTvlRequest)(nil), "dydxprotocol.vault.QueryTotalVaultTvlRequest")
+	proto.RegisterType((*QueryTotalVaultTvlResponse)(nil), "dydxprotocol.vault.QueryTotalVaultTvlResponse")
+	proto.RegisterType((*QueryTotalVaultInventoryRequest)(nil), "dydxprotocol.vault.QueryTotalVaultInventoryRequest")
+	proto.RegisterType((*QueryTotalVaultInventoryResponse)(nil), "dydxprotocol.vault.QueryTotalVaultInventoryResponse")
+	proto.RegisterType((*QueryExplainVaultOrderRequest)(nil), "dydxprotocol.vault.QueryExplainVaultOrderRequest")
+	proto.RegisterType((*QueryExplainVaultOrderResponse)(nil), "dydxprotocol.vault.QueryExplainVaultOrderResponse")
+	proto.RegisterType((*VaultOrderExplanation)(nil), "dydxprotocol.vault.VaultOrderExplanation")
+	proto.RegisterType((*QueryOrphanedVaultOrdersRequest)(nil), "dydxprotocol.vault.QueryOrphanedVaultOrdersRequest")
+	proto.RegisterType((*QueryOrphanedVaultOrdersResponse)(nil), "dydxprotocol.vault.QueryOrphanedVaultOrdersResponse")
+	proto.RegisterType((*QueryVaultMakerEdgeRequest)(nil), "dydxprotocol.vault.QueryVaultMakerEdgeRequest")
+	proto.RegisterType((*QueryVaultMakerEdgeResponse)(nil), "dydxprotocol.vault.QueryVaultMakerEdgeResponse")
+	proto.RegisterType((*QueryCurrentBlockClientIdParityRequest)(nil), "dydxprotocol.vault.QueryCurrentBlockClientIdParityRequest")
+	proto.RegisterType((*QueryCurrentBlockClientIdParityResponse)(nil), "dydxprotocol.vault.QueryCurrentBlockClientIdParityResponse")
+	proto.RegisterType((*QueryVaultLastQuotePriceRequest)(nil), "dydxprotocol.vault.QueryVaultLastQuotePriceRequest")
+	proto.RegisterType((*QueryVaultLastQuotePriceResponse)(nil), "dydxprotocol.vault.QueryVaultLastQuotePriceResponse")
+	proto.RegisterType((*QueryMarketVolatilityRequest)(nil), "dydxprotocol.vault.QueryMarketVolatilityRequest")
+	proto.RegisterType((*QueryMarketVolatilityResponse)(nil), "dydxprotocol.vault.QueryMarketVolatilityResponse")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/vault/query.proto", fileDescriptor_478fb8dc0ff21ea6) }
+
+var fileDescriptor_478fb8dc0ff21ea6 = []byte{
+	// 2706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xc9, 0x6f, 0x1c, 0xc7,
+	0xd5, 0x57, 0x8b, 0x8b, 0xc8, 0x37, 0xdc, 0x54, 0xf2, 0x42, 0x0f, 0xc5, 0x45, 0xfd, 0xd9, 0x12,
+	0xb5, 0x78, 0x46, 0xa4, 0xf4, 0xc9, 0x8e, 0x65, 0x18, 0x16, 0x29, 0x29, 0x92, 0x21, 0x59, 0x64,
+	0x53, 0xd1, 0xc1, 0x40, 0xd2, 0xa9, 0x99, 0x2e, 0x0e, 0x1b, 0xec, 0xe9, 0x6e, 0xf5, 0x32, 0x12,
+	0x2d, 0x10, 0xc8, 0x82, 0x20, 0x9b, 0x13, 0x18, 0x31, 0x02, 0xe4, 0x90, 0x4b, 0x02, 0xc4, 0xc8,
+	0x7a, 0x70, 0x82, 0x1c, 0x12, 0x24, 0xb9, 0x24, 0x80, 0x7d, 0x49, 0xe0, 0x20, 0x97, 0x20, 0x07,
+	0x23, 0x90, 0x82, 0xfc, 0x15, 0x39, 0x04, 0xb5, 0xf4, 0xbe, 0xcc, 0x48, 0x98, 0x01, 0x72, 0x21,
+	0xa6, 0xab, 0xde, 0xab, 0xf7, 0xab, 0x57, 0xaf, 0xea, 0xbd, 0xfa, 0x15, 0x61, 0x41, 0xdb, 0xd3,
+	0xee, 0xdb, 0x8e, 0xe5, 0x59, 0x4d, 0xcb, 0xa8, 0x77, 0xb0, 0x6f, 0x78, 0xf5, 0xbb, 0x3e, 0x71,
+	0xf6, 0x6a, 0xac, 0x11, 0xa1, 0x78, 0x7f, 0x8d, 0xf5, 0x57, 0x9f, 0x6a, 0x59, 0x2d, 0x8b, 0xb5,
+	0xd5, 0xe9, 0x2f, 0x2e, 0x59, 0x3d, 0xda, 0xb2, 0xac, 0x96, 0x41, 0xea, 0xd8, 0xd6, 0xeb, 0xd8,
+	0x34, 0x2d, 0x0f, 0x7b, 0xba, 0x65, 0xba, 0xa2, 0xf7, 0x54, 0xd3, 0x72, 0xdb, 0x96, 0x5b, 0x6f,
+	0x60, 0x97, 0x70, 0x03, 0xf5, 0xce, 0x4a, 0x83, 0x78, 0x78, 0xa5, 0x6e, 0xe3, 0x96, 0x6e, 0x32,
+	0x61, 0x21, 0x3b, 0x9f, 0xc0, 0xd4, 0x34, 0xac, 0x46, 0xdd, 0x72, 0x34, 0xe2, 0x88, 0xee, 0x93,
+	0x89, 0x6e, 0xd7, 0x6f, 0xe0, 0x66, 0xd3, 0xf2, 0x4d, 0xcf, 0x8d, 0xfd, 0x16, 0xa2, 0x8b, 0x39,
+	0xb3, 0xb3, 0xb1, 0x83, 0xdb, 0x01, 0xac, 0xbc, 0xe9, 0xb3, 0xbf, 0xbc, 0x5f, 0x7e, 0x0a, 0xd0,
+	0x26, 0x05, 0xbb, 0xc1, 0x94, 0x14, 0x72, 0xd7, 0x27, 0xae, 0x27, 0xdf, 0x82, 0x23, 0x89, 0x56,
+	0xd7, 0xb6, 0x4c, 0x97, 0xa0, 0x97, 0x61, 0x94, 0x0f, 0x3e, 0x2b, 0x2d, 0x49, 0xcb, 0x95, 0xd5,
+	0x6a, 0x2d, 0xeb, 0xbc, 0x1a, 0xd7, 0x59, 0x1b, 0xfe, 0xe8, 0x93, 0xc5, 0x03, 0x8a, 0x90, 0x97,
+	0x3f, 0x07, 0x87, 0xd9, 0x80, 0x77, 0xa8, 0x88, 0xb0, 0x82, 0x56, 0x60, 0xd8, 0xdb, 0xb3, 0x09,
+	0x1b, 0x6c, 0x6a, 0x75, 0x3e, 0x6f, 0x30, 0x26, 0x7f, 0x7b, 0xcf, 0x26, 0x0a, 0x13, 0x45, 0xcf,
+	0xc0, 0xa8, 0xe9, 0xb7, 0x1b, 0xc4, 0x99, 0x3d, 0xb8, 0x24, 0x2d, 0x4f, 0x2a, 0xe2, 0x4b, 0xfe,
+	0xf3, 0x90, 0x98, 0x87, 0x30, 0x20, 0x00, 0xbf, 0x0a, 0x63, 0x6c, 0x1c, 0x55, 0xd7, 0x04, 0xe4,
+	0xb9, 0x42, 0x2b, 0xd7, 0x35, 0x81, 0xf9, 0x50, 0x87, 0x7f, 0xa2, 0x4d, 0x98, 0x8c, 0x1c, 0x4e,
+	0x87, 0x38, 0xc8, 0x86, 0x38, 0x9e, 0x1c, 0x22, 0xb6, 0x3e, 0xb5, 0xad, 0xf0, 0x77, 0x38, 0xda,
+	0x84, 0x1b, 0x6b, 0x43, 0x9f, 0x87, 0x51, 0x72, 0xd7, 0xd7, 0xbd, 0xbd, 0xd9, 0xa1, 0x25, 0x69,
+	0x79, 0x62, 0xed, 0x1a, 0x95, 0xf9, 0xc7, 0x27, 0x8b, 0xaf, 0xb7, 0x74, 0x6f, 0xc7, 0x6f, 0xd4,
+	0x9a, 0x56, 0xbb, 0x9e, 0x5c, 0xb1, 0xf3, 0x2f, 0x36, 0x77, 0xb0, 0x6e, 0xd6, 0xc3, 0x16, 0x8d,
+	0x3a, 0xc2, 0xad, 0x6d, 0x11, 0x47, 0xc7, 0x86, 0xfe, 0x36, 0x6e, 0x18, 0xe4, 0xba, 0xe9, 0x29,
+	0x62, 0x5c, 0xb4, 0x0d, 0xe3, 0xba, 0xd9, 0x21, 0xa6, 0x67, 0x39, 0x7b, 0xb3, 0xc3, 0x7d, 0x36,
+	0x12, 0x0d, 0x8d, 0xae, 0xc2, 0x84, 0x67, 0x79, 0xd8, 0x50, 0xdd, 0x1d, 0xec, 0x10, 0x77, 0x76,
+	0x84, 0xf9, 0x26, 0x77, 0x11, 0xdf, 0xf4, 0xdb, 0x5b, 0x4c, 0x48, 0xb8, 0xa4, 0xc2, 0x14, 0x79,
+	0x13, 0x7a, 0x0a, 0x46, 0x0c, 0xdc, 0x20, 0xc6, 0xec, 0xe8, 0x92, 0xb4, 0x3c, 0xae, 0xf0, 0x0f,
+	0x59, 0x85, 0xa7, 0xd9, 0x72, 0x5e, 0x32, 0x0c, 0xb6, 0x38, 0x41, 0x64, 0xa2, 0xab, 0x00, 0xd1,
+	0x76, 0x12, 0x6b, 0x7a, 0xbc, 0xc6, 0xf7, 0x5e, 0x8d, 0xee, 0xbd, 0x1a, 0xdf, 0xdc, 0x62, 0xef,
+	0xd5, 0x36, 0x70, 0x8b, 0x08, 0x5d, 0x25, 0xa6, 0x29, 0xff, 0x40, 0x82, 0x67, 0xd2, 0x16, 0x44,
+	0xd0, 0xbc, 0x06, 0xa3, 0x0c, 0x37, 0x8d, 0xf2, 0xa1, 0xec, 0x7a, 0xf3, 0x39, 0x65, 0x83, 0x4d,
+	0x11, 0x5a, 0xe8, 0xd3, 0x09, 0x88, 0x3c, 0x66, 0x4e, 0x74, 0x85, 0x28, 0x06, 0x89, 0x63, 0xfc,
+	0xb9, 0x04, 0xcf, 0x32, 0x3b, 0xb7, 0xee, 0x99, 0xc4, 0xe1, 0xfe, 0xea, 0xff, 0xde, 0x49, 0xb9,
+	0x74, 0xe8, 0x89, 0x5d, 0xfa, 0xbe, 0x04, 0xb3, 0x59, 0xb8, 0xc2, 0xa9, 0x97, 0x60, 0xc2, 0xa2,
+	0xcd, 0x41, 0xb8, 0x70, 0xd7, 0x2e, 0xe4, 0xe1, 0x8e, 0xd4, 0x95, 0x8a, 0x15, 0x0d, 0xd5, 0x3f,
+	0xbf, 0xee, 0xc2, 0x42, 0xb4, 0x7c, 0x9b, 0xbe, 0xe5, 0xe9, 0x66, 0x6b, 0xcb, 0xc3, 0x9e, 0x3f,
+	0x00, 0xef, 0xca, 0x5b, 0xb0, 0x58, 0x68, 0x4c, 0xf8, 0x66, 0x16, 0x0e, 0xdd, 0xe5, 0x1d, 0xcc,
+	0xe0, 0x98, 0x12, 0x7c, 0xd2, 0x41, 0x1d, 0x82, 0x5d, 0x31, 0xdd, 0x71, 0x45, 0x7c, 0xc9, 0xef,
+	0x04, 0xae, 0xa6, 0x03, 0x92, 0xcb, 0xc4, 0xb6, 0x5c, 0x7d, 0x00, 0xc7, 0x2a, 0x7a, 0x01, 0xa6,
+	0x28, 0x14, 0xa2, 0xde, 0xf5, 0xb1, 0xe9, 0xf9, 0x6d, 0x97, 0x85, 0xc7, 0xb0, 0x32, 0xc9, 0x5a,
+	0x37, 0x45, 0xa3, 0xfc, 0x57, 0x09, 0x9e, 0xcb, 0x81, 0x23, 0xa6, 0xb7, 0x06, 0xc0, 0x17, 0x5d,
+	0xb5, 0x7c, 0x4f, 0x6c, 0xd9, 0x9e, 0xce, 0x89, 0x71, 0xae, 0x76, 0xcb, 0xf7, 0x90, 0x0d, 0xd3,
+	0xec, 0x43, 0xb5, 0x1d, 0xbd, 0x49, 0x54, 0xdb, 0x6e, 0x33, 0xa4, 0xfd, 0x3c, 0xdb, 0x26, 0x99,
+	0x81, 0x0d, 0x3a, 0xfe, 0x86, 0xdd, 0x96, 0x77, 0x60, 0x2e, 0xb9, 0x6e, 0x64, 0xdd, 0x77, 0x3a,
+	0x64, 0x00, 0x11, 0xf2, 0x0d, 0x09, 0x8e, 0xe6, 0x9b, 0x0a, 0xf7, 0xce, 0xa8, 0x6d, 0xe9, 0x66,
+	0x78, 0x20, 0xfd, 0x5f, 0xfe, 0x81, 0x14, 0xe8, 0x6d, 0x50, 0xd9, 0x30, 0xff, 0x32, 0x45, 0x74,
+	0x02, 0xa6, 0x2d, 0x07, 0x37, 0x0d, 0xa2, 0xba, 0x7e, 0xc3, 0xd3, 0x9b, 0xbb, 0x2e, 0x03, 0x31,
+	0xac, 0x4c, 0xf1, 0xe6, 0x2d, 0xd1, 0x2a, 0x7f, 0x47, 0x82, 0xe9, 0xd4, 0x50, 0x74, 0xae, 0xae,
+	0xae, 0x15, 0xcc, 0x95, 0x56, 0x2f, 0xb5, 0x5b, 0xac, 0x7a, 0xd9, 0xd2, 0x35, 0xa2, 0x30, 0x51,
+	0x54, 0x85, 0xb1, 0x94, 0xa1, 0xf0, 0x9b, 0xf6, 0xa5, 0xc2, 0x29, 0xfc, 0xe6, 0xd9, 0x60, 0x8f,
+	0x38, 0x2c, 0x73, 0x4d, 0x2a, 0xfc, 0x43, 0x36, 0xd2, 0x7b, 0x88, 0x68, 0x6f, 0x5a, 0x74, 0x2b,
+	0x63, 0x63, 0x00, 0xeb, 0xf1, 0x1f, 0x09, 0x96, 0x8a, 0xcd, 0x89, 0x35, 0xd9, 0x85, 0x89, 0x86,
+	0xae, 0xa9, 0xa6, 0x68, 0x67, 0x76, 0xfb, 0x19, 0x8d, 0x95, 0x86, 0x1e, 0x1a, 0xa5, 0xc6, 0xb0,
+	0xbb, 0x1b, 0x19, 0xeb, 0x77, 0xe8, 0x57, 0xb0, 0xbb, 0x1b, 0x18, 0x93, 0x5f, 0x13, 0xce, 0xbe,
+	0x4c, 0x9a, 0x96, 0x46, 0x98, 0x0f, 0xd6, 0x0d, 0x9d, 0xd0, 0xf2, 0x25, 0x70, 0xf6, 0x1c, 0x8c,
+	0x37, 0x59, 0x53, 0x50, 0x57, 0x4d, 0x2a, 0x63, 0x4d, 0x21, 0x23, 0x7f, 0x3b, 0x70, 0x5f, 0xee,
+	0x00, 0xc2, 0x7d, 0x4f, 0x10, 0x52, 0xc7, 0x60, 0xa2, 0x61, 0x58, 0xcd, 0x5d, 0xd5, 0xc6, 0x0e,
+	0x2d, 0xa0, 0xf8, 0xa2, 0x55, 0x58, 0xdb, 0x06, 0x6b, 0x8a, 0xa2, 0x67, 0x28, 0x1e, 0x3d, 0x2d,
+	0xa8, 0x46, 0xcb, 0x79, 0x55, 0x37, 0x0c, 0x7a, 0xfc, 0x0e, 0xe2, 0xa8, 0xff, 0x6c, 0xfc, 0xc8,
+	0x88, 0x19, 0x0a, 0xeb, 0x8a, 0x11, 0x97, 0x36, 0x88, 0x23, 0x50, 0x2e, 0x34, 0x15, 0xaa, 0x8a,
+	0x4d, 0xcc, 0xd5, 0x64, 0x4d, 0x54, 0x03, 0x4c, 0xe6, 0x26, 0x76, 0x5a, 0xba, 0x39, 0x80, 0x49,
+	0xfc, 0x65, 0x48, 0xa4, 0x96, 0x84, 0x19, 0x31, 0x85, 0x6f, 0x4a, 0x30, 0xaf, 0x9b, 0xba, 0xa7,
+	0x63, 0x43, 0x6d, 0xb3, 0x2e, 0x35, 0x95, 0x1f, 0xfa, 0xbd, 0x0f, 0xaa, 0xc2, 0x1c, 0x07, 0xb2,
+	0x19, 0x4f, 0x3b, 0xe8, 0x3d, 0x09, 0x8e, 0xb5, 0xb1, 0x6e, 0x7a, 0xc4, 0xc4, 0x66, 0x93, 0x14,
+	0x20, 0xea, 0xf7, 0x66, 0x59, 0x88, 0x99, 0xcc, 0x43, 0xf5, 0x2d, 0x09, 0x16, 0xb6, 0x1d, 0x42,
+	0xd4, 0xa6, 0x65, 0x18, 0xd8, 0x23, 0x0e, 0x36, 0xd4, 0x9c, 0x24, 0xda, 0x4f, 0x48, 0x73, 0xd4,
+	0xde, 0x7a, 0x68, 0x2e, 0x81, 0x47, 0xfe, 0x20, 0x91, 0x5e, 0x2e, 0x35, 0x3d, 0xbd, 0xa3, 0x7b,
+	0x7b, 0x37, 0xac, 0xd6, 0xff, 0x70, 0x29, 0xf9, 0x33, 0x09, 0xe6, 0x0b, 0x30, 0x87, 0x39, 0x11,
+	0x30, 0x6f, 0xd6, 0xc3, 0x6a, 0xf2, 0x58, 0x21, 0xf4, 0x60, 0x04, 0x25, 0xa6, 0xd4, 0xbf, 0x7a,
+	0x32, 0x91, 0x9e, 0x14, 0xb2, 0xed, 0x10, 0x77, 0xe7, 0x9a, 0xee, 0xd2, 0x6b, 0xd2, 0x00, 0x36,
+	0xe8, 0x4e, 0x3c, 0x3b, 0xa5, 0xad, 0x09, 0xef, 0x5c, 0x86, 0x71, 0x87, 0xf7, 0x84, 0xce, 0x59,
+	0x2a, 0xb4, 0x29, 0xc6, 0x08, 0x8a, 0xae, 0x50, 0x51, 0x7e, 0x37, 0x11, 0x39, 0x77, 0xb0, 0xe1,
+	0x93, 0x4b, 0x9e, 0xa2, 0xbb, 0xbb, 0x83, 0xa9, 0x34, 0x9b, 0x96, 0xb9, 0xad, 0x6b, 0xc4, 0x14,
+	0xf5, 0x1d, 0x3f, 0xc3, 0x27, 0xa3, 0x56, 0x5a, 0x95, 0xfd, 0x34, 0x11, 0x18, 0x09, 0x48, 0x62,
+	0xea, 0x5f, 0x93, 0xe0, 0x68, 0x87, 0xb6, 0xab, 0xd8, 0x53, 0x1d, 0xdd, 0xdd, 0x1d, 0xf4, 0x09,
+	0x35, 0xdb, 0x89, 0x50, 0x24, 0x77, 0xde, 0x17, 0x25, 0x71, 0xd1, 0x60, 0x37, 0x9a, 0xcf, 0x98,
+	0x0e, 0xa1, 0x7a, 0x44, 0xdb, 0x30, 0x07, 0x50, 0xb6, 0xd0, 0xe4, 0xc7, 0x6e, 0x4b, 0xcc, 0x71,
+	0xe3, 0x0a, 0xff, 0x90, 0x7f, 0x7d, 0x50, 0x04, 0x67, 0x1e, 0x86, 0xd8, 0xa9, 0xee, 0x87, 0x3d,
+	0xaa, 0x6d, 0x1a, 0x03, 0x3f, 0xd5, 0xfd, 0x38, 0x90, 0xe4, 0xf9, 0xf9, 0x65, 0x09, 0x9e, 0x6b,
+	0x5a, 0xae, 0xa7, 0x36, 0xb0, 0xab, 0xbb, 0x83, 0x3e, 0xcd, 0x9f, 0xa1, 0xa6, 0xd6, 0xa8, 0xa5,
+	0xe4, 0xda, 0xcd, 0x89, 0x1b, 0xcd, 0x6d, 0xcb, 0xc3, 0x9c, 0x20, 0xb8, 0xdd, 0x09, 0x56, 0x4d,
+	0xfe, 0x50, 0x12, 0x25, 0x45, 0xaa, 0x57, 0xf8, 0xf3, 0xab, 0x12, 0xcc, 0x71, 0x6e, 0x84, 0x73,
+	0x32, 0x03, 0x8f, 0x40, 0x66, 0xec, 0x0a, 0xb3, 0x95, 0xf4, 0xe5, 0x22, 0x54, 0x38, 0xff, 0xc5,
+	0xf8, 0x27, 0x11, 0x30, 0xc0, 0x9a, 0xd6, 0x69, 0x8b, 0xbc, 0x2e, 0xa2, 0x23, 0x9a, 0xc8, 0xf5,
+	0x80, 0xe1, 0x09, 0x42, 0x74, 0x09, 0x26, 0x68, 0x41, 0xa6, 0xda, 0x58, 0x77, 0xa2, 0x7a, 0x0f,
+	0x68, 0xdb, 0x06, 0xd6, 0x9d, 0xeb, 0x9a, 0xfc, 0xa3, 0xa0, 0xe2, 0xcb, 0x1d, 0x45, 0x38, 0xe5,
+	0x0b, 0x12, 0x3c, 0x1b, 0xb2, 0x47, 0x74, 0x6d, 0x07, 0xe8, 0x90, 0xa7, 0x43, 0x43, 0x6b, 0xd8,
+	0x8d, 0xd6, 0xf4, 0x57, 0xc1, 0xe1, 0x71, 0xe5, 0xbe, 0x6d, 0x60, 0xdd, 0x64, 0x48, 0x59, 0x9d,
+	0x39, 0x80, 0xed, 0x18, 0x54, 0xb8, 0x43, 0xbd, 0x57, 0xb8, 0xf9, 0x97, 0x1f, 0x57, 0x1c, 0x22,
+	0x39, 0xa0, 0x85, 0x6b, 0x37, 0xa1, 0x42, 0x68, 0x67, 0x82, 0x14, 0x3b, 0x59, 0x08, 0x9e, 0x29,
+	0x5f, 0x89, 0x14, 0x02, 0x56, 0x2e, 0x36, 0x86, 0xfc, 0xce, 0x08, 0x3c, 0x9d, 0x2b, 0xfc, 0x24,
+	0x95, 0x7b, 0x38, 0xaf, 0x83, 0xb1, 0x79, 0xa1, 0x79, 0x00, 0xd7, 0x76, 0x08, 0xd6, 0xc2, 0xd3,
+	0x7e, 0x58, 0x19, 0xe7, 0x2d, 0x1b, 0x76, 0x9b, 0xde, 0x79, 0x0c, 0xd2, 0x21, 0x0e, 0x6e, 0xf1,
+	0x74, 0xd0, 0x6f, 0x2a, 0xb3, 0x12, 0x8c, 0x4e, 0x8d, 0x35, 0x61, 0xcc, 0xdd, 0x25, 0xf7, 0x98,
+	0xa1, 0x91, 0x3e, 0x1b, 0x3a, 0x44, 0x47, 0x16, 0x33, 0x72, 0xf0, 0xbd, 0xe8, 0x02, 0x3e, 0xda,
+	0xef, 0x19, 0x39, 0xf8, 0x5e, 0x70, 0x8f, 0x47, 0x2e, 0xcc, 0x34, 0x2c, 0xdf, 0xd4, 0x88, 0x16,
+	0x19, 0x3c, 0xd4, 0x67, 0x83, 0xd3, 0xc2, 0x42, 0x68, 0xf4, 0x24, 0xcc, 0x38, 0x69, 0xa3, 0x63,
+	0x6c, 0x61, 0xa7, 0x9d, 0x94, 0xe8, 0x19, 0x40, 0xae, 0xfe, 0x36, 0x49, 0x1d, 0x04, 0xe3, 0x4c,
+	0x78, 0x86, 0xf6, 0x24, 0x76, 0x6e, 0x50, 0x61, 0xdd, 0x72, 0xec, 0x1d, 0x6c, 0x12, 0x2d, 0x0a,
+	0xcd, 0x41, 0xdc, 0xe3, 0xde, 0x12, 0xc7, 0x59, 0xae, 0x35, 0xb1, 0xe7, 0x2e, 0xc0, 0x28, 0x7b,
+	0xb2, 0x09, 0xca, 0xab, 0xd9, 0xa2, 0x8d, 0x10, 0x10, 0x31, 0x5c, 0x3a, 0x79, 0x19, 0xbd, 0x89,
+	0x77, 0x89, 0x73, 0x45, 0x6b, 0x0d, 0x82, 0x55, 0x5a, 0x8f, 0x5f, 0x46, 0x63, 0x86, 0x04, 0xfe,
+	0xe7, 0x61, 0xaa, 0x4d, 0x1b, 0x55, 0xa2, 0x89, 0x0d, 0x46, 0x6d, 0x22, 0x65, 0xa2, 0x1d, 0x88,
+	0xd2, 0x72, 0x6b, 0x19, 0x8e, 0xb3, 0x41, 0xd6, 0x7d, 0xc7, 0x21, 0xa6, 0xb7, 0x46, 0xef, 0xda,
+	0xc1, 0x5d, 0x9e, 0xdf, 0xb9, 0x83, 0x94, 0x78, 0x03, 0x4e, 0x74, 0x95, 0x14, 0xa6, 0xd3, 0x17,
+	0x79, 0x29, 0x73, 0x91, 0x4f, 0x56, 0xd4, 0x37, 0xb0, 0xcb, 0x59, 0x18, 0xc6, 0xcd, 0x0d, 0xc0,
+	0x55, 0x3f, 0x4e, 0x10, 0x3e, 0x69, 0x73, 0x02, 0xf5, 0x1c, 0x8c, 0xb7, 0xb1, 0xb3, 0x4b, 0xe2,
+	0x9c, 0x07, 0x6f, 0xb8, 0xae, 0xa1, 0x2a, 0x8c, 0x91, 0xfb, 0xb6, 0x65, 0x12, 0x91, 0x64, 0x0f,
+	0x2b, 0xe1, 0x37, 0x5a, 0x86, 0x19, 0x03, 0xbb, 0x9e, 0x28, 0x02, 0x18, 0x7f, 0x29, 0x4e, 0xbb,
+	0x29, 0x23, 0x61, 0x8a, 0x3a, 0x46, 0x90, 0x74, 0x5c, 0x6a, 0x98, 0x49, 0x55, 0x78, 0x1b, 0x13,
+	0x91, 0x2f, 0x8a, 0x8a, 0xfc, 0x26, 0xb3, 0x7c, 0xc7, 0x32, 0xb0, 0xa7, 0x1b, 0xd1, 0x32, 0x94,
+	0xa2, 0x94, 0xbf, 0x17, 0xe4, 0xbf, 0xac, 0xb6, 0x98, 0xe4, 0x1b, 0x00, 0x9d, 0xb0, 0x55, 0x24,
+	0x92, 0xe7, 0xf3, 0x5c, 0x9b, 0x1e, 0x41, 0x44, 0x79, 0x4c, 0x1b, 0x9d, 0x86, 0xc3, 0xba, 0xe9,
+	0xfa, 0xdb, 0xdb, 0x7a, 0x93, 0x51, 0x45, 0x1a, 0xf6, 0x30, 0x73, 0xce, 0x98, 0x32, 0x13, 0xef,
+	0xb8, 0x8c, 0x3d, 0xbc, 0xfa, 0xef, 0x45, 0x18, 0x61, 0xd0, 0xd0, 0x3e, 0x8c, 0xf2, 0x17, 0x44,
+	0x54, 0xfc, 0xee, 0x92, 0x78, 0xac, 0xac, 0x9e, 0xe8, 0x2a, 0xc7, 0x67, 0x27, 0xcb, 0x5f, 0xfa,
+	0xdb, 0xbf, 0xde, 0x3b, 0x78, 0x14, 0x55, 0xeb, 0x85, 0xaf, 0xa6, 0xe8, 0xeb, 0x12, 0x8c, 0xb0,
+	0x30, 0x40, 0x2f, 0x74, 0x7b, 0xf6, 0xe1, 0xd6, 0x7b, 0x7c, 0x1d, 0x92, 0x57, 0x98, 0xf1, 0xd3,
+	0xe8, 0x64, 0xbd, 0xe8, 0x45, 0xb6, 0xfe, 0x80, 0x46, 0xe9, 0x7e, 0xfd, 0x01, 0x0f, 0xcb, 0x7d,
+	0xf4, 0x15, 0x09, 0xc6, 0xc3, 0xe7, 0x29, 0x74, 0xb2, 0xd0, 0x50, 0xfa, 0x91, 0xac, 0x7a, 0xaa,
+	0x17, 0x51, 0x81, 0xeb, 0x18, 0xc3, 0x35, 0x87, 0x9e, 0x2b, 0xc4, 0x85, 0x7e, 0x28, 0x41, 0x25,
+	0xf6, 0xa6, 0x83, 0x4e, 0x17, 0x0e, 0x9f, 0x7d, 0xa8, 0xaa, 0x9e, 0xe9, 0x4d, 0x58, 0xa0, 0x79,
+	0x99, 0xa1, 0x59, 0x45, 0x67, 0xf3, 0xd0, 0xc4, 0x1f, 0x90, 0x32, 0xce, 0xfa, 0x8d, 0x04, 0x28,
+	0xfb, 0xc6, 0x82, 0x56, 0xcb, 0x97, 0x27, 0xef, 0xf5, 0xa7, 0x7a, 0xee, 0xb1, 0x74, 0x04, 0xf2,
+	0x57, 0x18, 0xf2, 0xf3, 0x68, 0xb5, 0x9e, 0xfb, 0x0f, 0x07, 0x4c, 0x45, 0x75, 0x99, 0x4e, 0x06,
+	0xfb, 0xfb, 0x12, 0x4c, 0xc4, 0x9f, 0x4e, 0x50, 0xb1, 0xd3, 0x72, 0x1e, 0x7c, 0xaa, 0x2f, 0xf6,
+	0x28, 0x2d, 0x90, 0x7e, 0x8a, 0x21, 0x3d, 0x87, 0x56, 0x8a, 0x90, 0x12, 0x55, 0xe3, 0x2a, 0x19,
+	0xa0, 0xbf, 0x90, 0x60, 0x3a, 0xf5, 0x4a, 0x81, 0xea, 0xdd, 0xbd, 0x95, 0x78, 0x3a, 0xa9, 0x9e,
+	0xed, 0x5d, 0x41, 0x20, 0x7e, 0x89, 0x21, 0x5e, 0x41, 0xf5, 0x62, 0xc4, 0x4d, 0xaa, 0x90, 0xc1,
+	0xfb, 0x7b, 0x09, 0x8e, 0xe4, 0xb0, 0xf8, 0xa8, 0x87, 0x15, 0xce, 0x3c, 0x31, 0x54, 0xcf, 0x3f,
+	0x9e, 0x92, 0xc0, 0x7e, 0x91, 0x61, 0xff, 0x7f, 0x74, 0xae, 0x10, 0x7b, 0xf4, 0x8a, 0x90, 0xc1,
+	0xff, 0x5b, 0x09, 0x8e, 0xe4, 0xd0, 0xe8, 0x25, 0xf8, 0x8b, 0x59, 0xfb, 0x12, 0xfc, 0x25, 0x4c,
+	0x7d, 0xf9, 0x8e, 0xd4, 0x98, 0xa2, 0x1a, 0x3e, 0x06, 0xd4, 0x1f, 0x84, 0x3f, 0xf7, 0xd1, 0x4f,
+	0x24, 0x98, 0x4a, 0xf2, 0xd9, 0xa8, 0x56, 0xee, 0xc2, 0x34, 0x39, 0x5f, 0xad, 0xf7, 0x2c, 0x2f,
+	0xd0, 0x5e, 0x60, 0x68, 0xcf, 0xa2, 0x5a, 0x1e, 0xda, 0x6d, 0xdd, 0x30, 0xd8, 0x16, 0xcc, 0xee,
+	0xc0, 0xef, 0x4b, 0x50, 0x89, 0x11, 0xde, 0x25, 0x47, 0x5c, 0x96, 0x7d, 0x2f, 0x39, 0xe2, 0x72,
+	0x38, 0x74, 0x79, 0x95, 0x41, 0x3c, 0x83, 0x4e, 0xe5, 0x41, 0xe4, 0x14, 0x76, 0x06, 0xde, 0x07,
+	0x12, 0xcc, 0xa4, 0xa9, 0x50, 0xd4, 0x65, 0x1f, 0x65, 0x99, 0xde, 0xea, 0xca, 0x63, 0x68, 0xf4,
+	0xb2, 0xfc, 0x82, 0x4c, 0xdd, 0x53, 0x0d, 0xab, 0x55, 0xbc, 0xf7, 0x92, 0x1c, 0x65, 0xb7, 0xbd,
+	0x97, 0xcb, 0x9f, 0x76, 0xdb, 0x7b, 0xf9, 0x34, 0x68, 0xf9, 0xde, 0x13, 0x3c, 0xa7, 0xba, 0xc3,
+	0x95, 0x32, 0xf8, 0xff, 0x18, 0xf8, 0x3c, 0xc6, 0x32, 0x76, 0xf3, 0x79, 0x96, 0x23, 0xed, 0xe6,
+	0xf3, 0x1c, 0x0a, 0x53, 0x7e, 0x83, 0xc1, 0xbe, 0x8c, 0xd6, 0xf2, 0x53, 0x72, 0x8c, 0xdb, 0x4c,
+	0x83, 0xae, 0x3f, 0x48, 0x92, 0xa8, 0xfb, 0xe8, 0x43, 0x09, 0x50, 0x96, 0xfa, 0x2b, 0x49, 0x8b,
+	0x85, 0x5c, 0x65, 0x49, 0x5a, 0x2c, 0xe6, 0x16, 0xe5, 0x6b, 0x6c, 0x2e, 0x6b, 0xe8, 0xf5, 0xe2,
+	0x84, 0x9e, 0xa4, 0x1e, 0xb3, 0x53, 0x62, 0x52, 0xfb, 0xe8, 0xbb, 0x12, 0x4c, 0x26, 0xf8, 0x36,
+	0x54, 0x9c, 0xf7, 0xf2, 0x58, 0xbb, 0x6a, 0xad, 0x57, 0x71, 0x01, 0xfd, 0x05, 0x06, 0x7d, 0x11,
+	0xcd, 0xe7, 0x41, 0xe7, 0xfc, 0x9e, 0xd7, 0x31, 0xd0, 0xef, 0x24, 0x38, 0x92, 0x43, 0x7c, 0x95,
+	0xc4, 0x79, 0x31, 0xd9, 0x56, 0x12, 0xe7, 0x25, 0xdc, 0x5a, 0x79, 0xed, 0xc1, 0x91, 0x86, 0x8c,
+	0x18, 0x3d, 0xa2, 0x23, 0x36, 0x6f, 0x1f, 0xfd, 0x49, 0x82, 0xc3, 0x19, 0x6a, 0x09, 0x15, 0x47,
+	0x6d, 0x11, 0x77, 0x56, 0x5d, 0x7d, 0x1c, 0x95, 0x5e, 0xa2, 0x83, 0x70, 0x35, 0x95, 0xdd, 0x9c,
+	0xb3, 0x61, 0xe1, 0xea, 0x1a, 0xfd, 0x66, 0x64, 0x12, 0x3f, 0x6d, 0x72, 0xee, 0xeb, 0x25, 0xab,
+	0x50, 0xcc, 0x25, 0x94, 0xac, 0x42, 0x09, 0x25, 0x50, 0x7e, 0xda, 0x58, 0x42, 0x91, 0xcf, 0x26,
+	0x9b, 0x80, 0xc2, 0x64, 0x19, 0x5e, 0xd5, 0xbb, 0x25, 0xcb, 0x34, 0x79, 0xd0, 0x2d, 0x59, 0x66,
+	0x38, 0x80, 0xf2, 0x64, 0x19, 0xb1, 0x03, 0x79, 0x27, 0x63, 0xb5, 0xf8, 0x9e, 0x8f, 0x5e, 0x29,
+	0xc4, 0xd1, 0x95, 0x46, 0xa8, 0x5e, 0x7c, 0x22, 0x5d, 0x31, 0x9f, 0x33, 0x6c, 0x3e, 0xc7, 0xd1,
+	0xf3, 0x79, 0xf3, 0x09, 0x0b, 0x13, 0x41, 0x3b, 0xa0, 0x3f, 0x04, 0xf9, 0x29, 0x79, 0xe1, 0xef,
+	0x96, 0x9f, 0x72, 0xd9, 0x88, 0x6e, 0xf9, 0x29, 0x9f, 0x53, 0x90, 0x5f, 0x65, 0x80, 0x2f, 0xa0,
+	0xf3, 0x79, 0x80, 0xd3, 0xa4, 0x41, 0x66, 0x19, 0x7e, 0x29, 0xc1, 0x4c, 0xfa, 0x1e, 0x5e, 0x92,
+	0xa0, 0x0a, 0x28, 0x83, 0x92, 0x04, 0x55, 0x44, 0x13, 0x94, 0xdf, 0x20, 0x04, 0xff, 0x10, 0x31,
+	0x01, 0xf5, 0x07, 0x21, 0x25, 0xb1, 0xbf, 0xb6, 0xf9, 0xd1, 0xc3, 0x05, 0xe9, 0xe3, 0x87, 0x0b,
+	0xd2, 0x3f, 0x1f, 0x2e, 0x48, 0xef, 0x3e, 0x5a, 0x38, 0xf0, 0xf1, 0xa3, 0x85, 0x03, 0x7f, 0x7f,
+	0xb4, 0x70, 0xe0, 0xad, 0x97, 0x7a, 0xe7, 0x23, 0xef, 0x07, 0x47, 0xdb, 0x9e, 0x4d, 0xdc, 0xc6,
+	0x28, 0x6b, 0x3f, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x02, 0xbb, 0x48, 0xea, 0x2d,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the Params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Vault by type and number.
+	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
+	// Queries all vaults.
+	AllVaults(ctx context.Context, in *QueryAllVaultsRequest, opts ...grpc.CallOption) (*QueryAllVaultsResponse, error)
+	// Queries owner shares of a vault.
+	OwnerShares(ctx context.Context, in *QueryOwnerSharesRequest, opts ...grpc.CallOption) (*QueryOwnerSharesResponse, error)
+	// Queries whether a vault is quoting and if not, why.
+	VaultQuotingStatus(ctx context.Context, in *QueryVaultQuotingStatusRequest, opts ...grpc.CallOption) (*QueryVaultQuotingStatusResponse, error)
+	// Queries shares that a deposit to a vault would mint.
+	QuoteDeposit(ctx context.Context, in *QueryQuoteDepositRequest, opts ...grpc.CallOption) (*QueryQuoteDepositResponse, error)
+	// Queries the quote curve of a vault, i.e. price and size of each of its
+	// orders.
+	VaultQuoteCurve(ctx context.Context, in *QueryVaultQuoteCurveRequest, opts ...grpc.CallOption) (*QueryVaultQuoteCurveResponse, error)
+	// Queries the notional value that a vault quotes on each side.
+	VaultQuotedNotional(ctx context.Context, in *QueryVaultQuotedNotionalRequest, opts ...grpc.CallOption) (*QueryVaultQuotedNotionalResponse, error)
+	// Decodes the client ID of a vault order into its side, block parity, and
+	// layer.
+	DecodeVaultClientId(ctx context.Context, in *QueryDecodeVaultClientIdRequest, opts ...grpc.CallOption) (*QueryDecodeVaultClientIdResponse, error)
+	// Queries the fill statistics of a vault.
+	VaultFillStats(ctx context.Context, in *QueryVaultFillStatsRequest, opts ...grpc.CallOption) (*QueryVaultFillStatsResponse, error)
+	// Queries the margin requirements and free collateral of a vault.
+	VaultMargin(ctx context.Context, in *QueryVaultMarginRequest, opts ...grpc.CallOption) (*QueryVaultMarginResponse, error)
+	// Queries the activity log of a vault, i.e. its params changes and status
+	// changes in the order they happened.
+	VaultActivityLog(ctx context.Context, in *QueryVaultActivityLogRequest, opts ...grpc.CallOption) (*QueryVaultActivityLogResponse, error)
+	// Queries the most recent order refreshes of a vault, oldest first.
+	VaultRefreshHistory(ctx context.Context, in *QueryVaultRefreshHistoryRequest, opts ...grpc.CallOption) (*QueryVaultRefreshHistoryResponse, error)
+	// Queries the one-block value at risk of a vault's position at a confidence.
+	VaultValueAtRisk(ctx context.Context, in *QueryVaultValueAtRiskRequest, opts ...grpc.CallOption) (*QueryVaultValueAtRiskResponse, error)
+	// Queries unrealized PnL of an owner's shares in a vault.
+	OwnerUnrealizedPnl(ctx context.Context, in *QueryOwnerUnrealizedPnlRequest, opts ...grpc.CallOption) (*QueryOwnerUnrealizedPnlResponse, error)
+	// Queries total value locked across all vaults.
+	TotalVaultTvl(ctx context.Context, in *QueryTotalVaultTvlRequest, opts ...grpc.CallOption) (*QueryTotalVaultTvlResponse, error)
+	// Queries net inventory of all vaults in the perpetual of a clob pair.
+	TotalVaultInventory(ctx context.Context, in *QueryTotalVaultInventoryRequest, opts ...grpc.CallOption) (*QueryTotalVaultInventoryResponse, error)
+	// Queries every intermediate value of the quoting computation of one order
+	// of a vault.
+	ExplainVaultOrder(ctx context.Context, in *QueryExplainVaultOrderRequest, opts ...grpc.CallOption) (*QueryExplainVaultOrderResponse, error)
+	// Queries resting orders of a vault that don't correspond to any order that
+	// the vault currently generates.
+	OrphanedVaultOrders(ctx context.Context, in *QueryOrphanedVaultOrdersRequest, opts ...grpc.CallOption) (*QueryOrphanedVaultOrdersResponse, error)
+	// Queries the estimated maker edge of a vault, i.e. its effective spread
+	// minus estimated adverse selection.
+	VaultMakerEdge(ctx context.Context, in *QueryVaultMakerEdgeRequest, opts ...grpc.CallOption) (*QueryVaultMakerEdgeResponse, error)
+	// Queries the block height parity that client IDs of vault orders placed in
+	// the current block encode.
+	CurrentBlockClientIdParity(ctx context.Context, in *QueryCurrentBlockClientIdParityRequest, opts ...grpc.CallOption) (*QueryCurrentBlockClientIdParityResponse, error)
+	// Queries the oracle price that a vault's orders were based on at its last
+	// refresh along with the live oracle price of the same market.
+	VaultLastQuotePrice(ctx context.Context, in *QueryVaultLastQuotePriceRequest, opts ...grpc.CallOption) (*QueryVaultLastQuotePriceResponse, error)
+	// Queries realized volatility of a market's oracle price.
+	MarketVolatility(ctx context.Context, in *QueryMarketVolatilityRequest, opts ...grpc.CallOption) (*QueryMarketVolatilityResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
+	out := new(QueryVaultResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/Vault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllVaults(ctx context.Context, in *QueryAllVaultsRequest, opts ...grpc.CallOption) (*QueryAllVaultsResponse, error) {
+	out := new(QueryAllVaultsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/AllVaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OwnerShares(ctx context.Context, in *QueryOwnerSharesRequest, opts ...grpc.CallOption) (*QueryOwnerSharesResponse, error) {
+	out := new(QueryOwnerSharesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/OwnerShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultQuotingStatus(ctx context.Context, in *QueryVaultQuotingStatusRequest, opts ...grpc.CallOption) (*QueryVaultQuotingStatusResponse, error) {
+	out := new(QueryVaultQuotingStatusResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultQuotingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteDeposit(ctx context.Context, in *QueryQuoteDepositRequest, opts ...grpc.CallOption) (*QueryQuoteDepositResponse, error) {
+	out := new(QueryQuoteDepositResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/QuoteDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultQuoteCurve(ctx context.Context, in *QueryVaultQuoteCurveRequest, opts ...grpc.CallOption) (*QueryVaultQuoteCurveResponse, error) {
+	out := new(QueryVaultQuoteCurveResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultQuoteCurve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultQuotedNotional(ctx context.Context, in *QueryVaultQuotedNotionalRequest, opts ...grpc.CallOption) (*QueryVaultQuotedNotionalResponse, error) {
+	out := new(QueryVaultQuotedNotionalResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultQuotedNotional", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecodeVaultClientId(ctx context.Context, in *QueryDecodeVaultClientIdRequest, opts ...grpc.CallOption) (*QueryDecodeVaultClientIdResponse, error) {
+	out := new(QueryDecodeVaultClientIdResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/DecodeVaultClientId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultFillStats(ctx context.Context, in *QueryVaultFillStatsRequest, opts ...grpc.CallOption) (*QueryVaultFillStatsResponse, error) {
+	out := new(QueryVaultFillStatsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultFillStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultMargin(ctx context.Context, in *QueryVaultMarginRequest, opts ...grpc.CallOption) (*QueryVaultMarginResponse, error) {
+	out := new(QueryVaultMarginResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultActivityLog(ctx context.Context, in *QueryVaultActivityLogRequest, opts ...grpc.CallOption) (*QueryVaultActivityLogResponse, error) {
+	out := new(QueryVaultActivityLogResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultActivityLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultRefreshHistory(ctx context.Context, in *QueryVaultRefreshHistoryRequest, opts ...grpc.CallOption) (*QueryVaultRefreshHistoryResponse, error) {
+	out := new(QueryVaultRefreshHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultRefreshHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultValueAtRisk(ctx context.Context, in *QueryVaultValueAtRiskRequest, opts ...grpc.CallOption) (*QueryVaultValueAtRiskResponse, error) {
+	out := new(QueryVaultValueAtRiskResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultValueAtRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OwnerUnrealizedPnl(ctx context.Context, in *QueryOwnerUnrealizedPnlRequest, opts ...grpc.CallOption) (*QueryOwnerUnrealizedPnlResponse, error) {
+	out := new(QueryOwnerUnrealizedPnlResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/OwnerUnrealizedPnl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalVaultTvl(ctx context.Context, in *QueryTotalVaultTvlRequest, opts ...grpc.CallOption) (*QueryTotalVaultTvlResponse, error) {
+	out := new(QueryTotalVaultTvlResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/TotalVaultTvl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalVaultInventory(ctx context.Context, in *QueryTotalVaultInventoryRequest, opts ...grpc.CallOption) (*QueryTotalVaultInventoryResponse, error) {
+	out := new(QueryTotalVaultInventoryResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/TotalVaultInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExplainVaultOrder(ctx context.Context, in *QueryExplainVaultOrderRequest, opts ...grpc.CallOption) (*QueryExplainVaultOrderResponse, error) {
+	out := new(QueryExplainVaultOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/ExplainVaultOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrphanedVaultOrders(ctx context.Context, in *QueryOrphanedVaultOrdersRequest, opts ...grpc.CallOption) (*QueryOrphanedVaultOrdersResponse, error) {
+	out := new(QueryOrphanedVaultOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/OrphanedVaultOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultMakerEdge(ctx context.Context, in *QueryVaultMakerEdgeRequest, opts ...grpc.CallOption) (*QueryVaultMakerEdgeResponse, error) {
+	out := new(QueryVaultMakerEdgeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultMakerEdge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentBlockClientIdParity(ctx context.Context, in *QueryCurrentBlockClientIdParityRequest, opts ...grpc.CallOption) (*QueryCurrentBlockClientIdParityResponse, error) {
+	out := new(QueryCurrentBlockClientIdParityResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/CurrentBlockClientIdParity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultLastQuotePrice(ctx context.Context, in *QueryVaultLastQuotePriceRequest, opts ...grpc.CallOption) (*QueryVaultLastQuotePriceResponse, error) {
+	out := new(QueryVaultLastQuotePriceResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/VaultLastQuotePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketVolatility(ctx context.Context, in *QueryMarketVolatilityRequest, opts ...grpc.CallOption) (*QueryMarketVolatilityResponse, error) {
+	out := new(QueryMarketVolatilityResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/MarketVolatility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the Params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Vault by type and number.
+	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
+	// Queries all vaults.
+	AllVaults(context.Context, *QueryAllVaultsRequest) (*QueryAllVaultsResponse, error)
+	// Queries owner shares of a vault.
+	OwnerShares(context.Context, *QueryOwnerSharesRequest) (*QueryOwnerSharesResponse, error)
+	// Queries whether a vault is quoting and if not, why.
+	VaultQuotingStatus(context.Context, *QueryVaultQuotingStatusRequest) (*QueryVaultQuotingStatusResponse, error)
+	// Queries shares that a deposit to a vault would mint.
+	QuoteDeposit(context.Context, *QueryQuoteDepositRequest) (*QueryQuoteDepositResponse, error)
+	// Queries the quote curve of a vault, i.e. price and size of each of its
+	// orders.
+	VaultQuoteCurve(context.Context, *QueryVaultQuoteCurveRequest) (*QueryVaultQuoteCurveResponse, error)
+	// Queries the notional value that a vault quotes on each side.
+	VaultQuotedNotional(context.Context, *QueryVaultQuotedNotionalRequest) (*QueryVaultQuotedNotionalResponse, error)
+	// Decodes the client ID of a vault order into its side, block parity, and
+	// layer.
+	DecodeVaultClientId(context.Context, *QueryDecodeVaultClientIdRequest) (*QueryDecodeVaultClientIdResponse, error)
+	// Queries the fill statistics of a vault.
+	VaultFillStats(context.Context, *QueryVaultFillStatsRequest) (*QueryVaultFillStatsResponse, error)
+	// Queries the margin requirements and free collateral of a vault.
+	VaultMargin(context.Context, *QueryVaultMarginRequest) (*QueryVaultMarginResponse, error)
+	// Queries the activity log of a vault, i.e. its params changes and status
+	// changes in the order they happened.
+	VaultActivityLog(context.Context, *QueryVaultActivityLogRequest) (*QueryVaultActivityLogResponse, error)
+	// Queries the most recent order refreshes of a vault, oldest first.
+	VaultRefreshHistory(context.Context, *QueryVaultRefreshHistoryRequest) (*QueryVaultRefreshHistoryResponse, error)
+	// Queries the one-block value at risk of a vault's position at a confidence.
+	VaultValueAtRisk(context.Context, *QueryVaultValueAtRiskRequest) (*QueryVaultValueAtRiskResponse, error)
+	// Queries unrealized PnL of an owner's shares in a vault.
+	OwnerUnrealizedPnl(context.Context, *QueryOwnerUnrealizedPnlRequest) (*QueryOwnerUnrealizedPnlResponse, error)
+	// Queries total value locked across all vaults.
+	TotalVaultTvl(context.Context, *QueryTotalVaultTvlRequest) (*QueryTotalVaultTvlResponse, error)
+	// Queries net inventory of all vaults in the perpetual of a clob pair.
+	TotalVaultInventory(context.Context, *QueryTotalVaultInventoryRequest) (*QueryTotalVaultInventoryResponse, error)
+	// Queries every intermediate value of the quoting computation of one order
+	// of a vault.
+	ExplainVaultOrder(context.Context, *QueryExplainVaultOrderRequest) (*QueryExplainVaultOrderResponse, error)
+	// Queries resting orders of a vault that don't correspond to any order that
+	// the vault currently generates.
+	OrphanedVaultOrders(context.Context, *QueryOrphanedVaultOrdersRequest) (*QueryOrphanedVaultOrdersResponse, error)
+	// Queries the estimated maker edge of a vault, i.e. its effective spread
+	// minus estimated adverse selection.
+	VaultMakerEdge(context.Context, *QueryVaultMakerEdgeRequest) (*QueryVaultMakerEdgeResponse, error)
+	// Queries the block height parity that client IDs of vault orders placed in
+	// the current block encode.
+	CurrentBlockClientIdParity(context.Context, *QueryCurrentBlockClientIdParityRequest) (*QueryCurrentBlockClientIdParityResponse, error)
+	// Queries the oracle price that a vault's orders were based on at its last
+	// refresh along with the live oracle price of the same market.
+	VaultLastQuotePrice(context.Context, *QueryVaultLastQuotePriceRequest) (*QueryVaultLastQuotePriceResponse, error)
+	// Queries realized volatility of a market's oracle price.
+	MarketVolatility(context.Context, *QueryMarketVolatilityRequest) (*QueryMarketVolatilityResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
+func (*UnimplementedQueryServer) AllVaults(ctx context.Context, req *QueryAllVaultsRequest) (*QueryAllVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllVaults not implemented")
+}
+func (*UnimplementedQueryServer) OwnerShares(ctx context.Context, req *QueryOwnerSharesRequest) (*QueryOwnerSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerShares not implemented")
+}
+func (*UnimplementedQueryServer) VaultQuotingStatus(ctx context.Context, req *QueryVaultQuotingStatusRequest) (*QueryVaultQuotingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultQuotingStatus not implemented")
+}
+func (*UnimplementedQueryServer) QuoteDeposit(ctx context.Context, req *QueryQuoteDepositRequest) (*QueryQuoteDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteDeposit not implemented")
+}
+func (*UnimplementedQueryServer) VaultQuoteCurve(ctx context.Context, req *QueryVaultQuoteCurveRequest) (*QueryVaultQuoteCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultQuoteCurve not implemented")
+}
+func (*UnimplementedQueryServer) VaultQuotedNotional(ctx context.Context, req *QueryVaultQuotedNotionalRequest) (*QueryVaultQuotedNotionalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultQuotedNotional not implemented")
+}
+func (*UnimplementedQueryServer) DecodeVaultClientId(ctx context.Context, req *QueryDecodeVaultClientIdRequest) (*QueryDecodeVaultClientIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeVaultClientId not implemented")
+}
+func (*UnimplementedQueryServer) VaultFillStats(ctx context.Context, req *QueryVaultFillStatsRequest) (*QueryVaultFillStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultFillStats not implemented")
+}
+func (*UnimplementedQueryServer) VaultMargin(ctx context.Context, req *QueryVaultMarginRequest) (*QueryVaultMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultMargin not implemented")
+}
+func (*UnimplementedQueryServer) VaultActivityLog(ctx context.Context, req *QueryVaultActivityLogRequest) (*QueryVaultActivityLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultActivityLog not implemented")
+}
+func (*UnimplementedQueryServer) VaultRefreshHistory(ctx context.Context, req *QueryVaultRefreshHistoryRequest) (*QueryVaultRefreshHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultRefreshHistory not implemented")
+}
+func (*UnimplementedQueryServer) VaultValueAtRisk(ctx context.Context, req *QueryVaultValueAtRiskRequest) (*QueryVaultValueAtRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultValueAtRisk not implemented")
+}
+func (*UnimplementedQueryServer) OwnerUnrealizedPnl(ctx context.Context, req *QueryOwnerUnrealizedPnlRequest) (*QueryOwnerUnrealizedPnlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerUnrealizedPnl not implemented")
+}
+func (*UnimplementedQueryServer) TotalVaultTvl(ctx context.Context, req *QueryTotalVaultTvlRequest) (*QueryTotalVaultTvlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVaultTvl not implemented")
+}
+func (*UnimplementedQueryServer) TotalVaultInventory(ctx context.Context, req *QueryTotalVaultInventoryRequest) (*QueryTotalVaultInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVaultInventory not implemented")
+}
+func (*UnimplementedQueryServer) ExplainVaultOrder(ctx context.Context, req *QueryExplainVaultOrderRequest) (*QueryExplainVaultOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainVaultOrder not implemented")
+}
+func (*UnimplementedQueryServer) OrphanedVaultOrders(ctx context.Context, req *QueryOrphanedVaultOrdersRequest) (*QueryOrphanedVaultOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrphanedVaultOrders not implemented")
+}
+func (*UnimplementedQueryServer) VaultMakerEdge(ctx context.Context, req *QueryVaultMakerEdgeRequest) (*QueryVaultMakerEdgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultMakerEdge not implemented")
+}
+func (*UnimplementedQueryServer) CurrentBlockClientIdParity(ctx context.Context, req *QueryCurrentBlockClientIdParityRequest) (*QueryCurrentBlockClientIdParityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentBlockClientIdParity not implemented")
+}
+func (*UnimplementedQueryServer) VaultLastQuotePrice(ctx context.Context, req *QueryVaultLastQuotePriceRequest) (*QueryVaultLastQuotePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultLastQuotePrice not implemented")
+}
+func (*UnimplementedQueryServer) MarketVolatility(ctx context.Context, req *QueryMarketVolatilityRequest) (*QueryMarketVolatilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketVolatility not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/Vault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllVaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/AllVaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllVaults(ctx, req.(*QueryAllVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OwnerShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/OwnerShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerShares(ctx, req.(*QueryOwnerSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultQuotingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultQuotingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultQuotingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultQuotingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultQuotingStatus(ctx, req.(*QueryVaultQuotingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/QuoteDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteDeposit(ctx, req.(*QueryQuoteDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultQuoteCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultQuoteCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultQuoteCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultQuoteCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultQuoteCurve(ctx, req.(*QueryVaultQuoteCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultQuotedNotional_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultQuotedNotionalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultQuotedNotional(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultQuotedNotional",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultQuotedNotional(ctx, req.(*QueryVaultQuotedNotionalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecodeVaultClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecodeVaultClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodeVaultClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/DecodeVaultClientId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodeVaultClientId(ctx, req.(*QueryDecodeVaultClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultFillStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultFillStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultFillStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultFillStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultFillStats(ctx, req.(*QueryVaultFillStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultMarginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultMargin(ctx, req.(*QueryVaultMarginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultActivityLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultActivityLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultActivityLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultActivityLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultActivityLog(ctx, req.(*QueryVaultActivityLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultRefreshHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRefreshHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultRefreshHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultRefreshHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultRefreshHistory(ctx, req.(*QueryVaultRefreshHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultValueAtRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultValueAtRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultValueAtRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultValueAtRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultValueAtRisk(ctx, req.(*QueryVaultValueAtRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OwnerUnrealizedPnl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerUnrealizedPnlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerUnrealizedPnl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/OwnerUnrealizedPnl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerUnrealizedPnl(ctx, req.(*QueryOwnerUnrealizedPnlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalVaultTvl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalVaultTvlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVaultTvl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/TotalVaultTvl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVaultTvl(ctx, req.(*QueryTotalVaultTvlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalVaultInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalVaultInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVaultInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/TotalVaultInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVaultInventory(ctx, req.(*QueryTotalVaultInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExplainVaultOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExplainVaultOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExplainVaultOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/ExplainVaultOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExplainVaultOrder(ctx, req.(*QueryExplainVaultOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrphanedVaultOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrphanedVaultOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrphanedVaultOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/OrphanedVaultOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrphanedVaultOrders(ctx, req.(*QueryOrphanedVaultOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultMakerEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultMakerEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultMakerEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultMakerEdge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultMakerEdge(ctx, req.(*QueryVaultMakerEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentBlockClientIdParity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentBlockClientIdParityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentBlockClientIdParity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/CurrentBlockClientIdParity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentBlockClientIdParity(ctx, req.(*QueryCurrentBlockClientIdParityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultLastQuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultLastQuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultLastQuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/VaultLastQuotePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultLastQuotePrice(ctx, req.(*QueryVaultLastQuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketVolatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketVolatilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketVolatility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/MarketVolatility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketVolatility(ctx, req.(*QueryMarketVolatilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.vault.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Query_Vault_Handler,
+		},
+		{
+			MethodName: "AllVaults",
+			Handler:    _Query_AllVaults_Handler,
+		},
+		{
+			MethodName: "OwnerShares",
+			Handler:    _Query_OwnerShares_Handler,
+		},
+		{
+			MethodName: "VaultQuotingStatus",
+			Handler:    _Query_VaultQuotingStatus_Handler,
+		},
+		{
+			MethodName: "QuoteDeposit",
+			Handler:    _Query_QuoteDeposit_Handler,
+		},
+		{
+			MethodName: "VaultQuoteCurve",
+			Handler:    _Query_VaultQuoteCurve_Handler,
+		},
+		{
+			MethodName: "VaultQuotedNotional",
+			Handler:    _Query_VaultQuotedNotional_Handler,
+		},
+		{
+			MethodName: "DecodeVaultClientId",
+			Handler:    _Query_DecodeVaultClientId_Handler,
+		},
+		{
+			MethodName: "VaultFillStats",
+			Handler:    _Query_VaultFillStats_Handler,
+		},
+		{
+			MethodName: "VaultMargin",
+			Handler:    _Query_VaultMargin_Handler,
+		},
+		{
+			MethodName: "VaultActivityLog",
+			Handler:    _Query_VaultActivityLog_Handler,
+		},
+		{
+			MethodName: "VaultRefreshHistory",
+			Handler:    _Query_VaultRefreshHistory_Handler,
+		},
+		{
+			MethodName: "VaultValueAtRisk",
+			Handler:    _Query_VaultValueAtRisk_Handler,
+		},
+		{
+			MethodName: "OwnerUnrealizedPnl",
+			Handler:    _Query_OwnerUnrealizedPnl_Handler,
+		},
+		{
+			MethodName: "TotalVaultTvl",
+			Handler:    _Query_TotalVaultTvl_Handler,
+		},
+		{
+			MethodName: "TotalVaultInventory",
+			Handler:    _Query_TotalVaultInventory_Handler,
+		},
+		{
+			MethodName: "ExplainVaultOrder",
+			Handler:    _Query_ExplainVaultOrder_Handler,
+		},
+		{
+			MethodName: "OrphanedVaultOrders",
+			Handler:    _Query_OrphanedVaultOrders_Handler,
+		},
+		{
+			MethodName: "VaultMakerEdge",
+			Handler:    _Query_VaultMakerEdge_Handler,
+		},
+		{
+			MethodName: "CurrentBlockClientIdParity",
+			Handler:    _Query_CurrentBlockClientIdParity_Handler,
+		},
+		{
+			MethodName: "VaultLastQuotePrice",
+			Handler:    _Query_VaultLastQuotePrice_Handler,
+		},
+		{
+			MethodName: "MarketVolatility",
+			Handler:    _Query_MarketVolatility_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/vault/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Inventory.Size()
+		i -= size
+		if _, err := m.Inventory.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Equity.Size()
+		i -= size
+		if _, err := m.Equity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerShares) > 0 {
+		for iNdEx := len(m.OwnerShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultQuotingStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultQuotingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultQuotingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultQuotingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultQuotingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultQuotingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Quoting {
+		i--
+		if m.Quoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteQuantums))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharePricePpm.Size()
+		i -= size
+		if _, err := m.SharePricePpm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SharesOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultQuoteCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultQuoteCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultQuoteCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultQuoteCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultQuoteCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultQuoteCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleSubticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuoteCurvePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteCurvePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteCurvePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Layer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Layer))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantums))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Subticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Subticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultQuotedNotionalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultQuotedNotionalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultQuotedNotionalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultQuotedNotionalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultQuotedNotionalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultQuotedNotionalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AskNotional.Size()
+		i -= size
+		if _, err := m.AskNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BidNotional.Size()
+		i -= size
+		if _, err := m.BidNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodeVaultClientIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodeVaultClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodeVaultClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClientId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodeVaultClientIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodeVaultClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodeVaultClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Layer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Layer))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockParity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockParity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultFillStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultFillStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultFillStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultFillStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultFillStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultFillStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultMarginRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultMarginRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultMarginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FreeCollateralQuoteQuantums.Size()
+		i -= size
+		if _, err := m.FreeCollateralQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaintenanceMarginQuoteQuantums.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialMarginQuoteQuantums.Size()
+		i -= size
+		if _, err := m.InitialMarginQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultActivityLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultActivityLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultActivityLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultActivityLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultActivityLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultActivityLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Activities) > 0 {
+		for iNdEx := len(m.Activities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Activities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRefreshHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRefreshHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRefreshHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRefreshHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRefreshHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRefreshHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refreshes) > 0 {
+		for iNdEx := len(m.Refreshes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refreshes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultValueAtRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultValueAtRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultValueAtRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfidencePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfidencePpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultValueAtRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultValueAtRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultValueAtRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValueAtRiskQuoteQuantums.Size()
+		i -= size
+		if _, err := m.ValueAtRiskQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerUnrealizedPnlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerUnrealizedPnlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerUnrealizedPnlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CostBasisQuoteQuantums.Size()
+		i -= size
+		if _, err := m.CostBasisQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnrealizedPnlQuoteQuantums.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVaultTvlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVaultTvlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVaultTvlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVaultTvlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVaultTvlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVaultTvlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VaultCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VaultCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TotalEquityQuoteQuantums.Size()
+		i -= size
+		if _, err := m.TotalEquityQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVaultInventoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVaultInventoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVaultInventoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVaultInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVaultInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVaultInventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InventoryBaseQuantums.Size()
+		i -= size
+		if _, err := m.InventoryBaseQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExplainVaultOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExplainVaultOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainVaultOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Layer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Layer))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExplainVaultOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExplainVaultOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainVaultOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Explanation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultOrderExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultOrderExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultOrderExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeBaseQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SizeBaseQuantums))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RoundedSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RoundedSubticks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.BoundedSubticks.Size()
+		i -= size
+		if _, err := m.BoundedSubticks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RawSubticks.Size()
+		i -= size
+		if _, err := m.RawSubticks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SkewPpm.Size()
+		i -= size
+		if _, err := m.SkewPpm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LeveragePpm.Size()
+		i -= size
+		if _, err := m.LeveragePpm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SpreadPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpreadPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Layer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Layer))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrphanedVaultOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrphanedVaultOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrphanedVaultOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrphanedVaultOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrphanedVaultOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrphanedVaultOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultMakerEdgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultMakerEdgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultMakerEdgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultMakerEdgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultMakerEdgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultMakerEdgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MakerEdgePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64((uint64(m.MakerEdgePpm)<<1)^uint64((m.MakerEdgePpm>>63))))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentBlockClientIdParityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentBlockClientIdParityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentBlockClientIdParityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentBlockClientIdParityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentBlockClientIdParityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentBlockClientIdParityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockParity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockParity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultLastQuotePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultLastQuotePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultLastQuotePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultLastQuotePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultLastQuotePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultLastQuotePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OraclePrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OraclePrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastQuotePrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastQuotePrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64((uint32(m.Exponent)<<1)^uint32((m.Exponent>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketVolatilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketVolatilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketVolatilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketVolatilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketVolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketVolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsufficientData {
+		i--
+		if m.InsufficientData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Volatility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VaultId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Equity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inventory.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OwnerShares) > 0 {
+		for _, e := range m.OwnerShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultQuotingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultQuotingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quoting {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.QuoteQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.QuoteQuantums))
+	}
+	return n
+}
+
+func (m *QueryQuoteDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SharesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SharePricePpm.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultQuoteCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultQuoteCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OracleSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.OracleSubticks))
+	}
+	return n
+}
+
+func (m *QuoteCurvePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.Subticks != 0 {
+		n += 1 + sovQuery(uint64(m.Subticks))
+	}
+	if m.Quantums != 0 {
+		n += 1 + sovQuery(uint64(m.Quantums))
+	}
+	if m.Layer != 0 {
+		n += 1 + sovQuery(uint64(m.Layer))
+	}
+	return n
+}
+
+func (m *QueryVaultQuotedNotionalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultQuotedNotionalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BidNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AskNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDecodeVaultClientIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientId != 0 {
+		n += 1 + sovQuery(uint64(m.ClientId))
+	}
+	return n
+}
+
+func (m *QueryDecodeVaultClientIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.BlockParity != 0 {
+		n += 1 + sovQuery(uint64(m.BlockParity))
+	}
+	if m.Layer != 0 {
+		n += 1 + sovQuery(uint64(m.Layer))
+	}
+	return n
+}
+
+func (m *QueryVaultFillStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultFillStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultMarginRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialMarginQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMarginQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeCollateralQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultActivityLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultActivityLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activities) > 0 {
+		for _, e := range m.Activities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultRefreshHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultRefreshHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refreshes) > 0 {
+		for _, e := range m.Refreshes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVaultValueAtRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.ConfidencePpm != 0 {
+		n += 1 + sovQuery(uint64(m.ConfidencePpm))
+	}
+	return n
+}
+
+func (m *QueryVaultValueAtRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValueAtRiskQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOwnerUnrealizedPnlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerUnrealizedPnlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnrealizedPnlQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CostBasisQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalVaultTvlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalVaultTvlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalEquityQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.VaultCount != 0 {
+		n += 1 + sovQuery(uint64(m.VaultCount))
+	}
+	return n
+}
+
+func (m *QueryTotalVaultInventoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	return n
+}
+
+func (m *QueryTotalVaultInventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InventoryBaseQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExplainVaultOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.Layer != 0 {
+		n += 1 + sovQuery(uint64(m.Layer))
+	}
+	return n
+}
+
+func (m *QueryExplainVaultOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Explanation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VaultOrderExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.Layer != 0 {
+		n += 1 + sovQuery(uint64(m.Layer))
+	}
+	if m.SpreadPpm != 0 {
+		n += 1 + sovQuery(uint64(m.SpreadPpm))
+	}
+	l = m.LeveragePpm.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SkewPpm.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RawSubticks.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BoundedSubticks.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RoundedSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.RoundedSubticks))
+	}
+	if m.SizeBaseQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.SizeBaseQuantums))
+	}
+	return n
+}
+
+func (m *QueryOrphanedVaultOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryOrphanedVaultOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryOwnerSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	if m.Number != 0 {
-		n += 1 + sovQuery(uint64(m.Number))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *QueryVaultMakerEdgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultMakerEdgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MakerEdgePpm != 0 {
+		n += 1 + sozQuery(uint64(m.MakerEdgePpm))
+	}
+	return n
+}
+
+func (m *QueryCurrentBlockClientIdParityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentBlockClientIdParityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockParity != 0 {
+		n += 1 + sovQuery(uint64(m.BlockParity))
+	}
+	return n
+}
+
+func (m *QueryVaultLastQuotePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryVaultLastQuotePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sozQuery(uint64(m.Exponent))
+	}
+	if m.LastQuotePrice != 0 {
+		n += 1 + sovQuery(uint64(m.LastQuotePrice))
+	}
+	if m.OraclePrice != 0 {
+		n += 1 + sovQuery(uint64(m.OraclePrice))
+	}
+	return n
+}
+
+func (m *QueryMarketVolatilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryMarketVolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InsufficientData {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equity", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Equity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inventory", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inventory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, &QueryVaultResponse{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerShares = append(m.OwnerShares, &OwnerShare{})
+			if err := m.OwnerShares[len(m.OwnerShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultQuotingStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultQuotingStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultQuotingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultQuotingStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultQuotingStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultQuotingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quoting = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
+			}
+			m.QuoteQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePricePpm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePricePpm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultQuoteCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultQuoteCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultQuoteCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultQuoteCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultQuoteCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultQuoteCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, QuoteCurvePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSubticks", wireType)
+			}
+			m.OracleSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteCurvePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteCurvePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteCurvePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= types1.Order_Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			}
+			m.Subticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			m.Quantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			m.Layer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultQuotedNotionalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultQuotedNotionalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultQuotedNotionalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultQuotedNotionalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultQuotedNotionalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultQuotedNotionalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecodeVaultClientIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecodeVaultClientIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecodeVaultClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			m.ClientId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecodeVaultClientIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecodeVaultClientIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecodeVaultClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= types1.Order_Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockParity", wireType)
+			}
+			m.BlockParity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockParity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			m.Layer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultFillStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultFillStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultFillStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultFillStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultFillStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultFillStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultMarginRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultMarginRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultMarginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMarginQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateralQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeCollateralQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultActivityLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultActivityLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultActivityLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultActivityLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultActivityLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultActivityLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Activities = append(m.Activities, &VaultActivity{})
+			if err := m.Activities[len(m.Activities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRefreshHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRefreshHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRefreshHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRefreshHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRefreshHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRefreshHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refreshes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refreshes = append(m.Refreshes, VaultRefresh{})
+			if err := m.Refreshes[len(m.Refreshes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultValueAtRiskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultValueAtRiskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultValueAtRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfidencePpm", wireType)
+			}
+			m.ConfidencePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfidencePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultValueAtRiskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultValueAtRiskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultValueAtRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueAtRiskQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValueAtRiskQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerUnrealizedPnlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerUnrealizedPnlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerUnrealizedPnlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerUnrealizedPnlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerUnrealizedPnlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerUnrealizedPnlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBasisQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CostBasisQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVaultTvlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVaultTvlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVaultTvlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVaultTvlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVaultTvlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVaultTvlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEquityQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalEquityQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultCount", wireType)
+			}
+			m.VaultCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VaultCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVaultInventoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVaultInventoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVaultInventoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVaultInventoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVaultInventoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVaultInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InventoryBaseQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InventoryBaseQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainVaultOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainVaultOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainVaultOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= types1.Order_Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			m.Layer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainVaultOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainVaultOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainVaultOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Explanation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultOrderExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultOrderExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultOrderExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= types1.Order_Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layer", wireType)
+			}
+			m.Layer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layer |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadPpm", wireType)
+			}
+			m.SpreadPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadPpm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeveragePpm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeveragePpm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkewPpm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SkewPpm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawSubticks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawSubticks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundedSubticks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoundedSubticks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundedSubticks", wireType)
+			}
+			m.RoundedSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundedSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBaseQuantums", wireType)
+			}
+			m.SizeBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryOwnerSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OwnerShares) > 0 {
-		for _, e := range m.OwnerShares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrphanedVaultOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,12 +10450,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrphanedVaultOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrphanedVaultOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1234,7 +10515,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrphanedVaultOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1257,15 +10538,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrphanedVaultOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrphanedVaultOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,7 +10573,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, types1.Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1317,7 +10599,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultMakerEdgeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1340,10 +10622,10 @@ func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultMakerEdgeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultMakerEdgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1405,7 +10687,7 @@ func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultMakerEdgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1428,149 +10710,17 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultMakerEdgeResponse: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equity", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Equity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inventory", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Inventory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultMakerEdgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerEdgePpm", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1580,25 +10730,63 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.MakerEdgePpm = int64(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentBlockClientIdParityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentBlockClientIdParityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentBlockClientIdParityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1620,7 +10808,7 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVaultsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentBlockClientIdParityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1643,17 +10831,17 @@ func (m *QueryAllVaultsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVaultsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentBlockClientIdParityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentBlockClientIdParityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockParity", wireType)
 			}
-			var msglen int
+			m.BlockParity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1663,28 +10851,11 @@ func (m *QueryAllVaultsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockParity |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1706,7 +10877,7 @@ func (m *QueryAllVaultsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVaultsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultLastQuotePriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1729,17 +10900,17 @@ func (m *QueryAllVaultsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVaultsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultLastQuotePriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultLastQuotePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1749,31 +10920,16 @@ func (m *QueryAllVaultsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= VaultType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Vaults = append(m.Vaults, &QueryVaultResponse{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1783,28 +10939,11 @@ func (m *QueryAllVaultsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1826,7 +10965,7 @@ func (m *QueryAllVaultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultLastQuotePriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1849,17 +10988,17 @@ func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultLastQuotePriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultLastQuotePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.Type = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1869,16 +11008,16 @@ func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= VaultType(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			m.Number = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1888,16 +11027,18 @@ func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Exponent = v
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastQuotePrice", wireType)
 			}
-			var msglen int
+			m.LastQuotePrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1907,28 +11048,30 @@ func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastQuotePrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePrice", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OraclePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OraclePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1950,7 +11093,7 @@ func (m *QueryOwnerSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketVolatilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1973,17 +11116,17 @@ func (m *QueryOwnerSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketVolatilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketVolatilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerShares", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1993,29 +11136,64 @@ func (m *QueryOwnerSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerShares = append(m.OwnerShares, &OwnerShare{})
-			if err := m.OwnerShares[len(m.OwnerShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketVolatilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketVolatilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketVolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2042,13 +11220,30 @@ func (m *QueryOwnerSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsufficientData = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,9 @@
+package types
+
+// Reasons why a vault is not quoting, i.e. doesn't place any orders.
+const (
+	QuotingStatusReasonNonPositiveShares        = "vault has non-positive total shares"
+	QuotingStatusReasonBelowActivationThreshold = "vault has no perpetual positions and quote balance below activation threshold"
+	QuotingStatusReasonNonPositiveEquity        = "vault has non-positive equity"
+	QuotingStatusReasonZeroOrderSize            = "vault order size is zero"
+)
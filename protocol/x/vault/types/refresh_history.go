@@ -0,0 +1,16 @@
+package types
+
+// MaxVaultRefreshHistoryLength is the maximum number of refreshes kept in a vault's refresh
+// history, beyond which the oldest refresh is dropped.
+const MaxVaultRefreshHistoryLength = 20
+
+// Reasons why a vault didn't place orders when refreshing its orders.
+const (
+	RefreshSkipReasonZeroLayers          = "layers is zero"
+	RefreshSkipReasonLiquidatable        = "vault subaccount is liquidatable"
+	RefreshSkipReasonUndercollateralized = "vault subaccount is undercollateralized"
+	RefreshSkipReasonTooRecent           = "vault refreshed its orders too recently"
+	RefreshSkipReasonSameParity          = "block has the same parity as block of last refresh"
+	RefreshSkipReasonMarketInactive      = "clob pair is not active"
+	RefreshSkipReasonUnchanged           = "orders to place are the same as resting orders"
+)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"math/big"
+)
+
+// VaultSweepCost is the cost of a hypothetical sweep of a given size against orders of a
+// CLOB vault.
+type VaultSweepCost struct {
+	// Average price (in subticks) at which the sweep fills.
+	AvgPriceSubticks *big.Rat
+	// Notional (in quote quantums) of the sweep.
+	QuoteQuantums *big.Int
+}
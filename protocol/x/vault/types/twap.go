@@ -0,0 +1,37 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// TwapEwmaAlphaPpm is the weight (in ppm) of the latest oracle price in the EWMA of
+// per-block oracle prices that `MarketTwap` tracks.
+const TwapEwmaAlphaPpm = 100_000
+
+// Update returns TWAP after observing the given market price, which is folded into the
+// EWMA. If there's no TWAP yet or its exponent differs from that of the given price, TWAP
+// is reset to the given price.
+func (t MarketTwap) Update(marketPrice pricestypes.MarketPrice) MarketTwap {
+	if t.Price == 0 || t.Exponent != marketPrice.Exponent {
+		return MarketTwap{
+			Price:    marketPrice.Price,
+			Exponent: marketPrice.Exponent,
+		}
+	}
+
+	// twap = alpha * price + (1 - alpha) * twap
+	twap := new(big.Int).Mul(lib.BigU(marketPrice.Price), lib.BigU(uint32(TwapEwmaAlphaPpm)))
+	twap.Add(
+		twap,
+		new(big.Int).Mul(lib.BigU(t.Price), lib.BigU(uint32(1_000_000-TwapEwmaAlphaPpm))),
+	)
+	twap.Quo(twap, lib.BigIntOneMillion())
+
+	return MarketTwap{
+		Price:    twap.Uint64(),
+		Exponent: marketPrice.Exponent,
+	}
+}
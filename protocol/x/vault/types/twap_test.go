@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketTwap_Update(t *testing.T) {
+	tests := map[string]struct {
+		twap         types.MarketTwap
+		marketPrice  pricestypes.MarketPrice
+		expectedTwap types.MarketTwap
+	}{
+		"No TWAP: TWAP is set to price": {
+			twap: types.MarketTwap{},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+			expectedTwap: types.MarketTwap{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+		},
+		"Price up 10%": {
+			twap: types.MarketTwap{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_500_000,
+				Exponent: -5,
+			},
+			expectedTwap: types.MarketTwap{
+				// 10% * 5_500_000 + 90% * 5_000_000
+				Price:    5_050_000,
+				Exponent: -5,
+			},
+		},
+		"Unchanged price": {
+			twap: types.MarketTwap{
+				Price:    5_050_000,
+				Exponent: -5,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_050_000,
+				Exponent: -5,
+			},
+			expectedTwap: types.MarketTwap{
+				Price:    5_050_000,
+				Exponent: -5,
+			},
+		},
+		"Exponent changed: TWAP is reset to price": {
+			twap: types.MarketTwap{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    50_000_000,
+				Exponent: -6,
+			},
+			expectedTwap: types.MarketTwap{
+				Price:    50_000_000,
+				Exponent: -6,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedTwap, tc.twap.Update(tc.marketPrice))
+		})
+	}
+}
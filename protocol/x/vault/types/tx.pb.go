@@ -355,619 +355,3437 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgDepositToVault)(nil), "dydxprotocol.vault.MsgDepositToVault")
-	proto.RegisterType((*MsgDepositToVaultResponse)(nil), "dydxprotocol.vault.MsgDepositToVaultResponse")
-	proto.RegisterType((*MsgWithdrawFromVault)(nil), "dydxprotocol.vault.MsgWithdrawFromVault")
-	proto.RegisterType((*MsgWithdrawFromVaultResponse)(nil), "dydxprotocol.vault.MsgWithdrawFromVaultResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "dydxprotocol.vault.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dydxprotocol.vault.MsgUpdateParamsResponse")
+// MsgSetVaultLabel is the Msg/SetVaultLabel request type.
+type MsgSetVaultLabel struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The vault to set the label of.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+	// The label to set. An empty label removes the vault's label.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/vault/tx.proto", fileDescriptor_ced574c6017ce006) }
+func (m *MsgSetVaultLabel) Reset()         { *m = MsgSetVaultLabel{} }
+func (m *MsgSetVaultLabel) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultLabel) ProtoMessage()    {}
+func (*MsgSetVaultLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{6}
+}
+func (m *MsgSetVaultLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVaultLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVaultLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultLabel.Merge(m, src)
+}
+func (m *MsgSetVaultLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultLabel.DiscardUnknown(m)
+}
 
-var fileDescriptor_ced574c6017ce006 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x16, 0x7e, 0xfc, 0x64, 0x80, 0x02, 0x13, 0x22, 0xa5, 0xe8, 0x42, 0x6a, 0x34, 0xa8,
-	0x61, 0x57, 0x51, 0xd1, 0x70, 0xd2, 0x46, 0x09, 0x84, 0xd4, 0xc8, 0xd6, 0x3f, 0x89, 0x97, 0x75,
-	0xda, 0x19, 0xb7, 0x9b, 0x74, 0x77, 0xca, 0xce, 0x6c, 0x01, 0x8f, 0x7c, 0x02, 0x13, 0xcf, 0x7e,
-	0x07, 0x0f, 0x7e, 0x00, 0x8f, 0x1c, 0x89, 0x27, 0xe3, 0x81, 0x18, 0x48, 0xf4, 0x3b, 0x78, 0x32,
-	0x3b, 0x3b, 0xbb, 0xec, 0xd2, 0x25, 0x59, 0x13, 0x0e, 0x5e, 0xda, 0xf9, 0xf3, 0xbc, 0xcf, 0xf3,
-	0xbe, 0xcf, 0xbc, 0x7d, 0x0b, 0x66, 0xf1, 0x2e, 0xde, 0xe9, 0x7a, 0x94, 0xd3, 0x16, 0xed, 0xe8,
-	0x3d, 0xe4, 0x77, 0xb8, 0xce, 0x77, 0x34, 0x71, 0x02, 0x61, 0xf2, 0x52, 0x13, 0x97, 0x95, 0x99,
-	0x16, 0x65, 0x0e, 0x65, 0xa6, 0x38, 0xd6, 0xc3, 0x4d, 0x08, 0xaf, 0x4c, 0x87, 0x3b, 0xdd, 0x61,
-	0x96, 0xde, 0xbb, 0x1d, 0x7c, 0xc9, 0x8b, 0xeb, 0x29, 0x11, 0xe6, 0x37, 0x51, 0xab, 0x45, 0x7d,
-	0x97, 0xb3, 0xc4, 0x5a, 0x42, 0xe7, 0x32, 0xf2, 0xe9, 0x22, 0x0f, 0x39, 0x91, 0x88, 0x9a, 0x01,
-	0x10, 0x9f, 0xf2, 0x7e, 0xca, 0xa2, 0x16, 0x0d, 0x93, 0x0b, 0x56, 0xe1, 0x69, 0xf5, 0x63, 0x11,
-	0x4c, 0xd6, 0x99, 0xf5, 0x98, 0x74, 0x29, 0xb3, 0xf9, 0x73, 0xfa, 0x32, 0x88, 0x80, 0xcb, 0xe0,
-	0x82, 0x08, 0x35, 0x6d, 0x5c, 0x56, 0xe6, 0x95, 0x85, 0x91, 0xa5, 0x59, 0xad, 0xbf, 0x64, 0x4d,
-	0x80, 0xd7, 0xb1, 0xf1, 0x7f, 0x2f, 0x5c, 0xc0, 0x0d, 0x30, 0x76, 0x92, 0x78, 0x10, 0x5c, 0x14,
-	0xc1, 0xd7, 0xd2, 0xc1, 0x89, 0x3a, 0xb5, 0x46, 0xbc, 0x5e, 0xc7, 0xc6, 0x28, 0x4b, 0xec, 0x20,
-	0x05, 0xa5, 0x2d, 0x9f, 0x72, 0x62, 0x6e, 0xf9, 0xc8, 0xe5, 0xbe, 0xc3, 0xca, 0x03, 0xf3, 0xca,
-	0xc2, 0x68, 0x6d, 0x6d, 0xff, 0x70, 0xae, 0xf0, 0xfd, 0x70, 0xee, 0xa1, 0x65, 0xf3, 0xb6, 0xdf,
-	0xd4, 0x5a, 0xd4, 0xd1, 0xd3, 0xb5, 0xdf, 0x5d, 0x6c, 0xb5, 0x91, 0xed, 0xea, 0xf1, 0x09, 0xe6,
-	0xbb, 0x5d, 0xc2, 0xb4, 0x06, 0xf1, 0x6c, 0xd4, 0xb1, 0xdf, 0xa1, 0x66, 0x87, 0xac, 0xbb, 0xdc,
-	0x18, 0x13, 0xfc, 0x9b, 0x92, 0x7e, 0x05, 0xee, 0xfd, 0xfa, 0x74, 0x23, 0x5d, 0x40, 0x75, 0x16,
-	0xcc, 0xf4, 0xd9, 0x63, 0x10, 0xd6, 0xa5, 0x2e, 0x23, 0xd5, 0x9f, 0x0a, 0x98, 0xaa, 0x33, 0xeb,
-	0x95, 0xcd, 0xdb, 0xd8, 0x43, 0xdb, 0xab, 0x1e, 0x75, 0xfe, 0x21, 0xff, 0xee, 0x81, 0x21, 0xd6,
-	0x46, 0x1e, 0x09, 0x7d, 0x1b, 0x59, 0xba, 0x9c, 0x95, 0xc2, 0x53, 0xdf, 0x69, 0x08, 0x90, 0x21,
-	0xc1, 0x99, 0x2e, 0xfc, 0x1e, 0x00, 0x97, 0xb2, 0x0a, 0x8d, 0x9c, 0x80, 0xab, 0x60, 0xdc, 0x23,
-	0x98, 0x10, 0x87, 0x60, 0x53, 0x8a, 0x2a, 0x79, 0x44, 0x4b, 0x51, 0x54, 0xb8, 0x87, 0x7b, 0x0a,
-	0x28, 0x6f, 0x4b, 0x15, 0xd7, 0x3c, 0xf5, 0xfc, 0xc5, 0x73, 0x7e, 0xfe, 0x8b, 0xb1, 0xd2, 0x66,
-	0xb2, 0x0f, 0xe0, 0x1a, 0x98, 0xf0, 0x88, 0x83, 0x6c, 0xd7, 0x76, 0x2d, 0xf3, 0x6f, 0x2c, 0x1c,
-	0x8f, 0xc3, 0x64, 0x39, 0x1b, 0x00, 0x72, 0xca, 0x51, 0xc7, 0x0c, 0xbb, 0x41, 0x72, 0x0d, 0xe6,
-	0xe1, 0x9a, 0x10, 0x81, 0xc2, 0x65, 0x49, 0xd6, 0x4b, 0x93, 0x91, 0x2d, 0xdf, 0xe6, 0xbb, 0xe5,
-	0xff, 0xce, 0xd9, 0x94, 0x84, 0xee, 0x13, 0xa1, 0x50, 0xfd, 0xa0, 0x80, 0xf1, 0x3a, 0xb3, 0x5e,
-	0x74, 0x31, 0xe2, 0xe4, 0x99, 0x18, 0x39, 0x70, 0x19, 0x0c, 0x23, 0x9f, 0xb7, 0xa9, 0x17, 0xa4,
-	0x10, 0xbc, 0xf4, 0x70, 0xad, 0xfc, 0xf5, 0xf3, 0xe2, 0x94, 0x1c, 0x7b, 0x8f, 0x30, 0xf6, 0x08,
-	0x63, 0x0d, 0xee, 0xd9, 0xae, 0x65, 0x9c, 0x40, 0xe1, 0x03, 0x30, 0x14, 0x0e, 0x2d, 0xd9, 0xd9,
-	0x95, 0x2c, 0x13, 0x42, 0x8d, 0xda, 0x60, 0x50, 0x93, 0x21, 0xf1, 0x2b, 0xa5, 0xa0, 0x2d, 0x4f,
-	0x98, 0xaa, 0x33, 0x60, 0xfa, 0x54, 0x52, 0x51, 0x33, 0x2e, 0x7d, 0x29, 0x82, 0x81, 0x3a, 0xb3,
-	0xe0, 0x5b, 0x50, 0x3a, 0x35, 0xd7, 0xae, 0x66, 0xc9, 0xf5, 0xfd, 0xbe, 0x2b, 0x8b, 0xb9, 0x60,
-	0x71, 0xf3, 0x53, 0x30, 0xd9, 0x3f, 0x02, 0x16, 0xce, 0xe0, 0xe8, 0x43, 0x56, 0x6e, 0xe5, 0x45,
-	0xc6, 0x82, 0x6f, 0xc0, 0x68, 0xea, 0x35, 0xae, 0x9c, 0xc1, 0x90, 0x04, 0x55, 0x6e, 0xe6, 0x00,
-	0x45, 0x0a, 0xb5, 0xcd, 0xfd, 0x23, 0x55, 0x39, 0x38, 0x52, 0x95, 0x1f, 0x47, 0xaa, 0xf2, 0xfe,
-	0x58, 0x2d, 0x1c, 0x1c, 0xab, 0x85, 0x6f, 0xc7, 0x6a, 0xe1, 0xf5, 0xfd, 0xfc, 0x1d, 0xb6, 0x13,
-	0xfd, 0x6d, 0x06, 0x8d, 0xd6, 0x1c, 0x12, 0xe7, 0x77, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x04,
-	0xff, 0xd7, 0x93, 0x59, 0x07, 0x00, 0x00,
+var xxx_messageInfo_MsgSetVaultLabel proto.InternalMessageInfo
+
+func (m *MsgSetVaultLabel) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetVaultLabel) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
+	}
+	return VaultId{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetVaultLabel) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// DepositToVault deposits funds into a vault.
-	DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error)
-	// WithdrawFromVault attempts to withdraw funds from a vault.
-	WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error)
-	// UpdateParams updates the Params in state.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgSetVaultLabelResponse is the Msg/SetVaultLabel response type.
+type MsgSetVaultLabelResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetVaultLabelResponse) Reset()         { *m = MsgSetVaultLabelResponse{} }
+func (m *MsgSetVaultLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultLabelResponse) ProtoMessage()    {}
+func (*MsgSetVaultLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{7}
+}
+func (m *MsgSetVaultLabelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVaultLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultLabelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVaultLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultLabelResponse.Merge(m, src)
+}
+func (m *MsgSetVaultLabelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultLabelResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetVaultLabelResponse proto.InternalMessageInfo
+
+// MsgFreezeVaultShares is the Msg/FreezeVaultShares request type.
+type MsgFreezeVaultShares struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The vault to freeze shares in.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+	// The owner whose shares to freeze. Frozen shares can't be withdrawn.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (c *msgClient) DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error) {
-	out := new(MsgDepositToVaultResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/DepositToVault", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeVaultShares) Reset()         { *m = MsgFreezeVaultShares{} }
+func (m *MsgFreezeVaultShares) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeVaultShares) ProtoMessage()    {}
+func (*MsgFreezeVaultShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{8}
+}
+func (m *MsgFreezeVaultShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeVaultShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeVaultShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgFreezeVaultShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeVaultShares.Merge(m, src)
+}
+func (m *MsgFreezeVaultShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeVaultShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeVaultShares.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error) {
-	out := new(MsgWithdrawFromVaultResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/WithdrawFromVault", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFreezeVaultShares proto.InternalMessageInfo
+
+func (m *MsgFreezeVaultShares) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeVaultShares) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
 	}
-	return out, nil
+	return VaultId{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// DepositToVault deposits funds into a vault.
-	DepositToVault(context.Context, *MsgDepositToVault) (*MsgDepositToVaultResponse, error)
-	// WithdrawFromVault attempts to withdraw funds from a vault.
-	WithdrawFromVault(context.Context, *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error)
-	// UpdateParams updates the Params in state.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgFreezeVaultShares) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgFreezeVaultSharesResponse is the Msg/FreezeVaultShares response type.
+type MsgFreezeVaultSharesResponse struct {
 }
 
-func (*UnimplementedMsgServer) DepositToVault(ctx context.Context, req *MsgDepositToVault) (*MsgDepositToVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositToVault not implemented")
+func (m *MsgFreezeVaultSharesResponse) Reset()         { *m = MsgFreezeVaultSharesResponse{} }
+func (m *MsgFreezeVaultSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeVaultSharesResponse) ProtoMessage()    {}
+func (*MsgFreezeVaultSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{9}
 }
-func (*UnimplementedMsgServer) WithdrawFromVault(ctx context.Context, req *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromVault not implemented")
+func (m *MsgFreezeVaultSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgFreezeVaultSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeVaultSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgFreezeVaultSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeVaultSharesResponse.Merge(m, src)
+}
+func (m *MsgFreezeVaultSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeVaultSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeVaultSharesResponse.DiscardUnknown(m)
 }
 
-func _Msg_DepositToVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositToVault)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositToVault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Msg/DepositToVault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositToVault(ctx, req.(*MsgDepositToVault))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgFreezeVaultSharesResponse proto.InternalMessageInfo
+
+// MsgUnfreezeVaultShares is the Msg/UnfreezeVaultShares request type.
+type MsgUnfreezeVaultShares struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The vault to unfreeze shares in.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+	// The owner whose shares to unfreeze.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func _Msg_WithdrawFromVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFromVault)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFromVault(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Msg/WithdrawFromVault",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFromVault(ctx, req.(*MsgWithdrawFromVault))
+func (m *MsgUnfreezeVaultShares) Reset()         { *m = MsgUnfreezeVaultShares{} }
+func (m *MsgUnfreezeVaultShares) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeVaultShares) ProtoMessage()    {}
+func (*MsgUnfreezeVaultShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{10}
+}
+func (m *MsgUnfreezeVaultShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeVaultShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeVaultShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnfreezeVaultShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeVaultShares.Merge(m, src)
+}
+func (m *MsgUnfreezeVaultShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeVaultShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeVaultShares.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.vault.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgUnfreezeVaultShares proto.InternalMessageInfo
+
+func (m *MsgUnfreezeVaultShares) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.vault.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DepositToVault",
-			Handler:    _Msg_DepositToVault_Handler,
-		},
-		{
-			MethodName: "WithdrawFromVault",
-			Handler:    _Msg_WithdrawFromVault_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/vault/tx.proto",
+func (m *MsgUnfreezeVaultShares) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
+	}
+	return VaultId{}
 }
 
-func (m *MsgDepositToVault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnfreezeVaultShares) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDepositToVault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnfreezeVaultSharesResponse is the Msg/UnfreezeVaultShares response type.
+type MsgUnfreezeVaultSharesResponse struct {
 }
 
-func (m *MsgDepositToVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.QuoteQuantums.Size()
-		i -= size
-		if _, err := m.QuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.SubaccountId != nil {
-		{
-			size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUnfreezeVaultSharesResponse) Reset()         { *m = MsgUnfreezeVaultSharesResponse{} }
+func (m *MsgUnfreezeVaultSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeVaultSharesResponse) ProtoMessage()    {}
+func (*MsgUnfreezeVaultSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{11}
+}
+func (m *MsgUnfreezeVaultSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeVaultSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeVaultSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.VaultId != nil {
-		{
-			size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgUnfreezeVaultSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeVaultSharesResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeVaultSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeVaultSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeVaultSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeVaultSharesResponse proto.InternalMessageInfo
+
+// MsgRepairVaultShares is the Msg/RepairVaultShares request type.
+type MsgRepairVaultShares struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The vault whose total shares to recompute from owner shares.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+}
+
+func (m *MsgRepairVaultShares) Reset()         { *m = MsgRepairVaultShares{} }
+func (m *MsgRepairVaultShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRepairVaultShares) ProtoMessage()    {}
+func (*MsgRepairVaultShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{12}
+}
+func (m *MsgRepairVaultShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepairVaultShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepairVaultShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRepairVaultShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepairVaultShares.Merge(m, src)
+}
+func (m *MsgRepairVaultShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepairVaultShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepairVaultShares.DiscardUnknown(m)
 }
 
-func (m *MsgDepositToVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRepairVaultShares proto.InternalMessageInfo
+
+func (m *MsgRepairVaultShares) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDepositToVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRepairVaultShares) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
+	}
+	return VaultId{}
 }
 
-func (m *MsgDepositToVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRepairVaultSharesResponse is the Msg/RepairVaultShares response type.
+type MsgRepairVaultSharesResponse struct {
 }
 
-func (m *MsgWithdrawFromVault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRepairVaultSharesResponse) Reset()         { *m = MsgRepairVaultSharesResponse{} }
+func (m *MsgRepairVaultSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRepairVaultSharesResponse) ProtoMessage()    {}
+func (*MsgRepairVaultSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{13}
+}
+func (m *MsgRepairVaultSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepairVaultSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepairVaultSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRepairVaultSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepairVaultSharesResponse.Merge(m, src)
+}
+func (m *MsgRepairVaultSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepairVaultSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepairVaultSharesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawFromVault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRepairVaultSharesResponse proto.InternalMessageInfo
+
+// MsgCloseVault is the Msg/CloseVault request type.
+type MsgCloseVault struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The vault to close. Must be deactivated and have no resting orders and no
+	// outstanding shares.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+	// The subaccount to transfer all of the vault's equity to.
+	Destination types.SubaccountId `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination"`
 }
 
-func (m *MsgWithdrawFromVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Shares != nil {
-		{
-			size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCloseVault) Reset()         { *m = MsgCloseVault{} }
+func (m *MsgCloseVault) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseVault) ProtoMessage()    {}
+func (*MsgCloseVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{14}
+}
+func (m *MsgCloseVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.SubaccountId != nil {
-		{
-			size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgCloseVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseVault.Merge(m, src)
+}
+func (m *MsgCloseVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseVault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseVault proto.InternalMessageInfo
+
+func (m *MsgCloseVault) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.VaultId != nil {
-		{
-			size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCloseVault) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
 	}
-	return len(dAtA) - i, nil
+	return VaultId{}
 }
 
-func (m *MsgWithdrawFromVaultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCloseVault) GetDestination() types.SubaccountId {
+	if m != nil {
+		return m.Destination
 	}
-	return dAtA[:n], nil
+	return types.SubaccountId{}
 }
 
-func (m *MsgWithdrawFromVaultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCloseVaultResponse is the Msg/CloseVault response type.
+type MsgCloseVaultResponse struct {
+	// Number of quote quantums transferred to the destination subaccount.
+	QuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=quote_quantums,json=quoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quote_quantums"`
 }
 
-func (m *MsgWithdrawFromVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalVaultEquity.Size()
-		i -= size
-		if _, err := m.TotalVaultEquity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.TotalVaultShares != nil {
-		{
-			size, err := m.TotalVaultShares.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCloseVaultResponse) Reset()         { *m = MsgCloseVaultResponse{} }
+func (m *MsgCloseVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseVaultResponse) ProtoMessage()    {}
+func (*MsgCloseVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{15}
+}
+func (m *MsgCloseVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.RemainingShares != nil {
-		{
-			size, err := m.RemainingShares.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgCloseVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseVaultResponse.Merge(m, src)
+}
+func (m *MsgCloseVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseVaultResponse proto.InternalMessageInfo
+
+// MsgOperatorUpdateVaultParams is the Msg/OperatorUpdateVaultParams request
+// type.
+type MsgOperatorUpdateVaultParams struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The new `spread_min_ppm`. Must be within operator param bounds.
+	SpreadMinPpm uint32 `protobuf:"varint,2,opt,name=spread_min_ppm,json=spreadMinPpm,proto3" json:"spread_min_ppm,omitempty"`
+	// The new `order_size_pct_ppm`. Must be within operator param bounds.
+	OrderSizePctPpm uint32 `protobuf:"varint,3,opt,name=order_size_pct_ppm,json=orderSizePctPpm,proto3" json:"order_size_pct_ppm,omitempty"`
+}
+
+func (m *MsgOperatorUpdateVaultParams) Reset()         { *m = MsgOperatorUpdateVaultParams{} }
+func (m *MsgOperatorUpdateVaultParams) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorUpdateVaultParams) ProtoMessage()    {}
+func (*MsgOperatorUpdateVaultParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{16}
+}
+func (m *MsgOperatorUpdateVaultParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOperatorUpdateVaultParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorUpdateVaultParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	{
-		size := m.WithdrawnQuoteQuantums.Size()
-		i -= size
-		if _, err := m.WithdrawnQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgOperatorUpdateVaultParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorUpdateVaultParams.Merge(m, src)
+}
+func (m *MsgOperatorUpdateVaultParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOperatorUpdateVaultParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorUpdateVaultParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOperatorUpdateVaultParams proto.InternalMessageInfo
+
+func (m *MsgOperatorUpdateVaultParams) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.RedeemedShares != nil {
-		{
-			size, err := m.RedeemedShares.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgOperatorUpdateVaultParams) GetSpreadMinPpm() uint32 {
+	if m != nil {
+		return m.SpreadMinPpm
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOperatorUpdateVaultParams) GetOrderSizePctPpm() uint32 {
+	if m != nil {
+		return m.OrderSizePctPpm
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOperatorUpdateVaultParamsResponse is the Msg/OperatorUpdateVaultParams
+// response type.
+type MsgOperatorUpdateVaultParamsResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgOperatorUpdateVaultParamsResponse) Reset()         { *m = MsgOperatorUpdateVaultParamsResponse{} }
+func (m *MsgOperatorUpdateVaultParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorUpdateVaultParamsResponse) ProtoMessage()    {}
+func (*MsgOperatorUpdateVaultParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{17}
+}
+func (m *MsgOperatorUpdateVaultParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOperatorUpdateVaultParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorUpdateVaultParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgOperatorUpdateVaultParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorUpdateVaultParamsResponse.Merge(m, src)
+}
+func (m *MsgOperatorUpdateVaultParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOperatorUpdateVaultParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorUpdateVaultParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOperatorUpdateVaultParamsResponse proto.InternalMessageInfo
+
+// MsgRefreshVaultOrders is the Msg/RefreshVaultOrders request type.
+type MsgRefreshVaultOrders struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The vault whose orders to refresh.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+}
+
+func (m *MsgRefreshVaultOrders) Reset()         { *m = MsgRefreshVaultOrders{} }
+func (m *MsgRefreshVaultOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshVaultOrders) ProtoMessage()    {}
+func (*MsgRefreshVaultOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{18}
+}
+func (m *MsgRefreshVaultOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshVaultOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshVaultOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRefreshVaultOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshVaultOrders.Merge(m, src)
+}
+func (m *MsgRefreshVaultOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshVaultOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshVaultOrders.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRefreshVaultOrders proto.InternalMessageInfo
+
+func (m *MsgRefreshVaultOrders) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefreshVaultOrders) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
+	}
+	return VaultId{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRefreshVaultOrdersResponse is the Msg/RefreshVaultOrders response type.
+type MsgRefreshVaultOrdersResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRefreshVaultOrdersResponse) Reset()         { *m = MsgRefreshVaultOrdersResponse{} }
+func (m *MsgRefreshVaultOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshVaultOrdersResponse) ProtoMessage()    {}
+func (*MsgRefreshVaultOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{19}
 }
-func (m *MsgDepositToVault) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRefreshVaultOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshVaultOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshVaultOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.VaultId != nil {
-		l = m.VaultId.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRefreshVaultOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshVaultOrdersResponse.Merge(m, src)
+}
+func (m *MsgRefreshVaultOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshVaultOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshVaultOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefreshVaultOrdersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgDepositToVault)(nil), "dydxprotocol.vault.MsgDepositToVault")
+	proto.RegisterType((*MsgDepositToVaultResponse)(nil), "dydxprotocol.vault.MsgDepositToVaultResponse")
+	proto.RegisterType((*MsgWithdrawFromVault)(nil), "dydxprotocol.vault.MsgWithdrawFromVault")
+	proto.RegisterType((*MsgWithdrawFromVaultResponse)(nil), "dydxprotocol.vault.MsgWithdrawFromVaultResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "dydxprotocol.vault.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dydxprotocol.vault.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetVaultLabel)(nil), "dydxprotocol.vault.MsgSetVaultLabel")
+	proto.RegisterType((*MsgSetVaultLabelResponse)(nil), "dydxprotocol.vault.MsgSetVaultLabelResponse")
+	proto.RegisterType((*MsgFreezeVaultShares)(nil), "dydxprotocol.vault.MsgFreezeVaultShares")
+	proto.RegisterType((*MsgFreezeVaultSharesResponse)(nil), "dydxprotocol.vault.MsgFreezeVaultSharesResponse")
+	proto.RegisterType((*MsgUnfreezeVaultShares)(nil), "dydxprotocol.vault.MsgUnfreezeVaultShares")
+	proto.RegisterType((*MsgUnfreezeVaultSharesResponse)(nil), "dydxprotocol.vault.MsgUnfreezeVaultSharesResponse")
+	proto.RegisterType((*MsgRepairVaultShares)(nil), "dydxprotocol.vault.MsgRepairVaultShares")
+	proto.RegisterType((*MsgRepairVaultSharesResponse)(nil), "dydxprotocol.vault.MsgRepairVaultSharesResponse")
+	proto.RegisterType((*MsgCloseVault)(nil), "dydxprotocol.vault.MsgCloseVault")
+	proto.RegisterType((*MsgCloseVaultResponse)(nil), "dydxprotocol.vault.MsgCloseVaultResponse")
+	proto.RegisterType((*MsgOperatorUpdateVaultParams)(nil), "dydxprotocol.vault.MsgOperatorUpdateVaultParams")
+	proto.RegisterType((*MsgOperatorUpdateVaultParamsResponse)(nil), "dydxprotocol.vault.MsgOperatorUpdateVaultParamsResponse")
+	proto.RegisterType((*MsgRefreshVaultOrders)(nil), "dydxprotocol.vault.MsgRefreshVaultOrders")
+	proto.RegisterType((*MsgRefreshVaultOrdersResponse)(nil), "dydxprotocol.vault.MsgRefreshVaultOrdersResponse")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/vault/tx.proto", fileDescriptor_ced574c6017ce006) }
+
+var fileDescriptor_ced574c6017ce006 = []byte{
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xb3, 0x4d, 0x48, 0x5e, 0xb2, 0x9b, 0xd4, 0x84, 0x76, 0xe3, 0xb4, 0x9b, 0xb0, 0x84,
+	0x2a, 0x69, 0xc9, 0x6e, 0x1a, 0x4a, 0xa9, 0x22, 0x0e, 0x10, 0x20, 0x6a, 0x54, 0xb6, 0x4d, 0xbc,
+	0xfc, 0x91, 0x7a, 0x31, 0xde, 0xf5, 0xc4, 0x6b, 0x69, 0xed, 0x71, 0x66, 0xc6, 0xf9, 0x77, 0xec,
+	0x05, 0x8e, 0x48, 0x70, 0x84, 0x4f, 0xc0, 0x85, 0x03, 0xe2, 0x33, 0xf4, 0x80, 0x50, 0xc5, 0xa9,
+	0xe2, 0x50, 0xa1, 0x44, 0xc0, 0x77, 0xe0, 0x84, 0x3c, 0xf6, 0x4e, 0xbc, 0x6b, 0x9b, 0x75, 0xda,
+	0xa2, 0xa0, 0x5e, 0x12, 0x7b, 0xfc, 0x7b, 0xef, 0xf7, 0x7e, 0xef, 0xcd, 0xbc, 0x37, 0x5a, 0x98,
+	0x31, 0x0e, 0x8c, 0x7d, 0x97, 0x60, 0x86, 0x9b, 0xb8, 0x5d, 0xdd, 0xd5, 0xbd, 0x36, 0xab, 0xb2,
+	0xfd, 0x0a, 0x5f, 0x91, 0xe5, 0xe8, 0xc7, 0x0a, 0xff, 0xa8, 0x4c, 0x37, 0x31, 0xb5, 0x31, 0xd5,
+	0xf8, 0x72, 0x35, 0x78, 0x09, 0xe0, 0xca, 0xc5, 0xe0, 0xad, 0x6a, 0x53, 0xb3, 0xba, 0x7b, 0xdd,
+	0xff, 0x17, 0x7e, 0x58, 0xec, 0x22, 0xa1, 0x5e, 0x43, 0x6f, 0x36, 0xb1, 0xe7, 0x30, 0x1a, 0x79,
+	0x0e, 0xa1, 0xb3, 0x09, 0xf1, 0xb8, 0x3a, 0xd1, 0xed, 0x0e, 0x49, 0x29, 0x01, 0xc0, 0xff, 0x86,
+	0xdf, 0xa7, 0x4c, 0x6c, 0xe2, 0x20, 0x38, 0xff, 0x29, 0x58, 0x2d, 0x7f, 0x37, 0x08, 0xe7, 0x6b,
+	0xd4, 0xfc, 0x00, 0xb9, 0x98, 0x5a, 0xec, 0x63, 0xfc, 0xa9, 0x6f, 0x21, 0xdf, 0x84, 0x11, 0x6e,
+	0xaa, 0x59, 0x46, 0x51, 0x9a, 0x93, 0x16, 0xc6, 0x56, 0x66, 0x2a, 0x71, 0xc9, 0x15, 0x0e, 0xde,
+	0x30, 0xd4, 0x97, 0x76, 0x83, 0x07, 0xf9, 0x0e, 0xe4, 0x4f, 0x02, 0xf7, 0x8d, 0x07, 0xb9, 0xf1,
+	0x95, 0x6e, 0xe3, 0x88, 0xce, 0x4a, 0x5d, 0x3c, 0x6f, 0x18, 0xea, 0x38, 0x8d, 0xbc, 0xc9, 0x18,
+	0x0a, 0x3b, 0x1e, 0x66, 0x48, 0xdb, 0xf1, 0x74, 0x87, 0x79, 0x36, 0x2d, 0xe6, 0xe6, 0xa4, 0x85,
+	0xf1, 0xb5, 0xdb, 0x0f, 0x9f, 0xcc, 0x0e, 0xfc, 0xf6, 0x64, 0xf6, 0x5d, 0xd3, 0x62, 0x2d, 0xaf,
+	0x51, 0x69, 0x62, 0xbb, 0xda, 0xad, 0xfd, 0xc6, 0x52, 0xb3, 0xa5, 0x5b, 0x4e, 0x55, 0xac, 0x18,
+	0xec, 0xc0, 0x45, 0xb4, 0x52, 0x47, 0xc4, 0xd2, 0xdb, 0xd6, 0xa1, 0xde, 0x68, 0xa3, 0x0d, 0x87,
+	0xa9, 0x79, 0xee, 0x7f, 0x2b, 0x74, 0xbf, 0x2a, 0x3f, 0xf8, 0xeb, 0x87, 0xab, 0xdd, 0x02, 0xca,
+	0x33, 0x30, 0x1d, 0x4b, 0x8f, 0x8a, 0xa8, 0x8b, 0x1d, 0x8a, 0xca, 0x7f, 0x4a, 0x30, 0x55, 0xa3,
+	0xe6, 0x67, 0x16, 0x6b, 0x19, 0x44, 0xdf, 0x5b, 0x27, 0xd8, 0xfe, 0x1f, 0xe5, 0xef, 0x2d, 0x18,
+	0xa6, 0x2d, 0x9d, 0xa0, 0x20, 0x6f, 0x63, 0x2b, 0x97, 0x93, 0x42, 0xb8, 0xeb, 0xd9, 0x75, 0x0e,
+	0x52, 0x43, 0x70, 0x62, 0x16, 0xfe, 0xce, 0xc1, 0xa5, 0x24, 0xa1, 0x9d, 0x4c, 0xc8, 0xeb, 0x30,
+	0x41, 0x90, 0x81, 0x90, 0x8d, 0x0c, 0x2d, 0x24, 0x95, 0xb2, 0x90, 0x16, 0x3a, 0x56, 0xc1, 0xbb,
+	0xfc, 0x40, 0x82, 0xe2, 0x5e, 0xc8, 0xe2, 0x68, 0x3d, 0xe5, 0x1f, 0x7c, 0xce, 0xe5, 0xbf, 0x20,
+	0x98, 0xb6, 0xa2, 0xfb, 0x40, 0xbe, 0x0d, 0x93, 0x04, 0xd9, 0xba, 0xe5, 0x58, 0x8e, 0xa9, 0x9d,
+	0x26, 0x85, 0x13, 0xc2, 0x2c, 0x94, 0x73, 0x07, 0x64, 0x86, 0x99, 0xde, 0xd6, 0x82, 0xdd, 0x10,
+	0xfa, 0x3a, 0x97, 0xc5, 0xd7, 0x24, 0x37, 0xe4, 0x59, 0x0e, 0x9d, 0xed, 0x76, 0x3b, 0x43, 0x3b,
+	0x9e, 0xc5, 0x0e, 0x8a, 0x43, 0xcf, 0x39, 0x29, 0x11, 0xde, 0x0f, 0x39, 0x43, 0xf9, 0x6b, 0x09,
+	0x26, 0x6a, 0xd4, 0xfc, 0xc4, 0x35, 0x74, 0x86, 0x36, 0x79, 0xcb, 0x91, 0x6f, 0xc2, 0xa8, 0xee,
+	0xb1, 0x16, 0x26, 0x7e, 0x08, 0x7e, 0xa5, 0x47, 0xd7, 0x8a, 0xbf, 0xfe, 0xb8, 0x34, 0x15, 0xb6,
+	0xbd, 0xf7, 0x0c, 0x83, 0x20, 0x4a, 0xeb, 0x8c, 0x58, 0x8e, 0xa9, 0x9e, 0x40, 0xe5, 0x5b, 0x30,
+	0x1c, 0x34, 0xad, 0x70, 0x67, 0x2b, 0x49, 0x49, 0x08, 0x38, 0xd6, 0xce, 0xf9, 0x9a, 0xd4, 0x10,
+	0xbf, 0x5a, 0xf0, 0xb7, 0xe5, 0x89, 0xa7, 0xf2, 0x34, 0x5c, 0xec, 0x09, 0x4a, 0x1c, 0xcb, 0xef,
+	0x25, 0x98, 0xac, 0x51, 0xb3, 0x8e, 0x18, 0x97, 0xf1, 0x91, 0xde, 0x40, 0x6d, 0xf9, 0x06, 0x8c,
+	0x60, 0x17, 0x11, 0x9d, 0x61, 0xd2, 0x37, 0x60, 0x81, 0x94, 0xdf, 0x89, 0x1c, 0xe4, 0xc1, 0xbe,
+	0x07, 0x39, 0x0c, 0x59, 0x1c, 0xe7, 0x29, 0x18, 0x6a, 0xfb, 0xe4, 0x7c, 0xf7, 0x8c, 0xaa, 0xc1,
+	0xcb, 0x6a, 0xde, 0x57, 0x22, 0x28, 0xca, 0x0a, 0x14, 0x7b, 0x83, 0x15, 0x4a, 0x7e, 0x0e, 0x1a,
+	0xcc, 0x3a, 0x41, 0xe8, 0x10, 0x45, 0xf7, 0xc2, 0xd3, 0xe6, 0xff, 0xd9, 0xf4, 0x54, 0x60, 0x08,
+	0xef, 0x39, 0x88, 0x14, 0x73, 0x7d, 0x18, 0x03, 0x58, 0xac, 0x66, 0x25, 0xb8, 0x94, 0xa4, 0x46,
+	0xc8, 0xfd, 0x45, 0x82, 0x0b, 0x7e, 0x51, 0x9d, 0xed, 0x17, 0x44, 0xf0, 0x1c, 0x94, 0x92, 0xf5,
+	0x08, 0xc9, 0xdf, 0x06, 0x15, 0x56, 0x91, 0xab, 0x5b, 0xe4, 0xcc, 0x05, 0xa7, 0x54, 0x2c, 0x16,
+	0x9d, 0x08, 0xff, 0x0f, 0x09, 0xf2, 0x35, 0x6a, 0xbe, 0xdf, 0xc6, 0x14, 0x75, 0x46, 0xdf, 0x59,
+	0x14, 0xea, 0x2e, 0x8c, 0x19, 0x88, 0x32, 0xcb, 0xd1, 0x99, 0x85, 0x9d, 0x62, 0xee, 0x34, 0x63,
+	0x33, 0xf4, 0x15, 0x75, 0x10, 0xcb, 0xc3, 0x97, 0x12, 0xbc, 0xd2, 0xa5, 0x53, 0x4c, 0xbe, 0xf8,
+	0x2d, 0x45, 0xfa, 0x4f, 0x6f, 0x29, 0xe5, 0x9f, 0x24, 0x5e, 0x93, 0x7b, 0x61, 0xff, 0x08, 0x3a,
+	0x20, 0x8f, 0x29, 0xec, 0xcd, 0x4f, 0xd7, 0xe9, 0xe6, 0xa1, 0x40, 0x5d, 0x82, 0x74, 0x43, 0xb3,
+	0x2d, 0x47, 0x73, 0x5d, 0x9b, 0x57, 0x21, 0xaf, 0x8e, 0x07, 0xab, 0x35, 0xcb, 0xd9, 0x74, 0x6d,
+	0xf9, 0x1a, 0xc8, 0x98, 0x18, 0x88, 0x68, 0xd4, 0x3a, 0x44, 0x9a, 0xdb, 0x64, 0x1c, 0x99, 0xe3,
+	0xc8, 0x09, 0xfe, 0xa5, 0x6e, 0x1d, 0xa2, 0xcd, 0x26, 0xdb, 0x74, 0xed, 0xde, 0x46, 0x77, 0x05,
+	0xe6, 0xff, 0x2d, 0x6e, 0xb1, 0xa7, 0xbe, 0x09, 0x72, 0xad, 0xa2, 0x6d, 0x82, 0x68, 0x8b, 0x23,
+	0xee, 0xf9, 0x9e, 0xa9, 0xbc, 0x0c, 0xc3, 0xd4, 0x32, 0x1d, 0xd4, 0x5f, 0x57, 0x88, 0x7b, 0xc6,
+	0xd3, 0x30, 0xe6, 0x0b, 0x08, 0x5d, 0x95, 0x67, 0xe1, 0x72, 0x62, 0x54, 0x9d, 0xb8, 0x57, 0x1e,
+	0x8f, 0x40, 0xae, 0x46, 0x4d, 0x79, 0x1b, 0x0a, 0x3d, 0xd7, 0xe9, 0xd7, 0x93, 0x38, 0x63, 0xd7,
+	0x4a, 0x65, 0x29, 0x13, 0x2c, 0xb2, 0xf3, 0xce, 0xc7, 0x6f, 0x9e, 0x0b, 0x29, 0x3e, 0x62, 0x48,
+	0x65, 0x39, 0x2b, 0x52, 0x10, 0x7e, 0x0e, 0xe3, 0x5d, 0x97, 0x80, 0xd7, 0x52, 0x3c, 0x44, 0x41,
+	0xca, 0xb5, 0x0c, 0x20, 0xc1, 0xd0, 0x84, 0x7c, 0xf7, 0xd4, 0x9e, 0x4f, 0xb1, 0xee, 0x42, 0x29,
+	0x6f, 0x64, 0x41, 0x45, 0xf3, 0x16, 0x1f, 0xa8, 0x69, 0x79, 0x8b, 0x21, 0x95, 0xe5, 0xac, 0x48,
+	0x41, 0xe8, 0xc1, 0xcb, 0x49, 0x23, 0xed, 0x6a, 0x5a, 0x66, 0xe2, 0x58, 0x65, 0x25, 0x3b, 0x36,
+	0xaa, 0x33, 0x3e, 0x56, 0xd2, 0x74, 0xc6, 0x90, 0xca, 0x72, 0x56, 0xa4, 0x20, 0xbc, 0x0f, 0x10,
+	0x19, 0x04, 0xaf, 0xa6, 0xd8, 0x9f, 0x40, 0x94, 0xc5, 0xbe, 0x10, 0xe1, 0xfb, 0x0b, 0x09, 0xa6,
+	0xd3, 0x5b, 0x5e, 0x5a, 0xac, 0xa9, 0x16, 0xca, 0xad, 0xd3, 0x5a, 0x88, 0x48, 0x08, 0xc8, 0x09,
+	0xad, 0x69, 0x31, 0x35, 0x5b, 0xbd, 0x50, 0xe5, 0x7a, 0x66, 0x68, 0x87, 0x73, 0x6d, 0xeb, 0xe1,
+	0x51, 0x49, 0x7a, 0x74, 0x54, 0x92, 0x7e, 0x3f, 0x2a, 0x49, 0x5f, 0x1d, 0x97, 0x06, 0x1e, 0x1d,
+	0x97, 0x06, 0x1e, 0x1f, 0x97, 0x06, 0xee, 0xbf, 0x9d, 0x7d, 0xbc, 0xec, 0x77, 0x7e, 0xc5, 0xf0,
+	0xa7, 0x4c, 0x63, 0x98, 0xaf, 0xbf, 0xf9, 0xcf, 0x00, 0xf3, 0x8b, 0xda, 0x0b, 0xe8, 0x10, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// DepositToVault deposits funds into a vault.
+	DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error)
+	// WithdrawFromVault attempts to withdraw funds from a vault.
+	WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error)
+	// UpdateParams updates the Params in state.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetVaultLabel sets the label of a vault on behalf of the vault operator.
+	SetVaultLabel(ctx context.Context, in *MsgSetVaultLabel, opts ...grpc.CallOption) (*MsgSetVaultLabelResponse, error)
+	// FreezeVaultShares freezes an owner's shares in a vault.
+	FreezeVaultShares(ctx context.Context, in *MsgFreezeVaultShares, opts ...grpc.CallOption) (*MsgFreezeVaultSharesResponse, error)
+	// UnfreezeVaultShares unfreezes an owner's shares in a vault.
+	UnfreezeVaultShares(ctx context.Context, in *MsgUnfreezeVaultShares, opts ...grpc.CallOption) (*MsgUnfreezeVaultSharesResponse, error)
+	// RepairVaultShares sets total shares of a vault to the sum of its owner
+	// shares.
+	RepairVaultShares(ctx context.Context, in *MsgRepairVaultShares, opts ...grpc.CallOption) (*MsgRepairVaultSharesResponse, error)
+	// CloseVault transfers all equity of a deactivated vault without outstanding
+	// shares to a destination subaccount.
+	CloseVault(ctx context.Context, in *MsgCloseVault, opts ...grpc.CallOption) (*MsgCloseVaultResponse, error)
+	// OperatorUpdateVaultParams updates a whitelisted subset of params within
+	// gov-set bounds, signed by the vault operator.
+	OperatorUpdateVaultParams(ctx context.Context, in *MsgOperatorUpdateVaultParams, opts ...grpc.CallOption) (*MsgOperatorUpdateVaultParamsResponse, error)
+	// RefreshVaultOrders refreshes orders of a vault immediately instead of in
+	// the end blocker, signed by the vault operator.
+	RefreshVaultOrders(ctx context.Context, in *MsgRefreshVaultOrders, opts ...grpc.CallOption) (*MsgRefreshVaultOrdersResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error) {
+	out := new(MsgDepositToVaultResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/DepositToVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SubaccountId != nil {
-		l = m.SubaccountId.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error) {
+	out := new(MsgWithdrawFromVaultResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/WithdrawFromVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.QuoteQuantums.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgDepositToVaultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawFromVault) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetVaultLabel(ctx context.Context, in *MsgSetVaultLabel, opts ...grpc.CallOption) (*MsgSetVaultLabelResponse, error) {
+	out := new(MsgSetVaultLabelResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/SetVaultLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.VaultId != nil {
-		l = m.VaultId.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FreezeVaultShares(ctx context.Context, in *MsgFreezeVaultShares, opts ...grpc.CallOption) (*MsgFreezeVaultSharesResponse, error) {
+	out := new(MsgFreezeVaultSharesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/FreezeVaultShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SubaccountId != nil {
-		l = m.SubaccountId.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeVaultShares(ctx context.Context, in *MsgUnfreezeVaultShares, opts ...grpc.CallOption) (*MsgUnfreezeVaultSharesResponse, error) {
+	out := new(MsgUnfreezeVaultSharesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/UnfreezeVaultShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Shares != nil {
-		l = m.Shares.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RepairVaultShares(ctx context.Context, in *MsgRepairVaultShares, opts ...grpc.CallOption) (*MsgRepairVaultSharesResponse, error) {
+	out := new(MsgRepairVaultSharesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/RepairVaultShares", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
+}
+
+func (c *msgClient) CloseVault(ctx context.Context, in *MsgCloseVault, opts ...grpc.CallOption) (*MsgCloseVaultResponse, error) {
+	out := new(MsgCloseVaultResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/CloseVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OperatorUpdateVaultParams(ctx context.Context, in *MsgOperatorUpdateVaultParams, opts ...grpc.CallOption) (*MsgOperatorUpdateVaultParamsResponse, error) {
+	out := new(MsgOperatorUpdateVaultParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/OperatorUpdateVaultParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefreshVaultOrders(ctx context.Context, in *MsgRefreshVaultOrders, opts ...grpc.CallOption) (*MsgRefreshVaultOrdersResponse, error) {
+	out := new(MsgRefreshVaultOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/RefreshVaultOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// DepositToVault deposits funds into a vault.
+	DepositToVault(context.Context, *MsgDepositToVault) (*MsgDepositToVaultResponse, error)
+	// WithdrawFromVault attempts to withdraw funds from a vault.
+	WithdrawFromVault(context.Context, *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error)
+	// UpdateParams updates the Params in state.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetVaultLabel sets the label of a vault on behalf of the vault operator.
+	SetVaultLabel(context.Context, *MsgSetVaultLabel) (*MsgSetVaultLabelResponse, error)
+	// FreezeVaultShares freezes an owner's shares in a vault.
+	FreezeVaultShares(context.Context, *MsgFreezeVaultShares) (*MsgFreezeVaultSharesResponse, error)
+	// UnfreezeVaultShares unfreezes an owner's shares in a vault.
+	UnfreezeVaultShares(context.Context, *MsgUnfreezeVaultShares) (*MsgUnfreezeVaultSharesResponse, error)
+	// RepairVaultShares sets total shares of a vault to the sum of its owner
+	// shares.
+	RepairVaultShares(context.Context, *MsgRepairVaultShares) (*MsgRepairVaultSharesResponse, error)
+	// CloseVault transfers all equity of a deactivated vault without outstanding
+	// shares to a destination subaccount.
+	CloseVault(context.Context, *MsgCloseVault) (*MsgCloseVaultResponse, error)
+	// OperatorUpdateVaultParams updates a whitelisted subset of params within
+	// gov-set bounds, signed by the vault operator.
+	OperatorUpdateVaultParams(context.Context, *MsgOperatorUpdateVaultParams) (*MsgOperatorUpdateVaultParamsResponse, error)
+	// RefreshVaultOrders refreshes orders of a vault immediately instead of in
+	// the end blocker, signed by the vault operator.
+	RefreshVaultOrders(context.Context, *MsgRefreshVaultOrders) (*MsgRefreshVaultOrdersResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) DepositToVault(ctx context.Context, req *MsgDepositToVault) (*MsgDepositToVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToVault not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromVault(ctx context.Context, req *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromVault not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetVaultLabel(ctx context.Context, req *MsgSetVaultLabel) (*MsgSetVaultLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVaultLabel not implemented")
+}
+func (*UnimplementedMsgServer) FreezeVaultShares(ctx context.Context, req *MsgFreezeVaultShares) (*MsgFreezeVaultSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeVaultShares not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeVaultShares(ctx context.Context, req *MsgUnfreezeVaultShares) (*MsgUnfreezeVaultSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeVaultShares not implemented")
+}
+func (*UnimplementedMsgServer) RepairVaultShares(ctx context.Context, req *MsgRepairVaultShares) (*MsgRepairVaultSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairVaultShares not implemented")
+}
+func (*UnimplementedMsgServer) CloseVault(ctx context.Context, req *MsgCloseVault) (*MsgCloseVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseVault not implemented")
+}
+func (*UnimplementedMsgServer) OperatorUpdateVaultParams(ctx context.Context, req *MsgOperatorUpdateVaultParams) (*MsgOperatorUpdateVaultParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorUpdateVaultParams not implemented")
+}
+func (*UnimplementedMsgServer) RefreshVaultOrders(ctx context.Context, req *MsgRefreshVaultOrders) (*MsgRefreshVaultOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshVaultOrders not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_DepositToVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/DepositToVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToVault(ctx, req.(*MsgDepositToVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/WithdrawFromVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromVault(ctx, req.(*MsgWithdrawFromVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVaultLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVaultLabel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVaultLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/SetVaultLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVaultLabel(ctx, req.(*MsgSetVaultLabel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeVaultShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeVaultShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeVaultShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/FreezeVaultShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeVaultShares(ctx, req.(*MsgFreezeVaultShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeVaultShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeVaultShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeVaultShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/UnfreezeVaultShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeVaultShares(ctx, req.(*MsgUnfreezeVaultShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepairVaultShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepairVaultShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepairVaultShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/RepairVaultShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepairVaultShares(ctx, req.(*MsgRepairVaultShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/CloseVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseVault(ctx, req.(*MsgCloseVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OperatorUpdateVaultParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorUpdateVaultParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorUpdateVaultParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/OperatorUpdateVaultParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorUpdateVaultParams(ctx, req.(*MsgOperatorUpdateVaultParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefreshVaultOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefreshVaultOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefreshVaultOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/RefreshVaultOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefreshVaultOrders(ctx, req.(*MsgRefreshVaultOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.vault.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DepositToVault",
+			Handler:    _Msg_DepositToVault_Handler,
+		},
+		{
+			MethodName: "WithdrawFromVault",
+			Handler:    _Msg_WithdrawFromVault_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetVaultLabel",
+			Handler:    _Msg_SetVaultLabel_Handler,
+		},
+		{
+			MethodName: "FreezeVaultShares",
+			Handler:    _Msg_FreezeVaultShares_Handler,
+		},
+		{
+			MethodName: "UnfreezeVaultShares",
+			Handler:    _Msg_UnfreezeVaultShares_Handler,
+		},
+		{
+			MethodName: "RepairVaultShares",
+			Handler:    _Msg_RepairVaultShares_Handler,
+		},
+		{
+			MethodName: "CloseVault",
+			Handler:    _Msg_CloseVault_Handler,
+		},
+		{
+			MethodName: "OperatorUpdateVaultParams",
+			Handler:    _Msg_OperatorUpdateVaultParams_Handler,
+		},
+		{
+			MethodName: "RefreshVaultOrders",
+			Handler:    _Msg_RefreshVaultOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/vault/tx.proto",
+}
+
+func (m *MsgDepositToVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteQuantums.Size()
+		i -= size
+		if _, err := m.QuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SubaccountId != nil {
+		{
+			size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VaultId != nil {
+		{
+			size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shares != nil {
+		{
+			size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SubaccountId != nil {
+		{
+			size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VaultId != nil {
+		{
+			size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalVaultEquity.Size()
+		i -= size
+		if _, err := m.TotalVaultEquity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalVaultShares != nil {
+		{
+			size, err := m.TotalVaultShares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemainingShares != nil {
+		{
+			size, err := m.RemainingShares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.WithdrawnQuoteQuantums.Size()
+		i -= size
+		if _, err := m.WithdrawnQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RedeemedShares != nil {
+		{
+			size, err := m.RedeemedShares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVaultLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultLabel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVaultLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeVaultShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeVaultShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeVaultShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeVaultSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeVaultSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeVaultSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeVaultShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeVaultShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeVaultShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeVaultSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeVaultSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeVaultSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepairVaultShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepairVaultShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepairVaultShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepairVaultSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepairVaultSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepairVaultSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteQuantums.Size()
+		i -= size
+		if _, err := m.QuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorUpdateVaultParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorUpdateVaultParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorUpdateVaultParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderSizePctPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderSizePctPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpreadMinPpm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpreadMinPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorUpdateVaultParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorUpdateVaultParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorUpdateVaultParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshVaultOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshVaultOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshVaultOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshVaultOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshVaultOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshVaultOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDepositToVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VaultId != nil {
+		l = m.VaultId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubaccountId != nil {
+		l = m.SubaccountId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.QuoteQuantums.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositToVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFromVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VaultId != nil {
+		l = m.VaultId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubaccountId != nil {
+		l = m.SubaccountId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Shares != nil {
+		l = m.Shares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
 
 func (m *MsgWithdrawFromVaultResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.RedeemedShares != nil {
-		l = m.RedeemedShares.Size()
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	if m.RedeemedShares != nil {
+		l = m.RedeemedShares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WithdrawnQuoteQuantums.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemainingShares != nil {
+		l = m.RemainingShares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalVaultShares != nil {
+		l = m.TotalVaultShares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalVaultEquity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVaultLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetVaultLabelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeVaultShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeVaultSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeVaultShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeVaultSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepairVaultShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRepairVaultSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCloseVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QuoteQuantums.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOperatorUpdateVaultParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpreadMinPpm != 0 {
+		n += 1 + sovTx(uint64(m.SpreadMinPpm))
+	}
+	if m.OrderSizePctPpm != 0 {
+		n += 1 + sovTx(uint64(m.OrderSizePctPpm))
+	}
+	return n
+}
+
+func (m *MsgOperatorUpdateVaultParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefreshVaultOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefreshVaultOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VaultId == nil {
+				m.VaultId = &VaultId{}
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubaccountId == nil {
+				m.SubaccountId = &types.SubaccountId{}
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VaultId == nil {
+				m.VaultId = &VaultId{}
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubaccountId == nil {
+				m.SubaccountId = &types.SubaccountId{}
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shares == nil {
+				m.Shares = &NumShares{}
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedeemedShares == nil {
+				m.RedeemedShares = &NumShares{}
+			}
+			if err := m.RedeemedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawnQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemainingShares == nil {
+				m.RemainingShares = &NumShares{}
+			}
+			if err := m.RemainingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVaultShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalVaultShares == nil {
+				m.TotalVaultShares = &NumShares{}
+			}
+			if err := m.TotalVaultShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVaultEquity", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVaultEquity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVaultLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVaultLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVaultLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.WithdrawnQuoteQuantums.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.RemainingShares != nil {
-		l = m.RemainingShares.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgSetVaultLabelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVaultLabelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVaultLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.TotalVaultShares != nil {
-		l = m.TotalVaultShares.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeVaultShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeVaultShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeVaultShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.TotalVaultEquity.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFreezeVaultSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeVaultSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeVaultSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeVaultShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -990,13 +3808,45 @@ func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositToVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeVaultShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositToVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeVaultShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
 			}
@@ -1025,18 +3875,147 @@ func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VaultId == nil {
-				m.VaultId = &VaultId{}
-			}
 			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeVaultSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeVaultSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeVaultSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepairVaultShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepairVaultShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepairVaultShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1046,33 +4025,29 @@ func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubaccountId == nil {
-				m.SubaccountId = &types.SubaccountId{}
-			}
-			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1082,22 +4057,22 @@ func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1122,7 +4097,7 @@ func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositToVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepairVaultSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1145,10 +4120,10 @@ func (m *MsgDepositToVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositToVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepairVaultSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositToVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepairVaultSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1172,7 +4147,7 @@ func (m *MsgDepositToVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1195,17 +4170,17 @@ func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1215,31 +4190,27 @@ func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VaultId == nil {
-				m.VaultId = &VaultId{}
-			}
-			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1266,16 +4237,13 @@ func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubaccountId == nil {
-				m.SubaccountId = &types.SubaccountId{}
-			}
-			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1302,10 +4270,7 @@ func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shares == nil {
-				m.Shares = &NumShares{}
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1330,7 +4295,7 @@ func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1353,17 +4318,17 @@ func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantums", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1373,66 +4338,80 @@ func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RedeemedShares == nil {
-				m.RedeemedShares = &NumShares{}
-			}
-			if err := m.RedeemedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnQuoteQuantums", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorUpdateVaultParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawnQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorUpdateVaultParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorUpdateVaultParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1442,33 +4421,29 @@ func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemainingShares == nil {
-				m.RemainingShares = &NumShares{}
-			}
-			if err := m.RemainingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVaultShares", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMinPpm", wireType)
 			}
-			var msglen int
+			m.SpreadMinPpm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1478,33 +4453,16 @@ func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SpreadMinPpm |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TotalVaultShares == nil {
-				m.TotalVaultShares = &NumShares{}
-			}
-			if err := m.TotalVaultShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVaultEquity", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizePctPpm", wireType)
 			}
-			var byteLen int
+			m.OrderSizePctPpm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1514,25 +4472,61 @@ func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OrderSizePctPpm |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalVaultEquity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOperatorUpdateVaultParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOperatorUpdateVaultParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOperatorUpdateVaultParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1554,7 +4548,7 @@ func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRefreshVaultOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,15 +4571,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefreshVaultOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefreshVaultOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1613,11 +4607,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1644,7 +4638,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1669,7 +4663,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefreshVaultOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1692,10 +4686,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefreshVaultOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefreshVaultOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
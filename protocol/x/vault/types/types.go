@@ -24,6 +24,9 @@ type VaultKeeper interface {
 		vaultId VaultId,
 	) (err error)
 
+	// Activation.
+	InitializeVaultActivationStatuses(ctx sdk.Context)
+
 	// Params.
 	GetParams(
 		ctx sdk.Context,
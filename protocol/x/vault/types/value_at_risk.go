@@ -0,0 +1,52 @@
+package types
+
+// SqrtHalfPiPpm is `sqrt(pi / 2)` in ppm, which is the ratio of standard deviation to mean
+// absolute value of a zero-mean normal distribution.
+const SqrtHalfPiPpm = 1_253_314
+
+// ValueAtRiskTelemetryConfidencePpm is the confidence (in ppm) of the value at risk that is
+// emitted as telemetry for each active vault.
+const ValueAtRiskTelemetryConfidencePpm = 990_000
+
+// NormalQuantile is the quantile (in ppm) of the standard normal distribution at a confidence
+// (in ppm).
+type NormalQuantile struct {
+	ConfidencePpm uint32
+	QuantilePpm   uint32
+}
+
+// NormalQuantiles are quantiles of the standard normal distribution in increasing order of
+// confidence, between which quantiles at other confidences are linearly interpolated.
+var NormalQuantiles = []NormalQuantile{
+	{ConfidencePpm: 500_000, QuantilePpm: 0},
+	{ConfidencePpm: 750_000, QuantilePpm: 674_490},
+	{ConfidencePpm: 800_000, QuantilePpm: 841_621},
+	{ConfidencePpm: 850_000, QuantilePpm: 1_036_433},
+	{ConfidencePpm: 900_000, QuantilePpm: 1_281_552},
+	{ConfidencePpm: 950_000, QuantilePpm: 1_644_854},
+	{ConfidencePpm: 975_000, QuantilePpm: 1_959_964},
+	{ConfidencePpm: 990_000, QuantilePpm: 2_326_348},
+	{ConfidencePpm: 995_000, QuantilePpm: 2_575_829},
+	{ConfidencePpm: 999_000, QuantilePpm: 3_090_232},
+}
+
+// GetNormalQuantilePpm returns the quantile (in ppm) of the standard normal distribution at
+// the given confidence (in ppm), linearly interpolated between `NormalQuantiles`. Returns false
+// if confidence is outside of the range of `NormalQuantiles`.
+func GetNormalQuantilePpm(confidencePpm uint32) (quantilePpm uint64, ok bool) {
+	first, last := NormalQuantiles[0], NormalQuantiles[len(NormalQuantiles)-1]
+	if confidencePpm < first.ConfidencePpm || confidencePpm > last.ConfidencePpm {
+		return 0, false
+	}
+	for i := 1; i < len(NormalQuantiles); i++ {
+		lo, hi := NormalQuantiles[i-1], NormalQuantiles[i]
+		if confidencePpm <= hi.ConfidencePpm {
+			// quantile = lo.quantile + (hi.quantile - lo.quantile) * (confidence - lo.confidence) /
+			// (hi.confidence - lo.confidence)
+			quantilePpm = uint64(hi.QuantilePpm-lo.QuantilePpm) * uint64(confidencePpm-lo.ConfidencePpm)
+			quantilePpm /= uint64(hi.ConfidencePpm - lo.ConfidencePpm)
+			return uint64(lo.QuantilePpm) + quantilePpm, true
+		}
+	}
+	return uint64(last.QuantilePpm), true
+}
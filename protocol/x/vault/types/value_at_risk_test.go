@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNormalQuantilePpm(t *testing.T) {
+	tests := map[string]struct {
+		confidencePpm       uint32
+		expectedQuantilePpm uint64
+		expectedOk          bool
+	}{
+		"50%": {
+			confidencePpm:       500_000,
+			expectedQuantilePpm: 0,
+			expectedOk:          true,
+		},
+		"99%": {
+			confidencePpm:       990_000,
+			expectedQuantilePpm: 2_326_348,
+			expectedOk:          true,
+		},
+		"99.9%": {
+			confidencePpm:       999_000,
+			expectedQuantilePpm: 3_090_232,
+			expectedOk:          true,
+		},
+		"96.25%: interpolated halfway between 95% and 97.5%": {
+			confidencePpm: 962_500,
+			// 1_644_854 + (1_959_964 - 1_644_854) / 2
+			expectedQuantilePpm: 1_802_409,
+			expectedOk:          true,
+		},
+		"Below 50%": {
+			confidencePpm: 499_999,
+			expectedOk:    false,
+		},
+		"Above 99.9%": {
+			confidencePpm: 999_001,
+			expectedOk:    false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			quantilePpm, ok := types.GetNormalQuantilePpm(tc.confidencePpm)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expectedQuantilePpm, quantilePpm)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/gogoproto/types"
 	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	types "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	io "io"
@@ -54,6 +55,68 @@ func (VaultType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_32accb5830bb2860, []int{0}
 }
 
+// InventoryMarkSource represents different price sources that a vault's
+// inventory can be marked at.
+type InventoryMarkSource int32
+
+const (
+	// Default value. Inventory is marked at the oracle price.
+	InventoryMarkSource_INVENTORY_MARK_SOURCE_ORACLE InventoryMarkSource = 0
+	// Inventory is marked at the TWAP of the oracle price.
+	InventoryMarkSource_INVENTORY_MARK_SOURCE_TWAP InventoryMarkSource = 1
+)
+
+var InventoryMarkSource_name = map[int32]string{
+	0: "INVENTORY_MARK_SOURCE_ORACLE",
+	1: "INVENTORY_MARK_SOURCE_TWAP",
+}
+
+var InventoryMarkSource_value = map[string]int32{
+	"INVENTORY_MARK_SOURCE_ORACLE": 0,
+	"INVENTORY_MARK_SOURCE_TWAP":   1,
+}
+
+func (x InventoryMarkSource) String() string {
+	return proto.EnumName(InventoryMarkSource_name, int32(x))
+}
+
+func (InventoryMarkSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{1}
+}
+
+// VaultActivityType represents different types of entries in a vault's
+// activity log.
+type VaultActivityType int32
+
+const (
+	// Default value, invalid and unused.
+	VaultActivityType_VAULT_ACTIVITY_TYPE_UNSPECIFIED VaultActivityType = 0
+	// Individual params of the vault are set.
+	VaultActivityType_VAULT_ACTIVITY_TYPE_PARAMS_CHANGE VaultActivityType = 1
+	// Vault is activated or deactivated.
+	VaultActivityType_VAULT_ACTIVITY_TYPE_STATUS_CHANGE VaultActivityType = 2
+)
+
+var VaultActivityType_name = map[int32]string{
+	0: "VAULT_ACTIVITY_TYPE_UNSPECIFIED",
+	1: "VAULT_ACTIVITY_TYPE_PARAMS_CHANGE",
+	2: "VAULT_ACTIVITY_TYPE_STATUS_CHANGE",
+}
+
+var VaultActivityType_value = map[string]int32{
+	"VAULT_ACTIVITY_TYPE_UNSPECIFIED":   0,
+	"VAULT_ACTIVITY_TYPE_PARAMS_CHANGE": 1,
+	"VAULT_ACTIVITY_TYPE_STATUS_CHANGE": 2,
+}
+
+func (x VaultActivityType) String() string {
+	return proto.EnumName(VaultActivityType_name, int32(x))
+}
+
+func (VaultActivityType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{2}
+}
+
 // VaultId uniquely identifies a vault by its type and number.
 type VaultId struct {
 	// Type of the vault.
@@ -148,17 +211,114 @@ func (m *NumShares) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NumShares proto.InternalMessageInfo
 
+// OwnerCostBasis is the cost basis of an owner's shares in a vault, i.e. total
+// quote quantums that the owner has deposited into the vault.
+type OwnerCostBasis struct {
+	CostBasisQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=cost_basis_quote_quantums,json=costBasisQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"cost_basis_quote_quantums"`
+}
+
+func (m *OwnerCostBasis) Reset()         { *m = OwnerCostBasis{} }
+func (m *OwnerCostBasis) String() string { return proto.CompactTextString(m) }
+func (*OwnerCostBasis) ProtoMessage()    {}
+func (*OwnerCostBasis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{2}
+}
+func (m *OwnerCostBasis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerCostBasis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerCostBasis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerCostBasis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerCostBasis.Merge(m, src)
+}
+func (m *OwnerCostBasis) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerCostBasis) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerCostBasis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerCostBasis proto.InternalMessageInfo
+
+// OwnerEpochShareWeight is the time-weighted share balance of an owner in a
+// vault during an epoch, tracked from start of the epoch to the last time that
+// the owner's shares changed in the epoch.
+type OwnerEpochShareWeight struct {
+	// Shares of the owner times seconds held (share-seconds) from start of the
+	// epoch to `last_update_time`.
+	Weight github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=weight,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"weight"`
+	// Shares of the owner at start of the epoch.
+	StartShares github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=start_shares,json=startShares,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"start_shares"`
+	// Shares of the owner as of `last_update_time`.
+	EndShares github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=end_shares,json=endShares,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"end_shares"`
+	// Unix time (in seconds) at which shares of the owner last changed in the
+	// epoch.
+	LastUpdateTime uint64 `protobuf:"varint,4,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+}
+
+func (m *OwnerEpochShareWeight) Reset()         { *m = OwnerEpochShareWeight{} }
+func (m *OwnerEpochShareWeight) String() string { return proto.CompactTextString(m) }
+func (*OwnerEpochShareWeight) ProtoMessage()    {}
+func (*OwnerEpochShareWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{3}
+}
+func (m *OwnerEpochShareWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerEpochShareWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerEpochShareWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerEpochShareWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerEpochShareWeight.Merge(m, src)
+}
+func (m *OwnerEpochShareWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerEpochShareWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerEpochShareWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerEpochShareWeight proto.InternalMessageInfo
+
+func (m *OwnerEpochShareWeight) GetLastUpdateTime() uint64 {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return 0
+}
+
 // OwnerShare is a type for owner shares in a vault.
 type OwnerShare struct {
 	Owner  string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	Shares *NumShares `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	// Whether the owner's shares are frozen, i.e. can't be withdrawn.
+	Frozen bool `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *OwnerShare) Reset()         { *m = OwnerShare{} }
 func (m *OwnerShare) String() string { return proto.CompactTextString(m) }
 func (*OwnerShare) ProtoMessage()    {}
 func (*OwnerShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32accb5830bb2860, []int{2}
+	return fileDescriptor_32accb5830bb2860, []int{4}
 }
 func (m *OwnerShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,17 +361,65 @@ func (m *OwnerShare) GetShares() *NumShares {
 	return nil
 }
 
+func (m *OwnerShare) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // VaultParams is the individual parameters of a vault.
 type VaultParams struct {
 	// Lagged price that the vault quotes at.
 	LaggedPrice *types.MarketPrice `protobuf:"bytes,1,opt,name=lagged_price,json=laggedPrice,proto3" json:"lagged_price,omitempty"`
+	// Optional human-readable label of the vault.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Minimum oracle price (in the market's price units) at which the vault
+	// quotes. Zero means no lower bound.
+	MinOraclePrice uint64 `protobuf:"varint,3,opt,name=min_oracle_price,json=minOraclePrice,proto3" json:"min_oracle_price,omitempty"`
+	// Maximum oracle price (in the market's price units) at which the vault
+	// quotes. Zero means no upper bound.
+	MaxOraclePrice uint64 `protobuf:"varint,4,opt,name=max_oracle_price,json=maxOraclePrice,proto3" json:"max_oracle_price,omitempty"`
+	// Optional id of the market whose price the vault quotes at, instead of
+	// the market of the vault's clob pair. Unset means no override.
+	PriceMarketIdOverride *types1.UInt32Value `protobuf:"bytes,5,opt,name=price_market_id_override,json=priceMarketIdOverride,proto3" json:"price_market_id_override,omitempty"`
+	// Optional markets whose prices are blended with given weights into the
+	// price that the vault quotes at. Weights must sum to 1_000_000. Empty means
+	// no blending.
+	PriceBlend []PriceBlendComponent `protobuf:"bytes,6,rep,name=price_blend,json=priceBlend,proto3" json:"price_blend"`
+	// Optional perpetuals that make up the index that the vault quotes. If set,
+	// leverage of the vault is computed from its positions in these perpetuals
+	// weighted by their weights instead of its position in the perpetual of its
+	// clob pair. Weights must sum to 1_000_000. Empty means no index.
+	IndexConstituents []IndexConstituent `protobuf:"bytes,7,rep,name=index_constituents,json=indexConstituents,proto3" json:"index_constituents"`
+	// Price source that the vault's inventory is marked at when computing
+	// leverage.
+	InventoryMarkSource InventoryMarkSource `protobuf:"varint,8,opt,name=inventory_mark_source,json=inventoryMarkSource,proto3,enum=dydxprotocol.vault.InventoryMarkSource" json:"inventory_mark_source,omitempty"`
+	// Optional step size (in base quantums) that the vault rounds its order sizes
+	// to instead of the step size of its clob pair, which allows quoting in
+	// coarser lots. Must be a multiple of the clob pair's step size. Zero means
+	// no override.
+	OrderStepBaseQuantumsOverride uint64 `protobuf:"varint,9,opt,name=order_step_base_quantums_override,json=orderStepBaseQuantumsOverride,proto3" json:"order_step_base_quantums_override,omitempty"`
+	// Optional price that the vault quotes around instead of the oracle price
+	// until it expires, e.g. during an incident with an unreliable oracle. Unset
+	// means no manual reference price.
+	ManualReferencePrice *ManualReferencePrice `protobuf:"bytes,10,opt,name=manual_reference_price,json=manualReferencePrice,proto3" json:"manual_reference_price,omitempty"`
+	// Optional daily windows (in UTC) during which the vault widens its spread by
+	// the window's multiplier, e.g. during known low-liquidity hours. If windows
+	// overlap, the largest multiplier applies. Empty means no schedule.
+	SpreadSchedule []SpreadScheduleWindow `protobuf:"bytes,11,rep,name=spread_schedule,json=spreadSchedule,proto3" json:"spread_schedule"`
+	// Optional id of a spread oracle market whose price (in basis points, i.e.
+	// `price * 10^exponent` bps) overrides `spread_min_ppm` of the vault, which
+	// allows off-chain systems to adjust the vault's spread via the price feed.
+	// Unset means no override.
+	SpreadOracleMarketId *types1.UInt32Value `protobuf:"bytes,12,opt,name=spread_oracle_market_id,json=spreadOracleMarketId,proto3" json:"spread_oracle_market_id,omitempty"`
 }
 
 func (m *VaultParams) Reset()         { *m = VaultParams{} }
 func (m *VaultParams) String() string { return proto.CompactTextString(m) }
 func (*VaultParams) ProtoMessage()    {}
 func (*VaultParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32accb5830bb2860, []int{3}
+	return fileDescriptor_32accb5830bb2860, []int{5}
 }
 func (m *VaultParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,265 +455,3396 @@ func (m *VaultParams) GetLaggedPrice() *types.MarketPrice {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("dydxprotocol.vault.VaultType", VaultType_name, VaultType_value)
-	proto.RegisterType((*VaultId)(nil), "dydxprotocol.vault.VaultId")
-	proto.RegisterType((*NumShares)(nil), "dydxprotocol.vault.NumShares")
-	proto.RegisterType((*OwnerShare)(nil), "dydxprotocol.vault.OwnerShare")
-	proto.RegisterType((*VaultParams)(nil), "dydxprotocol.vault.VaultParams")
+func (m *VaultParams) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("dydxprotocol/vault/vault.proto", fileDescriptor_32accb5830bb2860) }
+func (m *VaultParams) GetMinOraclePrice() uint64 {
+	if m != nil {
+		return m.MinOraclePrice
+	}
+	return 0
+}
 
-var fileDescriptor_32accb5830bb2860 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x11, 0x04, 0x65, 0x52, 0xa0, 0x5a, 0xaa, 0x2a, 0x44, 0xc2, 0x8d, 0x72, 0x40, 0x15,
-	0x52, 0xd7, 0xa2, 0x80, 0xb8, 0x70, 0xa0, 0x09, 0x41, 0x44, 0x2a, 0x6d, 0x70, 0xd2, 0x4a, 0x70,
-	0xb1, 0x36, 0xf6, 0xca, 0xb1, 0xb0, 0xbd, 0xd6, 0xee, 0x1a, 0x1a, 0xbe, 0x82, 0x8f, 0xe1, 0x23,
-	0x7a, 0xac, 0x38, 0x21, 0x0e, 0x11, 0x4a, 0x7e, 0x04, 0x79, 0xd6, 0x44, 0x89, 0xe0, 0xd0, 0x8b,
-	0xb5, 0x6f, 0xde, 0x1b, 0xbf, 0x9d, 0x37, 0x0b, 0x4e, 0x38, 0x0b, 0x2f, 0x72, 0x29, 0xb4, 0x08,
-	0x44, 0xe2, 0x7e, 0x66, 0x45, 0xa2, 0xcd, 0x97, 0x62, 0x91, 0x90, 0x75, 0x9e, 0x22, 0xd3, 0x7a,
-	0xb4, 0xd1, 0x93, 0xcb, 0x38, 0xe0, 0xca, 0x4d, 0x99, 0xfc, 0xc4, 0xb5, 0x8f, 0xc8, 0xf4, 0xb6,
-	0x76, 0x22, 0x11, 0x09, 0x3c, 0xba, 0xe5, 0xa9, 0xaa, 0x3e, 0x08, 0x84, 0x4a, 0x85, 0xf2, 0x0d,
-	0x61, 0x80, 0xa1, 0x3a, 0x63, 0xb8, 0x7d, 0x5e, 0x3a, 0x0c, 0x42, 0xf2, 0x04, 0x6e, 0xea, 0x59,
-	0xce, 0x9b, 0x76, 0xdb, 0xde, 0xbf, 0x7b, 0xf8, 0x90, 0xfe, 0x7b, 0x0d, 0x8a, 0xd2, 0xf1, 0x2c,
-	0xe7, 0x1e, 0x4a, 0xc9, 0x2e, 0xd4, 0xb2, 0x22, 0x9d, 0x70, 0xd9, 0xbc, 0xd1, 0xb6, 0xf7, 0xef,
-	0x78, 0x15, 0xea, 0x68, 0xa8, 0x9f, 0x14, 0xe9, 0x68, 0xca, 0x24, 0x57, 0x24, 0x02, 0xc8, 0x8a,
-	0xd4, 0x57, 0x88, 0x50, 0xb8, 0xd5, 0x7d, 0x7b, 0x39, 0xdf, 0xb3, 0x7e, 0xcd, 0xf7, 0x5e, 0x45,
-	0xb1, 0x9e, 0x16, 0x13, 0x1a, 0x88, 0xd4, 0xdd, 0x8c, 0xe5, 0xd9, 0x41, 0x30, 0x65, 0x71, 0xe6,
-	0xae, 0x2a, 0x61, 0xe9, 0xa8, 0xe8, 0x88, 0xcb, 0x98, 0x25, 0xf1, 0x57, 0x36, 0x49, 0xf8, 0x20,
-	0xd3, 0x5e, 0x3d, 0xfb, 0x6b, 0xd4, 0x51, 0x00, 0xa7, 0x5f, 0x32, 0x2e, 0x11, 0x12, 0x0a, 0xb7,
-	0x44, 0x89, 0x70, 0x9e, 0x7a, 0xb7, 0xf9, 0xe3, 0xfb, 0xc1, 0x4e, 0x35, 0xfa, 0x51, 0x18, 0x4a,
-	0xae, 0xd4, 0x48, 0xcb, 0x38, 0x8b, 0x3c, 0x23, 0x23, 0xcf, 0xa1, 0xb6, 0x76, 0xc5, 0xc6, 0xff,
-	0x03, 0x58, 0x4d, 0xe5, 0x55, 0xe2, 0x8e, 0x07, 0x0d, 0x4c, 0x65, 0xc8, 0x24, 0x4b, 0x15, 0xe9,
-	0xc1, 0x56, 0xc2, 0xa2, 0x88, 0x87, 0x66, 0x2d, 0x68, 0xde, 0x38, 0x6c, 0x6f, 0xfe, 0xcb, 0xec,
-	0x8f, 0xbe, 0xc3, 0xfd, 0x0d, 0x4b, 0xe0, 0x35, 0x4c, 0x17, 0x82, 0xc7, 0x2f, 0xa1, 0xbe, 0x4a,
-	0x9a, 0xb4, 0x60, 0xf7, 0xfc, 0xe8, 0xec, 0x78, 0xec, 0x8f, 0x3f, 0x0c, 0xfb, 0xfe, 0xd9, 0xc9,
-	0x68, 0xd8, 0xef, 0x0d, 0xde, 0x0c, 0xfa, 0xaf, 0xb7, 0x2d, 0x72, 0x1f, 0xee, 0xad, 0x71, 0xbd,
-	0xe3, 0xd3, 0xee, 0xb6, 0xdd, 0x7d, 0x7f, 0xb9, 0x70, 0xec, 0xab, 0x85, 0x63, 0xff, 0x5e, 0x38,
-	0xf6, 0xb7, 0xa5, 0x63, 0x5d, 0x2d, 0x1d, 0xeb, 0xe7, 0xd2, 0xb1, 0x3e, 0xbe, 0xb8, 0x7e, 0xda,
-	0x17, 0xd5, 0xc3, 0xc4, 0xd0, 0x27, 0x35, 0xac, 0x3f, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x5e,
-	0x16, 0x11, 0xfd, 0xbb, 0x02, 0x00, 0x00,
+func (m *VaultParams) GetMaxOraclePrice() uint64 {
+	if m != nil {
+		return m.MaxOraclePrice
+	}
+	return 0
 }
 
-func (m *VaultId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultParams) GetPriceMarketIdOverride() *types1.UInt32Value {
+	if m != nil {
+		return m.PriceMarketIdOverride
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VaultId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VaultParams) GetPriceBlend() []PriceBlendComponent {
+	if m != nil {
+		return m.PriceBlend
+	}
+	return nil
 }
 
-func (m *VaultId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Number != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x10
+func (m *VaultParams) GetIndexConstituents() []IndexConstituent {
+	if m != nil {
+		return m.IndexConstituents
 	}
-	if m.Type != 0 {
-		i = encodeVarintVault(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *VaultParams) GetInventoryMarkSource() InventoryMarkSource {
+	if m != nil {
+		return m.InventoryMarkSource
 	}
-	return len(dAtA) - i, nil
+	return InventoryMarkSource_INVENTORY_MARK_SOURCE_ORACLE
 }
 
-func (m *NumShares) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultParams) GetOrderStepBaseQuantumsOverride() uint64 {
+	if m != nil {
+		return m.OrderStepBaseQuantumsOverride
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *NumShares) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VaultParams) GetManualReferencePrice() *ManualReferencePrice {
+	if m != nil {
+		return m.ManualReferencePrice
+	}
+	return nil
 }
 
-func (m *NumShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NumShares.Size()
-		i -= size
-		if _, err := m.NumShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintVault(dAtA, i, uint64(size))
+func (m *VaultParams) GetSpreadSchedule() []SpreadScheduleWindow {
+	if m != nil {
+		return m.SpreadSchedule
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *OwnerShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultParams) GetSpreadOracleMarketId() *types1.UInt32Value {
+	if m != nil {
+		return m.SpreadOracleMarketId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OwnerShare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpreadScheduleWindow is a daily window (in UTC) during which a vault
+// multiplies its spread.
+type SpreadScheduleWindow struct {
+	// Start of the window (inclusive) in seconds since midnight UTC.
+	StartSeconds uint32 `protobuf:"varint,1,opt,name=start_seconds,json=startSeconds,proto3" json:"start_seconds,omitempty"`
+	// End of the window (exclusive) in seconds since midnight UTC. A window whose
+	// end is before its start wraps around midnight.
+	EndSeconds uint32 `protobuf:"varint,2,opt,name=end_seconds,json=endSeconds,proto3" json:"end_seconds,omitempty"`
+	// Multiplier (in ppm) of the vault's spread during the window. Must be at
+	// least 1_000_000.
+	SpreadMultiplierPpm uint32 `protobuf:"varint,3,opt,name=spread_multiplier_ppm,json=spreadMultiplierPpm,proto3" json:"spread_multiplier_ppm,omitempty"`
 }
 
-func (m *OwnerShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Shares != nil {
-		{
-			size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintVault(dAtA, i, uint64(size))
+func (m *SpreadScheduleWindow) Reset()         { *m = SpreadScheduleWindow{} }
+func (m *SpreadScheduleWindow) String() string { return proto.CompactTextString(m) }
+func (*SpreadScheduleWindow) ProtoMessage()    {}
+func (*SpreadScheduleWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{6}
+}
+func (m *SpreadScheduleWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpreadScheduleWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpreadScheduleWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SpreadScheduleWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpreadScheduleWindow.Merge(m, src)
+}
+func (m *SpreadScheduleWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpreadScheduleWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpreadScheduleWindow.DiscardUnknown(m)
 }
 
-func (m *VaultParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpreadScheduleWindow proto.InternalMessageInfo
+
+func (m *SpreadScheduleWindow) GetStartSeconds() uint32 {
+	if m != nil {
+		return m.StartSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VaultParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpreadScheduleWindow) GetEndSeconds() uint32 {
+	if m != nil {
+		return m.EndSeconds
+	}
+	return 0
 }
 
-func (m *VaultParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LaggedPrice != nil {
-		{
-			size, err := m.LaggedPrice.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintVault(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *SpreadScheduleWindow) GetSpreadMultiplierPpm() uint32 {
+	if m != nil {
+		return m.SpreadMultiplierPpm
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVault(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// ManualReferencePrice is a manually-set price that a vault quotes around
+// instead of the oracle price until it expires.
+type ManualReferencePrice struct {
+	// Price (in the market's price units) that the vault quotes around.
+	Price uint64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// Block time (in seconds since unix epoch) at which the price expires and
+	// the vault reverts to quoting around the oracle price.
+	ExpiryTime uint32 `protobuf:"varint,2,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (m *ManualReferencePrice) Reset()         { *m = ManualReferencePrice{} }
+func (m *ManualReferencePrice) String() string { return proto.CompactTextString(m) }
+func (*ManualReferencePrice) ProtoMessage()    {}
+func (*ManualReferencePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{7}
+}
+func (m *ManualReferencePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManualReferencePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManualReferencePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *VaultId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ManualReferencePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualReferencePrice.Merge(m, src)
+}
+func (m *ManualReferencePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManualReferencePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualReferencePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualReferencePrice proto.InternalMessageInfo
+
+func (m *ManualReferencePrice) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovVault(uint64(m.Type))
+	return 0
+}
+
+func (m *ManualReferencePrice) GetExpiryTime() uint32 {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	if m.Number != 0 {
-		n += 1 + sovVault(uint64(m.Number))
+	return 0
+}
+
+// PriceBlendComponent is the weight of a market's price in a vault's blended
+// price.
+type PriceBlendComponent struct {
+	// Id of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Weight of the market's price in parts per million.
+	WeightPpm uint32 `protobuf:"varint,2,opt,name=weight_ppm,json=weightPpm,proto3" json:"weight_ppm,omitempty"`
+}
+
+func (m *PriceBlendComponent) Reset()         { *m = PriceBlendComponent{} }
+func (m *PriceBlendComponent) String() string { return proto.CompactTextString(m) }
+func (*PriceBlendComponent) ProtoMessage()    {}
+func (*PriceBlendComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{8}
+}
+func (m *PriceBlendComponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceBlendComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceBlendComponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PriceBlendComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBlendComponent.Merge(m, src)
+}
+func (m *PriceBlendComponent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceBlendComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBlendComponent.DiscardUnknown(m)
 }
 
-func (m *NumShares) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PriceBlendComponent proto.InternalMessageInfo
+
+func (m *PriceBlendComponent) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	var l int
-	_ = l
-	l = m.NumShares.Size()
-	n += 1 + l + sovVault(uint64(l))
-	return n
+	return 0
 }
 
-func (m *OwnerShare) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceBlendComponent) GetWeightPpm() uint32 {
+	if m != nil {
+		return m.WeightPpm
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return 0
+}
+
+// IndexConstituent is the weight of a perpetual in the index that a vault
+// quotes.
+type IndexConstituent struct {
+	// Id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Weight of the perpetual in parts per million.
+	WeightPpm uint32 `protobuf:"varint,2,opt,name=weight_ppm,json=weightPpm,proto3" json:"weight_ppm,omitempty"`
+}
+
+func (m *IndexConstituent) Reset()         { *m = IndexConstituent{} }
+func (m *IndexConstituent) String() string { return proto.CompactTextString(m) }
+func (*IndexConstituent) ProtoMessage()    {}
+func (*IndexConstituent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{9}
+}
+func (m *IndexConstituent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexConstituent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexConstituent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Shares != nil {
-		l = m.Shares.Size()
-		n += 1 + l + sovVault(uint64(l))
+}
+func (m *IndexConstituent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexConstituent.Merge(m, src)
+}
+func (m *IndexConstituent) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexConstituent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexConstituent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexConstituent proto.InternalMessageInfo
+
+func (m *IndexConstituent) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return n
+	return 0
 }
 
-func (m *VaultParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IndexConstituent) GetWeightPpm() uint32 {
+	if m != nil {
+		return m.WeightPpm
 	}
-	var l int
-	_ = l
-	if m.LaggedPrice != nil {
-		l = m.LaggedPrice.Size()
-		n += 1 + l + sovVault(uint64(l))
+	return 0
+}
+
+// MarketVolatility is the realized volatility of a market's oracle price,
+// tracked as an EWMA of absolute per-block price returns, along with its
+// short-term drift, tracked as an EWMA of signed per-block price returns.
+type MarketVolatility struct {
+	// Oracle price of the market when volatility was last updated.
+	LastPrice uint64 `protobuf:"varint,1,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	// Exponent of `last_price`.
+	LastExponent int32 `protobuf:"zigzag32,2,opt,name=last_exponent,json=lastExponent,proto3" json:"last_exponent,omitempty"`
+	// EWMA of absolute per-block returns (in ppm) of the oracle price.
+	EwmaAbsReturnPpm uint64 `protobuf:"varint,3,opt,name=ewma_abs_return_ppm,json=ewmaAbsReturnPpm,proto3" json:"ewma_abs_return_ppm,omitempty"`
+	// EWMA of signed per-block returns (in ppm) of the oracle price, i.e.
+	// short-term price drift.
+	EwmaReturnPpm int64 `protobuf:"zigzag64,4,opt,name=ewma_return_ppm,json=ewmaReturnPpm,proto3" json:"ewma_return_ppm,omitempty"`
+	// Number of per-block returns folded into the EWMAs.
+	NumReturns uint64 `protobuf:"varint,5,opt,name=num_returns,json=numReturns,proto3" json:"num_returns,omitempty"`
+}
+
+func (m *MarketVolatility) Reset()         { *m = MarketVolatility{} }
+func (m *MarketVolatility) String() string { return proto.CompactTextString(m) }
+func (*MarketVolatility) ProtoMessage()    {}
+func (*MarketVolatility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{10}
+}
+func (m *MarketVolatility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketVolatility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketVolatility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
+func (m *MarketVolatility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketVolatility.Merge(m, src)
+}
+func (m *MarketVolatility) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketVolatility) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketVolatility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketVolatility proto.InternalMessageInfo
+
+func (m *MarketVolatility) GetLastPrice() uint64 {
+	if m != nil {
+		return m.LastPrice
+	}
+	return 0
+}
+
+func (m *MarketVolatility) GetLastExponent() int32 {
+	if m != nil {
+		return m.LastExponent
+	}
+	return 0
+}
+
+func (m *MarketVolatility) GetEwmaAbsReturnPpm() uint64 {
+	if m != nil {
+		return m.EwmaAbsReturnPpm
+	}
+	return 0
+}
+
+func (m *MarketVolatility) GetEwmaReturnPpm() int64 {
+	if m != nil {
+		return m.EwmaReturnPpm
+	}
+	return 0
+}
+
+func (m *MarketVolatility) GetNumReturns() uint64 {
+	if m != nil {
+		return m.NumReturns
+	}
+	return 0
+}
+
+// MarketTwap is the time-weighted average of a market's oracle price, tracked
+// as an EWMA of per-block oracle prices.
+type MarketTwap struct {
+	// TWAP of the oracle price.
+	Price uint64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// Exponent of `price`.
+	Exponent int32 `protobuf:"zigzag32,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *MarketTwap) Reset()         { *m = MarketTwap{} }
+func (m *MarketTwap) String() string { return proto.CompactTextString(m) }
+func (*MarketTwap) ProtoMessage()    {}
+func (*MarketTwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{11}
+}
+func (m *MarketTwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketTwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketTwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketTwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketTwap.Merge(m, src)
+}
+func (m *MarketTwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketTwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketTwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketTwap proto.InternalMessageInfo
+
+func (m *MarketTwap) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *MarketTwap) GetExponent() int32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+// VaultFillStats is the cumulative statistics of fills of a vault's orders.
+type VaultFillStats struct {
+	// Number of fills.
+	NumFills uint64 `protobuf:"varint,1,opt,name=num_fills,json=numFills,proto3" json:"num_fills,omitempty"`
+	// Total volume (in quote quantums) of fills.
+	VolumeQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=volume_quote_quantums,json=volumeQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"volume_quote_quantums"`
+	// Total realized spread (in quote quantums) of fills, i.e. sum of how much
+	// better than oracle price at fill time each fill is, where fills worse than
+	// oracle price count negatively.
+	RealizedSpreadQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=realized_spread_quote_quantums,json=realizedSpreadQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"realized_spread_quote_quantums"`
+}
+
+func (m *VaultFillStats) Reset()         { *m = VaultFillStats{} }
+func (m *VaultFillStats) String() string { return proto.CompactTextString(m) }
+func (*VaultFillStats) ProtoMessage()    {}
+func (*VaultFillStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{12}
+}
+func (m *VaultFillStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultFillStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultFillStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultFillStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFillStats.Merge(m, src)
+}
+func (m *VaultFillStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultFillStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFillStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultFillStats proto.InternalMessageInfo
+
+func (m *VaultFillStats) GetNumFills() uint64 {
+	if m != nil {
+		return m.NumFills
+	}
+	return 0
+}
+
+// VaultActivity is an entry in a vault's append-only activity log.
+type VaultActivity struct {
+	// Block height at which the activity happened.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Type of the activity.
+	Type VaultActivityType `protobuf:"varint,2,opt,name=type,proto3,enum=dydxprotocol.vault.VaultActivityType" json:"type,omitempty"`
+	// Individual params of the vault after a params change.
+	VaultParams *VaultParams `protobuf:"bytes,3,opt,name=vault_params,json=vaultParams,proto3" json:"vault_params,omitempty"`
+	// Whether the vault is activated after a status change.
+	Activated bool `protobuf:"varint,4,opt,name=activated,proto3" json:"activated,omitempty"`
+}
+
+func (m *VaultActivity) Reset()         { *m = VaultActivity{} }
+func (m *VaultActivity) String() string { return proto.CompactTextString(m) }
+func (*VaultActivity) ProtoMessage()    {}
+func (*VaultActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{13}
+}
+func (m *VaultActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultActivity.Merge(m, src)
+}
+func (m *VaultActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultActivity proto.InternalMessageInfo
+
+func (m *VaultActivity) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *VaultActivity) GetType() VaultActivityType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultActivityType_VAULT_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+func (m *VaultActivity) GetVaultParams() *VaultParams {
+	if m != nil {
+		return m.VaultParams
+	}
+	return nil
+}
+
+func (m *VaultActivity) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+// VaultRefresh is an entry in a vault's refresh history, recording one attempt
+// of the vault to refresh its orders.
+type VaultRefresh struct {
+	// Block height at which the vault attempted to refresh its orders.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Number of orders placed.
+	NumOrdersPlaced uint32 `protobuf:"varint,2,opt,name=num_orders_placed,json=numOrdersPlaced,proto3" json:"num_orders_placed,omitempty"`
+	// Number of orders cancelled.
+	NumOrdersCancelled uint32 `protobuf:"varint,3,opt,name=num_orders_cancelled,json=numOrdersCancelled,proto3" json:"num_orders_cancelled,omitempty"`
+	// Reason why the vault didn't place orders, or empty if it did.
+	SkipReason string `protobuf:"bytes,4,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (m *VaultRefresh) Reset()         { *m = VaultRefresh{} }
+func (m *VaultRefresh) String() string { return proto.CompactTextString(m) }
+func (*VaultRefresh) ProtoMessage()    {}
+func (*VaultRefresh) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{14}
+}
+func (m *VaultRefresh) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRefresh.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultRefresh) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRefresh.Merge(m, src)
+}
+func (m *VaultRefresh) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRefresh) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRefresh.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultRefresh proto.InternalMessageInfo
+
+func (m *VaultRefresh) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *VaultRefresh) GetNumOrdersPlaced() uint32 {
+	if m != nil {
+		return m.NumOrdersPlaced
+	}
+	return 0
+}
+
+func (m *VaultRefresh) GetNumOrdersCancelled() uint32 {
+	if m != nil {
+		return m.NumOrdersCancelled
+	}
+	return 0
+}
+
+func (m *VaultRefresh) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
+	}
+	return ""
+}
+
+// VaultRefreshHistory is the most recent refreshes of a vault, oldest first.
+type VaultRefreshHistory struct {
+	Refreshes []VaultRefresh `protobuf:"bytes,1,rep,name=refreshes,proto3" json:"refreshes"`
+}
+
+func (m *VaultRefreshHistory) Reset()         { *m = VaultRefreshHistory{} }
+func (m *VaultRefreshHistory) String() string { return proto.CompactTextString(m) }
+func (*VaultRefreshHistory) ProtoMessage()    {}
+func (*VaultRefreshHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{15}
+}
+func (m *VaultRefreshHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRefreshHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRefreshHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultRefreshHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRefreshHistory.Merge(m, src)
+}
+func (m *VaultRefreshHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRefreshHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRefreshHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultRefreshHistory proto.InternalMessageInfo
+
+func (m *VaultRefreshHistory) GetRefreshes() []VaultRefresh {
+	if m != nil {
+		return m.Refreshes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dydxprotocol.vault.VaultType", VaultType_name, VaultType_value)
+	proto.RegisterEnum("dydxprotocol.vault.InventoryMarkSource", InventoryMarkSource_name, InventoryMarkSource_value)
+	proto.RegisterEnum("dydxprotocol.vault.VaultActivityType", VaultActivityType_name, VaultActivityType_value)
+	proto.RegisterType((*VaultId)(nil), "dydxprotocol.vault.VaultId")
+	proto.RegisterType((*NumShares)(nil), "dydxprotocol.vault.NumShares")
+	proto.RegisterType((*OwnerCostBasis)(nil), "dydxprotocol.vault.OwnerCostBasis")
+	proto.RegisterType((*OwnerEpochShareWeight)(nil), "dydxprotocol.vault.OwnerEpochShareWeight")
+	proto.RegisterType((*OwnerShare)(nil), "dydxprotocol.vault.OwnerShare")
+	proto.RegisterType((*VaultParams)(nil), "dydxprotocol.vault.VaultParams")
+	proto.RegisterType((*SpreadScheduleWindow)(nil), "dydxprotocol.vault.SpreadScheduleWindow")
+	proto.RegisterType((*ManualReferencePrice)(nil), "dydxprotocol.vault.ManualReferencePrice")
+	proto.RegisterType((*PriceBlendComponent)(nil), "dydxprotocol.vault.PriceBlendComponent")
+	proto.RegisterType((*IndexConstituent)(nil), "dydxprotocol.vault.IndexConstituent")
+	proto.RegisterType((*MarketVolatility)(nil), "dydxprotocol.vault.MarketVolatility")
+	proto.RegisterType((*MarketTwap)(nil), "dydxprotocol.vault.MarketTwap")
+	proto.RegisterType((*VaultFillStats)(nil), "dydxprotocol.vault.VaultFillStats")
+	proto.RegisterType((*VaultActivity)(nil), "dydxprotocol.vault.VaultActivity")
+	proto.RegisterType((*VaultRefresh)(nil), "dydxprotocol.vault.VaultRefresh")
+	proto.RegisterType((*VaultRefreshHistory)(nil), "dydxprotocol.vault.VaultRefreshHistory")
+}
+
+func init() { proto.RegisterFile("dydxprotocol/vault/vault.proto", fileDescriptor_32accb5830bb2860) }
+
+var fileDescriptor_32accb5830bb2860 = []byte{
+	// 1570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0x63, 0x49,
+	0x15, 0xce, 0x4d, 0xa7, 0x33, 0xf1, 0xb1, 0x93, 0x76, 0x57, 0x9c, 0xc6, 0x64, 0xba, 0x9d, 0xb4,
+	0x9b, 0x99, 0x89, 0x5a, 0x6a, 0x07, 0x32, 0x20, 0x84, 0x84, 0x10, 0xb6, 0xc7, 0x43, 0x2c, 0x3a,
+	0xb1, 0xbb, 0xec, 0x24, 0x6a, 0x46, 0xe2, 0x52, 0xbe, 0xb7, 0x62, 0x5f, 0xf5, 0x7d, 0x4d, 0x55,
+	0xdd, 0x3c, 0x46, 0xec, 0x90, 0xd8, 0x20, 0xa4, 0x59, 0xf1, 0x3b, 0x58, 0xb0, 0xe3, 0x0f, 0xcc,
+	0x0a, 0x46, 0xac, 0x10, 0x8b, 0x11, 0xea, 0xfe, 0x09, 0xac, 0xd8, 0xa1, 0x3a, 0x55, 0x7e, 0x24,
+	0xed, 0x88, 0x5e, 0x64, 0x36, 0xd6, 0xad, 0xef, 0x7c, 0xe7, 0xd4, 0xa9, 0x53, 0xe7, 0x51, 0x86,
+	0x8a, 0x7f, 0xe9, 0x5f, 0xa4, 0x22, 0x51, 0x89, 0x97, 0x84, 0xbb, 0x67, 0x2c, 0x0b, 0x95, 0xf9,
+	0xad, 0x21, 0x48, 0xc8, 0xac, 0xbc, 0x86, 0x92, 0xcd, 0x0f, 0xaf, 0xe8, 0xa4, 0x22, 0xf0, 0xb8,
+	0xdc, 0x8d, 0x98, 0x78, 0xc5, 0x95, 0x8b, 0x2b, 0xa3, 0xbb, 0x59, 0x1a, 0x26, 0xc3, 0x04, 0x3f,
+	0x77, 0xf5, 0x97, 0x45, 0xbf, 0xeb, 0x25, 0x32, 0x4a, 0xa4, 0x6b, 0x04, 0x66, 0x61, 0x45, 0x95,
+	0x61, 0x92, 0x0c, 0x43, 0xbe, 0x8b, 0xab, 0x41, 0x76, 0xba, 0x7b, 0x2e, 0x58, 0x9a, 0x72, 0x61,
+	0xe5, 0xd5, 0x3e, 0xbc, 0x77, 0xac, 0x3d, 0x68, 0xfb, 0xe4, 0x07, 0xb0, 0xa4, 0x2e, 0x53, 0x5e,
+	0x76, 0xb6, 0x9d, 0x9d, 0xb5, 0xbd, 0x47, 0xb5, 0xb7, 0xdd, 0xac, 0x21, 0xb5, 0x7f, 0x99, 0x72,
+	0x8a, 0x54, 0xf2, 0x00, 0x96, 0xe3, 0x2c, 0x1a, 0x70, 0x51, 0x5e, 0xdc, 0x76, 0x76, 0x56, 0xa9,
+	0x5d, 0x55, 0x15, 0xe4, 0x0e, 0xb3, 0xa8, 0x37, 0x62, 0x82, 0x4b, 0x32, 0x04, 0x88, 0xb3, 0xc8,
+	0x95, 0xb8, 0x42, 0x62, 0xa1, 0xb1, 0xff, 0xd5, 0x37, 0x5b, 0x0b, 0xff, 0xfa, 0x66, 0xeb, 0xe7,
+	0xc3, 0x40, 0x8d, 0xb2, 0x41, 0xcd, 0x4b, 0xa2, 0xdd, 0xab, 0x61, 0xfb, 0xe1, 0x33, 0x6f, 0xc4,
+	0x82, 0x78, 0x77, 0x82, 0xf8, 0x7a, 0x47, 0x59, 0xeb, 0x71, 0x11, 0xb0, 0x30, 0xf8, 0x82, 0x0d,
+	0x42, 0xde, 0x8e, 0x15, 0xcd, 0xc5, 0xe3, 0x8d, 0xaa, 0x7f, 0x72, 0x60, 0xad, 0x73, 0x1e, 0x73,
+	0xd1, 0x4c, 0xa4, 0x6a, 0x30, 0x19, 0x48, 0xf2, 0x3b, 0x07, 0x74, 0x70, 0x94, 0x3b, 0xd0, 0x4b,
+	0xf7, 0xf3, 0x2c, 0x51, 0xdc, 0xfd, 0x3c, 0x63, 0xb1, 0xca, 0x22, 0x89, 0x27, 0xbd, 0x4d, 0x5f,
+	0x1e, 0x78, 0xe3, 0x8d, 0x5f, 0xe8, 0x8d, 0x5e, 0xd8, 0x7d, 0xaa, 0xff, 0x5d, 0x84, 0x0d, 0x74,
+	0xac, 0x95, 0x26, 0xde, 0x08, 0xbd, 0x3d, 0xe1, 0xc1, 0x70, 0xa4, 0xc8, 0x6f, 0x60, 0xf9, 0x1c,
+	0xbf, 0x6e, 0xdd, 0x17, 0x6b, 0x97, 0xbc, 0x82, 0x82, 0x54, 0x4c, 0xa8, 0x6f, 0x2b, 0xfe, 0x79,
+	0xb4, 0x3e, 0xbd, 0x6a, 0x1e, 0xfb, 0xe3, 0xad, 0xee, 0xdc, 0xf6, 0x55, 0xf3, 0xd8, 0xb7, 0x1b,
+	0xed, 0x40, 0x31, 0x64, 0x52, 0xb9, 0x59, 0xea, 0x33, 0xc5, 0x5d, 0x15, 0x44, 0xbc, 0xbc, 0xb4,
+	0xed, 0xec, 0x2c, 0xd1, 0x35, 0x8d, 0x1f, 0x21, 0xdc, 0x0f, 0x22, 0x5e, 0xfd, 0x83, 0x03, 0x80,
+	0xb1, 0x47, 0x4d, 0x52, 0x83, 0xbb, 0x89, 0x5e, 0x61, 0xbc, 0x73, 0x8d, 0xf2, 0x3f, 0xfe, 0xf2,
+	0xac, 0x64, 0x0b, 0xa6, 0xee, 0xfb, 0x82, 0x4b, 0xd9, 0x53, 0x22, 0x88, 0x87, 0xd4, 0xd0, 0xc8,
+	0x8f, 0x60, 0x79, 0x26, 0x70, 0xf9, 0xf9, 0x65, 0x31, 0xc9, 0x75, 0x6a, 0xc9, 0xba, 0x30, 0x4e,
+	0x45, 0xf2, 0x05, 0x8f, 0x31, 0x08, 0x2b, 0xd4, 0xae, 0xaa, 0xff, 0x59, 0x86, 0x3c, 0x16, 0x51,
+	0x97, 0x09, 0x16, 0x49, 0xd2, 0x84, 0x42, 0xc8, 0x86, 0x43, 0xee, 0x9b, 0x2a, 0x47, 0xaf, 0xf2,
+	0x7b, 0xdb, 0x57, 0x37, 0x31, 0xed, 0xa0, 0x76, 0x80, 0xed, 0xa0, 0xab, 0x17, 0x34, 0x6f, 0xb4,
+	0x70, 0x41, 0x4a, 0x70, 0x37, 0x64, 0x03, 0x1e, 0xa2, 0x8b, 0x39, 0x6a, 0x16, 0x3a, 0x44, 0x51,
+	0x10, 0xbb, 0x89, 0x60, 0x5e, 0xc8, 0xad, 0xf9, 0x3b, 0x26, 0x44, 0x51, 0x10, 0x77, 0x10, 0x36,
+	0xfa, 0x9a, 0xc9, 0x2e, 0xae, 0x32, 0x6d, 0x30, 0x23, 0x76, 0x31, 0xcb, 0x3c, 0x82, 0x32, 0x8a,
+	0x5d, 0xdb, 0x9a, 0x02, 0xdf, 0x4d, 0xce, 0xb8, 0x10, 0x81, 0xcf, 0xcb, 0x77, 0xd1, 0xf5, 0x87,
+	0x35, 0xd3, 0x70, 0x6a, 0xe3, 0x86, 0x53, 0x3b, 0x6a, 0xc7, 0xea, 0xe3, 0xbd, 0x63, 0x16, 0x66,
+	0x9c, 0x6e, 0xa0, 0xb6, 0x39, 0x48, 0xdb, 0xef, 0x58, 0x55, 0x72, 0x08, 0x79, 0x63, 0x76, 0x10,
+	0xf2, 0xd8, 0x2f, 0x2f, 0x6f, 0xdf, 0xd9, 0xc9, 0xef, 0x7d, 0x34, 0x2f, 0xd2, 0xe8, 0x46, 0x43,
+	0xb3, 0x9a, 0x49, 0x94, 0x26, 0x31, 0x8f, 0x55, 0x63, 0x49, 0x27, 0x18, 0x85, 0x74, 0x22, 0x22,
+	0x2f, 0x81, 0x04, 0xb1, 0xcf, 0x2f, 0x5c, 0x2f, 0x89, 0xa5, 0x0a, 0x54, 0xc6, 0x63, 0x25, 0xcb,
+	0xef, 0xa1, 0xd9, 0xef, 0xcd, 0x33, 0xdb, 0xd6, 0xec, 0xe6, 0x94, 0x6c, 0x6d, 0xde, 0x0f, 0xae,
+	0xe1, 0x92, 0x7c, 0x06, 0x1b, 0x41, 0x7c, 0xc6, 0x63, 0x95, 0x88, 0x4b, 0x8c, 0x82, 0x2b, 0x93,
+	0x4c, 0x78, 0xbc, 0xbc, 0x82, 0x5d, 0xf3, 0xa3, 0xf9, 0xd6, 0xad, 0x82, 0x3e, 0x78, 0x0f, 0xe9,
+	0x74, 0x3d, 0x78, 0x1b, 0x24, 0xfb, 0xf0, 0x38, 0x11, 0x3e, 0x17, 0xae, 0x54, 0x3c, 0xd5, 0x2d,
+	0x6b, 0xda, 0xab, 0xa6, 0x71, 0xce, 0xe1, 0xcd, 0x3c, 0x42, 0x62, 0x4f, 0xf1, 0xb4, 0xc1, 0xe4,
+	0xa4, 0xd3, 0x4c, 0x22, 0xfa, 0x6b, 0x78, 0x10, 0xb1, 0x38, 0x63, 0xa1, 0x2b, 0xf8, 0x29, 0x17,
+	0x3c, 0xf6, 0xc6, 0x17, 0x0b, 0x78, 0x4d, 0x3b, 0xf3, 0xfc, 0x3c, 0x40, 0x0d, 0x3a, 0x56, 0x30,
+	0x99, 0x56, 0x8a, 0xe6, 0xa0, 0xe4, 0x04, 0xee, 0xc9, 0x54, 0x70, 0xe6, 0xbb, 0xd2, 0x1b, 0x71,
+	0x3f, 0x0b, 0x79, 0x39, 0x8f, 0xe1, 0x9d, 0x6b, 0xb8, 0x87, 0xd4, 0x9e, 0x65, 0x9e, 0x04, 0xb1,
+	0x9f, 0x9c, 0xdb, 0x10, 0xaf, 0xc9, 0x2b, 0x32, 0xd2, 0x83, 0xef, 0x58, 0xc3, 0x36, 0x1d, 0x27,
+	0x99, 0x56, 0x2e, 0xbc, 0x43, 0x82, 0x95, 0x8c, 0xb2, 0xc9, 0xd9, 0x71, 0x9e, 0x55, 0xbf, 0x74,
+	0xa0, 0x34, 0xcf, 0x07, 0xf2, 0x04, 0x56, 0x6d, 0x73, 0xe4, 0x5e, 0x12, 0xfb, 0x66, 0x22, 0xac,
+	0x52, 0xd3, 0x31, 0x7b, 0x06, 0x23, 0x5b, 0x90, 0xc7, 0xa6, 0x66, 0x29, 0x66, 0xd2, 0xe9, 0x3e,
+	0x37, 0x26, 0xec, 0xc1, 0x86, 0xf5, 0x39, 0xca, 0x42, 0x15, 0xa4, 0x61, 0xc0, 0x85, 0x9b, 0xa6,
+	0x11, 0x96, 0xdb, 0x2a, 0x5d, 0x37, 0xc2, 0x83, 0x89, 0xac, 0x9b, 0x46, 0xd5, 0x03, 0x28, 0xcd,
+	0x0b, 0xb7, 0xae, 0xe5, 0x69, 0x27, 0x58, 0xa2, 0x66, 0x81, 0x2e, 0x5c, 0xa4, 0x81, 0xb8, 0x34,
+	0x9d, 0x6e, 0xec, 0x02, 0x42, 0xd8, 0xe5, 0x5e, 0xc0, 0xfa, 0x9c, 0xd2, 0x20, 0xef, 0x43, 0x6e,
+	0x1a, 0x3f, 0x73, 0xb6, 0x95, 0xc8, 0x46, 0x85, 0x3c, 0x02, 0x30, 0x33, 0x02, 0x7d, 0x35, 0x36,
+	0x73, 0x06, 0xd1, 0x1e, 0xf6, 0xa1, 0x78, 0xbd, 0x2c, 0xc8, 0x63, 0x28, 0xa4, 0x5c, 0xa4, 0x5c,
+	0xe9, 0xcc, 0x9a, 0x98, 0xcc, 0x4f, 0xb0, 0xff, 0x6f, 0xf5, 0x6f, 0x0e, 0x14, 0xcd, 0xbd, 0x1c,
+	0x27, 0x21, 0x53, 0x41, 0x18, 0xa8, 0x4b, 0xad, 0x83, 0xdd, 0x7c, 0xf6, 0xe4, 0x39, 0x8d, 0x98,
+	0x98, 0x3c, 0x81, 0x55, 0x14, 0xf3, 0x0b, 0x73, 0x2c, 0xb4, 0x7a, 0x9f, 0x16, 0x34, 0xd8, 0xb2,
+	0x18, 0x79, 0x06, 0xeb, 0xfc, 0x3c, 0x62, 0x2e, 0x1b, 0x48, 0x57, 0x70, 0x95, 0x89, 0x78, 0x72,
+	0x05, 0x4b, 0xb4, 0xa8, 0x45, 0xf5, 0x81, 0xa4, 0x28, 0xe8, 0xa6, 0x11, 0xf9, 0x10, 0xee, 0x21,
+	0x7d, 0x86, 0xaa, 0x5b, 0x1e, 0xa1, 0xab, 0x1a, 0x9e, 0xf2, 0xb6, 0x20, 0xaf, 0x1f, 0x2f, 0x86,
+	0x26, 0xb1, 0xc9, 0x2d, 0x51, 0xfd, 0x9e, 0x31, 0x14, 0x59, 0xfd, 0x19, 0x80, 0x39, 0x4f, 0xff,
+	0x9c, 0xa5, 0x37, 0x5c, 0xdf, 0x26, 0xac, 0x5c, 0xf3, 0x7d, 0xb2, 0xae, 0xfe, 0x75, 0x11, 0xd6,
+	0x70, 0x22, 0x7c, 0x1a, 0x84, 0x61, 0x4f, 0x31, 0x25, 0xf5, 0xad, 0xe9, 0x3d, 0x4f, 0x83, 0x30,
+	0x94, 0xd6, 0xd0, 0x4a, 0x9c, 0x45, 0x9a, 0x20, 0xc9, 0x6f, 0x61, 0xe3, 0x2c, 0x09, 0xb3, 0x88,
+	0x5f, 0x7f, 0xcc, 0xdc, 0xf6, 0x60, 0x5f, 0x37, 0xdb, 0x5c, 0x79, 0xc9, 0x90, 0x3f, 0x3a, 0x50,
+	0x11, 0x5c, 0xd3, 0xb8, 0xef, 0xda, 0xa4, 0xbf, 0xe6, 0xc7, 0x6d, 0x4f, 0xfd, 0xf7, 0xc7, 0xfb,
+	0x99, 0x0a, 0xbe, 0xfa, 0xb2, 0xfa, 0xbb, 0x03, 0xab, 0x18, 0xbd, 0xba, 0xa7, 0x82, 0x33, 0x9d,
+	0x4b, 0x8f, 0xa1, 0x30, 0x08, 0x13, 0xef, 0x95, 0x3b, 0x9a, 0xbe, 0xab, 0x56, 0x69, 0x1e, 0xb1,
+	0x7d, 0xf3, 0x24, 0xfa, 0x89, 0x7d, 0xe8, 0x2e, 0x62, 0xcb, 0xfe, 0xe0, 0xc6, 0x87, 0xee, 0xd8,
+	0xe6, 0xcc, 0x83, 0xb7, 0x01, 0x05, 0x24, 0xb8, 0x29, 0xce, 0x6f, 0x3c, 0x6c, 0x7e, 0x6f, 0xeb,
+	0x46, 0x13, 0x66, 0xcc, 0xd3, 0xfc, 0xd9, 0xcc, 0xcc, 0x7f, 0x08, 0x39, 0xa6, 0x2d, 0x33, 0xc5,
+	0x7d, 0x4c, 0xba, 0x15, 0x3a, 0x05, 0xaa, 0x7f, 0x76, 0xa0, 0x80, 0xaa, 0x94, 0x9f, 0x0a, 0x2e,
+	0x47, 0xef, 0x72, 0xa0, 0xa7, 0x70, 0x5f, 0x27, 0x0c, 0x8e, 0x04, 0xe9, 0xa6, 0x21, 0xf3, 0xb8,
+	0x6f, 0x4b, 0xef, 0x5e, 0x9c, 0x45, 0x1d, 0xc4, 0xbb, 0x08, 0x93, 0xef, 0x43, 0x69, 0x86, 0xeb,
+	0xb1, 0xd8, 0xe3, 0x61, 0xc8, 0x7d, 0xdb, 0xab, 0xc8, 0x84, 0xde, 0x1c, 0x4b, 0x74, 0x09, 0xc8,
+	0x57, 0x41, 0xea, 0x0a, 0xce, 0x64, 0x12, 0xa3, 0xc7, 0x39, 0x0a, 0x1a, 0xa2, 0x88, 0x54, 0x3f,
+	0x83, 0xf5, 0x59, 0x8f, 0xf7, 0x03, 0xa9, 0xe7, 0x1a, 0xf9, 0x04, 0x72, 0xc2, 0x20, 0x5c, 0xa7,
+	0xf1, 0x9d, 0xb7, 0x1f, 0x36, 0x33, 0x81, 0xb2, 0xba, 0x76, 0x2a, 0x4c, 0x15, 0x9f, 0xfe, 0x14,
+	0x72, 0x93, 0x7f, 0x1d, 0x64, 0x13, 0x1e, 0x1c, 0xd7, 0x8f, 0x9e, 0xf7, 0xdd, 0xfe, 0xcb, 0x6e,
+	0xcb, 0x3d, 0x3a, 0xec, 0x75, 0x5b, 0xcd, 0xf6, 0xa7, 0xed, 0xd6, 0x27, 0xc5, 0x05, 0xb2, 0x0e,
+	0xf7, 0x66, 0x64, 0xcd, 0xe7, 0x9d, 0x46, 0xd1, 0x79, 0x7a, 0x02, 0xeb, 0x73, 0xa6, 0x2f, 0xd9,
+	0x86, 0x87, 0xed, 0xc3, 0xe3, 0xd6, 0x61, 0xbf, 0x43, 0x5f, 0xba, 0x07, 0x75, 0xfa, 0x4b, 0xb7,
+	0xd7, 0x39, 0xa2, 0xcd, 0x96, 0xdb, 0xa1, 0xf5, 0xe6, 0xf3, 0x56, 0x71, 0x81, 0x54, 0x60, 0x73,
+	0x3e, 0xa3, 0x7f, 0x52, 0xef, 0x16, 0x9d, 0xa7, 0xbf, 0x77, 0xe0, 0xfe, 0x5b, 0x49, 0x42, 0x9e,
+	0xc0, 0x96, 0xf1, 0xa1, 0xde, 0xec, 0xb7, 0x8f, 0xdb, 0xfd, 0x97, 0xf3, 0x1c, 0xfd, 0x00, 0x1e,
+	0xcf, 0x23, 0x75, 0xeb, 0xb4, 0x7e, 0xd0, 0x73, 0x9b, 0xfb, 0xf5, 0xc3, 0x5f, 0xb4, 0x8a, 0xce,
+	0x4d, 0xb4, 0x5e, 0xbf, 0xde, 0x3f, 0x9a, 0xd0, 0x16, 0x1b, 0x2f, 0xbe, 0x7a, 0x5d, 0x71, 0xbe,
+	0x7e, 0x5d, 0x71, 0xfe, 0xfd, 0xba, 0xe2, 0x7c, 0xf9, 0xa6, 0xb2, 0xf0, 0xf5, 0x9b, 0xca, 0xc2,
+	0x3f, 0xdf, 0x54, 0x16, 0x7e, 0xf5, 0xe3, 0x77, 0x2f, 0xbd, 0x0b, 0xfb, 0x37, 0x15, 0x2b, 0x70,
+	0xb0, 0x8c, 0xf8, 0xc7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x06, 0xff, 0x76, 0x2a, 0xc9, 0x0e,
+	0x00, 0x00,
+}
+
+func (m *VaultId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NumShares.Size()
+		i -= size
+		if _, err := m.NumShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerCostBasis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerCostBasis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerCostBasis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CostBasisQuoteQuantums.Size()
+		i -= size
+		if _, err := m.CostBasisQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerEpochShareWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerEpochShareWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerEpochShareWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.LastUpdateTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.EndShares.Size()
+		i -= size
+		if _, err := m.EndShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartShares.Size()
+		i -= size
+		if _, err := m.StartShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Shares != nil {
+		{
+			size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpreadOracleMarketId != nil {
+		{
+			size, err := m.SpreadOracleMarketId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SpreadSchedule) > 0 {
+		for iNdEx := len(m.SpreadSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ManualReferencePrice != nil {
+		{
+			size, err := m.ManualReferencePrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OrderStepBaseQuantumsOverride != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.OrderStepBaseQuantumsOverride))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InventoryMarkSource != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.InventoryMarkSource))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.IndexConstituents) > 0 {
+		for iNdEx := len(m.IndexConstituents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexConstituents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PriceBlend) > 0 {
+		for iNdEx := len(m.PriceBlend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceBlend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PriceMarketIdOverride != nil {
+		{
+			size, err := m.PriceMarketIdOverride.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxOraclePrice != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MaxOraclePrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinOraclePrice != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MinOraclePrice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaggedPrice != nil {
+		{
+			size, err := m.LaggedPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpreadScheduleWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpreadScheduleWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpreadScheduleWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpreadMultiplierPpm != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.SpreadMultiplierPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndSeconds != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.EndSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSeconds != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.StartSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManualReferencePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManualReferencePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManualReferencePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceBlendComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBlendComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBlendComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightPpm != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.WeightPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexConstituent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexConstituent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexConstituent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightPpm != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.WeightPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketVolatility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketVolatility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketVolatility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumReturns != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NumReturns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EwmaReturnPpm != 0 {
+		i = encodeVarintVault(dAtA, i, uint64((uint64(m.EwmaReturnPpm)<<1)^uint64((m.EwmaReturnPpm>>63))))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EwmaAbsReturnPpm != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.EwmaAbsReturnPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastExponent != 0 {
+		i = encodeVarintVault(dAtA, i, uint64((uint32(m.LastExponent)<<1)^uint32((m.LastExponent>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastPrice != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.LastPrice))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketTwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketTwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintVault(dAtA, i, uint64((uint32(m.Exponent)<<1)^uint32((m.Exponent>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultFillStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultFillStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultFillStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RealizedSpreadQuoteQuantums.Size()
+		i -= size
+		if _, err := m.RealizedSpreadQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VolumeQuoteQuantums.Size()
+		i -= size
+		if _, err := m.VolumeQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NumFills != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NumFills))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Activated {
+		i--
+		if m.Activated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VaultParams != nil {
+		{
+			size, err := m.VaultParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRefresh) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkipReason) > 0 {
+		i -= len(m.SkipReason)
+		copy(dAtA[i:], m.SkipReason)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.SkipReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumOrdersCancelled != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NumOrdersCancelled))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumOrdersPlaced != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.NumOrdersPlaced))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRefreshHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRefreshHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRefreshHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refreshes) > 0 {
+		for iNdEx := len(m.Refreshes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refreshes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VaultId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovVault(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovVault(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *NumShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NumShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *OwnerCostBasis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CostBasisQuoteQuantums.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *OwnerEpochShareWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Weight.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.StartShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.EndShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.LastUpdateTime != 0 {
+		n += 1 + sovVault(uint64(m.LastUpdateTime))
+	}
+	return n
+}
+
+func (m *OwnerShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Shares != nil {
+		l = m.Shares.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *VaultParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaggedPrice != nil {
+		l = m.LaggedPrice.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.MinOraclePrice != 0 {
+		n += 1 + sovVault(uint64(m.MinOraclePrice))
+	}
+	if m.MaxOraclePrice != 0 {
+		n += 1 + sovVault(uint64(m.MaxOraclePrice))
+	}
+	if m.PriceMarketIdOverride != nil {
+		l = m.PriceMarketIdOverride.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.PriceBlend) > 0 {
+		for _, e := range m.PriceBlend {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if len(m.IndexConstituents) > 0 {
+		for _, e := range m.IndexConstituents {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if m.InventoryMarkSource != 0 {
+		n += 1 + sovVault(uint64(m.InventoryMarkSource))
+	}
+	if m.OrderStepBaseQuantumsOverride != 0 {
+		n += 1 + sovVault(uint64(m.OrderStepBaseQuantumsOverride))
+	}
+	if m.ManualReferencePrice != nil {
+		l = m.ManualReferencePrice.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.SpreadSchedule) > 0 {
+		for _, e := range m.SpreadSchedule {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if m.SpreadOracleMarketId != nil {
+		l = m.SpreadOracleMarketId.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *SpreadScheduleWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSeconds != 0 {
+		n += 1 + sovVault(uint64(m.StartSeconds))
+	}
+	if m.EndSeconds != 0 {
+		n += 1 + sovVault(uint64(m.EndSeconds))
+	}
+	if m.SpreadMultiplierPpm != 0 {
+		n += 1 + sovVault(uint64(m.SpreadMultiplierPpm))
+	}
+	return n
+}
+
+func (m *ManualReferencePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != 0 {
+		n += 1 + sovVault(uint64(m.Price))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovVault(uint64(m.ExpiryTime))
+	}
+	return n
+}
+
+func (m *PriceBlendComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovVault(uint64(m.MarketId))
+	}
+	if m.WeightPpm != 0 {
+		n += 1 + sovVault(uint64(m.WeightPpm))
+	}
+	return n
+}
+
+func (m *IndexConstituent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovVault(uint64(m.PerpetualId))
+	}
+	if m.WeightPpm != 0 {
+		n += 1 + sovVault(uint64(m.WeightPpm))
+	}
+	return n
+}
+
+func (m *MarketVolatility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastPrice != 0 {
+		n += 1 + sovVault(uint64(m.LastPrice))
+	}
+	if m.LastExponent != 0 {
+		n += 1 + sozVault(uint64(m.LastExponent))
+	}
+	if m.EwmaAbsReturnPpm != 0 {
+		n += 1 + sovVault(uint64(m.EwmaAbsReturnPpm))
+	}
+	if m.EwmaReturnPpm != 0 {
+		n += 1 + sozVault(uint64(m.EwmaReturnPpm))
+	}
+	if m.NumReturns != 0 {
+		n += 1 + sovVault(uint64(m.NumReturns))
+	}
+	return n
+}
+
+func (m *MarketTwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != 0 {
+		n += 1 + sovVault(uint64(m.Price))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sozVault(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *VaultFillStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumFills != 0 {
+		n += 1 + sovVault(uint64(m.NumFills))
+	}
+	l = m.VolumeQuoteQuantums.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.RealizedSpreadQuoteQuantums.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovVault(uint64(m.BlockHeight))
+	}
+	if m.Type != 0 {
+		n += 1 + sovVault(uint64(m.Type))
+	}
+	if m.VaultParams != nil {
+		l = m.VaultParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Activated {
+		n += 2
+	}
+	return n
+}
+
+func (m *VaultRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovVault(uint64(m.BlockHeight))
+	}
+	if m.NumOrdersPlaced != 0 {
+		n += 1 + sovVault(uint64(m.NumOrdersPlaced))
+	}
+	if m.NumOrdersCancelled != 0 {
+		n += 1 + sovVault(uint64(m.NumOrdersCancelled))
+	}
+	l = len(m.SkipReason)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultRefreshHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refreshes) > 0 {
+		for _, e := range m.Refreshes {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VaultId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerCostBasis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerCostBasis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerCostBasis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBasisQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CostBasisQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerEpochShareWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerEpochShareWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerEpochShareWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			m.LastUpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shares == nil {
+				m.Shares = &NumShares{}
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaggedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LaggedPrice == nil {
+				m.LaggedPrice = &types.MarketPrice{}
+			}
+			if err := m.LaggedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOraclePrice", wireType)
+			}
+			m.MinOraclePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOraclePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOraclePrice", wireType)
+			}
+			m.MaxOraclePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOraclePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMarketIdOverride", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceMarketIdOverride == nil {
+				m.PriceMarketIdOverride = &types1.UInt32Value{}
+			}
+			if err := m.PriceMarketIdOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBlend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceBlend = append(m.PriceBlend, PriceBlendComponent{})
+			if err := m.PriceBlend[len(m.PriceBlend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexConstituents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexConstituents = append(m.IndexConstituents, IndexConstituent{})
+			if err := m.IndexConstituents[len(m.IndexConstituents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InventoryMarkSource", wireType)
+			}
+			m.InventoryMarkSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InventoryMarkSource |= InventoryMarkSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderStepBaseQuantumsOverride", wireType)
+			}
+			m.OrderStepBaseQuantumsOverride = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderStepBaseQuantumsOverride |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualReferencePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManualReferencePrice == nil {
+				m.ManualReferencePrice = &ManualReferencePrice{}
+			}
+			if err := m.ManualReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadSchedule = append(m.SpreadSchedule, SpreadScheduleWindow{})
+			if err := m.SpreadSchedule[len(m.SpreadSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadOracleMarketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpreadOracleMarketId == nil {
+				m.SpreadOracleMarketId = &types1.UInt32Value{}
+			}
+			if err := m.SpreadOracleMarketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpreadScheduleWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpreadScheduleWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpreadScheduleWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSeconds", wireType)
+			}
+			m.StartSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSeconds", wireType)
+			}
+			m.EndSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMultiplierPpm", wireType)
+			}
+			m.SpreadMultiplierPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadMultiplierPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManualReferencePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManualReferencePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManualReferencePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceBlendComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceBlendComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceBlendComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightPpm", wireType)
+			}
+			m.WeightPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexConstituent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexConstituent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexConstituent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightPpm", wireType)
+			}
+			m.WeightPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketVolatility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketVolatility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			m.LastPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExponent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.LastExponent = v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EwmaAbsReturnPpm", wireType)
+			}
+			m.EwmaAbsReturnPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EwmaAbsReturnPpm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EwmaReturnPpm", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.EwmaReturnPpm = int64(v)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReturns", wireType)
+			}
+			m.NumReturns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReturns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVault(x uint64) (n int) {
-	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *VaultId) Unmarshal(dAtA []byte) error {
+func (m *MarketTwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,17 +3867,17 @@ func (m *VaultId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultId: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketTwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketTwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Type = 0
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -548,16 +3887,16 @@ func (m *VaultId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= VaultType(b&0x7F) << shift
+				m.Price |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			m.Number = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -567,11 +3906,13 @@ func (m *VaultId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Exponent = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -593,7 +3934,7 @@ func (m *VaultId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumShares) Unmarshal(dAtA []byte) error {
+func (m *VaultFillStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,15 +3957,34 @@ func (m *NumShares) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumShares: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultFillStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumShares: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultFillStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFills", wireType)
+			}
+			m.NumFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeQuoteQuantums", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -651,7 +4011,40 @@ func (m *NumShares) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedSpreadQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedSpreadQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -676,7 +4069,7 @@ func (m *NumShares) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnerShare) Unmarshal(dAtA []byte) error {
+func (m *VaultActivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -699,17 +4092,55 @@ func (m *OwnerShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnerShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultActivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnerShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultActivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultActivityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -719,29 +4150,160 @@ func (m *OwnerShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.VaultParams == nil {
+				m.VaultParams = &VaultParams{}
+			}
+			if err := m.VaultParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Activated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultRefresh) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultRefresh: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOrdersPlaced", wireType)
+			}
+			m.NumOrdersPlaced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOrdersPlaced |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOrdersCancelled", wireType)
+			}
+			m.NumOrdersCancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOrdersCancelled |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -751,27 +4313,23 @@ func (m *OwnerShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shares == nil {
-				m.Shares = &NumShares{}
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SkipReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -794,7 +4352,7 @@ func (m *OwnerShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VaultParams) Unmarshal(dAtA []byte) error {
+func (m *VaultRefreshHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -817,15 +4375,15 @@ func (m *VaultParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VaultParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultRefreshHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VaultParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultRefreshHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaggedPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refreshes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -852,10 +4410,8 @@ func (m *VaultParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LaggedPrice == nil {
-				m.LaggedPrice = &types.MarketPrice{}
-			}
-			if err := m.LaggedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Refreshes = append(m.Refreshes, VaultRefresh{})
+			if err := m.Refreshes[len(m.Refreshes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	fmt "fmt"
 	"strconv"
 	"strings"
@@ -10,6 +12,11 @@ import (
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
+// OrderExpirationJitterWindowSeconds is the window (exclusive upper bound) of per-vault
+// jitter added to order expiration so that orders of different vaults don't all expire
+// in the same block.
+const OrderExpirationJitterWindowSeconds uint32 = 5
+
 // ToString returns the string representation of a vault ID.
 func (id *VaultId) ToString() string {
 	return fmt.Sprintf("%s-%d", id.Type, id.Number)
@@ -53,6 +60,22 @@ func GetVaultIdFromStateKey(stateKey []byte) (*VaultId, error) {
 	}, nil
 }
 
+// GetOrderExpirationJitterSeconds returns the number of seconds by which order expiration
+// of the vault is delayed, which is deterministically derived from the vault ID and is in
+// range [0, OrderExpirationJitterWindowSeconds).
+func (id *VaultId) GetOrderExpirationJitterSeconds() uint32 {
+	hash := sha256.Sum256(id.ToStateKey())
+	return binary.BigEndian.Uint32(hash[:4]) % OrderExpirationJitterWindowSeconds
+}
+
+// GetSubticksJitterTicks returns the number of ticks (0 or 1) by which orders of the vault
+// are moved away from oracle price if subticks jitter is enabled, which is deterministically
+// derived from the vault ID.
+func (id *VaultId) GetSubticksJitterTicks() uint64 {
+	hash := sha256.Sum256(id.ToStateKey())
+	return uint64(binary.BigEndian.Uint32(hash[4:8]) % 2)
+}
+
 // ToModuleAccountAddress returns the module account address for the vault ID
 // (generated from string "vault-<type>-<number>").
 func (id *VaultId) ToModuleAccountAddress() string {
@@ -147,3 +147,25 @@ func TestToSubaccountId(t *testing.T) {
 		*constants.Vault_Clob1.ToSubaccountId(),
 	)
 }
+
+func TestGetOrderExpirationJitterSeconds(t *testing.T) {
+	// Jitter is deterministic and within window.
+	for _, vaultId := range []types.VaultId{constants.Vault_Clob0, constants.Vault_Clob1} {
+		jitter := vaultId.GetOrderExpirationJitterSeconds()
+		require.Equal(t, jitter, vaultId.GetOrderExpirationJitterSeconds())
+		require.Less(t, jitter, types.OrderExpirationJitterWindowSeconds)
+	}
+	require.Equal(t, uint32(4), constants.Vault_Clob0.GetOrderExpirationJitterSeconds())
+	require.Equal(t, uint32(3), constants.Vault_Clob1.GetOrderExpirationJitterSeconds())
+}
+
+func TestGetSubticksJitterTicks(t *testing.T) {
+	// Jitter is deterministic and at most one tick.
+	for _, vaultId := range []types.VaultId{constants.Vault_Clob0, constants.Vault_Clob1} {
+		jitter := vaultId.GetSubticksJitterTicks()
+		require.Equal(t, jitter, vaultId.GetSubticksJitterTicks())
+		require.LessOrEqual(t, jitter, uint64(1))
+	}
+	require.Equal(t, uint64(0), constants.Vault_Clob0.GetSubticksJitterTicks())
+	require.Equal(t, uint64(1), constants.Vault_Clob1.GetSubticksJitterTicks())
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// VolatilityEwmaAlphaPpm is the weight (in ppm) of the latest per-block return in the
+// EWMAs of absolute and signed returns that `MarketVolatility` tracks.
+const VolatilityEwmaAlphaPpm = 100_000
+
+// Update returns volatility after observing the given market price. The absolute and signed
+// returns from the last observed price are folded into the respective EWMAs, which increments
+// the number of returns. If there's no last observed price or its exponent differs from that of
+// the given price, only the last price is updated.
+func (v MarketVolatility) Update(marketPrice pricestypes.MarketPrice) MarketVolatility {
+	updated := MarketVolatility{
+		LastPrice:        marketPrice.Price,
+		LastExponent:     marketPrice.Exponent,
+		EwmaAbsReturnPpm: v.EwmaAbsReturnPpm,
+		EwmaReturnPpm:    v.EwmaReturnPpm,
+		NumReturns:       v.NumReturns,
+	}
+	if v.LastPrice == 0 || v.LastExponent != marketPrice.Exponent {
+		return updated
+	}
+	updated.NumReturns++
+
+	// return = (price - last_price) / last_price
+	returnPpm := new(big.Int).Sub(lib.BigU(marketPrice.Price), lib.BigU(v.LastPrice))
+	returnPpm.Mul(returnPpm, lib.BigIntOneMillion())
+	returnPpm.Quo(returnPpm, lib.BigU(v.LastPrice))
+	absReturnPpm := new(big.Int).Abs(returnPpm)
+
+	// drift = alpha * return + (1 - alpha) * drift
+	driftPpm := returnPpm.Mul(returnPpm, lib.BigU(uint32(VolatilityEwmaAlphaPpm)))
+	driftPpm.Add(
+		driftPpm,
+		new(big.Int).Mul(big.NewInt(v.EwmaReturnPpm), lib.BigU(uint32(1_000_000-VolatilityEwmaAlphaPpm))),
+	)
+	driftPpm.Quo(driftPpm, lib.BigIntOneMillion())
+	if driftPpm.IsInt64() {
+		updated.EwmaReturnPpm = driftPpm.Int64()
+	}
+
+	// ewma = alpha * abs_return + (1 - alpha) * ewma
+	ewmaPpm := absReturnPpm.Mul(absReturnPpm, lib.BigU(uint32(VolatilityEwmaAlphaPpm)))
+	ewmaPpm.Add(
+		ewmaPpm,
+		new(big.Int).Mul(lib.BigU(v.EwmaAbsReturnPpm), lib.BigU(uint32(1_000_000-VolatilityEwmaAlphaPpm))),
+	)
+	ewmaPpm.Quo(ewmaPpm, lib.BigIntOneMillion())
+	if ewmaPpm.IsUint64() {
+		updated.EwmaAbsReturnPpm = ewmaPpm.Uint64()
+	}
+
+	return updated
+}
+
+// HasSufficientData returns whether at least one per-block return has been folded into the
+// EWMAs, i.e. whether volatility reflects observed price movements.
+func (v MarketVolatility) HasSufficientData() bool {
+	return v.NumReturns > 0
+}
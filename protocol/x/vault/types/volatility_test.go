@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketVolatility_Update(t *testing.T) {
+	tests := map[string]struct {
+		volatility         types.MarketVolatility
+		marketPrice        pricestypes.MarketPrice
+		expectedVolatility types.MarketVolatility
+	}{
+		"No last price: only last price is set": {
+			volatility: types.MarketVolatility{},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+			expectedVolatility: types.MarketVolatility{
+				LastPrice:    5_000_000,
+				LastExponent: -5,
+			},
+		},
+		"Price up 1% from zero volatility": {
+			volatility: types.MarketVolatility{
+				LastPrice:    5_000_000,
+				LastExponent: -5,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_050_000,
+				Exponent: -5,
+			},
+			expectedVolatility: types.MarketVolatility{
+				LastPrice:    5_050_000,
+				LastExponent: -5,
+				// 10% * 10_000 + 90% * 0
+				EwmaAbsReturnPpm: 1_000,
+				EwmaReturnPpm:    1_000,
+				NumReturns:       1,
+			},
+		},
+		"Price down 2% with existing volatility": {
+			volatility: types.MarketVolatility{
+				LastPrice:        5_000_000,
+				LastExponent:     -5,
+				EwmaAbsReturnPpm: 1_000,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    4_900_000,
+				Exponent: -5,
+			},
+			expectedVolatility: types.MarketVolatility{
+				LastPrice:    4_900_000,
+				LastExponent: -5,
+				// 10% * 20_000 + 90% * 1_000
+				EwmaAbsReturnPpm: 2_900,
+				// 10% * -20_000 + 90% * 0
+				EwmaReturnPpm: -2_000,
+				NumReturns:    1,
+			},
+		},
+		"Unchanged price decays volatility": {
+			volatility: types.MarketVolatility{
+				LastPrice:        5_000_000,
+				LastExponent:     -5,
+				EwmaAbsReturnPpm: 1_000,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+			expectedVolatility: types.MarketVolatility{
+				LastPrice:        5_000_000,
+				LastExponent:     -5,
+				EwmaAbsReturnPpm: 900,
+				NumReturns:       1,
+			},
+		},
+		"Unchanged price decays drift": {
+			volatility: types.MarketVolatility{
+				LastPrice:        5_000_000,
+				LastExponent:     -5,
+				EwmaAbsReturnPpm: 2_000,
+				EwmaReturnPpm:    -2_000,
+				NumReturns:       3,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    5_000_000,
+				Exponent: -5,
+			},
+			expectedVolatility: types.MarketVolatility{
+				LastPrice:        5_000_000,
+				LastExponent:     -5,
+				EwmaAbsReturnPpm: 1_800,
+				EwmaReturnPpm:    -1_800,
+				NumReturns:       4,
+			},
+		},
+		"Exponent changed: only last price is updated": {
+			volatility: types.MarketVolatility{
+				LastPrice:        5_000_000,
+				LastExponent:     -5,
+				EwmaAbsReturnPpm: 1_000,
+			},
+			marketPrice: pricestypes.MarketPrice{
+				Price:    50_000_000,
+				Exponent: -6,
+			},
+			expectedVolatility: types.MarketVolatility{
+				LastPrice:        50_000_000,
+				LastExponent:     -6,
+				EwmaAbsReturnPpm: 1_000,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedVolatility, tc.volatility.Update(tc.marketPrice))
+		})
+	}
+}